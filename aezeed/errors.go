@@ -16,6 +16,26 @@ var (
 	// the wrong mnemonic.
 	ErrIncorrectMnemonic = fmt.Errorf("mnemonic phrase checksum doesn't " +
 		"match")
+
+	// ErrInvalidShareParams is returned if the user attempts to split a
+	// mnemonic using a threshold smaller than two, a threshold larger
+	// than the number of shares, or more than MaxSeedShares shares.
+	ErrInvalidShareParams = fmt.Errorf("threshold must be at least 2 "+
+		"and no larger than the number of shares, which must be at "+
+		"most %v", MaxSeedShares)
+
+	// ErrInvalidShare is returned if a share mnemonic passes its checksum,
+	// but encodes an invalid index or threshold.
+	ErrInvalidShare = fmt.Errorf("invalid seed share")
+
+	// ErrShareMismatch is returned if the shares being combined don't
+	// stem from the same split.
+	ErrShareMismatch = fmt.Errorf("seed shares belong to different sets")
+
+	// ErrNotEnoughShares is returned if fewer distinct shares than the
+	// threshold are passed when attempting to combine shares.
+	ErrNotEnoughShares = fmt.Errorf("not enough seed shares to recover " +
+		"the seed")
 )
 
 // ErrUnknownMnenomicWord is returned when attempting to decipher and
@@ -0,0 +1,355 @@
+package aezeed
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"hash/crc32"
+
+	"github.com/kkdai/bstream"
+)
+
+const (
+	// SeedShareVersion is the current version of the seed share scheme as
+	// defined in this package. In this version, the fully enciphered
+	// cipher seed (including its version, salt and checksum) is split
+	// byte-wise into M-of-N shares using Shamir's secret sharing over
+	// GF(2^8). The final encoded share is:
+	//
+	//  * 1 byte version || 2 byte set id || 1 byte threshold+index ||
+	//    33 byte share data || 4 byte checksum
+	//
+	// The set id is chosen at random when splitting, and ensures that
+	// shares of different splits aren't combined by accident. The
+	// threshold and index are packed into the upper and lower 4 bits of a
+	// single byte respectively.
+	SeedShareVersion uint8 = 0
+
+	// EncodedSeedShareSize is the size of a fully encoded seed share.
+	EncodedSeedShareSize = 1 + 2 + 1 + EncipheredCipherSeedSize +
+		checkSumSize
+
+	// NumShareMnemonicWords is the number of words that an encoded seed
+	// share will result in. The 41 encoded bytes (328 bits) are padded
+	// with two zero bits to fit 30 words.
+	NumShareMnemonicWords = 30
+
+	// MaxSeedShares is the maximum number of shares a cipher seed can be
+	// split into, as governed by the 4 bits available to encode the share
+	// index.
+	MaxSeedShares = 15
+
+	// shareIDOffset is the index within an encoded share that marks the
+	// start of the set id.
+	shareIDOffset = 1
+
+	// shareParamsOffset is the index within an encoded share of the byte
+	// holding the threshold and index.
+	shareParamsOffset = 3
+
+	// shareDataOffset is the index within an encoded share that marks the
+	// start of the share data.
+	shareDataOffset = 4
+
+	// shareCheckSumOffset is the index within an encoded share that marks
+	// the start of the checksum.
+	shareCheckSumOffset = EncodedSeedShareSize - checkSumSize
+
+	// gfPoly is the irreducible polynomial x^8 + x^4 + x^3 + x + 1 used to
+	// construct GF(2^8).
+	gfPoly = 0x11b
+)
+
+var (
+	// gfExp and gfLog are the exponent and logarithm tables of GF(2^8)
+	// with generator 3. The exponent table is doubled in size so the sum
+	// of two logarithms can be used to index into it directly.
+	gfExp [510]byte
+	gfLog [256]byte
+)
+
+func init() {
+	x := 1
+	for i := 0; i < 255; i++ {
+		gfExp[i] = byte(x)
+		gfExp[i+255] = byte(x)
+		gfLog[x] = byte(i)
+
+		// Multiply by the generator 3, i.e. x*2 + x, reducing by our
+		// polynomial if needed.
+		x2 := x << 1
+		if x2&0x100 != 0 {
+			x2 ^= gfPoly
+		}
+		x = x2 ^ x
+	}
+}
+
+// gfMul multiplies two elements of GF(2^8).
+func gfMul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+
+	return gfExp[int(gfLog[a])+int(gfLog[b])]
+}
+
+// gfDiv divides a by b in GF(2^8). b MUST be non-zero.
+func gfDiv(a, b byte) byte {
+	if a == 0 {
+		return 0
+	}
+
+	return gfExp[int(gfLog[a])+255-int(gfLog[b])]
+}
+
+// SeedShare is a single share of an enciphered cipher seed that has been
+// split using Shamir's secret sharing. Any Threshold shares of the same set
+// can be combined to recover the original enciphered cipher seed, while fewer
+// shares reveal nothing about it.
+type SeedShare struct {
+	// Version is the version of the share scheme.
+	Version uint8
+
+	// ID identifies the set of shares that resulted from a single split.
+	ID uint16
+
+	// Threshold is the number of shares required to recover the seed.
+	Threshold uint8
+
+	// Index is the index of this share within the set, starting at 1.
+	Index uint8
+
+	// Data is the share of the enciphered cipher seed.
+	Data [EncipheredCipherSeedSize]byte
+}
+
+// encode serializes the share, including its checksum.
+func (s *SeedShare) encode() [EncodedSeedShareSize]byte {
+	var b [EncodedSeedShareSize]byte
+	b[0] = s.Version
+	binary.BigEndian.PutUint16(b[shareIDOffset:], s.ID)
+	b[shareParamsOffset] = s.Threshold<<4 | s.Index
+	copy(b[shareDataOffset:shareCheckSumOffset], s.Data[:])
+
+	checkSum := crc32.Checksum(b[:shareCheckSumOffset], crcTable)
+	binary.BigEndian.PutUint32(b[shareCheckSumOffset:], checkSum)
+
+	return b
+}
+
+// decodeSeedShare parses a serialized share, verifying its checksum and
+// version.
+func decodeSeedShare(b [EncodedSeedShareSize]byte) (*SeedShare, error) {
+	checkSum := crc32.Checksum(b[:shareCheckSumOffset], crcTable)
+	if checkSum != binary.BigEndian.Uint32(b[shareCheckSumOffset:]) {
+		return nil, ErrIncorrectMnemonic
+	}
+
+	if b[0] != SeedShareVersion {
+		return nil, ErrIncorrectVersion
+	}
+
+	s := &SeedShare{
+		Version:   b[0],
+		ID:        binary.BigEndian.Uint16(b[shareIDOffset:]),
+		Threshold: b[shareParamsOffset] >> 4,
+		Index:     b[shareParamsOffset] & 0x0f,
+	}
+	copy(s.Data[:], b[shareDataOffset:shareCheckSumOffset])
+
+	if s.Index == 0 || s.Threshold < 2 {
+		return nil, ErrInvalidShare
+	}
+
+	return s, nil
+}
+
+// ToMnemonic maps the share to a human readable 30-word mnemonic phrase.
+func (s *SeedShare) ToMnemonic() (ShareMnemonic, error) {
+	var words ShareMnemonic
+
+	// We'll pad the encoded share with an extra byte, such that the
+	// final word can be read in full.
+	var padded [EncodedSeedShareSize + 1]byte
+	encoded := s.encode()
+	copy(padded[:], encoded[:])
+
+	shareBits := bstream.NewBStreamReader(padded[:])
+	for i := 0; i < NumShareMnemonicWords; i++ {
+		index, err := shareBits.ReadBits(bitsPerWord)
+		if err != nil {
+			return words, err
+		}
+
+		words[i] = defaultWordList[index]
+	}
+
+	return words, nil
+}
+
+// ShareMnemonic is a 30-word passphrase that encodes a single SeedShare.
+type ShareMnemonic [NumShareMnemonicWords]string
+
+// ToSeedShare maps the mnemonic back to the share it encodes, verifying its
+// checksum.
+func (m *ShareMnemonic) ToSeedShare() (*SeedShare, error) {
+	shareBits := bstream.NewBStreamWriter(EncodedSeedShareSize + 1)
+	for _, word := range m {
+		index, ok := reverseWordMap[word]
+		if !ok {
+			return nil, ErrUnknownMnenomicWord{word}
+		}
+
+		shareBits.WriteBits(uint64(index), bitsPerWord)
+	}
+
+	var encoded [EncodedSeedShareSize]byte
+	copy(encoded[:], shareBits.Bytes())
+
+	return decodeSeedShare(encoded)
+}
+
+// Split splits the enciphered cipher seed encoded by the mnemonic into
+// numShares shares, any threshold of which can be combined to recover the
+// mnemonic using CombineShares. As the enciphered seed is split, the
+// passphrase of the mnemonic is still required to decipher the recovered
+// seed.
+func (m *Mnemonic) Split(threshold, numShares uint8) ([]ShareMnemonic, error) {
+	if threshold < 2 || threshold > numShares ||
+		numShares > MaxSeedShares {
+
+		return nil, ErrInvalidShareParams
+	}
+
+	// Before splitting, we'll make sure the mnemonic itself is valid, so
+	// we don't hand out shares that can never be deciphered.
+	for _, word := range m {
+		if _, ok := reverseWordMap[word]; !ok {
+			return nil, ErrUnknownMnenomicWord{word}
+		}
+	}
+	cipherText := mnemonicToCipherText(m)
+	checkSum := crc32.Checksum(cipherText[:checkSumOffset], crcTable)
+	if checkSum != binary.BigEndian.Uint32(cipherText[checkSumOffset:]) {
+		return nil, ErrIncorrectMnemonic
+	}
+
+	var id [2]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return nil, err
+	}
+
+	shares := make([]*SeedShare, numShares)
+	for i := range shares {
+		shares[i] = &SeedShare{
+			Version:   SeedShareVersion,
+			ID:        binary.BigEndian.Uint16(id[:]),
+			Threshold: threshold,
+			Index:     uint8(i + 1),
+		}
+	}
+
+	// For each byte of the enciphered seed, we'll create a random
+	// polynomial of degree threshold-1 with the byte as its constant
+	// term, and evaluate it at the index of each share.
+	coeffs := make([]byte, threshold)
+	for i, secret := range cipherText {
+		coeffs[0] = secret
+		if _, err := rand.Read(coeffs[1:]); err != nil {
+			return nil, err
+		}
+
+		for _, share := range shares {
+			// Evaluate the polynomial using Horner's method.
+			var y byte
+			for j := len(coeffs) - 1; j >= 0; j-- {
+				y = gfMul(y, share.Index) ^ coeffs[j]
+			}
+			share.Data[i] = y
+		}
+	}
+
+	mnemonics := make([]ShareMnemonic, numShares)
+	for i, share := range shares {
+		mnemonic, err := share.ToMnemonic()
+		if err != nil {
+			return nil, err
+		}
+		mnemonics[i] = mnemonic
+	}
+
+	return mnemonics, nil
+}
+
+// CombineShares recovers the cipher seed mnemonic from a set of share
+// mnemonics created by Mnemonic.Split. At least as many distinct shares of the
+// same set as the threshold used when splitting must be passed.
+func CombineShares(mnemonics []ShareMnemonic) (Mnemonic, error) {
+	var mnemonic Mnemonic
+
+	if len(mnemonics) == 0 {
+		return mnemonic, ErrNotEnoughShares
+	}
+
+	var shares []*SeedShare
+	seen := make(map[uint8]struct{})
+	for i := range mnemonics {
+		share, err := mnemonics[i].ToSeedShare()
+		if err != nil {
+			return mnemonic, err
+		}
+
+		// All shares must stem from the same split.
+		if len(shares) > 0 && (share.ID != shares[0].ID ||
+			share.Threshold != shares[0].Threshold) {
+
+			return mnemonic, ErrShareMismatch
+		}
+
+		// We'll ignore duplicates of shares we've already seen.
+		if _, ok := seen[share.Index]; ok {
+			continue
+		}
+		seen[share.Index] = struct{}{}
+
+		shares = append(shares, share)
+	}
+
+	threshold := int(shares[0].Threshold)
+	if len(shares) < threshold {
+		return mnemonic, ErrNotEnoughShares
+	}
+	shares = shares[:threshold]
+
+	// Using Lagrange interpolation, we'll now recover the constant term of
+	// each polynomial, which is the original byte of the enciphered seed.
+	var cipherText [EncipheredCipherSeedSize]byte
+	for i := range cipherText {
+		var secret byte
+		for j, share := range shares {
+			basis := byte(1)
+			for k, other := range shares {
+				if j == k {
+					continue
+				}
+
+				basis = gfMul(basis, gfDiv(
+					other.Index, other.Index^share.Index,
+				))
+			}
+
+			secret ^= gfMul(share.Data[i], basis)
+		}
+
+		cipherText[i] = secret
+	}
+
+	// Finally, we'll make sure the recovered seed has a valid checksum
+	// before converting it back to a mnemonic.
+	checkSum := crc32.Checksum(cipherText[:checkSumOffset], crcTable)
+	if checkSum != binary.BigEndian.Uint32(cipherText[checkSumOffset:]) {
+		return mnemonic, ErrIncorrectMnemonic
+	}
+
+	return cipherTextToMnemonic(cipherText)
+}
@@ -0,0 +1,209 @@
+package aezeed
+
+import (
+	"testing"
+	"time"
+)
+
+// newTestMnemonic creates a fresh cipher seed and returns its mnemonic
+// enciphered under the passed passphrase.
+func newTestMnemonic(t *testing.T, pass []byte) (*CipherSeed, Mnemonic) {
+	t.Helper()
+
+	cipherSeed, err := New(0, &testEntropy, time.Now())
+	if err != nil {
+		t.Fatalf("unable to create seed: %v", err)
+	}
+
+	mnemonic, err := cipherSeed.ToMnemonic(pass)
+	if err != nil {
+		t.Fatalf("unable to create mnemonic: %v", err)
+	}
+
+	return cipherSeed, mnemonic
+}
+
+// TestGFArithmetic ensures that division in GF(2^8) is the inverse of
+// multiplication for all non-zero elements.
+func TestGFArithmetic(t *testing.T) {
+	t.Parallel()
+
+	for a := 0; a < 256; a++ {
+		for b := 1; b < 256; b++ {
+			product := gfMul(byte(a), byte(b))
+			if gfDiv(product, byte(b)) != byte(a) {
+				t.Fatalf("(%v * %v) / %v != %v", a, b, b, a)
+			}
+		}
+	}
+}
+
+// TestSplitCombineShares tests that any subset of shares of at least the
+// threshold size recovers the original mnemonic, and that the recovered
+// mnemonic deciphers to the original seed.
+func TestSplitCombineShares(t *testing.T) {
+	t.Parallel()
+
+	pass := []byte("test")
+	cipherSeed, mnemonic := newTestMnemonic(t, pass)
+
+	testCases := []struct {
+		threshold uint8
+		numShares uint8
+	}{
+		{2, 2},
+		{2, 3},
+		{3, 5},
+		{5, 5},
+		{7, MaxSeedShares},
+	}
+
+	for _, test := range testCases {
+		shares, err := mnemonic.Split(test.threshold, test.numShares)
+		if err != nil {
+			t.Fatalf("unable to split mnemonic: %v", err)
+		}
+		if len(shares) != int(test.numShares) {
+			t.Fatalf("expected %v shares, got %v", test.numShares,
+				len(shares))
+		}
+
+		// Any window of threshold consecutive shares should recover
+		// the original mnemonic.
+		for i := 0; i+int(test.threshold) <= len(shares); i++ {
+			subset := shares[i : i+int(test.threshold)]
+			recovered, err := CombineShares(subset)
+			if err != nil {
+				t.Fatalf("unable to combine shares: %v", err)
+			}
+			if recovered != mnemonic {
+				t.Fatalf("recovered mnemonic mismatch: "+
+					"expected %v, got %v", mnemonic,
+					recovered)
+			}
+		}
+
+		// With one share less than the threshold, combining should
+		// fail.
+		_, err = CombineShares(shares[:test.threshold-1])
+		if err != ErrNotEnoughShares {
+			t.Fatalf("expected ErrNotEnoughShares, got %v", err)
+		}
+
+		// Duplicate shares shouldn't count towards the threshold.
+		dupes := make([]ShareMnemonic, test.threshold)
+		for i := range dupes {
+			dupes[i] = shares[0]
+		}
+		_, err = CombineShares(dupes)
+		if err != ErrNotEnoughShares {
+			t.Fatalf("expected ErrNotEnoughShares, got %v", err)
+		}
+	}
+
+	// Finally, the recovered mnemonic should still decipher to the
+	// original seed under the original passphrase.
+	shares, err := mnemonic.Split(2, 3)
+	if err != nil {
+		t.Fatalf("unable to split mnemonic: %v", err)
+	}
+	recovered, err := CombineShares(shares[1:])
+	if err != nil {
+		t.Fatalf("unable to combine shares: %v", err)
+	}
+	recoveredSeed, err := recovered.ToCipherSeed(pass)
+	if err != nil {
+		t.Fatalf("unable to decipher recovered mnemonic: %v", err)
+	}
+	if recoveredSeed.Entropy != cipherSeed.Entropy {
+		t.Fatalf("recovered entropy mismatch")
+	}
+}
+
+// TestSplitInvalidParams ensures that invalid thresholds and share counts are
+// rejected.
+func TestSplitInvalidParams(t *testing.T) {
+	t.Parallel()
+
+	_, mnemonic := newTestMnemonic(t, nil)
+
+	testCases := []struct {
+		threshold uint8
+		numShares uint8
+	}{
+		{0, 3},
+		{1, 3},
+		{4, 3},
+		{2, MaxSeedShares + 1},
+	}
+
+	for _, test := range testCases {
+		_, err := mnemonic.Split(test.threshold, test.numShares)
+		if err != ErrInvalidShareParams {
+			t.Fatalf("expected ErrInvalidShareParams for %v-of-%v, "+
+				"got %v", test.threshold, test.numShares, err)
+		}
+	}
+}
+
+// TestCombineMismatchedShares ensures that shares of different splits can't
+// be combined.
+func TestCombineMismatchedShares(t *testing.T) {
+	t.Parallel()
+
+	_, mnemonic := newTestMnemonic(t, nil)
+
+	shares1, err := mnemonic.Split(2, 3)
+	if err != nil {
+		t.Fatalf("unable to split mnemonic: %v", err)
+	}
+
+	// Keep splitting until we obtain a set with a different id.
+	var shares2 []ShareMnemonic
+	for {
+		shares2, err = mnemonic.Split(2, 3)
+		if err != nil {
+			t.Fatalf("unable to split mnemonic: %v", err)
+		}
+
+		share1, _ := shares1[0].ToSeedShare()
+		share2, _ := shares2[0].ToSeedShare()
+		if share1.ID != share2.ID {
+			break
+		}
+	}
+
+	_, err = CombineShares([]ShareMnemonic{shares1[0], shares2[1]})
+	if err != ErrShareMismatch {
+		t.Fatalf("expected ErrShareMismatch, got %v", err)
+	}
+}
+
+// TestShareMnemonicChecksum ensures that a share mnemonic with a swapped word
+// fails its checksum, and unknown words are rejected.
+func TestShareMnemonicChecksum(t *testing.T) {
+	t.Parallel()
+
+	_, mnemonic := newTestMnemonic(t, nil)
+
+	shares, err := mnemonic.Split(2, 2)
+	if err != nil {
+		t.Fatalf("unable to split mnemonic: %v", err)
+	}
+
+	share := shares[0]
+	share[0], share[1] = share[1], share[0]
+	if share[0] == share[1] {
+		t.Skip("swapped words are identical")
+	}
+	if _, err := share.ToSeedShare(); err != ErrIncorrectMnemonic {
+		t.Fatalf("expected ErrIncorrectMnemonic, got %v", err)
+	}
+
+	share = shares[0]
+	share[3] = "kek"
+	_, err = share.ToSeedShare()
+	if _, ok := err.(ErrUnknownMnenomicWord); !ok {
+		t.Fatalf("expected ErrUnknownMnenomicWord, got %v", err)
+	}
+}
@@ -59,6 +59,11 @@ type Config struct {
 	// when opening channels.
 	Constraints AgentConstraints
 
+	// ReportDecision is an optional closure that will be called for each
+	// decision the agent makes, allowing external callers to observe the
+	// agent's decision log.
+	ReportDecision func(*Decision)
+
 	// TODO(roasbeef): add additional signals from fee rates and revenue of
 	// currently opened channels
 }
@@ -323,31 +328,6 @@ func (a *Agent) OnChannelClose(closedChans ...lnwire.ShortChannelID) {
 	}()
 }
 
-// mergeNodeMaps merges the Agent's set of nodes that it already has active
-// channels open to, with the other sets of nodes that should be removed from
-// consideration during heuristic selection. This ensures that the Agent doesn't
-// attempt to open any "duplicate" channels to the same node.
-func mergeNodeMaps(c map[NodeID]Channel,
-	skips ...map[NodeID]struct{}) map[NodeID]struct{} {
-
-	numNodes := len(c)
-	for _, skip := range skips {
-		numNodes += len(skip)
-	}
-
-	res := make(map[NodeID]struct{}, len(c)+numNodes)
-	for nodeID := range c {
-		res[nodeID] = struct{}{}
-	}
-	for _, skip := range skips {
-		for nodeID := range skip {
-			res[nodeID] = struct{}{}
-		}
-	}
-
-	return res
-}
-
 // mergeChanState merges the Agent's set of active channels, with the set of
 // channels awaiting confirmation. This ensures that the agent doesn't go over
 // the prescribed channel limit or fund allocation limit.
@@ -490,15 +470,13 @@ func (a *Agent) controller() {
 		availableFunds, numChans := a.cfg.Constraints.ChannelBudget(
 			totalChans, a.totalBalance,
 		)
-		switch {
-		case numChans == 0:
-			continue
-
-		// If the amount is too small, we don't want to attempt opening
-		// another channel.
-		case availableFunds == 0:
-			continue
-		case availableFunds < a.cfg.Constraints.MinChanSize():
+		reason := budgetSkipReason(
+			a.cfg.Constraints, availableFunds, numChans,
+		)
+		if reason != "" {
+			a.reportDecision(newDecision(
+				DecisionSkip, NodeID{}, reason,
+			))
 			continue
 		}
 
@@ -512,10 +490,39 @@ func (a *Agent) controller() {
 	}
 }
 
-// openChans queries the agent's heuristic for a set of channel candidates, and
-// attempts to open channels to them.
-func (a *Agent) openChans(availableFunds btcutil.Amount, numChans uint32,
-	totalChans []Channel) error {
+// reportDecision hands the passed decision to the ReportDecision closure of
+// the agent's config, if one is set.
+func (a *Agent) reportDecision(d *Decision) {
+	log.Debugf("Autopilot decision: type=%v, node=%x, amt=%v, "+
+		"score=%v, reason=%v", d.Type, d.NodeID[:], d.ChanAmt,
+		d.Score, d.Reason)
+
+	if a.cfg.ReportDecision != nil {
+		a.cfg.ReportDecision(d)
+	}
+}
+
+// chanCandidates is the result of a single round of candidate selection. It
+// contains the set of attachment directives the agent should execute, in
+// addition to decisions detailing which nodes were chosen and which were
+// skipped.
+type chanCandidates struct {
+	// directives maps the nodes chosen by the heuristic to the channel
+	// that should be opened to them.
+	directives map[NodeID]*AttachmentDirective
+
+	// opens holds an open decision for each of the directives.
+	opens map[NodeID]*Decision
+
+	// skipped holds the decisions for nodes that were considered, but
+	// not chosen.
+	skipped []*Decision
+}
+
+// selectChanCandidates queries the agent's heuristic for a set of channel
+// candidates given the current budget, without executing any of them.
+func (a *Agent) selectChanCandidates(availableFunds btcutil.Amount,
+	numChans uint32, totalChans []Channel) (*chanCandidates, error) {
 
 	// We're to attempt an attachment so we'll obtain the set of
 	// nodes that we currently have channels with so we avoid
@@ -524,12 +531,30 @@ func (a *Agent) openChans(availableFunds btcutil.Amount, numChans uint32,
 	connectedNodes := a.chanState.ConnectedNodes()
 	a.chanStateMtx.Unlock()
 
+	// We'll also record why each of the nodes we want to skip should be
+	// skipped, such that this can be reported in the decision log.
+	skipReasons := make(map[NodeID]string)
+	for nID := range connectedNodes {
+		skipReasons[nID] = "channel already open"
+	}
+
 	a.pendingMtx.Lock()
-	nodesToSkip := mergeNodeMaps(a.pendingOpens,
-		a.pendingConns, connectedNodes, a.failedNodes,
-	)
+	for nID := range a.pendingOpens {
+		skipReasons[nID] = "channel open pending"
+	}
+	for nID := range a.pendingConns {
+		skipReasons[nID] = "connection attempt pending"
+	}
+	for nID := range a.failedNodes {
+		skipReasons[nID] = "previous attempt failed"
+	}
 	a.pendingMtx.Unlock()
 
+	candidates := &chanCandidates{
+		directives: make(map[NodeID]*AttachmentDirective),
+		opens:      make(map[NodeID]*Decision),
+	}
+
 	// Gather the set of all nodes in the graph, except those we
 	// want to skip.
 	selfPubBytes := a.cfg.Self.SerializeCompressed()
@@ -555,14 +580,18 @@ func (a *Agent) openChans(availableFunds btcutil.Amount, numChans uint32,
 
 		// Additionally, if this node is in the blacklist, then
 		// we'll skip it.
-		if _, ok := nodesToSkip[nID]; ok {
+		if reason, ok := skipReasons[nID]; ok {
+			candidates.skipped = append(
+				candidates.skipped,
+				newDecision(DecisionSkip, nID, reason),
+			)
 			return nil
 		}
 
 		nodes[nID] = struct{}{}
 		return nil
 	}); err != nil {
-		return fmt.Errorf("unable to get graph nodes: %v", err)
+		return nil, fmt.Errorf("unable to get graph nodes: %v", err)
 	}
 
 	// As channel size we'll use the maximum channel size available.
@@ -572,7 +601,7 @@ func (a *Agent) openChans(availableFunds btcutil.Amount, numChans uint32,
 	}
 
 	if chanSize < a.cfg.Constraints.MinChanSize() {
-		return fmt.Errorf("not enough funds available to open a " +
+		return nil, fmt.Errorf("not enough funds available to open a " +
 			"single channel")
 	}
 
@@ -582,7 +611,8 @@ func (a *Agent) openChans(availableFunds btcutil.Amount, numChans uint32,
 		a.cfg.Graph, totalChans, chanSize, nodes,
 	)
 	if err != nil {
-		return fmt.Errorf("unable to calculate node scores : %v", err)
+		return nil, fmt.Errorf("unable to calculate node scores : %v",
+			err)
 	}
 
 	log.Debugf("Got scores for %d nodes", len(scores))
@@ -591,12 +621,11 @@ func (a *Agent) openChans(availableFunds btcutil.Amount, numChans uint32,
 	// to open channels to.
 	scores, err = chooseN(numChans, scores)
 	if err != nil {
-		return fmt.Errorf("Unable to make weighted choice: %v",
+		return nil, fmt.Errorf("Unable to make weighted choice: %v",
 			err)
 	}
 
-	chanCandidates := make(map[NodeID]*AttachmentDirective)
-	for nID := range scores {
+	for nID, score := range scores {
 		// Add addresses to the candidates.
 		addrs := addresses[nID]
 
@@ -612,18 +641,60 @@ func (a *Agent) openChans(availableFunds btcutil.Amount, numChans uint32,
 		}
 		availableFunds -= chanSize
 
-		// If we run out of funds, we can break early.
+		// If we run out of funds, we won't be able to open channels
+		// to the remaining nodes.
 		if chanSize < a.cfg.Constraints.MinChanSize() {
-			break
+			skip := newDecision(
+				DecisionSkip, nID, "insufficient funds left",
+			)
+			skip.Score = score.Score
+			candidates.skipped = append(candidates.skipped, skip)
+			continue
 		}
 
-		chanCandidates[nID] = &AttachmentDirective{
+		candidates.directives[nID] = &AttachmentDirective{
 			NodeID:  nID,
 			ChanAmt: chanSize,
 			Addrs:   addrs,
 		}
+
+		open := newDecision(
+			DecisionOpen, nID, "chosen by heuristic "+
+				a.cfg.Heuristic.Name(),
+		)
+		open.ChanAmt = chanSize
+		open.Score = score.Score
+		candidates.opens[nID] = open
 	}
 
+	return candidates, nil
+}
+
+// maxPendingOpensReached returns true if we've reached the maximum number of
+// channels that can be pending open at the same time.
+//
+// NOTE: Must be called with the pendingMtx held.
+func (a *Agent) maxPendingOpensReached() bool {
+	return uint16(len(a.pendingOpens)) >= a.cfg.Constraints.MaxPendingOpens()
+}
+
+// openChans queries the agent's heuristic for a set of channel candidates, and
+// attempts to open channels to them.
+func (a *Agent) openChans(availableFunds btcutil.Amount, numChans uint32,
+	totalChans []Channel) error {
+
+	candidates, err := a.selectChanCandidates(
+		availableFunds, numChans, totalChans,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, skip := range candidates.skipped {
+		a.reportDecision(skip)
+	}
+
+	chanCandidates := candidates.directives
 	if len(chanCandidates) == 0 {
 		log.Infof("No eligible candidates to connect to")
 		return nil
@@ -640,11 +711,15 @@ func (a *Agent) openChans(availableFunds btcutil.Amount, numChans uint32,
 	// available to future heuristic selections.
 	a.pendingMtx.Lock()
 	defer a.pendingMtx.Unlock()
-	if uint16(len(a.pendingOpens)) >= a.cfg.Constraints.MaxPendingOpens() {
+	if a.maxPendingOpensReached() {
 		log.Debugf("Reached cap of %v pending "+
 			"channel opens, will retry "+
 			"after success/failure",
 			a.cfg.Constraints.MaxPendingOpens())
+
+		a.reportDecision(newDecision(
+			DecisionSkip, NodeID{}, "max pending opens reached",
+		))
 		return nil
 	}
 
@@ -661,12 +736,90 @@ func (a *Agent) openChans(availableFunds btcutil.Amount, numChans uint32,
 		}
 		a.pendingConns[nodeID] = struct{}{}
 
+		a.reportDecision(candidates.opens[nodeID])
+
 		a.wg.Add(1)
 		go a.executeDirective(*chanCandidate)
 	}
 	return nil
 }
 
+// DryRun runs the agent's heuristics and constraints against the current
+// channel graph and wallet balance, and returns the decisions the agent would
+// make. None of the proposed channel opens are executed.
+func (a *Agent) DryRun() (*DryRunResult, error) {
+	balance, err := a.cfg.WalletBalance()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch wallet balance: %v",
+			err)
+	}
+
+	a.chanStateMtx.Lock()
+	a.pendingMtx.Lock()
+	totalChans := mergeChanState(a.pendingOpens, a.chanState)
+	pendingCapReached := a.maxPendingOpensReached()
+	a.pendingMtx.Unlock()
+	a.chanStateMtx.Unlock()
+
+	availableFunds, numChans := a.cfg.Constraints.ChannelBudget(
+		totalChans, balance,
+	)
+	result := &DryRunResult{
+		WalletBalance:  balance,
+		AvailableFunds: availableFunds,
+		NumChans:       numChans,
+	}
+
+	reason := budgetSkipReason(a.cfg.Constraints, availableFunds, numChans)
+	if reason != "" {
+		result.Decisions = append(
+			result.Decisions,
+			newDecision(DecisionSkip, NodeID{}, reason),
+		)
+		return result, nil
+	}
+
+	candidates, err := a.selectChanCandidates(
+		availableFunds, numChans, totalChans,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Decisions = append(result.Decisions, candidates.skipped...)
+
+	// If we're already at the cap of pending opens, none of the
+	// directives would be executed right now.
+	if pendingCapReached && len(candidates.opens) > 0 {
+		result.Decisions = append(
+			result.Decisions, newDecision(
+				DecisionSkip, NodeID{},
+				"max pending opens reached",
+			),
+		)
+		return result, nil
+	}
+
+	for _, open := range candidates.opens {
+		result.Decisions = append(result.Decisions, open)
+	}
+
+	return result, nil
+}
+
+// reportOpenFailure reports a failed attempt at executing the given
+// directive to the decision log.
+func (a *Agent) reportOpenFailure(directive AttachmentDirective,
+	reason string, err error) {
+
+	failure := newDecision(
+		DecisionOpenFailed, directive.NodeID,
+		fmt.Sprintf("%v: %v", reason, err),
+	)
+	failure.ChanAmt = directive.ChanAmt
+	a.reportDecision(failure)
+}
+
 // executeDirective attempts to connect to the channel candidate specified by
 // the given attachment directive, and open a channel of the given size.
 //
@@ -728,6 +881,8 @@ func (a *Agent) executeDirective(directive AttachmentDirective) {
 		a.failedNodes[nodeID] = struct{}{}
 		a.pendingMtx.Unlock()
 
+		a.reportOpenFailure(directive, "unable to connect", err)
+
 		// Finally, we'll trigger the agent to select new peers to
 		// connect to.
 		a.OnChannelOpenFailure()
@@ -741,7 +896,7 @@ func (a *Agent) executeDirective(directive AttachmentDirective) {
 	// fewer slots were available, and other successful attempts finished
 	// first.
 	a.pendingMtx.Lock()
-	if uint16(len(a.pendingOpens)) >= a.cfg.Constraints.MaxPendingOpens() {
+	if a.maxPendingOpensReached() {
 		// Since we've reached our max number of pending opens, we'll
 		// disconnect this peer and exit. However, if we were
 		// previously connected to them, then we'll make sure to
@@ -794,6 +949,8 @@ func (a *Agent) executeDirective(directive AttachmentDirective) {
 		a.failedNodes[nodeID] = struct{}{}
 		a.pendingMtx.Unlock()
 
+		a.reportOpenFailure(directive, "unable to open channel", err)
+
 		// Trigger the agent to re-evaluate everything and possibly
 		// retry with a different node.
 		a.OnChannelOpenFailure()
@@ -11,6 +11,7 @@ import (
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/davecgh/go-spew/spew"
 )
 
 type moreChansResp struct {
@@ -1310,3 +1311,147 @@ func TestAgentChannelSizeAllocation(t *testing.T) {
 	// channels.
 	checkChannelOpens(t, testCtx, channelBudget, 2)
 }
+
+// TestAgentDryRun ensures that a dry run reports the channels the agent would
+// open and the nodes it would skip, without opening any channels.
+func TestAgentDryRun(t *testing.T) {
+	t.Parallel()
+
+	self, err := randKey()
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	quit := make(chan struct{})
+	defer close(quit)
+
+	heuristic := &mockHeuristic{
+		nodeScoresResps: make(chan map[NodeID]*NodeScore, 1),
+		quit:            quit,
+	}
+	constraints := &mockConstraints{
+		moreChansResps: make(chan moreChansResp, 1),
+		quit:           quit,
+	}
+	chanController := &mockChanController{
+		openChanSignals: make(chan openChanIntent, 10),
+	}
+	memGraph, _, _ := newMemChanGraph()
+
+	// We'll add three nodes to the graph, one of which we already have a
+	// channel open with.
+	var nodes []NodeID
+	for i := 0; i < 3; i++ {
+		pub, err := memGraph.addRandNode()
+		if err != nil {
+			t.Fatalf("unable to generate key: %v", err)
+		}
+		nodes = append(nodes, NewNodeID(pub))
+	}
+	initialChans := []Channel{{
+		ChanID:   randChanID(),
+		Capacity: btcutil.SatoshiPerBitcoin,
+		Node:     nodes[0],
+	}}
+
+	agent, err := New(Config{
+		Self:           self,
+		Heuristic:      heuristic,
+		ChanController: chanController,
+		WalletBalance: func() (btcutil.Amount, error) {
+			return 10 * btcutil.SatoshiPerBitcoin, nil
+		},
+		ConnectToPeer: func(*btcec.PublicKey, []net.Addr) (bool, error) {
+			return false, nil
+		},
+		DisconnectPeer: func(*btcec.PublicKey) error {
+			return nil
+		},
+		Graph:       memGraph,
+		Constraints: constraints,
+	}, initialChans)
+	if err != nil {
+		t.Fatalf("unable to create agent: %v", err)
+	}
+
+	// First, we'll let the constraints indicate that no more channels are
+	// needed. The dry run should report that the agent would skip opening
+	// channels altogether.
+	constraints.moreChansResps <- moreChansResp{0, 0}
+	result, err := agent.DryRun()
+	if err != nil {
+		t.Fatalf("unable to perform dry run: %v", err)
+	}
+	if len(result.Decisions) != 1 {
+		t.Fatalf("expected 1 decision, got %v", len(result.Decisions))
+	}
+	if result.Decisions[0].Type != DecisionSkip ||
+		result.Decisions[0].HasNode() {
+
+		t.Fatalf("expected budget skip decision, got %v",
+			spew.Sdump(result.Decisions[0]))
+	}
+
+	// Now we'll allow two more channels, and score the two nodes we don't
+	// have a channel with yet.
+	constraints.moreChansResps <- moreChansResp{
+		numMore: 2,
+		amt:     5 * btcutil.SatoshiPerBitcoin,
+	}
+	heuristic.nodeScoresResps <- map[NodeID]*NodeScore{
+		nodes[1]: {NodeID: nodes[1], Score: 0.5},
+		nodes[2]: {NodeID: nodes[2], Score: 0.5},
+	}
+	result, err = agent.DryRun()
+	if err != nil {
+		t.Fatalf("unable to perform dry run: %v", err)
+	}
+	if result.WalletBalance != 10*btcutil.SatoshiPerBitcoin {
+		t.Fatalf("unexpected wallet balance: %v", result.WalletBalance)
+	}
+
+	opens := make(map[NodeID]*Decision)
+	skips := make(map[NodeID]*Decision)
+	for _, d := range result.Decisions {
+		switch d.Type {
+		case DecisionOpen:
+			opens[d.NodeID] = d
+		case DecisionSkip:
+			skips[d.NodeID] = d
+		default:
+			t.Fatalf("unexpected decision: %v", spew.Sdump(d))
+		}
+	}
+
+	if len(opens) != 2 {
+		t.Fatalf("expected 2 open decisions, got %v", len(opens))
+	}
+	for _, nID := range nodes[1:] {
+		open, ok := opens[nID]
+		if !ok {
+			t.Fatalf("expected open decision for %x", nID[:])
+		}
+		if open.ChanAmt != constraints.MaxChanSize() {
+			t.Fatalf("expected chan amt %v, got %v",
+				constraints.MaxChanSize(), open.ChanAmt)
+		}
+		if open.Score != 0.5 {
+			t.Fatalf("expected score 0.5, got %v", open.Score)
+		}
+	}
+
+	if len(skips) != 1 {
+		t.Fatalf("expected 1 skip decision, got %v", len(skips))
+	}
+	if _, ok := skips[nodes[0]]; !ok {
+		t.Fatalf("expected node with open channel to be skipped")
+	}
+
+	// Finally, the dry run should never have attempted to open any
+	// channels.
+	select {
+	case <-chanController.openChanSignals:
+		t.Fatalf("agent unexpectedly opened channel")
+	default:
+	}
+}
@@ -0,0 +1,132 @@
+package autopilot
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+)
+
+// DecisionType denotes the kind of decision the autopilot agent made during
+// one of its control loop iterations.
+type DecisionType uint8
+
+const (
+	// DecisionOpen indicates that the agent decided to open a channel to
+	// the target node.
+	DecisionOpen DecisionType = iota
+
+	// DecisionSkip indicates that the agent decided not to open a channel.
+	// If the NodeID of the decision is set, then only that particular
+	// node was skipped, otherwise the agent skipped opening channels
+	// altogether during this iteration.
+	DecisionSkip
+
+	// DecisionOpenFailed indicates that the agent attempted to carry out
+	// a previous DecisionOpen, but either the connection attempt or the
+	// channel funding flow failed.
+	DecisionOpenFailed
+)
+
+// String returns a human readable version of the decision type.
+func (d DecisionType) String() string {
+	switch d {
+	case DecisionOpen:
+		return "Open"
+	case DecisionSkip:
+		return "Skip"
+	case DecisionOpenFailed:
+		return "OpenFailed"
+	default:
+		return fmt.Sprintf("Unknown(%d)", uint8(d))
+	}
+}
+
+// Decision is a single entry of the autopilot agent's decision log. Each time
+// the agent considers opening channels, it will emit a set of decisions
+// detailing which nodes it chose to open channels to, and which nodes or
+// constraints caused it to refrain from doing so.
+type Decision struct {
+	// Type is the kind of decision that was made.
+	Type DecisionType
+
+	// Timestamp is the time at which the decision was made.
+	Timestamp time.Time
+
+	// NodeID is the node this decision applies to. This will be the zero
+	// value for decisions that don't concern a single node, such as the
+	// agent skipping an iteration because its channel budget is
+	// exhausted.
+	NodeID NodeID
+
+	// ChanAmt is the size of the channel the agent decided to open. Only
+	// set for DecisionOpen and DecisionOpenFailed.
+	ChanAmt btcutil.Amount
+
+	// Score is the score the agent's heuristic assigned to the node, if
+	// the node was scored at all.
+	Score float64
+
+	// Reason is a human readable explanation of the decision.
+	Reason string
+}
+
+// HasNode returns true if the decision concerns a particular node.
+func (d *Decision) HasNode() bool {
+	return d.NodeID != NodeID{}
+}
+
+// newDecision creates a new Decision of the given type, timestamped with the
+// current time.
+func newDecision(t DecisionType, nID NodeID, reason string) *Decision {
+	return &Decision{
+		Type:      t,
+		Timestamp: time.Now(),
+		NodeID:    nID,
+		Reason:    reason,
+	}
+}
+
+// DryRunResult is the outcome of running the agent's heuristics and
+// constraints against the current channel graph and wallet balance, without
+// executing any of the resulting channel opens.
+type DryRunResult struct {
+	// WalletBalance is the wallet balance that was used for the dry run.
+	WalletBalance btcutil.Amount
+
+	// AvailableFunds is the amount of funds the agent's constraints allow
+	// it to commit towards new channels.
+	AvailableFunds btcutil.Amount
+
+	// NumChans is the number of additional channels the agent's
+	// constraints allow it to open.
+	NumChans uint32
+
+	// Decisions is the set of decisions the agent would have made, both
+	// the proposed channel opens and the nodes that were skipped.
+	Decisions []*Decision
+}
+
+// budgetSkipReason returns a human readable reason for why no channels should
+// be opened given the channel budget returned by the passed constraints. An
+// empty string is returned if the budget allows for new channels.
+func budgetSkipReason(constraints AgentConstraints,
+	availableFunds btcutil.Amount, numChans uint32) string {
+
+	switch {
+	case numChans == 0:
+		return "channel limit reached or target allocation met"
+
+	case availableFunds == 0:
+		return "no funds available"
+
+	// If the amount is too small, we don't want to attempt opening
+	// another channel.
+	case availableFunds < constraints.MinChanSize():
+		return fmt.Sprintf("available funds %v below minimum "+
+			"channel size %v", availableFunds,
+			constraints.MinChanSize())
+	}
+
+	return ""
+}
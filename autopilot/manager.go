@@ -9,6 +9,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/subscribe"
 )
 
 // ManagerCfg houses a set of values and methods that is passed to the Manager
@@ -49,6 +50,12 @@ type Manager struct {
 	// disabled.
 	pilot *Agent
 
+	// decisionServer is used to dispatch the decisions made by the
+	// active autopilot agent to any subscribed clients. It outlives the
+	// individual agents, such that subscriptions survive the agent being
+	// disabled and re-enabled.
+	decisionServer *subscribe.Server
+
 	quit chan struct{}
 	wg   sync.WaitGroup
 	sync.Mutex
@@ -57,8 +64,9 @@ type Manager struct {
 // NewManager creates a new instance of the Manager from the passed config.
 func NewManager(cfg *ManagerCfg) (*Manager, error) {
 	return &Manager{
-		cfg:  cfg,
-		quit: make(chan struct{}),
+		cfg:            cfg,
+		decisionServer: subscribe.NewServer(),
+		quit:           make(chan struct{}),
 	}, nil
 }
 
@@ -68,7 +76,7 @@ func (m *Manager) Start() error {
 		return nil
 	}
 
-	return nil
+	return m.decisionServer.Start()
 }
 
 // Stop stops the Manager. If an autopilot agent is active, it will also be
@@ -85,6 +93,10 @@ func (m *Manager) Stop() error {
 	close(m.quit)
 	m.wg.Wait()
 
+	if err := m.decisionServer.Stop(); err != nil {
+		log.Errorf("Unable to stop decision server: %v", err)
+	}
+
 	return nil
 }
 
@@ -115,8 +127,11 @@ func (m *Manager) StartAgent() error {
 	}
 
 	// Now that we have all the initial dependencies, we can create the
-	// auto-pilot instance itself.
-	pilot, err := New(*m.cfg.PilotCfg, initialChanState)
+	// auto-pilot instance itself. We'll make sure all decisions made by
+	// the agent are dispatched to our decision subscribers.
+	pilotCfg := *m.cfg.PilotCfg
+	pilotCfg.ReportDecision = m.reportDecision
+	pilot, err := New(pilotCfg, initialChanState)
 	if err != nil {
 		return err
 	}
@@ -381,3 +396,46 @@ func (m *Manager) SetNodeScores(name string, scores map[NodeID]float64) error {
 
 	return nil
 }
+
+// reportDecision dispatches a decision made by the active agent to all
+// decision subscribers.
+func (m *Manager) reportDecision(d *Decision) {
+	if err := m.decisionServer.SendUpdate(d); err != nil {
+		log.Debugf("Unable to dispatch autopilot decision: %v", err)
+	}
+}
+
+// SubscribeDecisions returns a subscription client that will receive a
+// *Decision for each decision made by the active autopilot agent, including
+// failed attempts at opening channels.
+func (m *Manager) SubscribeDecisions() (*subscribe.Client, error) {
+	return m.decisionServer.Subscribe()
+}
+
+// DryRun runs the autopilot heuristics and constraints against the current
+// channel graph and wallet balance, returning the channels the agent would
+// open, and the nodes it would skip. If the agent is active, its current
+// internal state is taken into account. No channels will be opened.
+func (m *Manager) DryRun() (*DryRunResult, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	if m.pilot != nil {
+		return m.pilot.DryRun()
+	}
+
+	// If the agent isn't active, we'll create a temporary agent from the
+	// current channel state that is never started, and let it compute
+	// its decisions.
+	chanState, err := m.cfg.ChannelState()
+	if err != nil {
+		return nil, err
+	}
+
+	pilot, err := New(*m.cfg.PilotCfg, chanState)
+	if err != nil {
+		return nil, err
+	}
+
+	return pilot.DryRun()
+}
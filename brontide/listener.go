@@ -24,18 +24,36 @@ type Listener struct {
 
 	tcp *net.TCPListener
 
+	// connLimiter, if set, is consulted for each inbound connection before
+	// its handshake is started.
+	connLimiter ConnLimiter
+
 	handshakeSema chan struct{}
 	conns         chan maybeConn
 	quit          chan struct{}
 }
 
+// ListenerOption is a functional option that modifies a Listener upon
+// creation.
+type ListenerOption func(*Listener)
+
+// WithConnLimiter returns a ListenerOption that makes the Listener drop any
+// inbound connection rejected by the passed limiter, before performing its
+// handshake.
+func WithConnLimiter(limiter ConnLimiter) ListenerOption {
+	return func(l *Listener) {
+		l.connLimiter = limiter
+	}
+}
+
 // A compile-time assertion to ensure that Conn meets the net.Listener interface.
 var _ net.Listener = (*Listener)(nil)
 
 // NewListener returns a new net.Listener which enforces the Brontide scheme
 // during both initial connection establishment and data transfer.
-func NewListener(localStatic *btcec.PrivateKey, listenAddr string) (*Listener,
-	error) {
+func NewListener(localStatic *btcec.PrivateKey, listenAddr string,
+	opts ...ListenerOption) (*Listener, error) {
+
 	addr, err := net.ResolveTCPAddr("tcp", listenAddr)
 	if err != nil {
 		return nil, err
@@ -53,6 +71,9 @@ func NewListener(localStatic *btcec.PrivateKey, listenAddr string) (*Listener,
 		conns:         make(chan maybeConn),
 		quit:          make(chan struct{}),
 	}
+	for _, opt := range opts {
+		opt(brontideListener)
+	}
 
 	for i := 0; i < defaultHandshakes; i++ {
 		brontideListener.handshakeSema <- struct{}{}
@@ -83,6 +104,16 @@ func (l *Listener) listen() {
 			continue
 		}
 
+		// If the connection is rejected by our limiter, we'll drop it
+		// before spending any work on the handshake.
+		if l.connLimiter != nil &&
+			!l.connLimiter.AllowConn(conn.RemoteAddr()) {
+
+			conn.Close()
+			l.handshakeSema <- struct{}{}
+			continue
+		}
+
 		go l.doHandshake(conn)
 	}
 }
@@ -0,0 +1,254 @@
+package brontide
+
+import (
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// ipv4SubnetBits is the prefix length of the subnet an IPv4 address is
+	// grouped into when rate limiting by subnet.
+	ipv4SubnetBits = 24
+
+	// ipv6SubnetBits is the prefix length of the subnet an IPv6 address is
+	// grouped into when rate limiting by subnet.
+	ipv6SubnetBits = 48
+
+	// limiterPruneInterval is how often the rate limiter removes the token
+	// buckets of sources it hasn't seen in a while.
+	limiterPruneInterval = time.Minute
+)
+
+// ConnLimiter decides whether an inbound connection should be accepted,
+// before any work is spent on its handshake.
+type ConnLimiter interface {
+	// AllowConn returns true if a connection from the given remote address
+	// should proceed to the handshake.
+	AllowConn(remoteAddr net.Addr) bool
+}
+
+// RateLimiterConfig houses the parameters of a ConnRateLimiter.
+type RateLimiterConfig struct {
+	// IPInterval is the minimum average interval between connections
+	// from a single IP address. A zero interval disables rate limiting by
+	// IP address.
+	IPInterval time.Duration
+
+	// IPBurst is the number of connections a single IP address may make
+	// in quick succession before being rate limited.
+	IPBurst int
+
+	// SubnetInterval is the minimum average interval between connections
+	// from a single subnet. A zero interval disables rate limiting by
+	// subnet.
+	SubnetInterval time.Duration
+
+	// SubnetBurst is the number of connections a single subnet may make
+	// in quick succession before being rate limited.
+	SubnetBurst int
+}
+
+// tokenBucket is the rate limiter of a single connection source, along with
+// the last time it was used.
+type tokenBucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// ConnRateLimiter is a ConnLimiter which applies a token bucket rate limit to
+// inbound connections, both per source IP address and per source subnet. The
+// latter prevents an attacker from circumventing the per IP limit by
+// connecting from many addresses within the same network.
+type ConnRateLimiter struct {
+	// numRejected is the number of connections rejected by the limiter.
+	//
+	// NOTE: This MUST be used atomically.
+	numRejected uint64
+
+	cfg RateLimiterConfig
+
+	mu            sync.Mutex
+	ipBuckets     map[string]*tokenBucket
+	subnetBuckets map[string]*tokenBucket
+	lastPrune     time.Time
+}
+
+// A compile-time assertion to ensure ConnRateLimiter meets the ConnLimiter
+// interface.
+var _ ConnLimiter = (*ConnRateLimiter)(nil)
+
+// NewConnRateLimiter creates a new ConnRateLimiter from the given config.
+func NewConnRateLimiter(cfg RateLimiterConfig) *ConnRateLimiter {
+	return &ConnRateLimiter{
+		cfg:           cfg,
+		ipBuckets:     make(map[string]*tokenBucket),
+		subnetBuckets: make(map[string]*tokenBucket),
+		lastPrune:     time.Now(),
+	}
+}
+
+// AllowConn returns true if a connection from the given remote address
+// should proceed to the handshake. Connections from addresses that aren't IP
+// based are always allowed, as are those from loopback addresses. The latter
+// include all inbound connections through our onion service, which the Tor
+// daemon makes on behalf of many different peers.
+//
+// NOTE: Part of the ConnLimiter interface.
+func (c *ConnRateLimiter) AllowConn(remoteAddr net.Addr) bool {
+	ip := addrIP(remoteAddr)
+	if ip == nil || ip.IsLoopback() {
+		return true
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	c.pruneBuckets(now)
+
+	// We'll first check the limit of the IP itself, and only then the
+	// limit of its subnet, such that a single abusive IP doesn't consume
+	// the tokens of its entire subnet.
+	var ipToken *rate.Reservation
+	if c.cfg.IPInterval > 0 {
+		bucket := c.fetchBucket(
+			c.ipBuckets, ip.String(), c.cfg.IPInterval,
+			c.cfg.IPBurst, now,
+		)
+		ipToken = takeToken(bucket, now)
+		if ipToken == nil {
+			atomic.AddUint64(&c.numRejected, 1)
+			return false
+		}
+	}
+
+	if c.cfg.SubnetInterval > 0 {
+		bucket := c.fetchBucket(
+			c.subnetBuckets, subnetKey(ip), c.cfg.SubnetInterval,
+			c.cfg.SubnetBurst, now,
+		)
+		if takeToken(bucket, now) == nil {
+			// The connection is rejected, so it shouldn't count
+			// against the limit of its IP either.
+			if ipToken != nil {
+				ipToken.CancelAt(now)
+			}
+
+			atomic.AddUint64(&c.numRejected, 1)
+			return false
+		}
+	}
+
+	return true
+}
+
+// takeToken takes a token from the given bucket, and returns the reservation
+// of the token, which can be cancelled to return it to the bucket. If the
+// bucket is empty, nil is returned.
+func takeToken(bucket *tokenBucket, now time.Time) *rate.Reservation {
+	reservation := bucket.limiter.ReserveN(now, 1)
+	if !reservation.OK() {
+		return nil
+	}
+	if reservation.DelayFrom(now) > 0 {
+		reservation.CancelAt(now)
+		return nil
+	}
+
+	return reservation
+}
+
+// NumRejected returns the number of connections that have been rejected by
+// the limiter.
+func (c *ConnRateLimiter) NumRejected() uint64 {
+	return atomic.LoadUint64(&c.numRejected)
+}
+
+// fetchBucket returns the token bucket of the given source, creating it if it
+// doesn't exist yet.
+//
+// NOTE: This method MUST be called with the limiter's mutex held.
+func (c *ConnRateLimiter) fetchBucket(buckets map[string]*tokenBucket,
+	key string, interval time.Duration, burst int,
+	now time.Time) *tokenBucket {
+
+	bucket, ok := buckets[key]
+	if !ok {
+		bucket = &tokenBucket{
+			limiter: rate.NewLimiter(rate.Every(interval), burst),
+		}
+		buckets[key] = bucket
+	}
+	bucket.lastSeen = now
+
+	return bucket
+}
+
+// pruneBuckets removes the token buckets of all sources that have been idle
+// for long enough for their bucket to be full again, as a fresh bucket would
+// behave identically. This keeps the memory used by the limiter bounded by
+// the rate of new sources.
+//
+// NOTE: This method MUST be called with the limiter's mutex held.
+func (c *ConnRateLimiter) pruneBuckets(now time.Time) {
+	if now.Sub(c.lastPrune) < limiterPruneInterval {
+		return
+	}
+	c.lastPrune = now
+
+	prune := func(buckets map[string]*tokenBucket,
+		interval time.Duration, burst int) {
+
+		refillTime := interval * time.Duration(burst)
+		for key, bucket := range buckets {
+			if now.Sub(bucket.lastSeen) > refillTime {
+				delete(buckets, key)
+			}
+		}
+	}
+	prune(c.ipBuckets, c.cfg.IPInterval, c.cfg.IPBurst)
+	prune(c.subnetBuckets, c.cfg.SubnetInterval, c.cfg.SubnetBurst)
+}
+
+// addrIP returns the IP address of the given network address, or nil if it
+// isn't IP based.
+func addrIP(addr net.Addr) net.IP {
+	switch a := addr.(type) {
+	case *net.TCPAddr:
+		return a.IP
+
+	case *net.UDPAddr:
+		return a.IP
+
+	case *net.IPAddr:
+		return a.IP
+	}
+
+	host, _, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return nil
+	}
+
+	return net.ParseIP(host)
+}
+
+// subnetKey returns the subnet the given IP address belongs to, in CIDR
+// notation.
+func subnetKey(ip net.IP) string {
+	subnet := &net.IPNet{
+		IP:   ip.Mask(net.CIDRMask(ipv6SubnetBits, 8*net.IPv6len)),
+		Mask: net.CIDRMask(ipv6SubnetBits, 8*net.IPv6len),
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		subnet = &net.IPNet{
+			IP:   ip4.Mask(net.CIDRMask(ipv4SubnetBits, 8*net.IPv4len)),
+			Mask: net.CIDRMask(ipv4SubnetBits, 8*net.IPv4len),
+		}
+	}
+
+	return subnet.String()
+}
@@ -0,0 +1,130 @@
+package brontide
+
+import (
+	"net"
+	"testing"
+	"time"
+)
+
+// TestConnRateLimiter ensures that the ConnRateLimiter enforces its limits
+// both per IP address and per subnet, and doesn't limit sources it can't
+// attribute to an IP address.
+func TestConnRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewConnRateLimiter(RateLimiterConfig{
+		IPInterval:     time.Hour,
+		IPBurst:        2,
+		SubnetInterval: time.Hour,
+		SubnetBurst:    3,
+	})
+
+	tcpAddr := func(ip string) net.Addr {
+		return &net.TCPAddr{IP: net.ParseIP(ip), Port: 9735}
+	}
+
+	// A single IP address may burst up to its limit, after which it's
+	// rejected.
+	for i := 0; i < 2; i++ {
+		if !limiter.AllowConn(tcpAddr("10.0.0.1")) {
+			t.Fatalf("connection %d should be allowed", i)
+		}
+	}
+	if limiter.AllowConn(tcpAddr("10.0.0.1")) {
+		t.Fatalf("connection exceeding ip burst should be rejected")
+	}
+
+	// Another IP within the same /24 subnet is allowed once more before
+	// the subnet limit kicks in.
+	if !limiter.AllowConn(tcpAddr("10.0.0.2")) {
+		t.Fatalf("connection from new ip should be allowed")
+	}
+	if limiter.AllowConn(tcpAddr("10.0.0.3")) {
+		t.Fatalf("connection exceeding subnet burst should be rejected")
+	}
+
+	// IPs of other subnets remain unaffected, including IPv6 ones.
+	if !limiter.AllowConn(tcpAddr("10.0.1.1")) {
+		t.Fatalf("connection from other subnet should be allowed")
+	}
+	if !limiter.AllowConn(tcpAddr("2001:db8::1")) {
+		t.Fatalf("connection from ipv6 address should be allowed")
+	}
+
+	// Addresses that aren't IP based are never limited.
+	unixAddr := &net.UnixAddr{Name: "/tmp/lnd.sock", Net: "unix"}
+	for i := 0; i < 5; i++ {
+		if !limiter.AllowConn(unixAddr) {
+			t.Fatalf("non-ip connection should be allowed")
+		}
+	}
+
+	// Neither are loopback addresses, which all inbound onion service
+	// connections originate from.
+	for i := 0; i < 5; i++ {
+		if !limiter.AllowConn(tcpAddr("127.0.0.1")) {
+			t.Fatalf("ipv4 loopback connection should be allowed")
+		}
+		if !limiter.AllowConn(tcpAddr("::1")) {
+			t.Fatalf("ipv6 loopback connection should be allowed")
+		}
+	}
+
+	if limiter.NumRejected() != 2 {
+		t.Fatalf("expected 2 rejected connections, got %d",
+			limiter.NumRejected())
+	}
+}
+
+// TestConnRateLimiterSubnetRejection ensures that a connection rejected by
+// the limit of its subnet doesn't consume a token of its IP address.
+func TestConnRateLimiterSubnetRejection(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewConnRateLimiter(RateLimiterConfig{
+		IPInterval:     time.Hour,
+		IPBurst:        1,
+		SubnetInterval: 50 * time.Millisecond,
+		SubnetBurst:    1,
+	})
+
+	addr1 := &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 9735}
+	addr2 := &net.TCPAddr{IP: net.ParseIP("10.0.0.2"), Port: 9735}
+
+	if !limiter.AllowConn(addr1) {
+		t.Fatalf("first connection should be allowed")
+	}
+	if limiter.AllowConn(addr2) {
+		t.Fatalf("connection exceeding subnet burst should be rejected")
+	}
+
+	// Once the subnet's bucket has refilled, the second IP should still
+	// have its only token left.
+	time.Sleep(100 * time.Millisecond)
+	if !limiter.AllowConn(addr2) {
+		t.Fatalf("connection after subnet refill should be allowed")
+	}
+}
+
+// TestSubnetKey ensures that IP addresses are grouped into the expected
+// subnets.
+func TestSubnetKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		ip     string
+		subnet string
+	}{
+		{ip: "192.168.1.77", subnet: "192.168.1.0/24"},
+		{ip: "::ffff:192.168.1.77", subnet: "192.168.1.0/24"},
+		{ip: "2001:db8:aaaa:bbbb::1", subnet: "2001:db8:aaaa::/48"},
+	}
+
+	for _, test := range tests {
+		subnet := subnetKey(net.ParseIP(test.ip))
+		if subnet != test.subnet {
+			t.Fatalf("expected subnet %v for %v, got %v",
+				test.subnet, test.ip, subnet)
+		}
+	}
+}
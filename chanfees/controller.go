@@ -0,0 +1,489 @@
+package chanfees
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/subscribe"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+const (
+	// maxEventsPerQuery is the maximum number of forwarding events we'll
+	// request from the forwarding log at once.
+	maxEventsPerQuery = 10000
+)
+
+// ForwardingLog is the subset of the channeldb.ForwardingLog that the
+// controller uses to measure the volume forwarded over our channels.
+type ForwardingLog interface {
+	// Query returns the forwarding events within the given time slice.
+	Query(channeldb.ForwardingEventQuery) (channeldb.ForwardingLogTimeSlice,
+		error)
+}
+
+// ChannelInfo describes the current state and routing policy of one of our
+// channels.
+type ChannelInfo struct {
+	// ChanID is the short channel ID of the channel.
+	ChanID lnwire.ShortChannelID
+
+	// ChanPoint is the funding outpoint of the channel.
+	ChanPoint wire.OutPoint
+
+	// LocalBalance is our balance within the channel.
+	LocalBalance lnwire.MilliSatoshi
+
+	// RemoteBalance is the balance of the remote party within the
+	// channel.
+	RemoteBalance lnwire.MilliSatoshi
+
+	// BaseFee is the base fee currently charged by the channel.
+	BaseFee lnwire.MilliSatoshi
+
+	// FeeRate is the fee rate, in millionths, currently charged by the
+	// channel.
+	FeeRate uint32
+
+	// TimeLockDelta is the time lock delta of the channel, which the
+	// controller leaves untouched.
+	TimeLockDelta uint32
+
+	// LastUpdate is the timestamp of the latest update of the channel's
+	// routing policy.
+	LastUpdate time.Time
+}
+
+// Decision records the outcome of the controller recomputing the fees of a
+// channel.
+type Decision struct {
+	// ChanID is the short channel ID of the channel.
+	ChanID lnwire.ShortChannelID
+
+	// ChanPoint is the funding outpoint of the channel.
+	ChanPoint wire.OutPoint
+
+	// Timestamp is the time at which the decision was made.
+	Timestamp time.Time
+
+	// LocalBalanceRatio is the share of the channel's balance that was on
+	// our side.
+	LocalBalanceRatio float64
+
+	// ForwardVolume is the amount forwarded out of the channel during the
+	// last interval.
+	ForwardVolume lnwire.MilliSatoshi
+
+	// LiquidityFailures is the number of forwards that failed for lack of
+	// liquidity on the channel during the last interval.
+	LiquidityFailures uint32
+
+	// OldBaseFee and OldFeeRate are the fees of the channel before the
+	// decision was made.
+	OldBaseFee lnwire.MilliSatoshi
+	OldFeeRate uint32
+
+	// NewBaseFee and NewFeeRate are the fees the controller computed for
+	// the channel. These are only in effect if Applied is true.
+	NewBaseFee lnwire.MilliSatoshi
+	NewFeeRate uint32
+
+	// Applied is true if the new fees were applied to the channel.
+	Applied bool
+
+	// Reason describes why the new fees weren't applied, if they weren't.
+	Reason string
+}
+
+// Config houses the dependencies of the fee controller.
+type Config struct {
+	// Policy is the fee policy that drives the controller.
+	Policy *Policy
+
+	// FetchChannels returns the state of all of our public and private
+	// channels that have a routing policy.
+	FetchChannels func() ([]*ChannelInfo, error)
+
+	// FwdingLog is used to measure the volume forwarded over our
+	// channels.
+	FwdingLog ForwardingLog
+
+	// SubscribeHtlcEvents subscribes to the htlc events of the switch,
+	// which are used to count the forwards that failed for lack of
+	// liquidity.
+	SubscribeHtlcEvents func() (*subscribe.Client, error)
+
+	// ApplyPolicy applies a new routing policy to the given channel, both
+	// within the switch and by announcing it to the network.
+	ApplyPolicy func(wire.OutPoint, routing.ChannelPolicy) error
+
+	// Ticker signals the controller to recompute the fees of our
+	// channels. It should tick at the interval of the policy.
+	Ticker ticker.Ticker
+
+	// Now returns the current time.
+	Now func() time.Time
+}
+
+// Controller periodically recomputes the fees of our channels based on their
+// local balance, the volume forwarded over them and the number of forwards
+// they failed for lack of liquidity, as described by a declarative Policy.
+// New fees are rate limited and clamped to avoid spamming the network with
+// channel updates.
+type Controller struct {
+	started uint32 // To be used atomically.
+	stopped uint32 // To be used atomically.
+
+	cfg *Config
+
+	// lastUpdate tracks when we last applied new fees to each channel.
+	// Channels that we haven't updated since we started fall back to the
+	// timestamp of their latest update.
+	//
+	// NOTE: This is only accessed by the controller's main goroutine.
+	lastUpdate map[lnwire.ShortChannelID]time.Time
+
+	mu sync.Mutex
+
+	// failures counts the forwards that failed for lack of liquidity on
+	// each channel since we last recomputed fees.
+	failures map[lnwire.ShortChannelID]uint32
+
+	// decisions holds the latest decision made for each channel.
+	decisions map[lnwire.ShortChannelID]*Decision
+
+	htlcEvents *subscribe.Client
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// NewController creates a new fee controller from the given config.
+func NewController(cfg *Config) *Controller {
+	return &Controller{
+		cfg:        cfg,
+		lastUpdate: make(map[lnwire.ShortChannelID]time.Time),
+		failures:   make(map[lnwire.ShortChannelID]uint32),
+		decisions:  make(map[lnwire.ShortChannelID]*Decision),
+		quit:       make(chan struct{}),
+	}
+}
+
+// Start launches the controller's main goroutine.
+func (c *Controller) Start() error {
+	if !atomic.CompareAndSwapUint32(&c.started, 0, 1) {
+		return nil
+	}
+
+	log.Infof("Fee controller starting with interval=%v",
+		time.Duration(c.cfg.Policy.Interval))
+
+	htlcEvents, err := c.cfg.SubscribeHtlcEvents()
+	if err != nil {
+		return err
+	}
+	c.htlcEvents = htlcEvents
+
+	c.cfg.Ticker.Resume()
+
+	c.wg.Add(1)
+	go c.controllerLoop()
+
+	return nil
+}
+
+// Stop signals the controller for a graceful shutdown.
+func (c *Controller) Stop() error {
+	if !atomic.CompareAndSwapUint32(&c.stopped, 0, 1) {
+		return nil
+	}
+
+	log.Info("Fee controller shutting down")
+
+	close(c.quit)
+	c.wg.Wait()
+
+	c.htlcEvents.Cancel()
+	c.cfg.Ticker.Stop()
+
+	return nil
+}
+
+// Decisions returns the latest decision made for each of our channels,
+// ordered by their short channel ID.
+func (c *Controller) Decisions() []Decision {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	decisions := make([]Decision, 0, len(c.decisions))
+	for _, decision := range c.decisions {
+		decisions = append(decisions, *decision)
+	}
+
+	sort.Slice(decisions, func(i, j int) bool {
+		return decisions[i].ChanID.ToUint64() <
+			decisions[j].ChanID.ToUint64()
+	})
+
+	return decisions
+}
+
+// controllerLoop counts liquidity failures as they are reported by the switch
+// and recomputes fees every time the ticker fires.
+//
+// NOTE: This MUST be run as a goroutine.
+func (c *Controller) controllerLoop() {
+	defer c.wg.Done()
+
+	for {
+		select {
+		case event := <-c.htlcEvents.Updates():
+			c.processHtlcEvent(event)
+
+		case <-c.cfg.Ticker.Ticks():
+			if err := c.updateFees(); err != nil {
+				log.Errorf("Unable to update channel fees: %v",
+					err)
+			}
+
+		case <-c.htlcEvents.Quit():
+			log.Warnf("Htlc event subscription terminated")
+			return
+
+		case <-c.quit:
+			return
+		}
+	}
+}
+
+// processHtlcEvent counts forwards that failed on their outgoing channel for
+// lack of liquidity.
+func (c *Controller) processHtlcEvent(event interface{}) {
+	// Htlcs rejected because their incoming channel exceeded its quota
+	// say nothing about the liquidity of the outgoing channel, and would
+	// otherwise allow a jammer to drive up our fees.
+	failEvent, ok := event.(*htlcswitch.LinkFailEvent)
+	if !ok || failEvent.Incoming || failEvent.QuotaExceeded ||
+		failEvent.HtlcEventType != htlcswitch.HtlcEventTypeForward {
+
+		return
+	}
+
+	// The switch and links fail forwards with a temporary channel failure
+	// when the outgoing channel can't carry them.
+	_, ok = failEvent.FailureMessage.(*lnwire.FailTemporaryChannelFailure)
+	if !ok {
+		return
+	}
+
+	c.mu.Lock()
+	c.failures[failEvent.OutgoingCircuit.ChanID]++
+	c.mu.Unlock()
+}
+
+// updateFees recomputes the fees of all of our channels, and applies those
+// that have changed enough to warrant a new channel update.
+func (c *Controller) updateFees() error {
+	now := c.cfg.Now()
+
+	channels, err := c.cfg.FetchChannels()
+	if err != nil {
+		return err
+	}
+
+	volumes, err := c.forwardVolumes(
+		now.Add(-time.Duration(c.cfg.Policy.Interval)), now,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Reset the failure counts so that the next round only takes those
+	// of the next interval into account.
+	c.mu.Lock()
+	failures := c.failures
+	c.failures = make(map[lnwire.ShortChannelID]uint32)
+	c.mu.Unlock()
+
+	decisions := make(map[lnwire.ShortChannelID]*Decision, len(channels))
+	for _, channel := range channels {
+		decision := c.decide(
+			channel, volumes[channel.ChanID],
+			failures[channel.ChanID], now,
+		)
+		decisions[channel.ChanID] = decision
+
+		if !decision.Applied {
+			log.Debugf("Leaving fees of chan_id=%v unchanged: %v",
+				channel.ChanID, decision.Reason)
+			continue
+		}
+
+		log.Infof("Updating fees of chan_id=%v: base_fee=%v->%v, "+
+			"fee_rate=%v->%v", channel.ChanID, decision.OldBaseFee,
+			decision.NewBaseFee, decision.OldFeeRate,
+			decision.NewFeeRate)
+
+		policy := routing.ChannelPolicy{
+			FeeSchema: routing.FeeSchema{
+				BaseFee: decision.NewBaseFee,
+				FeeRate: decision.NewFeeRate,
+			},
+			TimeLockDelta: channel.TimeLockDelta,
+		}
+		err := c.cfg.ApplyPolicy(channel.ChanPoint, policy)
+		if err != nil {
+			log.Errorf("Unable to update fees of chan_id=%v: %v",
+				channel.ChanID, err)
+
+			decision.Applied = false
+			decision.Reason = err.Error()
+			continue
+		}
+
+		c.lastUpdate[channel.ChanID] = now
+	}
+
+	// Channels that were closed since the previous round no longer have a
+	// decision.
+	c.mu.Lock()
+	c.decisions = decisions
+	c.mu.Unlock()
+
+	return nil
+}
+
+// forwardVolumes returns the amount forwarded out of each of our channels
+// within the given time range.
+func (c *Controller) forwardVolumes(start, end time.Time) (
+	map[lnwire.ShortChannelID]lnwire.MilliSatoshi, error) {
+
+	volumes := make(map[lnwire.ShortChannelID]lnwire.MilliSatoshi)
+
+	query := channeldb.ForwardingEventQuery{
+		StartTime:    start,
+		EndTime:      end,
+		NumMaxEvents: maxEventsPerQuery,
+	}
+	for {
+		timeSlice, err := c.cfg.FwdingLog.Query(query)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, event := range timeSlice.ForwardingEvents {
+			volumes[event.OutgoingChanID] += event.AmtOut
+		}
+
+		numEvents := uint32(len(timeSlice.ForwardingEvents))
+		if numEvents < query.NumMaxEvents {
+			return volumes, nil
+		}
+
+		query.IndexOffset = timeSlice.LastIndexOffset
+	}
+}
+
+// decide computes the new fees of a channel, and determines whether they
+// should be applied.
+func (c *Controller) decide(channel *ChannelInfo,
+	volume lnwire.MilliSatoshi, failures uint32,
+	now time.Time) *Decision {
+
+	policy := c.cfg.Policy
+	params := policy.paramsFor(channel.ChanID)
+
+	localRatio := 0.5
+	total := channel.LocalBalance + channel.RemoteBalance
+	if total > 0 {
+		localRatio = float64(channel.LocalBalance) / float64(total)
+	}
+
+	decision := &Decision{
+		ChanID:            channel.ChanID,
+		ChanPoint:         channel.ChanPoint,
+		Timestamp:         now,
+		LocalBalanceRatio: localRatio,
+		ForwardVolume:     volume,
+		LiquidityFailures: failures,
+		OldBaseFee:        channel.BaseFee,
+		OldFeeRate:        channel.FeeRate,
+		NewBaseFee:        lnwire.MilliSatoshi(params.BaseFeeMsat),
+	}
+
+	// Move the fee rate towards its target, but by no more than the max
+	// step of the policy.
+	feeRate := targetFeeRate(params, localRatio, volume, failures)
+	oldFeeRate := float64(channel.FeeRate)
+	if policy.MaxStep > 0 && oldFeeRate > 0 {
+		feeRate = math.Max(feeRate, oldFeeRate*(1-policy.MaxStep))
+		feeRate = math.Min(feeRate, oldFeeRate*(1+policy.MaxStep))
+	}
+	feeRate = math.Max(feeRate, float64(params.MinFeeRate))
+	feeRate = math.Min(feeRate, float64(params.MaxFeeRate))
+	decision.NewFeeRate = uint32(math.Round(feeRate))
+
+	baseFeeChanged := decision.NewBaseFee != decision.OldBaseFee
+	feeRateChange := math.Abs(float64(decision.NewFeeRate) - oldFeeRate)
+
+	// If we haven't updated the channel since we started, we'll use the
+	// timestamp of its latest update, such that the min update interval
+	// is also enforced across restarts.
+	lastUpdate, updated := c.lastUpdate[channel.ChanID]
+	if !updated && !channel.LastUpdate.IsZero() {
+		lastUpdate, updated = channel.LastUpdate, true
+	}
+	minInterval := time.Duration(policy.MinUpdateInterval)
+
+	switch {
+	case !baseFeeChanged && feeRateChange == 0:
+		decision.Reason = "fees unchanged"
+
+	case !baseFeeChanged && oldFeeRate > 0 &&
+		feeRateChange/oldFeeRate < policy.MinChange:
+
+		decision.Reason = "fee rate change below min_change"
+
+	case updated && now.Sub(lastUpdate) < minInterval:
+		decision.Reason = "fees updated within min_update_interval"
+
+	default:
+		decision.Applied = true
+	}
+
+	return decision
+}
+
+// targetFeeRate computes the fee rate, in millionths, a channel should charge
+// given its local balance ratio, the volume forwarded out of it, and the
+// number of forwards it failed for lack of liquidity.
+func targetFeeRate(params *FeeParams, localRatio float64,
+	volume lnwire.MilliSatoshi, failures uint32) float64 {
+
+	// Channels that are depleted on our side are more valuable, so we'll
+	// charge more for them, and less for those we have plenty of funds
+	// in.
+	feeRate := float64(params.FeeRate)
+	feeRate *= 1 + params.BalanceWeight*(1-2*localRatio)
+
+	// Demand above the target volume raises the fee rate, while demand
+	// below it lowers the fee rate.
+	if params.TargetVolumeMsat > 0 {
+		demand := float64(volume)/float64(params.TargetVolumeMsat) - 1
+		feeRate *= 1 + params.VolumeWeight*math.Min(demand, 1)
+	}
+
+	// Every forward that we failed for lack of liquidity is a sign that
+	// the channel is priced too low.
+	feeRate *= 1 + params.FailureWeight*float64(failures)
+
+	return feeRate
+}
@@ -0,0 +1,345 @@
+package chanfees
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/subscribe"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+// mockFwdingLog is a forwarding log that returns a fixed set of events.
+type mockFwdingLog struct {
+	events []channeldb.ForwardingEvent
+}
+
+func (m *mockFwdingLog) Query(q channeldb.ForwardingEventQuery) (
+	channeldb.ForwardingLogTimeSlice, error) {
+
+	return channeldb.ForwardingLogTimeSlice{
+		ForwardingEventQuery: q,
+		ForwardingEvents:     m.events,
+		LastIndexOffset:      uint32(len(m.events)),
+	}, nil
+}
+
+// controllerHarness wraps a controller with mocked channels, whose policies
+// are updated as the controller applies them.
+type controllerHarness struct {
+	t *testing.T
+
+	controller *Controller
+	ticker     *ticker.Force
+	htlcEvents *subscribe.Server
+
+	mu       sync.Mutex
+	channels []*ChannelInfo
+	updates  chan wire.OutPoint
+}
+
+func newControllerHarness(t *testing.T, policy *Policy,
+	channels []*ChannelInfo,
+	events []channeldb.ForwardingEvent) *controllerHarness {
+
+	h := &controllerHarness{
+		t:          t,
+		ticker:     ticker.NewForce(time.Hour),
+		htlcEvents: subscribe.NewServer(),
+		channels:   channels,
+		updates:    make(chan wire.OutPoint, len(channels)),
+	}
+	if err := h.htlcEvents.Start(); err != nil {
+		t.Fatalf("unable to start htlc event server: %v", err)
+	}
+
+	now := time.Unix(1000000, 0)
+	h.controller = NewController(&Config{
+		Policy:              policy,
+		FetchChannels:       h.fetchChannels,
+		FwdingLog:           &mockFwdingLog{events: events},
+		SubscribeHtlcEvents: h.htlcEvents.Subscribe,
+		ApplyPolicy:         h.applyPolicy,
+		Ticker:              h.ticker,
+		Now: func() time.Time {
+			return now
+		},
+	})
+	if err := h.controller.Start(); err != nil {
+		t.Fatalf("unable to start controller: %v", err)
+	}
+
+	return h
+}
+
+func (h *controllerHarness) stop() {
+	h.controller.Stop()
+	h.htlcEvents.Stop()
+}
+
+func (h *controllerHarness) fetchChannels() ([]*ChannelInfo, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	channels := make([]*ChannelInfo, 0, len(h.channels))
+	for _, channel := range h.channels {
+		c := *channel
+		channels = append(channels, &c)
+	}
+
+	return channels, nil
+}
+
+func (h *controllerHarness) applyPolicy(chanPoint wire.OutPoint,
+	policy routing.ChannelPolicy) error {
+
+	h.mu.Lock()
+	for _, channel := range h.channels {
+		if channel.ChanPoint != chanPoint {
+			continue
+		}
+
+		channel.BaseFee = policy.BaseFee
+		channel.FeeRate = policy.FeeRate
+	}
+	h.mu.Unlock()
+
+	h.updates <- chanPoint
+
+	return nil
+}
+
+// tick forces the controller to recompute fees, and waits for the given
+// number of channels to be updated.
+func (h *controllerHarness) tick(numUpdates int) {
+	h.t.Helper()
+
+	select {
+	case h.ticker.Force <- time.Time{}:
+	case <-time.After(time.Second):
+		h.t.Fatalf("controller did not accept tick")
+	}
+
+	for i := 0; i < numUpdates; i++ {
+		select {
+		case <-h.updates:
+		case <-time.After(time.Second):
+			h.t.Fatalf("expected %v channel updates, got %v",
+				numUpdates, i)
+		}
+	}
+
+	// Wait for the decisions of this round to be recorded.
+	time.Sleep(50 * time.Millisecond)
+
+	select {
+	case chanPoint := <-h.updates:
+		h.t.Fatalf("unexpected update of %v", chanPoint)
+	default:
+	}
+}
+
+// assertDecision asserts the latest decision for the given channel.
+func (h *controllerHarness) assertDecision(chanID uint64, feeRate uint32,
+	applied bool) {
+
+	h.t.Helper()
+
+	for _, decision := range h.controller.Decisions() {
+		if decision.ChanID.ToUint64() != chanID {
+			continue
+		}
+
+		if decision.NewFeeRate != feeRate {
+			h.t.Fatalf("chan_id=%v: expected fee rate %v, got %v",
+				chanID, feeRate, decision.NewFeeRate)
+		}
+		if decision.Applied != applied {
+			h.t.Fatalf("chan_id=%v: expected applied=%v, got "+
+				"%v: %v", chanID, applied, decision.Applied,
+				decision.Reason)
+		}
+
+		return
+	}
+
+	h.t.Fatalf("no decision for chan_id=%v", chanID)
+}
+
+// TestControllerUpdateFees ensures that the controller raises the fees of
+// depleted channels, channels with high demand and channels that failed
+// forwards for lack of liquidity, while limiting the rate and size of its
+// updates.
+func TestControllerUpdateFees(t *testing.T) {
+	t.Parallel()
+
+	// Only channel 4 takes its forward volume into account.
+	policy, err := ParsePolicy(strings.NewReader(`{
+		"interval": "1h",
+		"min_update_interval": "6h",
+		"min_change": 0.1,
+		"max_step": 0.25,
+		"default": {
+			"base_fee_msat": 1000,
+			"fee_rate_ppm": 100,
+			"min_fee_rate_ppm": 10,
+			"max_fee_rate_ppm": 1000,
+			"balance_weight": 0.5,
+			"failure_weight": 0.1
+		},
+		"channels": [{
+			"chan_id": 4,
+			"target_volume_msat": 100000,
+			"volume_weight": 0.2
+		}]
+	}`))
+	if err != nil {
+		t.Fatalf("unable to parse policy: %v", err)
+	}
+
+	newChannel := func(chanID uint64, local,
+		remote lnwire.MilliSatoshi) *ChannelInfo {
+
+		return &ChannelInfo{
+			ChanID:        lnwire.NewShortChanIDFromInt(chanID),
+			ChanPoint:     wire.OutPoint{Index: uint32(chanID)},
+			LocalBalance:  local,
+			RemoteBalance: remote,
+			BaseFee:       1000,
+			FeeRate:       100,
+			TimeLockDelta: 40,
+		}
+	}
+
+	// Channel 1 is balanced, 2 is depleted on our side, 3 holds all funds
+	// on our side and 4 is balanced, but has twice the target volume
+	// forwarded out of it.
+	channels := []*ChannelInfo{
+		newChannel(1, 500, 500),
+		newChannel(2, 0, 1000),
+		newChannel(3, 1000, 0),
+		newChannel(4, 500, 500),
+	}
+	events := []channeldb.ForwardingEvent{
+		{
+			IncomingChanID: lnwire.NewShortChanIDFromInt(1),
+			OutgoingChanID: lnwire.NewShortChanIDFromInt(4),
+			AmtOut:         200000,
+		},
+	}
+
+	h := newControllerHarness(t, policy, channels, events)
+	defer h.stop()
+
+	// Channel 3 also failed two forwards for lack of liquidity. Failures
+	// that occurred on the incoming link, because of an htlc quota or for
+	// other reasons should not be counted.
+	chanID3 := lnwire.NewShortChanIDFromInt(3)
+	failEvents := []*htlcswitch.LinkFailEvent{
+		{
+			FailureMessage: &lnwire.FailTemporaryChannelFailure{},
+		},
+		{
+			FailureMessage: &lnwire.FailTemporaryChannelFailure{},
+		},
+		{
+			FailureMessage: &lnwire.FailTemporaryChannelFailure{},
+			Incoming:       true,
+		},
+		{
+			FailureMessage: &lnwire.FailTemporaryChannelFailure{},
+			QuotaExceeded:  true,
+		},
+		{
+			FailureMessage: &lnwire.FailFeeInsufficient{},
+		},
+	}
+	for _, event := range failEvents {
+		event.HtlcEventType = htlcswitch.HtlcEventTypeForward
+		event.OutgoingCircuit.ChanID = chanID3
+		if err := h.htlcEvents.SendUpdate(event); err != nil {
+			t.Fatalf("unable to send htlc event: %v", err)
+		}
+	}
+
+	for i := 0; i < 100; i++ {
+		h.controller.mu.Lock()
+		numFailures := h.controller.failures[chanID3]
+		h.controller.mu.Unlock()
+		if numFailures == 2 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// The balanced channel stays at its fee rate, the depleted one moves
+	// towards 150 by the max step, and the full one moves towards
+	// 100*0.5*1.2=60 by the max step. The volume of channel 4 raises its
+	// fee rate to 120.
+	h.tick(3)
+	h.assertDecision(1, 100, false)
+	h.assertDecision(2, 125, true)
+	h.assertDecision(3, 75, true)
+	h.assertDecision(4, 120, true)
+
+	// As the channels were just updated, the next round should be rate
+	// limited. Since the failures of channel 3 were reset, its target
+	// rate is now 50.
+	h.tick(0)
+	h.assertDecision(2, 150, false)
+	h.assertDecision(3, 56, false)
+	h.assertDecision(4, 120, false)
+}
+
+// TestControllerMinUpdateIntervalRestart ensures that the min update interval
+// is enforced against the timestamp of a channel's latest update, even if the
+// controller hasn't updated the channel itself.
+func TestControllerMinUpdateIntervalRestart(t *testing.T) {
+	t.Parallel()
+
+	policy, err := ParsePolicy(strings.NewReader(`{
+		"interval": "1h",
+		"min_update_interval": "6h",
+		"default": {
+			"base_fee_msat": 1000,
+			"fee_rate_ppm": 200,
+			"max_fee_rate_ppm": 1000
+		}
+	}`))
+	if err != nil {
+		t.Fatalf("unable to parse policy: %v", err)
+	}
+
+	// Channel 1 was updated an hour ago, while channel 2 was last updated
+	// well before the min update interval.
+	now := time.Unix(1000000, 0)
+	channels := []*ChannelInfo{
+		{
+			ChanID:     lnwire.NewShortChanIDFromInt(1),
+			ChanPoint:  wire.OutPoint{Index: 1},
+			BaseFee:    1000,
+			FeeRate:    100,
+			LastUpdate: now.Add(-time.Hour),
+		},
+		{
+			ChanID:     lnwire.NewShortChanIDFromInt(2),
+			ChanPoint:  wire.OutPoint{Index: 2},
+			BaseFee:    1000,
+			FeeRate:    100,
+			LastUpdate: now.Add(-7 * time.Hour),
+		},
+	}
+
+	h := newControllerHarness(t, policy, channels, nil)
+	defer h.stop()
+
+	h.tick(1)
+	h.assertDecision(1, 200, false)
+	h.assertDecision(2, 200, true)
+}
@@ -0,0 +1,285 @@
+package chanfees
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// DefaultInterval is the default interval at which the controller
+	// recomputes the fees of our channels.
+	DefaultInterval = time.Hour
+)
+
+// Duration is a time.Duration that is encoded within a policy file as a
+// string that can be parsed by time.ParseDuration, e.g. "1h30m".
+type Duration time.Duration
+
+// UnmarshalJSON parses a duration string.
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(duration)
+
+	return nil
+}
+
+// MarshalJSON encodes the duration as a string.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// FeeParams are the parameters that determine the fees of a channel. The fee
+// rate of a channel starts out at FeeRate, and is then scaled up or down by
+// its local balance ratio, the volume forwarded over it and the number of
+// forwards it failed for lack of liquidity during the last interval.
+type FeeParams struct {
+	// BaseFeeMsat is the base fee charged for every forward.
+	BaseFeeMsat uint64 `json:"base_fee_msat"`
+
+	// FeeRate is the proportional fee rate, in millionths, charged by a
+	// balanced channel without any demand signal.
+	FeeRate uint32 `json:"fee_rate_ppm"`
+
+	// MinFeeRate is the lowest fee rate, in millionths, that will be set.
+	MinFeeRate uint32 `json:"min_fee_rate_ppm"`
+
+	// MaxFeeRate is the highest fee rate, in millionths, that will be
+	// set.
+	MaxFeeRate uint32 `json:"max_fee_rate_ppm"`
+
+	// BalanceWeight determines how strongly the local balance ratio of a
+	// channel affects its fee rate. A channel that is fully depleted on
+	// our side has its fee rate scaled by 1+BalanceWeight, while one that
+	// holds all of its funds on our side is scaled by 1-BalanceWeight.
+	BalanceWeight float64 `json:"balance_weight"`
+
+	// TargetVolumeMsat is the volume forwarded out of a channel within an
+	// interval that is considered normal demand. Forwarding more than
+	// that raises the fee rate, and forwarding less lowers it, by up to
+	// VolumeWeight. If zero, forward volume doesn't affect fees.
+	TargetVolumeMsat uint64 `json:"target_volume_msat"`
+
+	// VolumeWeight determines how strongly forward volume affects the fee
+	// rate of a channel.
+	VolumeWeight float64 `json:"volume_weight"`
+
+	// FailureWeight is the relative increase of the fee rate for every
+	// forward that failed for lack of liquidity on a channel during the
+	// last interval.
+	FailureWeight float64 `json:"failure_weight"`
+}
+
+// validate ensures that the fee parameters are sane.
+func (p *FeeParams) validate() error {
+	switch {
+	case p.MaxFeeRate == 0:
+		return errors.New("max_fee_rate_ppm must be set")
+
+	case p.MinFeeRate > p.MaxFeeRate:
+		return fmt.Errorf("min_fee_rate_ppm %v is above "+
+			"max_fee_rate_ppm %v", p.MinFeeRate, p.MaxFeeRate)
+
+	case p.FeeRate < p.MinFeeRate || p.FeeRate > p.MaxFeeRate:
+		return fmt.Errorf("fee_rate_ppm %v is outside of [%v, %v]",
+			p.FeeRate, p.MinFeeRate, p.MaxFeeRate)
+
+	case p.BalanceWeight < 0 || p.BalanceWeight >= 1:
+		return fmt.Errorf("balance_weight %v must be within [0, 1)",
+			p.BalanceWeight)
+
+	case p.VolumeWeight < 0 || p.VolumeWeight >= 1:
+		return fmt.Errorf("volume_weight %v must be within [0, 1)",
+			p.VolumeWeight)
+
+	case p.FailureWeight < 0:
+		return fmt.Errorf("failure_weight %v must not be negative",
+			p.FailureWeight)
+	}
+
+	return nil
+}
+
+// ChannelParams overrides the default fee parameters for a single channel.
+// Any parameter that is omitted is inherited from the defaults, while
+// parameters that are explicitly set to zero override them.
+type ChannelParams struct {
+	// ChanID is the short channel ID of the channel.
+	ChanID uint64 `json:"chan_id"`
+
+	BaseFeeMsat      *uint64  `json:"base_fee_msat"`
+	FeeRate          *uint32  `json:"fee_rate_ppm"`
+	MinFeeRate       *uint32  `json:"min_fee_rate_ppm"`
+	MaxFeeRate       *uint32  `json:"max_fee_rate_ppm"`
+	BalanceWeight    *float64 `json:"balance_weight"`
+	TargetVolumeMsat *uint64  `json:"target_volume_msat"`
+	VolumeWeight     *float64 `json:"volume_weight"`
+	FailureWeight    *float64 `json:"failure_weight"`
+}
+
+// apply returns a copy of the given default fee parameters in which all
+// parameters that are set by the override are replaced.
+func (c *ChannelParams) apply(params FeeParams) FeeParams {
+	if c.BaseFeeMsat != nil {
+		params.BaseFeeMsat = *c.BaseFeeMsat
+	}
+	if c.FeeRate != nil {
+		params.FeeRate = *c.FeeRate
+	}
+	if c.MinFeeRate != nil {
+		params.MinFeeRate = *c.MinFeeRate
+	}
+	if c.MaxFeeRate != nil {
+		params.MaxFeeRate = *c.MaxFeeRate
+	}
+	if c.BalanceWeight != nil {
+		params.BalanceWeight = *c.BalanceWeight
+	}
+	if c.TargetVolumeMsat != nil {
+		params.TargetVolumeMsat = *c.TargetVolumeMsat
+	}
+	if c.VolumeWeight != nil {
+		params.VolumeWeight = *c.VolumeWeight
+	}
+	if c.FailureWeight != nil {
+		params.FailureWeight = *c.FailureWeight
+	}
+
+	return params
+}
+
+// Policy is the declarative fee policy that drives the controller. It is
+// read from a JSON file such as:
+//
+//	{
+//		"interval": "1h",
+//		"min_update_interval": "6h",
+//		"min_change": 0.1,
+//		"max_step": 0.25,
+//		"default": {
+//			"base_fee_msat": 1000,
+//			"fee_rate_ppm": 100,
+//			"min_fee_rate_ppm": 10,
+//			"max_fee_rate_ppm": 2000,
+//			"balance_weight": 0.5,
+//			"target_volume_msat": 1000000000,
+//			"volume_weight": 0.25,
+//			"failure_weight": 0.1
+//		},
+//		"channels": [
+//			{"chan_id": 1361129467683753984, "fee_rate_ppm": 500}
+//		]
+//	}
+type Policy struct {
+	// Interval is how often the fees of our channels are recomputed. It
+	// is also the window over which forward volume and liquidity failures
+	// are measured.
+	Interval Duration `json:"interval"`
+
+	// MinUpdateInterval is the minimum amount of time between two updates
+	// of the same channel, preventing us from spamming the network with
+	// channel updates. If zero, it defaults to Interval.
+	MinUpdateInterval Duration `json:"min_update_interval"`
+
+	// MinChange is the minimum relative change of a channel's fee rate
+	// that warrants a new channel update. Smaller changes are dropped.
+	MinChange float64 `json:"min_change"`
+
+	// MaxStep is the maximum relative change of a channel's fee rate
+	// within a single update. If zero, the fee rate is set to its target
+	// right away.
+	MaxStep float64 `json:"max_step"`
+
+	// Default are the fee parameters of all channels without an override.
+	Default FeeParams `json:"default"`
+
+	// Channels overrides the fee parameters of individual channels.
+	Channels []ChannelParams `json:"channels"`
+
+	// channels indexes the resolved parameters of the overrides by their
+	// short channel ID.
+	channels map[lnwire.ShortChannelID]FeeParams
+}
+
+// ParsePolicy reads and validates a JSON encoded fee policy.
+func ParsePolicy(r io.Reader) (*Policy, error) {
+	var policy Policy
+
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&policy); err != nil {
+		return nil, fmt.Errorf("unable to parse fee policy: %v", err)
+	}
+
+	if policy.Interval == 0 {
+		policy.Interval = Duration(DefaultInterval)
+	}
+	if policy.MinUpdateInterval == 0 {
+		policy.MinUpdateInterval = policy.Interval
+	}
+
+	switch {
+	case policy.Interval < 0 || policy.MinUpdateInterval < 0:
+		return nil, errors.New("intervals must not be negative")
+
+	case policy.MinChange < 0 || policy.MaxStep < 0:
+		return nil, errors.New("min_change and max_step must not be " +
+			"negative")
+	}
+
+	if err := policy.Default.validate(); err != nil {
+		return nil, fmt.Errorf("invalid default fee params: %v", err)
+	}
+
+	policy.channels = make(map[lnwire.ShortChannelID]FeeParams)
+	for _, override := range policy.Channels {
+		chanID := lnwire.NewShortChanIDFromInt(override.ChanID)
+		if _, ok := policy.channels[chanID]; ok {
+			return nil, fmt.Errorf("duplicate fee params for "+
+				"chan_id=%v", override.ChanID)
+		}
+
+		params := override.apply(policy.Default)
+		if err := params.validate(); err != nil {
+			return nil, fmt.Errorf("invalid fee params for "+
+				"chan_id=%v: %v", override.ChanID, err)
+		}
+
+		policy.channels[chanID] = params
+	}
+
+	return &policy, nil
+}
+
+// LoadPolicy reads and validates the fee policy stored at the given path.
+func LoadPolicy(path string) (*Policy, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ParsePolicy(f)
+}
+
+// paramsFor returns the fee parameters that apply to the given channel.
+func (p *Policy) paramsFor(chanID lnwire.ShortChannelID) *FeeParams {
+	if params, ok := p.channels[chanID]; ok {
+		return &params
+	}
+
+	return &p.Default
+}
@@ -0,0 +1,140 @@
+package chanfees
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestParsePolicy ensures that a policy file is parsed with its defaults
+// applied, and that channel overrides inherit the default parameters.
+func TestParsePolicy(t *testing.T) {
+	t.Parallel()
+
+	policy, err := ParsePolicy(strings.NewReader(`{
+		"min_change": 0.1,
+		"default": {
+			"base_fee_msat": 1000,
+			"fee_rate_ppm": 100,
+			"min_fee_rate_ppm": 10,
+			"max_fee_rate_ppm": 2000,
+			"balance_weight": 0.5
+		},
+		"channels": [
+			{"chan_id": 1, "fee_rate_ppm": 500}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("unable to parse policy: %v", err)
+	}
+
+	if time.Duration(policy.Interval) != DefaultInterval {
+		t.Fatalf("expected default interval, got %v",
+			time.Duration(policy.Interval))
+	}
+	if policy.MinUpdateInterval != policy.Interval {
+		t.Fatalf("expected min update interval to default to interval")
+	}
+
+	params := policy.paramsFor(lnwire.NewShortChanIDFromInt(1))
+	expected := policy.Default
+	expected.FeeRate = 500
+	if *params != expected {
+		t.Fatalf("expected params %v, got %v", expected, *params)
+	}
+
+	params = policy.paramsFor(lnwire.NewShortChanIDFromInt(2))
+	if *params != policy.Default {
+		t.Fatalf("expected default params, got %v", *params)
+	}
+}
+
+// TestParsePolicyExplicitZero ensures that parameters explicitly set to zero
+// by a channel override aren't inherited from the defaults.
+func TestParsePolicyExplicitZero(t *testing.T) {
+	t.Parallel()
+
+	policy, err := ParsePolicy(strings.NewReader(`{
+		"default": {
+			"base_fee_msat": 1000,
+			"fee_rate_ppm": 100,
+			"min_fee_rate_ppm": 10,
+			"max_fee_rate_ppm": 2000,
+			"balance_weight": 0.5
+		},
+		"channels": [{
+			"chan_id": 1,
+			"base_fee_msat": 0,
+			"min_fee_rate_ppm": 0,
+			"balance_weight": 0
+		}]
+	}`))
+	if err != nil {
+		t.Fatalf("unable to parse policy: %v", err)
+	}
+
+	params := policy.paramsFor(lnwire.NewShortChanIDFromInt(1))
+	expected := policy.Default
+	expected.BaseFeeMsat = 0
+	expected.MinFeeRate = 0
+	expected.BalanceWeight = 0
+	if *params != expected {
+		t.Fatalf("expected params %v, got %v", expected, *params)
+	}
+}
+
+// TestParsePolicyInvalid ensures that invalid policies are rejected.
+func TestParsePolicyInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		policy string
+	}{
+		{
+			name: "unknown field",
+			policy: `{"default": {"max_fee_rate_ppm": 1},
+				"foo": 1}`,
+		},
+		{
+			name: "invalid interval",
+			policy: `{"interval": "1x",
+				"default": {"max_fee_rate_ppm": 1}}`,
+		},
+		{
+			name:   "no max fee rate",
+			policy: `{"default": {"fee_rate_ppm": 1}}`,
+		},
+		{
+			name: "fee rate above max",
+			policy: `{"default": {"fee_rate_ppm": 2,
+				"max_fee_rate_ppm": 1}}`,
+		},
+		{
+			name: "balance weight too large",
+			policy: `{"default": {"max_fee_rate_ppm": 1,
+				"balance_weight": 1}}`,
+		},
+		{
+			name: "invalid override",
+			policy: `{"default": {"max_fee_rate_ppm": 10},
+				"channels": [{"chan_id": 1,
+					"fee_rate_ppm": 20}]}`,
+		},
+		{
+			name: "duplicate override",
+			policy: `{"default": {"max_fee_rate_ppm": 10},
+				"channels": [{"chan_id": 1}, {"chan_id": 1}]}`,
+		},
+	}
+
+	for _, test := range tests {
+		_, err := ParsePolicy(strings.NewReader(test.policy))
+		if err == nil {
+			t.Fatalf("%v: expected policy to be rejected",
+				test.name)
+		}
+	}
+}
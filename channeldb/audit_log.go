@@ -0,0 +1,367 @@
+package channeldb
+
+import (
+	"bytes"
+	"io"
+	"time"
+
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// auditLogBucket is the bucket that we'll use to store the audit log.
+	// The audit log is a time series database of the RPC calls made to a
+	// lightning daemon. Each key within the bucket is a timestamp (in nano
+	// seconds since the unix epoch) followed by a sequence number, and the
+	// value the audit event of the call made at that time.
+	auditLogBucket = []byte("rpc-audit-log")
+
+	// auditLogCountKey is the key within the audit log bucket that stores
+	// the number of events in the log. As it isn't of the size of an
+	// event key, it's skipped when iterating over the events.
+	auditLogCountKey = []byte("event-count")
+)
+
+const (
+	// auditKeySize is the size of the key of an audit event. The breakdown
+	// is as follows:
+	//
+	//  * 8 byte timestamp || 8 byte sequence number
+	//
+	// The sequence number ensures that calls made within the same
+	// nanosecond don't overwrite each other.
+	auditKeySize = 16
+)
+
+// AuditLogOption is a function signature for modifying the retention policy
+// of an AuditLog.
+type AuditLogOption func(*AuditLog)
+
+// AuditLogMaxEntries limits the audit log to the n most recent events. A zero
+// value means no limit.
+func AuditLogMaxEntries(n uint64) AuditLogOption {
+	return func(a *AuditLog) {
+		a.maxEntries = n
+	}
+}
+
+// AuditLogMaxAge limits the audit log to events that are younger than the
+// given age. A zero value means no limit.
+func AuditLogMaxAge(age time.Duration) AuditLogOption {
+	return func(a *AuditLog) {
+		a.maxAge = age
+	}
+}
+
+// AuditLog returns an instance of the AuditLog object backed by the target
+// database instance. Any retention options passed are applied whenever an
+// event is added to the log.
+func (d *DB) AuditLog(opts ...AuditLogOption) *AuditLog {
+	a := &AuditLog{
+		db: d,
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	return a
+}
+
+// AuditLog is a time series database that logs the RPC calls made to a
+// lightning network daemon. Each audit event records who made a call, when,
+// and whether it succeeded, such that it can later be shown who moved funds
+// and when.
+type AuditLog struct {
+	db *DB
+
+	// maxEntries is the maximum number of events kept in the log, or zero
+	// if there is no limit.
+	maxEntries uint64
+
+	// maxAge is the maximum age of the events kept in the log, or zero if
+	// there is no limit.
+	maxAge time.Duration
+}
+
+// AuditEvent is an event in the audit log's time series. Each audit event
+// describes a single RPC call.
+type AuditEvent struct {
+	// Timestamp is the time the call was made.
+	Timestamp time.Time
+
+	// Method is the full name of the RPC method that was called.
+	Method string
+
+	// MacaroonID is the ID of the macaroon the call was made with. It's
+	// empty if macaroons are disabled or the call didn't carry one.
+	MacaroonID []byte
+
+	// RemoteAddr is the address of the remote peer that made the call.
+	RemoteAddr string
+
+	// Error is the error the call failed with, or an empty string if the
+	// call succeeded.
+	Error string
+
+	// Amount is the amount moved by the call, for calls that move funds.
+	Amount lnwire.MilliSatoshi
+
+	// Destination is the destination of the funds moved by the call, for
+	// calls that move funds.
+	Destination string
+}
+
+// encodeAuditEvent writes out the target audit event to the passed io.Writer,
+// using the expected DB format. Note that the timestamp isn't serialized as
+// this will be the key value within the bucket.
+func encodeAuditEvent(w io.Writer, e *AuditEvent) error {
+	return WriteElements(
+		w, []byte(e.Method), e.MacaroonID, []byte(e.RemoteAddr),
+		[]byte(e.Error), e.Amount, []byte(e.Destination),
+	)
+}
+
+// decodeAuditEvent attempts to decode the raw bytes of a serialized audit
+// event into the target AuditEvent. Note that the timestamp won't be decoded,
+// as the caller is expected to set this due to the bucket structure of the
+// audit log.
+func decodeAuditEvent(r io.Reader, e *AuditEvent) error {
+	var method, remoteAddr, errStr, dest []byte
+	err := ReadElements(
+		r, &method, &e.MacaroonID, &remoteAddr, &errStr, &e.Amount,
+		&dest,
+	)
+	if err != nil {
+		return err
+	}
+
+	e.Method = string(method)
+	e.RemoteAddr = string(remoteAddr)
+	e.Error = string(errStr)
+	e.Destination = string(dest)
+
+	return nil
+}
+
+// AddAuditEvent adds an audit event to the database. Within the same
+// transaction, the oldest events are removed from the log until it satisfies
+// its retention policy.
+func (a *AuditLog) AddAuditEvent(event *AuditEvent) error {
+	var eventBuf bytes.Buffer
+	if err := encodeAuditEvent(&eventBuf, event); err != nil {
+		return err
+	}
+
+	return a.db.Batch(func(tx *bbolt.Tx) error {
+		logBucket, err := tx.CreateBucketIfNotExists(auditLogBucket)
+		if err != nil {
+			return err
+		}
+
+		seq, err := logBucket.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		var key [auditKeySize]byte
+		byteOrder.PutUint64(key[:8], uint64(event.Timestamp.UnixNano()))
+		byteOrder.PutUint64(key[8:], seq)
+
+		numEvents := auditEventCount(logBucket)
+		if logBucket.Get(key[:]) == nil {
+			numEvents++
+		}
+		if err := logBucket.Put(key[:], eventBuf.Bytes()); err != nil {
+			return err
+		}
+
+		numEvents, err = a.trim(logBucket, numEvents)
+		if err != nil {
+			return err
+		}
+
+		var count [8]byte
+		byteOrder.PutUint64(count[:], numEvents)
+		return logBucket.Put(auditLogCountKey, count[:])
+	})
+}
+
+// auditEventCount returns the number of events stored in the given audit log
+// bucket. Logs written before the count was tracked are counted once.
+func auditEventCount(logBucket *bbolt.Bucket) uint64 {
+	count := logBucket.Get(auditLogCountKey)
+	if count != nil {
+		return byteOrder.Uint64(count)
+	}
+
+	var numEvents uint64
+	logBucket.ForEach(func(k, _ []byte) error {
+		if len(k) == auditKeySize {
+			numEvents++
+		}
+		return nil
+	})
+
+	return numEvents
+}
+
+// trim removes the oldest events from the given audit log bucket, which holds
+// numEvents events, until the log satisfies its retention policy. The number
+// of events left in the log is returned.
+func (a *AuditLog) trim(logBucket *bbolt.Bucket,
+	numEvents uint64) (uint64, error) {
+
+	if a.maxEntries == 0 && a.maxAge == 0 {
+		return numEvents, nil
+	}
+
+	var cutoff [8]byte
+	if a.maxAge != 0 {
+		byteOrder.PutUint64(
+			cutoff[:], uint64(time.Now().Add(-a.maxAge).UnixNano()),
+		)
+	}
+
+	// As the keys are prefixed by their timestamp, the oldest events are
+	// found at the start of the bucket.
+	logCursor := logBucket.Cursor()
+	for {
+		key, _ := logCursor.First()
+		if len(key) != auditKeySize {
+			break
+		}
+
+		tooMany := a.maxEntries != 0 && numEvents > a.maxEntries
+		tooOld := a.maxAge != 0 && bytes.Compare(key[:8], cutoff[:]) < 0
+		if !tooMany && !tooOld {
+			break
+		}
+
+		if err := logCursor.Delete(); err != nil {
+			return 0, err
+		}
+		numEvents--
+	}
+
+	return numEvents, nil
+}
+
+// AuditEventQuery represents a query to the audit log time series database.
+// The query allows a caller to retrieve all records for a particular time
+// slice, offset in that time slice, limiting the total number of responses
+// returned.
+type AuditEventQuery struct {
+	// StartTime is the start time of the time slice.
+	StartTime time.Time
+
+	// EndTime is the end time of the time slice.
+	EndTime time.Time
+
+	// IndexOffset is the offset within the time slice to start at. This
+	// can be used to start the response at a particular record.
+	IndexOffset uint32
+
+	// NumMaxEvents is the max number of events to return.
+	NumMaxEvents uint32
+}
+
+// AuditLogTimeSlice is the response to an audit log query. It includes the
+// original query, the set of events that match the query, and an integer which
+// represents the offset index of the last item in the set of returned events.
+type AuditLogTimeSlice struct {
+	AuditEventQuery
+
+	// AuditEvents is the set of events in our time series that answer the
+	// query embedded above.
+	AuditEvents []AuditEvent
+
+	// LastIndexOffset is the index of the last element in the set of
+	// returned AuditEvents above. Callers can use this to resume their
+	// query in the event that the time slice has too many events to fit
+	// into a single response.
+	LastIndexOffset uint32
+}
+
+// Query allows a caller to query the audit event time series for a particular
+// time slice. The caller can control the precise time as well as the number of
+// events to be returned.
+func (a *AuditLog) Query(q AuditEventQuery) (AuditLogTimeSlice, error) {
+	resp := AuditLogTimeSlice{
+		AuditEventQuery: q,
+	}
+
+	// If the user provided an index offset, then we'll need to skip that
+	// many records. We'll also keep track of the record offset as that's
+	// part of the final return value.
+	recordsToSkip := q.IndexOffset
+	recordOffset := q.IndexOffset
+
+	err := a.db.View(func(tx *bbolt.Tx) error {
+		// If the bucket wasn't found, then there aren't any events to
+		// be returned.
+		logBucket := tx.Bucket(auditLogBucket)
+		if logBucket == nil {
+			return nil
+		}
+
+		// We'll be using a cursor to seek into the database, so we'll
+		// populate byte slices that represent the start of the key
+		// space we're interested in, and the end.
+		var startTime, endTime [8]byte
+		byteOrder.PutUint64(
+			startTime[:], uint64(q.StartTime.UnixNano()),
+		)
+		byteOrder.PutUint64(endTime[:], uint64(q.EndTime.UnixNano()))
+
+		logCursor := logBucket.Cursor()
+		key, eventBytes := logCursor.Seek(startTime[:])
+		for ; key != nil; key, eventBytes = logCursor.Next() {
+			if len(key) != auditKeySize {
+				continue
+			}
+
+			// Once we're past the end of the time slice, there are
+			// no more events to be returned.
+			if bytes.Compare(key[:8], endTime[:]) > 0 {
+				return nil
+			}
+
+			// If our current return payload exceeds the max number
+			// of events, then we'll exit now.
+			if uint32(len(resp.AuditEvents)) >= q.NumMaxEvents {
+				return nil
+			}
+
+			// If we're not yet past the user defined offset, then
+			// we'll continue to seek forward.
+			if recordsToSkip > 0 {
+				recordsToSkip--
+				continue
+			}
+
+			var event AuditEvent
+			err := decodeAuditEvent(
+				bytes.NewReader(eventBytes), &event,
+			)
+			if err != nil {
+				return err
+			}
+			event.Timestamp = time.Unix(
+				0, int64(byteOrder.Uint64(key[:8])),
+			)
+
+			resp.AuditEvents = append(resp.AuditEvents, event)
+			recordOffset++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return AuditLogTimeSlice{}, err
+	}
+
+	resp.LastIndexOffset = recordOffset
+
+	return resp, nil
+}
@@ -0,0 +1,154 @@
+package channeldb
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestAuditLogStorageAndQuery tests that we're able to store audit events and
+// then query for them by time slice and offset.
+func TestAuditLogStorageAndQuery(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+	log := db.AuditLog()
+
+	// We'll create 20 events, two of which share each timestamp, with
+	// the timestamps spaced a minute apart.
+	initialTime := time.Unix(1234, 0)
+	timestamp := initialTime
+	numEvents := 20
+	events := make([]AuditEvent, numEvents)
+	for i := 0; i < numEvents; i++ {
+		events[i] = AuditEvent{
+			Timestamp:  timestamp,
+			Method:     "/lnrpc.Lightning/SendPaymentSync",
+			MacaroonID: []byte{byte(i)},
+			RemoteAddr: "127.0.0.1:1234",
+		}
+		if i%2 == 0 {
+			events[i].Amount = lnwire.MilliSatoshi(i * 1000)
+			events[i].Destination = fmt.Sprintf("dest%d", i)
+		} else {
+			events[i].Error = "payment failed"
+			timestamp = timestamp.Add(time.Minute)
+		}
+
+		if err := log.AddAuditEvent(&events[i]); err != nil {
+			t.Fatalf("unable to add event: %v", err)
+		}
+	}
+
+	// All events should be returned in the order they were added.
+	timeSlice, err := log.Query(AuditEventQuery{
+		StartTime:    initialTime,
+		EndTime:      timestamp,
+		NumMaxEvents: 1000,
+	})
+	if err != nil {
+		t.Fatalf("unable to query for events: %v", err)
+	}
+	if !reflect.DeepEqual(events, timeSlice.AuditEvents) {
+		t.Fatalf("event mismatch: expected %v vs %v",
+			spew.Sdump(events), spew.Sdump(timeSlice.AuditEvents))
+	}
+	if timeSlice.LastIndexOffset != uint32(numEvents) {
+		t.Fatalf("wrong final offset: expected %v, got %v",
+			numEvents, timeSlice.LastIndexOffset)
+	}
+
+	// Querying a time slice that ends within the log, starting at an
+	// offset and limited in size should only return the matching events.
+	timeSlice, err = log.Query(AuditEventQuery{
+		StartTime:    initialTime.Add(time.Minute),
+		EndTime:      initialTime.Add(5 * time.Minute),
+		IndexOffset:  1,
+		NumMaxEvents: 5,
+	})
+	if err != nil {
+		t.Fatalf("unable to query for events: %v", err)
+	}
+	if !reflect.DeepEqual(events[3:8], timeSlice.AuditEvents) {
+		t.Fatalf("event mismatch: expected %v vs %v",
+			spew.Sdump(events[3:8]),
+			spew.Sdump(timeSlice.AuditEvents))
+	}
+	if timeSlice.LastIndexOffset != 6 {
+		t.Fatalf("wrong final offset: expected %v, got %v", 6,
+			timeSlice.LastIndexOffset)
+	}
+}
+
+// TestAuditLogRetention tests that the oldest events are removed from the
+// audit log once it exceeds its maximum number of entries or age.
+func TestAuditLogRetention(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	queryAll := func(log *AuditLog) []AuditEvent {
+		timeSlice, err := log.Query(AuditEventQuery{
+			StartTime:    time.Unix(0, 0),
+			EndTime:      time.Now().Add(time.Hour),
+			NumMaxEvents: 1000,
+		})
+		if err != nil {
+			t.Fatalf("unable to query for events: %v", err)
+		}
+		return timeSlice.AuditEvents
+	}
+
+	// We'll add more events than the log may hold, which should leave
+	// only the most recent ones in the log.
+	const maxEntries = 5
+	log := db.AuditLog(AuditLogMaxEntries(maxEntries))
+	now := time.Now()
+	for i := 0; i < 2*maxEntries; i++ {
+		err := log.AddAuditEvent(&AuditEvent{
+			Timestamp: now.Add(time.Duration(i-100) * time.Minute),
+			Method:    fmt.Sprintf("method%d", i),
+		})
+		if err != nil {
+			t.Fatalf("unable to add event: %v", err)
+		}
+	}
+
+	events := queryAll(log)
+	if len(events) != maxEntries {
+		t.Fatalf("expected %v events, got %v", maxEntries, len(events))
+	}
+	if events[0].Method != fmt.Sprintf("method%d", maxEntries) {
+		t.Fatalf("expected oldest events to be removed, got %v",
+			spew.Sdump(events))
+	}
+
+	// Adding an event to a log with a maximum age of an hour should remove
+	// all events older than that, as they were made over an hour ago.
+	log = db.AuditLog(AuditLogMaxAge(time.Hour))
+	err = log.AddAuditEvent(&AuditEvent{
+		Timestamp: now,
+		Method:    "recent",
+	})
+	if err != nil {
+		t.Fatalf("unable to add event: %v", err)
+	}
+
+	events = queryAll(log)
+	if len(events) != 1 || events[0].Method != "recent" {
+		t.Fatalf("expected only the recent event, got %v",
+			spew.Sdump(events))
+	}
+}
@@ -46,6 +46,10 @@ var (
 	// specific identity can't be found.
 	ErrNodeNotFound = fmt.Errorf("link node with target identity not found")
 
+	// ErrNoPeerFlapStats is returned when no flap stats have been recorded
+	// for a peer.
+	ErrNoPeerFlapStats = fmt.Errorf("no flap stats found for peer")
+
 	// ErrChannelNotFound is returned when we attempt to locate a channel
 	// for a specific chain, but it is not found.
 	ErrChannelNotFound = fmt.Errorf("channel not found")
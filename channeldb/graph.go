@@ -3129,6 +3129,41 @@ func (c *ChannelGraph) IsZombieEdge(chanID uint64) (bool, [33]byte, [33]byte) {
 	return isZombie, pubKey1, pubKey2
 }
 
+// ZombieChansOfNode returns the channel IDs of all edges within our zombie
+// index that the given node is a party of.
+func (c *ChannelGraph) ZombieChansOfNode(nodePub [33]byte) ([]uint64, error) {
+	var chanIDs []uint64
+	err := c.db.View(func(tx *bbolt.Tx) error {
+		edges := tx.Bucket(edgeBucket)
+		if edges == nil {
+			return nil
+		}
+		zombieIndex := edges.Bucket(zombieBucket)
+		if zombieIndex == nil {
+			return nil
+		}
+
+		return zombieIndex.ForEach(func(k, v []byte) error {
+			if len(k) != 8 || len(v) != 66 {
+				return nil
+			}
+
+			if bytes.Equal(v[:33], nodePub[:]) ||
+				bytes.Equal(v[33:], nodePub[:]) {
+
+				chanIDs = append(chanIDs, byteOrder.Uint64(k))
+			}
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return chanIDs, nil
+}
+
 // isZombieEdge returns whether an entry exists for the given channel in the
 // zombie index. If an entry exists, then the two node public keys corresponding
 // to this edge are also returned.
@@ -11,6 +11,7 @@ import (
 	"net"
 	"reflect"
 	"runtime"
+	"sort"
 	"testing"
 	"time"
 
@@ -2912,6 +2913,63 @@ func TestGraphZombieIndex(t *testing.T) {
 	}
 }
 
+// TestGraphZombieChansOfNode ensures that we can look up the zombie edges of a
+// node.
+func TestGraphZombieChansOfNode(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to create test database: %v", err)
+	}
+	graph := db.ChannelGraph()
+
+	var nodes [3]*LightningNode
+	for i := range nodes {
+		nodes[i], err = createTestVertex(db)
+		if err != nil {
+			t.Fatalf("unable to create test vertex: %v", err)
+		}
+	}
+
+	// We'll create a channel between the first two nodes and one between
+	// the last two, and mark both of them as zombies.
+	edge1, _, _ := createChannelEdge(db, nodes[0], nodes[1])
+	edge2, _, _ := createChannelEdge(db, nodes[1], nodes[2])
+	for _, edge := range []*ChannelEdgeInfo{edge1, edge2} {
+		if err := graph.AddChannelEdge(edge); err != nil {
+			t.Fatalf("unable to create channel edge: %v", err)
+		}
+		if err := graph.DeleteChannelEdges(edge.ChannelID); err != nil {
+			t.Fatalf("unable to mark edge as zombie: %v", err)
+		}
+	}
+
+	assertZombieChans := func(node *LightningNode, expected ...uint64) {
+		t.Helper()
+
+		chanIDs, err := graph.ZombieChansOfNode(node.PubKeyBytes)
+		if err != nil {
+			t.Fatalf("unable to fetch zombie chans: %v", err)
+		}
+		sort.Slice(chanIDs, func(i, j int) bool {
+			return chanIDs[i] < chanIDs[j]
+		})
+		sort.Slice(expected, func(i, j int) bool {
+			return expected[i] < expected[j]
+		})
+		if !reflect.DeepEqual(chanIDs, expected) {
+			t.Fatalf("expected zombie chans %v, got %v", expected,
+				chanIDs)
+		}
+	}
+
+	assertZombieChans(nodes[0], edge1.ChannelID)
+	assertZombieChans(nodes[1], edge1.ChannelID, edge2.ChannelID)
+	assertZombieChans(nodes[2], edge2.ChannelID)
+}
+
 // compareNodes is used to compare two LightningNodes while excluding the
 // Features struct, which cannot be compared as the semantics for reserializing
 // the featuresMap have not been defined.
@@ -142,7 +142,24 @@ func (db *DB) deleteLinkNode(tx *bbolt.Tx, identity *btcec.PublicKey) error {
 	}
 
 	pubKey := identity.SerializeCompressed()
-	return nodeMetaBucket.Delete(pubKey)
+	if err := nodeMetaBucket.Delete(pubKey); err != nil {
+		return err
+	}
+
+	// We only track the flap stats and errors of peers we have channels
+	// with, so we'll remove them along with the link node.
+	if peers := tx.Bucket(peersBucket); peers != nil {
+		if err := peers.Delete(pubKey); err != nil {
+			return err
+		}
+	}
+
+	peerErrors := tx.Bucket(peerErrorsBucket)
+	if peerErrors == nil || peerErrors.Bucket(pubKey) == nil {
+		return nil
+	}
+
+	return peerErrors.DeleteBucket(pubKey)
 }
 
 // FetchLinkNode attempts to lookup the data for a LinkNode based on a target
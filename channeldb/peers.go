@@ -0,0 +1,315 @@
+package channeldb
+
+import (
+	"bytes"
+	"io"
+	"time"
+
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// peersBucket is the name of the top-level bucket that stores
+	// connection health information about the peers we have channels
+	// with. Within this bucket, each peer's PeerFlapStats is keyed by its
+	// compressed identity public key.
+	peersBucket = []byte("peers-bucket")
+
+	// peerErrorsBucket is the name of the top-level bucket that stores the
+	// most recent errors exchanged with the peers we have channels with.
+	// Within this bucket, each peer has a sub-bucket keyed by its
+	// compressed identity public key, which in turn stores the errors
+	// keyed by a big-endian sequence number, such that they're iterated
+	// from oldest to newest.
+	peerErrorsBucket = []byte("peer-errors-bucket")
+)
+
+// PeerFlapStats tracks the health of our connection to a peer across
+// restarts. A flap is counted each time an established connection to the
+// peer goes down, which allows flaky peers to be told apart from healthy
+// ones. The stats of a peer are removed along with its LinkNode once we no
+// longer have any channels with it.
+type PeerFlapStats struct {
+	// FlapCount is the number of times the connection to the peer has
+	// gone down after having been established.
+	FlapCount uint32
+
+	// LastFlap is the time at which the connection to the peer last went
+	// down. It is the zero time if the peer never flapped.
+	LastFlap time.Time
+
+	// LastOnline is the last time the peer was known to be online, i.e.
+	// the time at which the most recent connection to the peer was either
+	// established or went down.
+	LastOnline time.Time
+}
+
+// FetchPeerFlapStats returns the flap stats stored for the given peer. If no
+// stats have been recorded for the peer yet, ErrNoPeerFlapStats is returned.
+func (d *DB) FetchPeerFlapStats(pubKey [33]byte) (*PeerFlapStats, error) {
+	var stats *PeerFlapStats
+	err := d.View(func(tx *bbolt.Tx) error {
+		var err error
+		stats, err = fetchPeerFlapStats(tx, pubKey)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// RecordPeerOnline marks the given peer as having been online at the passed
+// time.
+func (d *DB) RecordPeerOnline(pubKey [33]byte, onlineTime time.Time) error {
+	return d.updatePeerFlapStats(pubKey, func(stats *PeerFlapStats) {
+		stats.LastOnline = onlineTime
+	})
+}
+
+// RecordPeerFlap increments the flap count of the given peer, and records
+// the passed time as both the time of its last flap, and the last time it was
+// online. The updated stats are returned.
+func (d *DB) RecordPeerFlap(pubKey [33]byte,
+	flapTime time.Time) (*PeerFlapStats, error) {
+
+	var updated PeerFlapStats
+	err := d.updatePeerFlapStats(pubKey, func(stats *PeerFlapStats) {
+		stats.FlapCount++
+		stats.LastFlap = flapTime
+		stats.LastOnline = flapTime
+
+		updated = *stats
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// updatePeerFlapStats applies the passed modification to the stored flap
+// stats of the given peer, creating a fresh record if none exists yet.
+func (d *DB) updatePeerFlapStats(pubKey [33]byte,
+	modify func(*PeerFlapStats)) error {
+
+	return d.Update(func(tx *bbolt.Tx) error {
+		peers, err := tx.CreateBucketIfNotExists(peersBucket)
+		if err != nil {
+			return err
+		}
+
+		stats, err := fetchPeerFlapStats(tx, pubKey)
+		switch {
+		case err == ErrNoPeerFlapStats:
+			stats = &PeerFlapStats{}
+		case err != nil:
+			return err
+		}
+
+		modify(stats)
+
+		var b bytes.Buffer
+		if err := serializePeerFlapStats(&b, stats); err != nil {
+			return err
+		}
+
+		return peers.Put(pubKey[:], b.Bytes())
+	})
+}
+
+// fetchPeerFlapStats reads the flap stats of the given peer from the peers
+// bucket.
+func fetchPeerFlapStats(tx *bbolt.Tx, pubKey [33]byte) (*PeerFlapStats,
+	error) {
+
+	peers := tx.Bucket(peersBucket)
+	if peers == nil {
+		return nil, ErrNoPeerFlapStats
+	}
+
+	statsBytes := peers.Get(pubKey[:])
+	if statsBytes == nil {
+		return nil, ErrNoPeerFlapStats
+	}
+
+	return deserializePeerFlapStats(bytes.NewReader(statsBytes))
+}
+
+// serializePeerFlapStats writes the passed flap stats to w. Timestamps are
+// written as unix nanoseconds, with the zero time being encoded as 0.
+func serializePeerFlapStats(w io.Writer, stats *PeerFlapStats) error {
+	return WriteElements(w,
+		stats.FlapCount, unixNanoOrZero(stats.LastFlap),
+		unixNanoOrZero(stats.LastOnline),
+	)
+}
+
+// deserializePeerFlapStats reads flap stats serialized by
+// serializePeerFlapStats from r.
+func deserializePeerFlapStats(r io.Reader) (*PeerFlapStats, error) {
+	var (
+		stats                PeerFlapStats
+		lastFlap, lastOnline uint64
+	)
+	err := ReadElements(r, &stats.FlapCount, &lastFlap, &lastOnline)
+	if err != nil {
+		return nil, err
+	}
+
+	stats.LastFlap = timeFromUnixNano(lastFlap)
+	stats.LastOnline = timeFromUnixNano(lastOnline)
+
+	return &stats, nil
+}
+
+// unixNanoOrZero returns the unix nanoseconds of the passed time, or 0 if it
+// is the zero time.
+func unixNanoOrZero(t time.Time) uint64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return uint64(t.UnixNano())
+}
+
+// timeFromUnixNano is the inverse of unixNanoOrZero.
+func timeFromUnixNano(nanos uint64) time.Time {
+	if nanos == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(0, int64(nanos))
+}
+
+// PeerError is an error message that was either received from or sent to a
+// peer.
+type PeerError struct {
+	// Timestamp is the time at which the error was received or sent.
+	Timestamp time.Time
+
+	// Outgoing is true if the error was sent by us to the peer, and false
+	// if it was received from the peer.
+	Outgoing bool
+
+	// ChanID is the channel the error relates to. An all-zero ID denotes
+	// an error relating to all channels with the peer.
+	ChanID lnwire.ChannelID
+
+	// Data is the raw error message.
+	Data []byte
+}
+
+// AddPeerError stores an error exchanged with the given peer. Only the most
+// recent maxErrors errors of each peer are kept, any older errors are removed.
+func (d *DB) AddPeerError(pubKey [33]byte, peerErr *PeerError,
+	maxErrors int) error {
+
+	var b bytes.Buffer
+	if err := serializePeerError(&b, peerErr); err != nil {
+		return err
+	}
+
+	return d.Update(func(tx *bbolt.Tx) error {
+		peerErrors, err := tx.CreateBucketIfNotExists(peerErrorsBucket)
+		if err != nil {
+			return err
+		}
+		errBucket, err := peerErrors.CreateBucketIfNotExists(pubKey[:])
+		if err != nil {
+			return err
+		}
+
+		seqNo, err := errBucket.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		var seqKey [8]byte
+		byteOrder.PutUint64(seqKey[:], seqNo)
+		if err := errBucket.Put(seqKey[:], b.Bytes()); err != nil {
+			return err
+		}
+
+		// With the new error added, we'll remove the oldest errors
+		// until we're within the limit again.
+		var seqKeys [][]byte
+		err = errBucket.ForEach(func(k, _ []byte) error {
+			seqKeys = append(seqKeys, append([]byte(nil), k...))
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for ; len(seqKeys) > maxErrors; seqKeys = seqKeys[1:] {
+			if err := errBucket.Delete(seqKeys[0]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// FetchPeerErrors returns the errors stored for the given peer, ordered from
+// oldest to newest.
+func (d *DB) FetchPeerErrors(pubKey [33]byte) ([]*PeerError, error) {
+	var peerErrs []*PeerError
+	err := d.View(func(tx *bbolt.Tx) error {
+		peerErrors := tx.Bucket(peerErrorsBucket)
+		if peerErrors == nil {
+			return nil
+		}
+		errBucket := peerErrors.Bucket(pubKey[:])
+		if errBucket == nil {
+			return nil
+		}
+
+		return errBucket.ForEach(func(_, v []byte) error {
+			peerErr, err := deserializePeerError(bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+
+			peerErrs = append(peerErrs, peerErr)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return peerErrs, nil
+}
+
+// serializePeerError writes the passed peer error to w.
+func serializePeerError(w io.Writer, peerErr *PeerError) error {
+	return WriteElements(w,
+		unixNanoOrZero(peerErr.Timestamp), peerErr.Outgoing,
+		[32]byte(peerErr.ChanID), peerErr.Data,
+	)
+}
+
+// deserializePeerError reads a peer error serialized by serializePeerError
+// from r.
+func deserializePeerError(r io.Reader) (*PeerError, error) {
+	var (
+		peerErr   PeerError
+		timestamp uint64
+		chanID    [32]byte
+	)
+	err := ReadElements(r,
+		&timestamp, &peerErr.Outgoing, &chanID, &peerErr.Data,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	peerErr.Timestamp = timeFromUnixNano(timestamp)
+	peerErr.ChanID = lnwire.ChannelID(chanID)
+
+	return &peerErr, nil
+}
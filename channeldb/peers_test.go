@@ -0,0 +1,167 @@
+package channeldb
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/davecgh/go-spew/spew"
+)
+
+// TestPeerFlapStats tests that peer flap stats are properly recorded and
+// updated, and deleted along with the peer's link node.
+func TestPeerFlapStats(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	_, pub := btcec.PrivKeyFromBytes(btcec.S256(), key[:])
+	linkNode := cdb.NewLinkNode(wire.MainNet, pub)
+	if err := linkNode.Sync(); err != nil {
+		t.Fatalf("unable to sync link node: %v", err)
+	}
+
+	var pubKey [33]byte
+	copy(pubKey[:], pub.SerializeCompressed())
+
+	if _, err := cdb.FetchPeerFlapStats(pubKey); err != ErrNoPeerFlapStats {
+		t.Fatalf("expected ErrNoPeerFlapStats, got %v", err)
+	}
+
+	// Marking the peer as online should create a record without any
+	// flaps.
+	onlineTime := time.Unix(1000, 500)
+	if err := cdb.RecordPeerOnline(pubKey, onlineTime); err != nil {
+		t.Fatalf("unable to record peer online: %v", err)
+	}
+	stats, err := cdb.FetchPeerFlapStats(pubKey)
+	if err != nil {
+		t.Fatalf("unable to fetch flap stats: %v", err)
+	}
+	if stats.FlapCount != 0 || !stats.LastFlap.IsZero() ||
+		!stats.LastOnline.Equal(onlineTime) {
+
+		t.Fatalf("unexpected flap stats: %v", spew.Sdump(stats))
+	}
+
+	// Each flap should increment the count, and update both timestamps.
+	for i := 1; i <= 3; i++ {
+		flapTime := onlineTime.Add(time.Duration(i) * time.Minute)
+		updated, err := cdb.RecordPeerFlap(pubKey, flapTime)
+		if err != nil {
+			t.Fatalf("unable to record peer flap: %v", err)
+		}
+
+		stats, err := cdb.FetchPeerFlapStats(pubKey)
+		if err != nil {
+			t.Fatalf("unable to fetch flap stats: %v", err)
+		}
+		if stats.FlapCount != updated.FlapCount ||
+			!stats.LastFlap.Equal(updated.LastFlap) {
+
+			t.Fatalf("returned stats %v don't match stored stats "+
+				"%v", spew.Sdump(updated), spew.Sdump(stats))
+		}
+		if stats.FlapCount != uint32(i) ||
+			!stats.LastFlap.Equal(flapTime) ||
+			!stats.LastOnline.Equal(flapTime) {
+
+			t.Fatalf("unexpected flap stats: %v", spew.Sdump(stats))
+		}
+	}
+
+	if err := cdb.DeleteLinkNode(pub); err != nil {
+		t.Fatalf("unable to delete link node: %v", err)
+	}
+	if _, err := cdb.FetchPeerFlapStats(pubKey); err != ErrNoPeerFlapStats {
+		t.Fatalf("expected ErrNoPeerFlapStats, got %v", err)
+	}
+}
+
+// TestPeerErrors tests that only the most recent errors exchanged with a peer
+// are kept, and that they're deleted along with the peer's link node.
+func TestPeerErrors(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	_, pub := btcec.PrivKeyFromBytes(btcec.S256(), key[:])
+	linkNode := cdb.NewLinkNode(wire.MainNet, pub)
+	if err := linkNode.Sync(); err != nil {
+		t.Fatalf("unable to sync link node: %v", err)
+	}
+
+	var pubKey [33]byte
+	copy(pubKey[:], pub.SerializeCompressed())
+
+	peerErrs, err := cdb.FetchPeerErrors(pubKey)
+	if err != nil {
+		t.Fatalf("unable to fetch peer errors: %v", err)
+	}
+	if len(peerErrs) != 0 {
+		t.Fatalf("expected no peer errors, got %v", len(peerErrs))
+	}
+
+	const (
+		maxErrors = 3
+		numErrors = 5
+	)
+	var added []*PeerError
+	for i := 0; i < numErrors; i++ {
+		peerErr := &PeerError{
+			Timestamp: time.Unix(int64(i), 0),
+			Outgoing:  i%2 == 0,
+			Data:      []byte{byte(i)},
+		}
+		peerErr.ChanID[0] = byte(i)
+
+		err := cdb.AddPeerError(pubKey, peerErr, maxErrors)
+		if err != nil {
+			t.Fatalf("unable to add peer error: %v", err)
+		}
+		added = append(added, peerErr)
+	}
+
+	// Only the most recent errors should have been kept, oldest first.
+	peerErrs, err = cdb.FetchPeerErrors(pubKey)
+	if err != nil {
+		t.Fatalf("unable to fetch peer errors: %v", err)
+	}
+	expected := added[numErrors-maxErrors:]
+	if len(peerErrs) != len(expected) {
+		t.Fatalf("expected %v peer errors, got %v", len(expected),
+			len(peerErrs))
+	}
+	for i, peerErr := range peerErrs {
+		exp := expected[i]
+		if !peerErr.Timestamp.Equal(exp.Timestamp) ||
+			peerErr.Outgoing != exp.Outgoing ||
+			peerErr.ChanID != exp.ChanID ||
+			!bytes.Equal(peerErr.Data, exp.Data) {
+
+			t.Fatalf("peer error mismatch: expected %v, got %v",
+				spew.Sdump(exp), spew.Sdump(peerErr))
+		}
+	}
+
+	if err := cdb.DeleteLinkNode(pub); err != nil {
+		t.Fatalf("unable to delete link node: %v", err)
+	}
+	peerErrs, err = cdb.FetchPeerErrors(pubKey)
+	if err != nil {
+		t.Fatalf("unable to fetch peer errors: %v", err)
+	}
+	if len(peerErrs) != 0 {
+		t.Fatalf("expected no peer errors, got %v", len(peerErrs))
+	}
+}
@@ -0,0 +1,158 @@
+package channeldb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"time"
+
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// rebalanceBucket is the name of the bucket within the database that
+	// stores all completed circular rebalances of our channels. These are
+	// kept apart from the payments bucket, as they don't move any funds
+	// out of the node besides the fees paid.
+	//
+	// Within the rebalance bucket, each rebalance is keyed by a
+	// monotonically increasing uint64 generated by BoltDB's sequence
+	// feature.
+	rebalanceBucket = []byte("rebalances")
+)
+
+// Rebalance is a completed circular payment that moved funds from one of our
+// channels to another one, by paying ourselves.
+type Rebalance struct {
+	// OutgoingChanID is the channel the funds were moved out of.
+	OutgoingChanID lnwire.ShortChannelID
+
+	// IncomingChanID is the channel the funds were moved into.
+	IncomingChanID lnwire.ShortChannelID
+
+	// Amount is the amount that was moved, excluding fees.
+	Amount lnwire.MilliSatoshi
+
+	// Fee is the total fee paid to the nodes along the route.
+	Fee lnwire.MilliSatoshi
+
+	// PaymentHash is the payment hash of the internal invoice that was
+	// paid to complete the rebalance.
+	PaymentHash [32]byte
+
+	// PaymentPreimage is the preimage of the internal invoice.
+	PaymentPreimage [32]byte
+
+	// Path is the list of channels the payment traversed, starting with
+	// OutgoingChanID and ending with IncomingChanID.
+	Path []lnwire.ShortChannelID
+
+	// Timestamp is the time at which the rebalance completed.
+	Timestamp time.Time
+}
+
+// AddRebalance saves a completed rebalance to the database.
+func (db *DB) AddRebalance(rebalance *Rebalance) error {
+	var b bytes.Buffer
+	if err := serializeRebalance(&b, rebalance); err != nil {
+		return err
+	}
+	rebalanceBytes := b.Bytes()
+
+	return db.Batch(func(tx *bbolt.Tx) error {
+		rebalances, err := tx.CreateBucketIfNotExists(rebalanceBucket)
+		if err != nil {
+			return err
+		}
+
+		rebalanceID, err := rebalances.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		// We use BigEndian for keys as it orders keys in ascending
+		// order, such that rebalances are fetched in the order in
+		// which they were completed.
+		var rebalanceIDBytes [8]byte
+		binary.BigEndian.PutUint64(rebalanceIDBytes[:], rebalanceID)
+
+		return rebalances.Put(rebalanceIDBytes[:], rebalanceBytes)
+	})
+}
+
+// FetchAllRebalances returns all completed rebalances in the database, in the
+// order in which they were completed.
+func (db *DB) FetchAllRebalances() ([]*Rebalance, error) {
+	var rebalances []*Rebalance
+
+	err := db.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(rebalanceBucket)
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			rebalance, err := deserializeRebalance(
+				bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+
+			rebalances = append(rebalances, rebalance)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rebalances, nil
+}
+
+func serializeRebalance(w io.Writer, r *Rebalance) error {
+	err := WriteElements(
+		w, r.OutgoingChanID, r.IncomingChanID, r.Amount, r.Fee,
+		r.PaymentHash, r.PaymentPreimage,
+		uint64(r.Timestamp.UnixNano()), uint16(len(r.Path)),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, chanID := range r.Path {
+		if err := WriteElement(w, chanID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deserializeRebalance(r io.Reader) (*Rebalance, error) {
+	var (
+		rebalance Rebalance
+		timestamp uint64
+		pathLen   uint16
+	)
+
+	err := ReadElements(
+		r, &rebalance.OutgoingChanID, &rebalance.IncomingChanID,
+		&rebalance.Amount, &rebalance.Fee, &rebalance.PaymentHash,
+		&rebalance.PaymentPreimage, &timestamp, &pathLen,
+	)
+	if err != nil {
+		return nil, err
+	}
+	rebalance.Timestamp = time.Unix(0, int64(timestamp))
+
+	rebalance.Path = make([]lnwire.ShortChannelID, pathLen)
+	for i := range rebalance.Path {
+		if err := ReadElement(r, &rebalance.Path[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return &rebalance, nil
+}
@@ -0,0 +1,72 @@
+package channeldb
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestRebalanceStorage asserts that rebalances are stored and fetched in the
+// order in which they were added, and are kept apart from payments.
+func TestRebalanceStorage(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	rebalances, err := db.FetchAllRebalances()
+	if err != nil {
+		t.Fatalf("unable to fetch rebalances: %v", err)
+	}
+	if len(rebalances) != 0 {
+		t.Fatalf("expected no rebalances, got %v", len(rebalances))
+	}
+
+	var expectedRebalances []*Rebalance
+	for i := 0; i < 3; i++ {
+		rebalance := &Rebalance{
+			OutgoingChanID: lnwire.NewShortChanIDFromInt(uint64(i)),
+			IncomingChanID: lnwire.NewShortChanIDFromInt(100),
+			Amount:         lnwire.MilliSatoshi(1000 * (i + 1)),
+			Fee:            lnwire.MilliSatoshi(i),
+			Path: []lnwire.ShortChannelID{
+				lnwire.NewShortChanIDFromInt(uint64(i)),
+				lnwire.NewShortChanIDFromInt(50),
+				lnwire.NewShortChanIDFromInt(100),
+			},
+			Timestamp: time.Unix(0, int64(i)),
+		}
+		rebalance.PaymentHash[0] = byte(i)
+		rebalance.PaymentPreimage[1] = byte(i)
+
+		if err := db.AddRebalance(rebalance); err != nil {
+			t.Fatalf("unable to add rebalance: %v", err)
+		}
+
+		expectedRebalances = append(expectedRebalances, rebalance)
+	}
+
+	rebalances, err = db.FetchAllRebalances()
+	if err != nil {
+		t.Fatalf("unable to fetch rebalances: %v", err)
+	}
+	if !reflect.DeepEqual(rebalances, expectedRebalances) {
+		t.Fatalf("expected rebalances %v, got %v",
+			spew.Sdump(expectedRebalances), spew.Sdump(rebalances))
+	}
+
+	// Rebalances must not show up as payments.
+	payments, err := db.FetchAllPayments()
+	if err != nil {
+		t.Fatalf("unable to fetch payments: %v", err)
+	}
+	if len(payments) != 0 {
+		t.Fatalf("expected no payments, got %v", len(payments))
+	}
+}
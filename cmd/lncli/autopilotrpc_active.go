@@ -4,6 +4,7 @@ package main
 
 import (
 	"context"
+	"io"
 
 	"github.com/lightningnetwork/lnd/lnrpc/autopilotrpc"
 	"github.com/urfave/cli"
@@ -143,6 +144,67 @@ loop:
 	return nil
 }
 
+var dryRunCommand = cli.Command{
+	Name:  "dryrun",
+	Usage: "Show the channels the autopilot would open right now.",
+	Description: `
+	Runs the autopilot heuristics and constraints against the current
+	channel graph and wallet balance, and returns the channels the agent
+	would open, along with the nodes it would skip and why. No channels
+	are opened. This works regardless of whether the autopilot is active.`,
+	Action: actionDecorator(dryRun),
+}
+
+func dryRun(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getAutopilotClient(ctx)
+	defer cleanUp()
+
+	req := &autopilotrpc.DryRunRequest{}
+
+	resp, err := client.DryRun(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var subscribeDecisionsCommand = cli.Command{
+	Name:  "decisions",
+	Usage: "Watch the decisions made by the active autopilot.",
+	Description: `
+	Streams the decisions made by the active autopilot agent as they
+	happen, including the channels it opens, the nodes it skips and any
+	failed attempts at opening channels.`,
+	Action: actionDecorator(subscribeDecisions),
+}
+
+func subscribeDecisions(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getAutopilotClient(ctx)
+	defer cleanUp()
+
+	req := &autopilotrpc.SubscribeDecisionsRequest{}
+
+	stream, err := client.SubscribeDecisions(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		decision, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		printRespJSON(decision)
+	}
+}
+
 // autopilotCommands will return the set of commands to enable for autopilotrpc
 // builds.
 func autopilotCommands() []cli.Command {
@@ -157,6 +219,8 @@ func autopilotCommands() []cli.Command {
 				enableCommand,
 				disableCommand,
 				queryScoresCommand,
+				dryRunCommand,
+				subscribeDecisionsCommand,
 			},
 		},
 	}
@@ -15,18 +15,21 @@ import (
 	"strings"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/proto"
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/macaroons"
 	"github.com/lightningnetwork/lnd/walletunlocker"
 	"github.com/urfave/cli"
 	"golang.org/x/crypto/ssh/terminal"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	macaroon "gopkg.in/macaroon.v2"
 )
 
 // TODO(roasbeef): cli logic for supporting both positional and unix style
@@ -1279,7 +1282,14 @@ var listPeersCommand = cli.Command{
 	Name:     "listpeers",
 	Category: "Peers",
 	Usage:    "List all active, currently connected peers.",
-	Action:   actionDecorator(listPeers),
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name: "errors",
+			Usage: "include the most recent errors exchanged with " +
+				"each peer",
+		},
+	},
+	Action: actionDecorator(listPeers),
 }
 
 func listPeers(ctx *cli.Context) error {
@@ -1287,7 +1297,9 @@ func listPeers(ctx *cli.Context) error {
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
-	req := &lnrpc.ListPeersRequest{}
+	req := &lnrpc.ListPeersRequest{
+		IncludeErrors: ctx.Bool("errors"),
+	}
 	resp, err := client.ListPeers(ctxb, req)
 	if err != nil {
 		return err
@@ -1297,6 +1309,128 @@ func listPeers(ctx *cli.Context) error {
 	return nil
 }
 
+var sendCustomCommand = cli.Command{
+	Name:      "sendcustom",
+	Category:  "Peers",
+	Usage:     "Send a custom message to a connected peer.",
+	ArgsUsage: "peer type data",
+	Description: `
+	Sends a custom message of the given type to a connected peer. The type
+	must be within the custom range, i.e. 32768 or above, and the data is
+	sent as is.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "peer",
+			Usage: "the hex-encoded compressed public key of the " +
+				"peer to send the message to",
+		},
+		cli.Uint64Flag{
+			Name:  "type",
+			Usage: "the message type, 32768 or above",
+		},
+		cli.StringFlag{
+			Name:  "data",
+			Usage: "the hex-encoded payload of the message",
+		},
+	},
+	Action: actionDecorator(sendCustom),
+}
+
+func sendCustom(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	args := ctx.Args()
+
+	var peerHex string
+	switch {
+	case ctx.IsSet("peer"):
+		peerHex = ctx.String("peer")
+	case args.Present():
+		peerHex = args.First()
+		args = args.Tail()
+	default:
+		return fmt.Errorf("peer argument missing")
+	}
+	peer, err := hex.DecodeString(peerHex)
+	if err != nil {
+		return fmt.Errorf("unable to decode peer pubkey: %v", err)
+	}
+
+	var msgType uint64
+	switch {
+	case ctx.IsSet("type"):
+		msgType = ctx.Uint64("type")
+	case args.Present():
+		msgType, err = strconv.ParseUint(args.First(), 10, 16)
+		if err != nil {
+			return fmt.Errorf("unable to decode type: %v", err)
+		}
+		args = args.Tail()
+	default:
+		return fmt.Errorf("type argument missing")
+	}
+
+	var dataHex string
+	switch {
+	case ctx.IsSet("data"):
+		dataHex = ctx.String("data")
+	case args.Present():
+		dataHex = args.First()
+	}
+	data, err := hex.DecodeString(dataHex)
+	if err != nil {
+		return fmt.Errorf("unable to decode data: %v", err)
+	}
+
+	req := &lnrpc.SendCustomMessageRequest{
+		Peer: peer,
+		Type: uint32(msgType),
+		Data: data,
+	}
+	resp, err := client.SendCustomMessage(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var subscribeCustomCommand = cli.Command{
+	Name:     "subscribecustom",
+	Category: "Peers",
+	Usage:    "Watch the custom messages received from our peers.",
+	Description: `
+	Streams the custom messages received from any of our connected peers
+	as they arrive.`,
+	Action: actionDecorator(subscribeCustom),
+}
+
+func subscribeCustom(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.SubscribeCustomMessagesRequest{}
+	stream, err := client.SubscribeCustomMessages(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		printRespJSON(msg)
+	}
+}
+
 var createCommand = cli.Command{
 	Name:     "create",
 	Category: "Startup",
@@ -1320,6 +1454,13 @@ var createCommand = cli.Command{
 	to potentially recover all on-chain funds, and most off-chain funds as
 	well.
 
+	If the --shares flag is set in the form M-of-N, then a freshly
+	generated seed will instead be split into N 30-word seed shares, any M
+	of which are required to restore the wallet. The full 24-word seed
+	won't be displayed in this case. When restoring from an existing seed
+	with this flag set, M of the seed shares will be prompted for instead
+	of the 24-word mnemonic.
+
 	Finally, it's also possible to use this command and a set of static
 	channel backups to trigger a recover attempt for the provided Static
 	Channel Backups. Only one of the three parameters will be accepted. See
@@ -1341,6 +1482,12 @@ var createCommand = cli.Command{
 			Name:  "multi_file",
 			Usage: "the path to a multi-channel back up file",
 		},
+		cli.StringFlag{
+			Name: "shares",
+			Usage: "split the cipher seed into seed shares, " +
+				"specified as M-of-N, where M shares out of " +
+				"N are needed to restore the wallet",
+		},
 	},
 	Action: actionDecorator(create),
 }
@@ -1372,6 +1519,49 @@ func monowidthColumns(words []string, ncols int) []string {
 	return finalWords
 }
 
+// parseShareParams parses the threshold and number of shares from a string of
+// the form M-of-N.
+func parseShareParams(shares string) (uint32, uint32, error) {
+	parts := strings.Split(strings.ToLower(shares), "-of-")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid shares %q, expected M-of-N",
+			shares)
+	}
+
+	threshold, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("unable to parse share threshold: %v",
+			err)
+	}
+	numShares, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("unable to parse number of shares: %v",
+			err)
+	}
+
+	if threshold < 2 || threshold > numShares {
+		return 0, 0, fmt.Errorf("share threshold must be at least 2 " +
+			"and no more than the number of shares")
+	}
+
+	return uint32(threshold), uint32(numShares), nil
+}
+
+// printCipherSeedWords displays the words of a cipher seed, or one of its
+// shares, in numbered mono-width columns.
+func printCipherSeedWords(words []string, numCols int) {
+	colWords := monowidthColumns(words, numCols)
+	for i := 0; i < len(colWords); i += numCols {
+		for j := i; j < i+numCols && j < len(colWords); j++ {
+			if j != i {
+				fmt.Printf("  ")
+			}
+			fmt.Printf("%2d. %3s", j+1, colWords[j])
+		}
+		fmt.Println()
+	}
+}
+
 func create(ctx *cli.Context) error {
 	ctxb := context.Background()
 	client, cleanUp := getWalletUnlockerClient(ctx)
@@ -1405,6 +1595,19 @@ func create(ctx *cli.Context) error {
 		return pwErr
 	}
 
+	// If the user wishes to use seed shares, we'll parse the threshold and
+	// number of shares up front.
+	var shareThreshold, numShares uint32
+	if ctx.IsSet("shares") {
+		shareThreshold, numShares, err = parseShareParams(
+			ctx.String("shares"),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	useShares := numShares != 0
+
 	// Next, we'll see if the user has 24-word mnemonic they want to use to
 	// derive a seed within the wallet.
 	var (
@@ -1442,10 +1645,43 @@ mnemonicCheck:
 	// we'll read that in directly from the terminal.
 	var (
 		cipherSeedMnemonic []string
+		cipherSeedShares   []*lnrpc.CipherSeedShare
 		aezeedPass         []byte
 		recoveryWindow     int32
 	)
-	if hasMnemonic {
+	switch {
+	// If the user restores from seed shares, we'll prompt them for as many
+	// 30-word shares as the threshold requires.
+	case hasMnemonic && useShares:
+		for i := uint32(0); i < shareThreshold; i++ {
+			fmt.Printf("Input 30-word seed share %d of %d "+
+				"separated by spaces: ", i+1, shareThreshold)
+			reader := bufio.NewReader(os.Stdin)
+			share, err := reader.ReadString('\n')
+			if err != nil {
+				return err
+			}
+
+			share = strings.TrimSpace(share)
+			share = strings.ToLower(share)
+			shareWords := strings.Split(share, " ")
+
+			fmt.Println()
+
+			if len(shareWords) != 30 {
+				return fmt.Errorf("wrong seed share length: "+
+					"got %v words, expecting %v words",
+					len(shareWords), 30)
+			}
+
+			cipherSeedShares = append(
+				cipherSeedShares, &lnrpc.CipherSeedShare{
+					ShareMnemonic: shareWords,
+				},
+			)
+		}
+
+	case hasMnemonic:
 		// We'll now prompt the user to enter in their 24-word
 		// mnemonic.
 		fmt.Printf("Input your 24-word mnemonic separated by spaces: ")
@@ -1469,6 +1705,9 @@ mnemonicCheck:
 				"length: got %v words, expecting %v words",
 				len(cipherSeedMnemonic), 24)
 		}
+	}
+
+	if hasMnemonic {
 
 		// Additionally, the user may have a passphrase, that will also
 		// need to be provided so the daemon can properly decipher the
@@ -1551,6 +1790,8 @@ mnemonicCheck:
 
 		genSeedReq := &lnrpc.GenSeedRequest{
 			AezeedPassphrase: aezeedPass1,
+			ShareThreshold:   shareThreshold,
+			NumShares:        numShares,
 		}
 		seedResp, err := client.GenSeed(ctxb, genSeedReq)
 		if err != nil {
@@ -1558,30 +1799,44 @@ mnemonicCheck:
 		}
 
 		cipherSeedMnemonic = seedResp.CipherSeedMnemonic
+		cipherSeedShares = seedResp.CipherSeedShares
 		aezeedPass = aezeedPass1
 	}
 
-	// Before we initialize the wallet, we'll display the cipher seed to
-	// the user so they can write it down.
-	mnemonicWords := cipherSeedMnemonic
+	// Before we initialize the wallet, we'll display the cipher seed, or
+	// its shares, to the user so they can write it down.
+	switch {
+	case useShares:
+		fmt.Printf("!!!YOU MUST WRITE DOWN THESE SEED SHARES AND "+
+			"STORE THEM SEPARATELY, %d OF THEM ARE NEEDED TO "+
+			"RESTORE THE WALLET!!!\n\n", shareThreshold)
 
-	fmt.Println("!!!YOU MUST WRITE DOWN THIS SEED TO BE ABLE TO " +
-		"RESTORE THE WALLET!!!\n")
+		for i, share := range cipherSeedShares {
+			fmt.Printf("-------------BEGIN LND SEED SHARE %2d-----"+
+				"--------\n", i+1)
 
-	fmt.Println("---------------BEGIN LND CIPHER SEED---------------")
+			printCipherSeedWords(share.ShareMnemonic, 3)
 
-	numCols := 4
-	colWords := monowidthColumns(mnemonicWords, numCols)
-	for i := 0; i < len(colWords); i += numCols {
-		fmt.Printf("%2d. %3s  %2d. %3s  %2d. %3s  %2d. %3s\n",
-			i+1, colWords[i], i+2, colWords[i+1], i+3,
-			colWords[i+2], i+4, colWords[i+3])
-	}
+			fmt.Printf("-------------END LND SEED SHARE %2d-------"+
+				"--------\n\n", i+1)
+		}
+
+		fmt.Println("!!!YOU MUST WRITE DOWN THESE SEED SHARES TO BE " +
+			"ABLE TO RESTORE THE WALLET!!!")
+
+	default:
+		fmt.Println("!!!YOU MUST WRITE DOWN THIS SEED TO BE ABLE TO " +
+			"RESTORE THE WALLET!!!\n")
+
+		fmt.Println("---------------BEGIN LND CIPHER SEED---------------")
 
-	fmt.Println("---------------END LND CIPHER SEED-----------------")
+		printCipherSeedWords(cipherSeedMnemonic, 4)
 
-	fmt.Println("\n!!!YOU MUST WRITE DOWN THIS SEED TO BE ABLE TO " +
-		"RESTORE THE WALLET!!!")
+		fmt.Println("---------------END LND CIPHER SEED-----------------")
+
+		fmt.Println("\n!!!YOU MUST WRITE DOWN THIS SEED TO BE ABLE TO " +
+			"RESTORE THE WALLET!!!")
+	}
 
 	// We'll also check to see if they provided any static channel backups,
 	// if so, then we'll also tack these onto the final innit wallet
@@ -1612,6 +1867,7 @@ mnemonicCheck:
 	req := &lnrpc.InitWalletRequest{
 		WalletPassword:     pw1,
 		CipherSeedMnemonic: cipherSeedMnemonic,
+		CipherSeedShares:   cipherSeedShares,
 		AezeedPassphrase:   aezeedPass,
 		RecoveryWindow:     recoveryWindow,
 		ChannelBackups:     chanBackups,
@@ -2004,6 +2260,117 @@ var cltvLimitFlag = cli.UintFlag{
 		"this payment",
 }
 
+var lastHopFlag = cli.StringFlag{
+	Name: "last_hop",
+	Usage: "(optional) the hex-encoded pubkey of the node that " +
+		"must be the last hop before the destination",
+}
+
+var ignoreNodeFlag = cli.StringSliceFlag{
+	Name: "ignore_node",
+	Usage: "(optional) the hex-encoded pubkey of a node that must " +
+		"not be used by the route, can be specified multiple times",
+}
+
+var ignorePairFlag = cli.StringSliceFlag{
+	Name: "ignore_pair",
+	Usage: "(optional) a directed node pair of the form " +
+		"<from_pubkey>:<to_pubkey> whose channels must not be used " +
+		"in that direction, can be specified multiple times",
+}
+
+// parsePubKeyHex decodes a hex-encoded compressed public key.
+func parsePubKeyHex(pubKeyHex string) ([]byte, error) {
+	pubKey, err := hex.DecodeString(pubKeyHex)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode pubkey %v: %v",
+			pubKeyHex, err)
+	}
+
+	if len(pubKey) != 33 {
+		return nil, fmt.Errorf("pubkey %v must be exactly 33 bytes",
+			pubKeyHex)
+	}
+
+	return pubKey, nil
+}
+
+// retrieveLastHop retrieves the last hop restriction passed using the
+// --last_hop flag, if any.
+func retrieveLastHop(ctx *cli.Context) ([]byte, error) {
+	if !ctx.IsSet(lastHopFlag.Name) {
+		return nil, nil
+	}
+
+	return parsePubKeyHex(ctx.String(lastHopFlag.Name))
+}
+
+// retrieveIgnoredNodes retrieves the nodes passed using the --ignore_node
+// flag.
+func retrieveIgnoredNodes(ctx *cli.Context) ([][]byte, error) {
+	var ignoredNodes [][]byte
+	for _, pubKeyHex := range ctx.StringSlice(ignoreNodeFlag.Name) {
+		pubKey, err := parsePubKeyHex(pubKeyHex)
+		if err != nil {
+			return nil, err
+		}
+
+		ignoredNodes = append(ignoredNodes, pubKey)
+	}
+
+	return ignoredNodes, nil
+}
+
+// retrieveIgnoredPairs retrieves the directed node pairs passed using the
+// --ignore_pair flag.
+func retrieveIgnoredPairs(ctx *cli.Context) ([]*lnrpc.NodePair, error) {
+	var ignoredPairs []*lnrpc.NodePair
+	for _, pair := range ctx.StringSlice(ignorePairFlag.Name) {
+		pubKeys := strings.Split(pair, ":")
+		if len(pubKeys) != 2 {
+			return nil, fmt.Errorf("invalid node pair %v, "+
+				"expected <from_pubkey>:<to_pubkey>", pair)
+		}
+
+		from, err := parsePubKeyHex(pubKeys[0])
+		if err != nil {
+			return nil, err
+		}
+
+		to, err := parsePubKeyHex(pubKeys[1])
+		if err != nil {
+			return nil, err
+		}
+
+		ignoredPairs = append(ignoredPairs, &lnrpc.NodePair{
+			From: from,
+			To:   to,
+		})
+	}
+
+	return ignoredPairs, nil
+}
+
+// retrievePaymentRestrictions populates the path finding restrictions of the
+// given payment from the --last_hop, --ignore_node and --ignore_pair flags.
+func retrievePaymentRestrictions(ctx *cli.Context,
+	req *lnrpc.SendRequest) error {
+
+	var err error
+	req.LastHopPubkey, err = retrieveLastHop(ctx)
+	if err != nil {
+		return err
+	}
+
+	req.IgnoredNodes, err = retrieveIgnoredNodes(ctx)
+	if err != nil {
+		return err
+	}
+
+	req.IgnoredPairs, err = retrieveIgnoredPairs(ctx)
+	return err
+}
+
 var sendPaymentCommand = cli.Command{
 	Name:     "sendpayment",
 	Category: "Payments",
@@ -2073,6 +2440,9 @@ var sendPaymentCommand = cli.Command{
 				"use for the first hop of the payment",
 			Value: 0,
 		},
+		lastHopFlag,
+		ignoreNodeFlag,
+		ignorePairFlag,
 		cli.BoolFlag{
 			Name:  "force, f",
 			Usage: "will skip payment request confirmation",
@@ -2172,6 +2542,9 @@ func sendPayment(ctx *cli.Context) error {
 			OutgoingChanId: ctx.Uint64("outgoing_chan_id"),
 			CltvLimit:      uint32(ctx.Int(cltvLimitFlag.Name)),
 		}
+		if err := retrievePaymentRestrictions(ctx, req); err != nil {
+			return err
+		}
 
 		return sendPaymentRequest(client, req)
 	}
@@ -2212,9 +2585,14 @@ func sendPayment(ctx *cli.Context) error {
 	}
 
 	req := &lnrpc.SendRequest{
-		Dest:     destNode,
-		Amt:      amount,
-		FeeLimit: feeLimit,
+		Dest:           destNode,
+		Amt:            amount,
+		FeeLimit:       feeLimit,
+		OutgoingChanId: ctx.Uint64("outgoing_chan_id"),
+		CltvLimit:      uint32(ctx.Int(cltvLimitFlag.Name)),
+	}
+	if err := retrievePaymentRestrictions(ctx, req); err != nil {
+		return err
 	}
 
 	if ctx.Bool("debug_send") && (ctx.IsSet("payment_hash") || args.Present()) {
@@ -2325,6 +2703,9 @@ var payInvoiceCommand = cli.Command{
 				"use for the first hop of the payment",
 			Value: 0,
 		},
+		lastHopFlag,
+		ignoreNodeFlag,
+		ignorePairFlag,
 		cli.BoolFlag{
 			Name:  "force, f",
 			Usage: "will skip payment request confirmation",
@@ -2367,6 +2748,9 @@ func payInvoice(ctx *cli.Context) error {
 		OutgoingChanId: ctx.Uint64("outgoing_chan_id"),
 		CltvLimit:      uint32(ctx.Int(cltvLimitFlag.Name)),
 	}
+	if err := retrievePaymentRestrictions(ctx, req); err != nil {
+		return err
+	}
 
 	return sendPaymentRequest(client, req)
 }
@@ -2987,6 +3371,14 @@ var queryRoutesCommand = cli.Command{
 			Usage: "(optional) number of blocks the last hop has to reveal " +
 				"the preimage",
 		},
+		cli.Uint64Flag{
+			Name: "outgoing_chan_id",
+			Usage: "(optional) the channel id of the channel " +
+				"that must be taken to the first hop",
+		},
+		lastHopFlag,
+		ignoreNodeFlag,
+		ignorePairFlag,
 	},
 	Action: actionDecorator(queryRoutes),
 }
@@ -3031,12 +3423,31 @@ func queryRoutes(ctx *cli.Context) error {
 		return err
 	}
 
+	lastHop, err := retrieveLastHop(ctx)
+	if err != nil {
+		return err
+	}
+
+	ignoredNodes, err := retrieveIgnoredNodes(ctx)
+	if err != nil {
+		return err
+	}
+
+	ignoredPairs, err := retrieveIgnoredPairs(ctx)
+	if err != nil {
+		return err
+	}
+
 	req := &lnrpc.QueryRoutesRequest{
 		PubKey:         dest,
 		Amt:            amt,
 		FeeLimit:       feeLimit,
 		NumRoutes:      int32(ctx.Int("num_max_routes")),
 		FinalCltvDelta: int32(ctx.Int("final_cltv_delta")),
+		OutgoingChanId: ctx.Uint64("outgoing_chan_id"),
+		LastHopPubkey:  lastHop,
+		IgnoredNodes:   ignoredNodes,
+		IgnoredPairs:   ignoredPairs,
 	}
 
 	route, err := client.QueryRoutes(ctxb, req)
@@ -3074,58 +3485,277 @@ func getNetworkInfo(ctx *cli.Context) error {
 	return nil
 }
 
-var debugLevelCommand = cli.Command{
-	Name:  "debuglevel",
-	Usage: "Set the debug level.",
-	Description: `Logging level for all subsystems {trace, debug, info, warn, error, critical, off}
-	You may also specify <subsystem>=<level>,<subsystem2>=<level>,... to set the log level for individual subsystems
-
-	Use show to list available subsystems`,
+var updateBanListCommand = cli.Command{
+	Name:     "updatebanlist",
+	Category: "Channels",
+	Usage:    "Ban or unban nodes and channels of the graph.",
+	Description: `
+	Adds nodes and channels to, or removes them from, the ban list of the
+	graph. Announcements for banned nodes and channels are dropped rather
+	than validated and relayed, they're purged from the graph, and they're
+	never used for path finding. Each flag can be specified multiple times.
+	`,
 	Flags: []cli.Flag{
-		cli.BoolFlag{
-			Name:  "show",
-			Usage: "if true, then the list of available sub-systems will be printed out",
+		cli.StringSliceFlag{
+			Name:  "ban_node",
+			Usage: "the hex encoded public key of a node to ban",
 		},
-		cli.StringFlag{
-			Name:  "level",
-			Usage: "the level specification to target either a coarse logging level, or granular set of specific sub-systems with logging levels for each",
+		cli.StringSliceFlag{
+			Name:  "unban_node",
+			Usage: "the hex encoded public key of a node to unban",
+		},
+		cli.StringSliceFlag{
+			Name:  "ban_chan_id",
+			Usage: "the short channel ID of a channel to ban",
+		},
+		cli.StringSliceFlag{
+			Name:  "unban_chan_id",
+			Usage: "the short channel ID of a channel to unban",
 		},
 	},
-	Action: actionDecorator(debugLevel),
+	Action: actionDecorator(updateBanList),
 }
 
-func debugLevel(ctx *cli.Context) error {
+func updateBanList(ctx *cli.Context) error {
 	ctxb := context.Background()
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
-	req := &lnrpc.DebugLevelRequest{
-		Show:      ctx.Bool("show"),
-		LevelSpec: ctx.String("level"),
+
+	banChanIDs, err := parseChanIDs(ctx.StringSlice("ban_chan_id"))
+	if err != nil {
+		return err
+	}
+	unbanChanIDs, err := parseChanIDs(ctx.StringSlice("unban_chan_id"))
+	if err != nil {
+		return err
 	}
 
-	resp, err := client.DebugLevel(ctxb, req)
+	req := &lnrpc.UpdateGraphBanListRequest{
+		BanNodes:     ctx.StringSlice("ban_node"),
+		UnbanNodes:   ctx.StringSlice("unban_node"),
+		BanChanIds:   banChanIDs,
+		UnbanChanIds: unbanChanIDs,
+	}
+	if len(req.BanNodes) == 0 && len(req.UnbanNodes) == 0 &&
+		len(req.BanChanIds) == 0 && len(req.UnbanChanIds) == 0 {
+
+		return cli.ShowCommandHelp(ctx, "updatebanlist")
+	}
+
+	banList, err := client.UpdateGraphBanList(ctxb, req)
 	if err != nil {
 		return err
 	}
 
-	printRespJSON(resp)
+	printRespJSON(banList)
 	return nil
 }
 
-var decodePayReqCommand = cli.Command{
-	Name:        "decodepayreq",
-	Category:    "Payments",
-	Usage:       "Decode a payment request.",
-	Description: "Decode the passed payment request revealing the destination, payment hash and value of the payment request",
-	ArgsUsage:   "pay_req",
-	Flags: []cli.Flag{
-		cli.StringFlag{
-			Name:  "pay_req",
-			Usage: "the bech32 encoded payment request",
-		},
-	},
-	Action: actionDecorator(decodePayReq),
-}
+// parseChanIDs parses the given decimal short channel IDs.
+func parseChanIDs(chanIDs []string) ([]uint64, error) {
+	parsed := make([]uint64, 0, len(chanIDs))
+	for _, chanID := range chanIDs {
+		id, err := strconv.ParseUint(chanID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode "+
+				"chan_id %v: %v", chanID, err)
+		}
+		parsed = append(parsed, id)
+	}
+
+	return parsed, nil
+}
+
+var listBanListCommand = cli.Command{
+	Name:     "listbanlist",
+	Category: "Channels",
+	Usage:    "List the banned nodes and channels of the graph.",
+	Action:   actionDecorator(listBanList),
+}
+
+func listBanList(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ListGraphBanListRequest{}
+	banList, err := client.ListGraphBanList(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(banList)
+	return nil
+}
+
+var exportGraphCommand = cli.Command{
+	Name:      "exportgraph",
+	Category:  "Channels",
+	Usage:     "Export a snapshot of the channel graph to a file.",
+	ArgsUsage: "output_file",
+	Description: `
+	Writes a compact binary snapshot of the public part of the channel
+	graph to the given file. The snapshot can be imported by other nodes
+	through importgraph, allowing them to bootstrap their graph without
+	syncing it over the gossip network.
+	`,
+	Action: actionDecorator(exportGraph),
+}
+
+func exportGraph(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "exportgraph")
+	}
+
+	stream, err := client.ExportGraphSnapshot(
+		ctxb, &lnrpc.ExportGraphSnapshotRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			return f.Sync()
+		}
+		if err != nil {
+			return err
+		}
+
+		if _, err := f.Write(chunk.Data); err != nil {
+			return err
+		}
+	}
+}
+
+var importGraphCommand = cli.Command{
+	Name:      "importgraph",
+	Category:  "Channels",
+	Usage:     "Import a snapshot of the channel graph from a file.",
+	ArgsUsage: "input_file",
+	Description: `
+	Adds the contents of a graph snapshot created through exportgraph to
+	the channel graph. The signature of every announcement is validated,
+	but as the snapshot is trusted, the existence of its channels isn't
+	validated against the chain. Therefore, only import snapshots from a
+	trusted source.
+	`,
+	Action: actionDecorator(importGraph),
+}
+
+func importGraph(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "importgraph")
+	}
+
+	f, err := os.Open(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	stream, err := client.ImportGraphSnapshot(ctxb)
+	if err != nil {
+		return err
+	}
+
+	// We'll stream the snapshot in chunks, as it may exceed the maximum
+	// size of a single message.
+	buf := make([]byte, 64*1024)
+	for {
+		n, err := f.Read(buf)
+		if n > 0 {
+			chunk := &lnrpc.GraphSnapshotChunk{
+				Data: append([]byte(nil), buf[:n]...),
+			}
+			if err := stream.Send(chunk); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var debugLevelCommand = cli.Command{
+	Name:  "debuglevel",
+	Usage: "Set the debug level.",
+	Description: `Logging level for all subsystems {trace, debug, info, warn, error, critical, off}
+	You may also specify <subsystem>=<level>,<subsystem2>=<level>,... to set the log level for individual subsystems
+
+	Use show to list available subsystems`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "show",
+			Usage: "if true, then the list of available sub-systems will be printed out",
+		},
+		cli.StringFlag{
+			Name:  "level",
+			Usage: "the level specification to target either a coarse logging level, or granular set of specific sub-systems with logging levels for each",
+		},
+	},
+	Action: actionDecorator(debugLevel),
+}
+
+func debugLevel(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+	req := &lnrpc.DebugLevelRequest{
+		Show:      ctx.Bool("show"),
+		LevelSpec: ctx.String("level"),
+	}
+
+	resp, err := client.DebugLevel(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var decodePayReqCommand = cli.Command{
+	Name:        "decodepayreq",
+	Category:    "Payments",
+	Usage:       "Decode a payment request.",
+	Description: "Decode the passed payment request revealing the destination, payment hash and value of the payment request",
+	ArgsUsage:   "pay_req",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "pay_req",
+			Usage: "the bech32 encoded payment request",
+		},
+	},
+	Action: actionDecorator(decodePayReq),
+}
 
 func decodePayReq(ctx *cli.Context) error {
 	ctxb := context.Background()
@@ -3333,6 +3963,33 @@ func feeReport(ctx *cli.Context) error {
 	return nil
 }
 
+var feeDecisionsCommand = cli.Command{
+	Name:     "feedecisions",
+	Category: "Channels",
+	Usage:    "Display the latest decisions of the fee controller.",
+	Description: `
+	Returns the latest decision of the fee controller for each channel,
+	including the inputs it was based on, the computed fees and whether
+	they were applied. The fee controller is only active if lnd was
+	started with a fee policy file.`,
+	Action: actionDecorator(feeDecisions),
+}
+
+func feeDecisions(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.FeeControllerDecisionsRequest{}
+	resp, err := client.FeeControllerDecisions(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var updateChannelPolicyCommand = cli.Command{
 	Name:     "updatechanpolicy",
 	Category: "Channels",
@@ -3611,6 +4268,35 @@ func forwardingHistory(ctx *cli.Context) error {
 	return nil
 }
 
+var htlcQuotasCommand = cli.Command{
+	Name:     "htlcquotas",
+	Category: "Payments",
+	Usage:    "Display the htlc quota counters of incoming channels.",
+	Description: `
+	Returns the configured htlc quotas, along with the counters of every
+	incoming channel that htlcs have been forwarded or rejected for since
+	startup. These include the htlcs currently in flight, the number of
+	htlcs rejected for exceeding each quota and the number of htlcs that
+	were held for longer than the maximum hold time. Large reject or slow
+	counts for a channel are a sign of a jamming attempt.`,
+	Action: actionDecorator(htlcQuotas),
+}
+
+func htlcQuotas(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.HtlcQuotasRequest{}
+	resp, err := client.HtlcQuotas(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var exportChanBackupCommand = cli.Command{
 	Name:     "exportchanbackup",
 	Category: "Channels",
@@ -3985,3 +4671,326 @@ func restoreChanBackup(ctx *cli.Context) error {
 
 	return nil
 }
+
+var bakeMacaroonCommand = cli.Command{
+	Name:     "bakemacaroon",
+	Category: "Macaroons",
+	Usage: "Bakes a new macaroon with the provided list of permissions " +
+		"and restrictions",
+	ArgsUsage: "[--save_to=] [--root_key_id=] [--allow_method=] " +
+		"[--max_payment_sat=] [--spend_limit_sat= [--spend_window=]] " +
+		"[--max_invoice_sat=] permissions...",
+	Description: `
+	Bake a new macaroon that grants the provided permissions, and
+	optionally save it to a file.
+
+	The permissions must be specified in the format entity:action, where
+	entity is one of onchain, offchain, address, message, peers, info,
+	invoices, signer or macaroon, and action is one of read, write or
+	generate.
+
+	The macaroon is minted with the root key of the given ID, which is
+	created if it doesn't exist yet. All macaroons minted with a root key
+	can be revoked at once by deleting it with the deletemacaroonid
+	command. If no root key ID is given, the default root key is used,
+	which can't be deleted.
+
+	The macaroon can be further restricted with caveats. The
+	--allow_method flag, which may be given multiple times, restricts it
+	to the named RPC methods, e.g. GetInfo or /lnrpc.Lightning/GetInfo.
+	The --max_payment_sat flag limits the cost of every single payment,
+	and the --spend_limit_sat flag limits the total cost of the payments
+	made with the macaroon within every --spend_window. The cost of a
+	payment is its amount plus its fee limit until it completes, and its
+	amount plus the fees actually paid afterwards. Macaroons with either
+	limit can't be used to move funds on-chain. The --max_invoice_sat flag
+	limits the amount of invoices created with the macaroon.
+
+	For example:
+
+		lncli bakemacaroon --root_key_id=1 info:read invoices:write
+
+		lncli bakemacaroon --spend_limit_sat=100000 --spend_window=24h \
+			offchain:read offchain:write
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "save_to",
+			Usage: "save the created macaroon to this file " +
+				"using the default binary format",
+		},
+		cli.Uint64Flag{
+			Name: "root_key_id",
+			Usage: "the numerical root key ID used to create the " +
+				"macaroon",
+		},
+		cli.StringSliceFlag{
+			Name: "allow_method",
+			Usage: "restrict the macaroon to this RPC method, can " +
+				"be specified multiple times",
+		},
+		cli.Int64Flag{
+			Name: "max_payment_sat",
+			Usage: "the maximum cost in satoshis, including " +
+				"fees, of a single payment made with the " +
+				"macaroon",
+		},
+		cli.Int64Flag{
+			Name: "spend_limit_sat",
+			Usage: "the maximum total amount in satoshis, " +
+				"including fees, spent with the macaroon " +
+				"within the spend window",
+		},
+		cli.DurationFlag{
+			Name:  "spend_window",
+			Value: 24 * time.Hour,
+			Usage: "the time window the spend limit applies to",
+		},
+		cli.Int64Flag{
+			Name: "max_invoice_sat",
+			Usage: "the maximum amount in satoshis of invoices " +
+				"created with the macaroon",
+		},
+	},
+	Action: actionDecorator(bakeMacaroon),
+}
+
+func bakeMacaroon(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments.
+	if ctx.NArg() == 0 {
+		return cli.ShowCommandHelp(ctx, "bakemacaroon")
+	}
+
+	var parsedPermissions []*lnrpc.MacaroonPermission
+	for _, permission := range ctx.Args() {
+		tuple := strings.Split(permission, ":")
+		if len(tuple) != 2 || tuple[0] == "" || tuple[1] == "" {
+			return fmt.Errorf("unable to parse permission tuple: "+
+				"%s, must be in the format entity:action",
+				permission)
+		}
+
+		parsedPermissions = append(
+			parsedPermissions, &lnrpc.MacaroonPermission{
+				Entity: tuple[0],
+				Action: tuple[1],
+			},
+		)
+	}
+
+	req := &lnrpc.BakeMacaroonRequest{
+		Permissions: parsedPermissions,
+		RootKeyId:   ctx.Uint64("root_key_id"),
+	}
+	resp, err := client.BakeMacaroon(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	macBytes, err := hex.DecodeString(resp.Macaroon)
+	if err != nil {
+		return fmt.Errorf("unable to decode macaroon: %v", err)
+	}
+
+	// Caveats can be added by anyone holding a macaroon, so we'll apply
+	// the requested restrictions on our side before handing it out.
+	macBytes, err = addBakeConstraints(ctx, macBytes)
+	if err != nil {
+		return err
+	}
+	resp.Macaroon = hex.EncodeToString(macBytes)
+
+	// If a file is specified, we'll write the macaroon to it in the same
+	// binary format that lnd uses for its own macaroon files.
+	if ctx.IsSet("save_to") {
+		macSavePath := cleanAndExpandPath(ctx.String("save_to"))
+		err = ioutil.WriteFile(macSavePath, macBytes, 0644)
+		if err != nil {
+			_ = os.Remove(macSavePath)
+			return err
+		}
+
+		fmt.Printf("Macaroon saved to %s\n", macSavePath)
+		return nil
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+// addBakeConstraints adds the method and spending restrictions given on the
+// command line to the serialized macaroon.
+func addBakeConstraints(ctx *cli.Context, macBytes []byte) ([]byte, error) {
+	satToMsat := func(name string) (uint64, error) {
+		amt := ctx.Int64(name)
+		if amt < 0 {
+			return 0, fmt.Errorf("%s must not be negative", name)
+		}
+		return uint64(amt) * 1000, nil
+	}
+
+	maxPayment, err := satToMsat("max_payment_sat")
+	if err != nil {
+		return nil, err
+	}
+	spendLimit, err := satToMsat("spend_limit_sat")
+	if err != nil {
+		return nil, err
+	}
+	maxInvoice, err := satToMsat("max_invoice_sat")
+	if err != nil {
+		return nil, err
+	}
+
+	constraints := []macaroons.Constraint{
+		macaroons.MethodsConstraint(ctx.StringSlice("allow_method")...),
+		macaroons.PaymentLimitConstraint(maxPayment),
+		macaroons.SpendLimitConstraint(
+			spendLimit, ctx.Duration("spend_window"),
+		),
+		macaroons.InvoiceLimitConstraint(maxInvoice),
+	}
+
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(macBytes); err != nil {
+		return nil, fmt.Errorf("unable to decode macaroon: %v", err)
+	}
+
+	constrainedMac, err := macaroons.AddConstraints(mac, constraints...)
+	if err != nil {
+		return nil, err
+	}
+
+	return constrainedMac.MarshalBinary()
+}
+
+var listMacaroonIDsCommand = cli.Command{
+	Name:     "listmacaroonids",
+	Category: "Macaroons",
+	Usage:    "List all macaroon root key IDs in use.",
+	Action:   actionDecorator(listMacaroonIDs),
+}
+
+func listMacaroonIDs(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ListMacaroonIDsRequest{}
+	resp, err := client.ListMacaroonIDs(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var deleteMacaroonIDCommand = cli.Command{
+	Name:      "deletemacaroonid",
+	Category:  "Macaroons",
+	Usage:     "Delete a specific macaroon root key ID.",
+	ArgsUsage: "root_key_id",
+	Description: `
+	Remove a macaroon root key ID from the macaroon database. All
+	macaroons minted with the root key of this ID are revoked and can no
+	longer be used. The default root key ID 0, which the admin, readonly
+	and invoice macaroons are minted with, can't be deleted.
+	`,
+	Action: actionDecorator(deleteMacaroonID),
+}
+
+func deleteMacaroonID(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Validate args length. Only one argument is allowed.
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "deletemacaroonid")
+	}
+
+	rootKeyIDString := ctx.Args().First()
+	rootKeyID, err := strconv.ParseUint(rootKeyIDString, 10, 64)
+	if err != nil {
+		return fmt.Errorf("root key ID must be a positive integer")
+	}
+
+	req := &lnrpc.DeleteMacaroonIDRequest{
+		RootKeyId: rootKeyID,
+	}
+	resp, err := client.DeleteMacaroonID(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var auditLogCommand = cli.Command{
+	Name:     "auditlog",
+	Category: "Macaroons",
+	Usage:    "Query the audit log of all RPC calls.",
+	Description: `
+	Query the audit log for all RPC calls made over a particular time range
+	(--start_time and --end_time). Calls are only recorded if lnd was
+	started with the --auditlog option. The start and end times are meant
+	to be expressed in seconds since the Unix epoch. If a start and end
+	time aren't provided, then events over the past 24 hours are queried
+	for.
+
+	Each event holds the time of the call, the method called, the ID of the
+	macaroon and the address it was called with, and whether it succeeded.
+	For calls that move funds, the amount and destination are included.
+
+	The max number of events returned is 50k. The default number is 100,
+	callers can use the --max_events param to modify this value. Callers
+	can skip a series of events using the --index_offset parameter.
+	`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "start_time",
+			Usage: "the starting time for the query, expressed in " +
+				"seconds since the unix epoch",
+		},
+		cli.Uint64Flag{
+			Name: "end_time",
+			Usage: "the end time for the query, expressed in " +
+				"seconds since the unix epoch",
+		},
+		cli.Uint64Flag{
+			Name:  "index_offset",
+			Usage: "the number of events to skip",
+		},
+		cli.Uint64Flag{
+			Name:  "max_events",
+			Usage: "the max number of events to return",
+		},
+	},
+	Action: actionDecorator(auditLog),
+}
+
+func auditLog(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.AuditLogRequest{
+		StartTime:    ctx.Uint64("start_time"),
+		EndTime:      ctx.Uint64("end_time"),
+		IndexOffset:  uint32(ctx.Uint64("index_offset")),
+		NumMaxEvents: uint32(ctx.Uint64("max_events")),
+	}
+	resp, err := client.AuditLog(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
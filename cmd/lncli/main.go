@@ -268,6 +268,8 @@ func main() {
 		closeAllChannelsCommand,
 		abandonChannelCommand,
 		listPeersCommand,
+		sendCustomCommand,
+		subscribeCustomCommand,
 		walletBalanceCommand,
 		channelBalanceCommand,
 		getInfoCommand,
@@ -286,6 +288,10 @@ func main() {
 		getNodeInfoCommand,
 		queryRoutesCommand,
 		getNetworkInfoCommand,
+		updateBanListCommand,
+		listBanListCommand,
+		exportGraphCommand,
+		importGraphCommand,
 		debugLevelCommand,
 		decodePayReqCommand,
 		listChainTxnsCommand,
@@ -293,11 +299,17 @@ func main() {
 		signMessageCommand,
 		verifyMessageCommand,
 		feeReportCommand,
+		feeDecisionsCommand,
 		updateChannelPolicyCommand,
 		forwardingHistoryCommand,
+		htlcQuotasCommand,
 		exportChanBackupCommand,
 		verifyChanBackupCommand,
 		restoreChanBackupCommand,
+		bakeMacaroonCommand,
+		listMacaroonIDsCommand,
+		deleteMacaroonIDCommand,
+		auditLogCommand,
 	}
 
 	// Add any extra autopilot commands determined by build flags.
@@ -68,6 +68,9 @@ const (
 	defaultTorV2PrivateKeyFilename = "v2_onion_private_key"
 	defaultTorV3PrivateKeyFilename = "v3_onion_private_key"
 
+	defaultTorV3RPCPrivateKeyFilename = "v3_rpc_onion_private_key"
+	defaultTorRPCOnionURIsFilename    = "rpc_onion_uris"
+
 	// defaultIncomingBroadcastDelta defines the number of blocks before the
 	// expiry of an incoming htlc at which we force close the channel. We
 	// only go to chain if we also have the preimage to actually pull in the
@@ -119,6 +122,13 @@ const (
 
 	defaultAlias = ""
 	defaultColor = "#3399FF"
+
+	// The names of the peer bootstrappers that can be selected through the
+	// bootstrapper option.
+	bootstrapperGraph    = "graph"
+	bootstrapperDNS      = "dns"
+	bootstrapperFile     = "file"
+	bootstrapperLinkNode = "linknode"
 )
 
 var (
@@ -202,14 +212,20 @@ type autoPilotConfig struct {
 }
 
 type torConfig struct {
-	Active          bool   `long:"active" description:"Allow outbound and inbound connections to be routed through Tor"`
-	SOCKS           string `long:"socks" description:"The host:port that Tor's exposed SOCKS5 proxy is listening on"`
-	DNS             string `long:"dns" description:"The DNS server as host:port that Tor will use for SRV queries - NOTE must have TCP resolution enabled"`
-	StreamIsolation bool   `long:"streamisolation" description:"Enable Tor stream isolation by randomizing user credentials for each connection."`
-	Control         string `long:"control" description:"The host:port that Tor is listening on for Tor control connections"`
-	V2              bool   `long:"v2" description:"Automatically set up a v2 onion service to listen for inbound connections"`
-	V3              bool   `long:"v3" description:"Automatically set up a v3 onion service to listen for inbound connections"`
-	PrivateKeyPath  string `long:"privatekeypath" description:"The path to the private key of the onion service being created"`
+	Active          bool     `long:"active" description:"Allow outbound and inbound connections to be routed through Tor"`
+	SOCKS           string   `long:"socks" description:"The host:port that Tor's exposed SOCKS5 proxy is listening on"`
+	DNS             string   `long:"dns" description:"The DNS server as host:port that Tor will use for SRV queries - NOTE must have TCP resolution enabled"`
+	StreamIsolation bool     `long:"streamisolation" description:"Enable Tor stream isolation by randomizing user credentials for each connection."`
+	PeerIsolation   bool     `long:"peerisolation" description:"Enable Tor stream isolation per peer by deriving user credentials from the address of each peer. Unlike streamisolation, reconnects to the same peer may reuse a circuit."`
+	Control         string   `long:"control" description:"The host:port that Tor is listening on for Tor control connections"`
+	V2              bool     `long:"v2" description:"Automatically set up a v2 onion service to listen for inbound connections"`
+	V3              bool     `long:"v3" description:"Automatically set up a v3 onion service to listen for inbound connections"`
+	PrivateKeyPath  string   `long:"privatekeypath" description:"The path to the private key of the onion service being created"`
+	RPCOnion        bool     `long:"rpconion" description:"Automatically set up a v3 onion service exposing the gRPC and REST interfaces. Requires tor.active"`
+	RPCKeyPath      string   `long:"rpcprivatekeypath" description:"The path to the private key of the onion service exposing the gRPC and REST interfaces"`
+	EncryptKey      bool     `long:"encryptkey" description:"Encrypt the private keys of the onion services at rest using a key derived from the wallet's seed"`
+	V3ClientAuth    []string `long:"v3clientauth" description:"The base32-encoded x25519 public key of a client authorized to access the v3 onion service for inbound peer connections. Can be specified multiple times. If none are set, the onion service is reachable by anyone knowing its address"`
+	RPCClientAuth   []string `long:"rpcclientauth" description:"The base32-encoded x25519 public key of a client authorized to access the onion service exposing the gRPC and REST interfaces. Can be specified multiple times. If none are set, the onion service is reachable by anyone knowing its address"`
 }
 
 // config defines the configuration options for lnd.
@@ -227,6 +243,7 @@ type config struct {
 	TLSExtraIP     string `long:"tlsextraip" description:"Adds an extra ip to the generated certificate"`
 	TLSExtraDomain string `long:"tlsextradomain" description:"Adds an extra domain to the generated certificate"`
 	NoMacaroons    bool   `long:"no-macaroons" description:"Disable macaroon authentication"`
+	AuditLog       bool   `long:"auditlog" description:"Record every RPC call, along with the macaroon it was made with and the funds it moved, in the audit log of the channel database"`
 	AdminMacPath   string `long:"adminmacaroonpath" description:"Path to write the admin macaroon for lnd's RPC and REST services if it doesn't exist"`
 	ReadMacPath    string `long:"readonlymacaroonpath" description:"Path to write the read-only macaroon for lnd's RPC and REST services if it doesn't exist"`
 	InvoiceMacPath string `long:"invoicemacaroonpath" description:"Path to the invoice-only macaroon for lnd's RPC and REST services if it doesn't exist"`
@@ -234,6 +251,10 @@ type config struct {
 	MaxLogFiles    int    `long:"maxlogfiles" description:"Maximum logfiles to keep (0 for no rotation)"`
 	MaxLogFileSize int    `long:"maxlogfilesize" description:"Maximum logfile size in MB"`
 
+	AuditLogMaxEntries uint64        `long:"auditlogmaxentries" description:"The maximum number of events kept in the audit log, after which the oldest ones are removed (0 for no limit)"`
+	AuditLogMaxAge     time.Duration `long:"auditlogmaxage" description:"The maximum age of the events kept in the audit log, after which they are removed (0 for no limit)"`
+	AuditLogExclude    []string      `long:"auditlogexclude" description:"An RPC method that isn't recorded in the audit log, such as GetInfo or /lnrpc.Lightning/GetInfo. Can be specified multiple times"`
+
 	// We'll parse these 'raw' string arguments into real net.Addrs in the
 	// loadConfig function. We need to expose the 'raw' strings so the
 	// command line library can access them.
@@ -251,6 +272,8 @@ type config struct {
 	MinBackoff       time.Duration `long:"minbackoff" description:"Shortest backoff when reconnecting to persistent peers. Valid time units are {s, m, h}."`
 	MaxBackoff       time.Duration `long:"maxbackoff" description:"Longest backoff when reconnecting to persistent peers. Valid time units are {s, m, h}."`
 
+	PersistPeerErrors bool `long:"persistpeererrors" description:"Persist the most recent errors exchanged with peers we have channels with, such that they survive restarts"`
+
 	DebugLevel string `short:"d" long:"debuglevel" description:"Logging level for all subsystems {trace, debug, info, warn, error, critical} -- You may also specify <subsystem>=<level>,<subsystem2>=<level>,... to set the log level for individual subsystems -- Use show to list available subsystems"`
 
 	CPUProfile string `long:"cpuprofile" description:"Write CPU profile to the specified file"`
@@ -282,6 +305,9 @@ type config struct {
 
 	NoNetBootstrap bool `long:"nobootstrap" description:"If true, then automatic network bootstrapping will not be attempted."`
 
+	Bootstrappers []string `long:"bootstrapper" description:"A peer bootstrapper to use, one of: graph, dns, file, linknode. May be specified multiple times, in which case the bootstrappers are queried in the given order. If unset, the graph and dns bootstrappers are queried in random order, along with the file bootstrapper if a bootstrapfile is set."`
+	BootstrapFile string   `long:"bootstrapfile" description:"Path to a file with one peer of the form pubkey@host:port per line to bootstrap from"`
+
 	NoSeedBackup bool `long:"noseedbackup" description:"If true, NO SEED WILL BE EXPOSED AND THE WALLET WILL BE ENCRYPTED USING THE DEFAULT PASSPHRASE -- EVER. THIS FLAG IS ONLY FOR TESTING AND IS BEING DEPRECATED."`
 
 	TrickleDelay             int           `long:"trickledelay" description:"Time in milliseconds between each release of announcements to the network"`
@@ -300,6 +326,8 @@ type config struct {
 
 	StaggerInitialReconnect bool `long:"stagger-initial-reconnect" description:"If true, will apply a randomized staggering between 0s and 30s when reconnecting to persistent peers on startup. The first 10 reconnections will be attempted instantly, regardless of the flag's value"`
 
+	FeePolicy string `long:"feepolicy" description:"Path to a JSON fee policy file. If set, the fees of our channels are periodically recomputed from their local balance, forward volume and forwards failed for lack of liquidity, as described by the policy"`
+
 	net tor.Net
 
 	Routing *routing.Conf `group:"routing" namespace:"routing"`
@@ -307,6 +335,12 @@ type config struct {
 	Workers *lncfg.Workers `group:"workers" namespace:"workers"`
 
 	Caches *lncfg.Caches `group:"caches" namespace:"caches"`
+
+	ConnLimit *lncfg.ConnLimit `group:"connlimit" namespace:"connlimit"`
+
+	Gossip *lncfg.Gossip `group:"gossip" namespace:"gossip"`
+
+	HtlcQuota *lncfg.HtlcQuota `group:"htlcquota" namespace:"htlcquota"`
 }
 
 // loadConfig initializes and parses the config using a config file and command
@@ -400,6 +434,21 @@ func loadConfig() (*config, error) {
 			RejectCacheSize:  channeldb.DefaultRejectCacheSize,
 			ChannelCacheSize: channeldb.DefaultChannelCacheSize,
 		},
+		ConnLimit: &lncfg.ConnLimit{
+			IPInterval:       lncfg.DefaultIPConnInterval,
+			IPBurst:          lncfg.DefaultIPConnBurst,
+			SubnetInterval:   lncfg.DefaultSubnetConnInterval,
+			SubnetBurst:      lncfg.DefaultSubnetConnBurst,
+			MaxChanlessPeers: lncfg.DefaultMaxChanlessPeers,
+		},
+		Gossip: &lncfg.Gossip{
+			ChanUpdateInterval: lncfg.DefaultChanUpdateInterval,
+			ChanUpdateBurst:    lncfg.DefaultChanUpdateBurst,
+		},
+		HtlcQuota: &lncfg.HtlcQuota{
+			MaxSlotPercent:  lncfg.DefaultHtlcSlotPercent,
+			MaxValuePercent: lncfg.DefaultHtlcValuePercent,
+		},
 	}
 
 	// Pre-parse the command line options to pick up an alternative config
@@ -496,6 +545,9 @@ func loadConfig() (*config, error) {
 	cfg.BitcoindMode.Dir = cleanAndExpandPath(cfg.BitcoindMode.Dir)
 	cfg.LitecoindMode.Dir = cleanAndExpandPath(cfg.LitecoindMode.Dir)
 	cfg.Tor.PrivateKeyPath = cleanAndExpandPath(cfg.Tor.PrivateKeyPath)
+	cfg.Tor.RPCKeyPath = cleanAndExpandPath(cfg.Tor.RPCKeyPath)
+	cfg.BootstrapFile = cleanAndExpandPath(cfg.BootstrapFile)
+	cfg.FeePolicy = cleanAndExpandPath(cfg.FeePolicy)
 
 	// Ensure that the user didn't attempt to specify negative values for
 	// any of the autopilot params.
@@ -582,6 +634,24 @@ func loadConfig() (*config, error) {
 	case cfg.DisableListen && (cfg.Tor.V2 || cfg.Tor.V3):
 		return nil, errors.New("listening must be enabled when " +
 			"enabling inbound connections over Tor")
+	case cfg.Tor.StreamIsolation && cfg.Tor.PeerIsolation:
+		return nil, errors.New("either tor.streamisolation or " +
+			"tor.peerisolation can be set, but not both")
+	case len(cfg.Tor.V3ClientAuth) != 0 && !cfg.Tor.V3:
+		return nil, errors.New("tor.v3clientauth can only be set " +
+			"when tor.v3 is set")
+	case len(cfg.Tor.RPCClientAuth) != 0 && !cfg.Tor.RPCOnion:
+		return nil, errors.New("tor.rpcclientauth can only be set " +
+			"when tor.rpconion is set")
+	case cfg.Tor.RPCOnion && !cfg.Tor.Active:
+		return nil, errors.New("tor.rpconion can only be set when " +
+			"tor.active is set")
+	}
+
+	if cfg.Tor.RPCKeyPath == "" && cfg.Tor.RPCOnion {
+		cfg.Tor.RPCKeyPath = filepath.Join(
+			lndDir, defaultTorV3RPCPrivateKeyFilename,
+		)
 	}
 
 	if cfg.Tor.PrivateKeyPath == "" {
@@ -607,6 +677,7 @@ func loadConfig() (*config, error) {
 			SOCKS:           cfg.Tor.SOCKS,
 			DNS:             cfg.Tor.DNS,
 			StreamIsolation: cfg.Tor.StreamIsolation,
+			PeerIsolation:   cfg.Tor.PeerIsolation,
 		}
 	}
 
@@ -1041,10 +1112,19 @@ func loadConfig() (*config, error) {
 			"minbackoff")
 	}
 
-	// Validate the subconfigs for workers and caches.
+	// Ensure that the set of requested peer bootstrappers is valid.
+	if err := validateBootstrappers(&cfg); err != nil {
+		return nil, err
+	}
+
+	// Validate the subconfigs for workers, caches, connection limits,
+	// gossip limits and htlc quotas.
 	err = lncfg.Validate(
 		cfg.Workers,
 		cfg.Caches,
+		cfg.ConnLimit,
+		cfg.Gossip,
+		cfg.HtlcQuota,
 	)
 	if err != nil {
 		return nil, err
@@ -1068,6 +1148,36 @@ func loadConfig() (*config, error) {
 	return &cfg, nil
 }
 
+// validateBootstrappers ensures that each of the configured peer
+// bootstrappers is known and only specified once, and that a bootstrap file is
+// set if the file bootstrapper is requested.
+func validateBootstrappers(cfg *config) error {
+	seen := make(map[string]struct{}, len(cfg.Bootstrappers))
+	for _, bootstrapper := range cfg.Bootstrappers {
+		switch bootstrapper {
+		case bootstrapperGraph, bootstrapperDNS, bootstrapperLinkNode:
+		case bootstrapperFile:
+			if cfg.BootstrapFile == "" {
+				return fmt.Errorf("the %v bootstrapper requires "+
+					"bootstrapfile to be set", bootstrapperFile)
+			}
+		default:
+			return fmt.Errorf("unknown bootstrapper %q, must be one "+
+				"of: %v, %v, %v, %v", bootstrapper,
+				bootstrapperGraph, bootstrapperDNS,
+				bootstrapperFile, bootstrapperLinkNode)
+		}
+
+		if _, ok := seen[bootstrapper]; ok {
+			return fmt.Errorf("bootstrapper %v specified more "+
+				"than once", bootstrapper)
+		}
+		seen[bootstrapper] = struct{}{}
+	}
+
+	return nil
+}
+
 // cleanAndExpandPath expands environment variables and leading ~ in the
 // passed path, cleans the result, and returns it.
 // This function is taken from https://github.com/btcsuite/btcd
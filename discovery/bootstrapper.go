@@ -1,6 +1,7 @@
 package discovery
 
 import (
+	"bufio"
 	"bytes"
 	"crypto/rand"
 	"crypto/sha256"
@@ -8,6 +9,7 @@ import (
 	"fmt"
 	prand "math/rand"
 	"net"
+	"os"
 	"strconv"
 	"strings"
 	"time"
@@ -16,6 +18,8 @@ import (
 	"github.com/btcsuite/btcutil/bech32"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/autopilot"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/tor"
 	"github.com/miekg/dns"
@@ -59,6 +63,17 @@ func MultiSourceBootstrap(ignore map[autopilot.NodeID]struct{}, numAddrs uint32,
 	// than others.
 	bootstrappers = shuffleBootstrappers(bootstrappers)
 
+	return OrderedMultiSourceBootstrap(ignore, numAddrs, bootstrappers...)
+}
+
+// OrderedMultiSourceBootstrap is identical to MultiSourceBootstrap, except
+// that the passed bootstrappers are queried in the order given, rather than
+// being shuffled first. This allows the caller to prefer some bootstrap
+// sources over others, e.g. a local seed file over the DNS seeds.
+func OrderedMultiSourceBootstrap(ignore map[autopilot.NodeID]struct{},
+	numAddrs uint32,
+	bootstrappers ...NetworkPeerBootstrapper) ([]*lnwire.NetAddress, error) {
+
 	var addrs []*lnwire.NetAddress
 	for _, bootstrapper := range bootstrappers {
 		// If we already have enough addresses, then we can exit early
@@ -519,3 +534,175 @@ search:
 func (d *DNSSeedBootstrapper) Name() string {
 	return fmt.Sprintf("BOLT-0010 DNS Seed: %v", d.dnsSeeds)
 }
+
+// sampleNetAddrs returns up to numAddrs randomly selected addresses from the
+// passed set, skipping any nodes within the ignore map.
+func sampleNetAddrs(addrs []*lnwire.NetAddress, numAddrs uint32,
+	ignore map[autopilot.NodeID]struct{}) []*lnwire.NetAddress {
+
+	var sampled []*lnwire.NetAddress
+	for _, i := range prand.Perm(len(addrs)) {
+		if uint32(len(sampled)) >= numAddrs {
+			break
+		}
+
+		nID := autopilot.NewNodeID(addrs[i].IdentityKey)
+		if _, ok := ignore[nID]; ok {
+			continue
+		}
+
+		sampled = append(sampled, addrs[i])
+	}
+
+	return sampled
+}
+
+// FileBootstrapper is an implementation of the NetworkPeerBootstrapper
+// interface which samples peers from a local seed file. Each line of the file
+// should contain a single peer of the form pubkey@host:port, while empty lines
+// and lines starting with '#' are skipped. This allows nodes on private
+// networks, or without access to DNS, to find their first peers.
+type FileBootstrapper struct {
+	path        string
+	defaultPort string
+	net         tor.Net
+}
+
+// A compile time assertion to ensure that FileBootstrapper meets the
+// NetworkPeerBootstrapper interface.
+var _ NetworkPeerBootstrapper = (*FileBootstrapper)(nil)
+
+// NewFileBootstrapper returns a new instance of the FileBootstrapper which
+// reads peers from the seed file at the given path. Peers without an explicit
+// port are assumed to listen on the default port, and any host names are
+// resolved using the passed tor.Net.
+func NewFileBootstrapper(path, defaultPort string,
+	net tor.Net) NetworkPeerBootstrapper {
+
+	return &FileBootstrapper{
+		path:        path,
+		defaultPort: defaultPort,
+		net:         net,
+	}
+}
+
+// SampleNodeAddrs uniformly samples a set of specified address from the
+// network peer bootstrapper source. The num addrs field passed in denotes how
+// many valid peer addresses to return. The seed file is read anew on each
+// call, such that it can be edited while lnd is running.
+//
+// NOTE: Part of the NetworkPeerBootstrapper interface.
+func (f *FileBootstrapper) SampleNodeAddrs(numAddrs uint32,
+	ignore map[autopilot.NodeID]struct{}) ([]*lnwire.NetAddress, error) {
+
+	seedFile, err := os.Open(f.path)
+	if err != nil {
+		return nil, err
+	}
+	defer seedFile.Close()
+
+	var (
+		addrs   []*lnwire.NetAddress
+		lineNum int
+	)
+	scanner := bufio.NewScanner(seedFile)
+	for scanner.Scan() {
+		lineNum++
+
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		// We'll skip over any malformed entries, rather than failing
+		// outright, as the remaining entries may still be usable.
+		addr, err := lncfg.ParseLNAddressString(
+			line, f.defaultPort, f.net.ResolveTCPAddr,
+		)
+		if err != nil {
+			log.Warnf("Skipping invalid entry on line %d of seed "+
+				"file %v: %v", lineNum, f.path, err)
+			continue
+		}
+
+		addrs = append(addrs, addr)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return sampleNetAddrs(addrs, numAddrs, ignore), nil
+}
+
+// Name returns a human readable string which names the concrete
+// implementation of the NetworkPeerBootstrapper.
+//
+// NOTE: Part of the NetworkPeerBootstrapper interface.
+func (f *FileBootstrapper) Name() string {
+	return fmt.Sprintf("Seed File: %v", f.path)
+}
+
+// LinkNodeBootstrapper is an implementation of the NetworkPeerBootstrapper
+// interface which samples the peers we have or had channels with, using the
+// addresses we've persisted for them within their channeldb.LinkNode.
+type LinkNodeBootstrapper struct {
+	fetchLinkNodes func() ([]*channeldb.LinkNode, error)
+}
+
+// A compile time assertion to ensure that LinkNodeBootstrapper meets the
+// NetworkPeerBootstrapper interface.
+var _ NetworkPeerBootstrapper = (*LinkNodeBootstrapper)(nil)
+
+// NewLinkNodeBootstrapper returns a new instance of the LinkNodeBootstrapper,
+// which uses the passed closure to fetch the current set of link nodes, e.g.
+// channeldb.DB's FetchAllLinkNodes.
+func NewLinkNodeBootstrapper(
+	fetchLinkNodes func() ([]*channeldb.LinkNode, error)) NetworkPeerBootstrapper {
+
+	return &LinkNodeBootstrapper{
+		fetchLinkNodes: fetchLinkNodes,
+	}
+}
+
+// SampleNodeAddrs uniformly samples a set of specified address from the
+// network peer bootstrapper source. The num addrs field passed in denotes how
+// many valid peer addresses to return.
+//
+// NOTE: Part of the NetworkPeerBootstrapper interface.
+func (l *LinkNodeBootstrapper) SampleNodeAddrs(numAddrs uint32,
+	ignore map[autopilot.NodeID]struct{}) ([]*lnwire.NetAddress, error) {
+
+	linkNodes, err := l.fetchLinkNodes()
+	if err != nil {
+		return nil, err
+	}
+
+	var addrs []*lnwire.NetAddress
+	for _, linkNode := range linkNodes {
+		// We'll use the first address of the node that's supported by
+		// the protocol, if any.
+		for _, nodeAddr := range linkNode.Addresses {
+			switch nodeAddr.(type) {
+			case *net.TCPAddr, *tor.OnionAddr:
+			default:
+				continue
+			}
+
+			addrs = append(addrs, &lnwire.NetAddress{
+				IdentityKey: linkNode.IdentityPub,
+				Address:     nodeAddr,
+			})
+			break
+		}
+	}
+
+	return sampleNetAddrs(addrs, numAddrs, ignore), nil
+}
+
+// Name returns a human readable string which names the concrete
+// implementation of the NetworkPeerBootstrapper.
+//
+// NOTE: Part of the NetworkPeerBootstrapper interface.
+func (l *LinkNodeBootstrapper) Name() string {
+	return "Channel Link Nodes"
+}
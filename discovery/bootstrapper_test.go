@@ -0,0 +1,170 @@
+package discovery
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/autopilot"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tor"
+)
+
+// assertSampledNodes ensures that the sampled addresses belong to exactly the
+// expected set of nodes.
+func assertSampledNodes(t *testing.T, addrs []*lnwire.NetAddress,
+	expected map[autopilot.NodeID]struct{}) {
+
+	t.Helper()
+
+	if len(addrs) != len(expected) {
+		t.Fatalf("expected %d addrs, got %d", len(expected), len(addrs))
+	}
+	for _, addr := range addrs {
+		nID := autopilot.NewNodeID(addr.IdentityKey)
+		if _, ok := expected[nID]; !ok {
+			t.Fatalf("unexpected node sampled: %x", nID[:])
+		}
+	}
+}
+
+// TestFileBootstrapper ensures that the FileBootstrapper samples the valid
+// peers of its seed file, skipping comments, malformed lines and ignored
+// nodes.
+func TestFileBootstrapper(t *testing.T) {
+	t.Parallel()
+
+	pub1, pub2, pub3 := randPubKey(t), randPubKey(t), randPubKey(t)
+	seeds := fmt.Sprintf("# Our test cluster.\n\n"+
+		"%x@127.0.0.1:10011\n"+
+		"  %x@127.0.0.1  \n"+
+		"not-a-peer\n"+
+		"%x@127.0.0.1:10013\n",
+		pub1.SerializeCompressed(), pub2.SerializeCompressed(),
+		pub3.SerializeCompressed())
+
+	seedFile, err := ioutil.TempFile("", "seeds")
+	if err != nil {
+		t.Fatalf("unable to create seed file: %v", err)
+	}
+	defer os.Remove(seedFile.Name())
+
+	if _, err := seedFile.WriteString(seeds); err != nil {
+		t.Fatalf("unable to write seed file: %v", err)
+	}
+	seedFile.Close()
+
+	bootstrapper := NewFileBootstrapper(
+		seedFile.Name(), "9735", &tor.ClearNet{},
+	)
+
+	// All three valid peers should be returned, with the default port
+	// being used for the peer without an explicit one.
+	addrs, err := bootstrapper.SampleNodeAddrs(10, nil)
+	if err != nil {
+		t.Fatalf("unable to sample addrs: %v", err)
+	}
+	assertSampledNodes(t, addrs, map[autopilot.NodeID]struct{}{
+		autopilot.NewNodeID(pub1): {},
+		autopilot.NewNodeID(pub2): {},
+		autopilot.NewNodeID(pub3): {},
+	})
+	for _, addr := range addrs {
+		if !addr.IdentityKey.IsEqual(pub2) {
+			continue
+		}
+		if addr.Address.(*net.TCPAddr).Port != 9735 {
+			t.Fatalf("expected default port, got %v", addr.Address)
+		}
+	}
+
+	// Ignored nodes shouldn't be sampled, and no more than the requested
+	// number of addresses should be returned.
+	ignore := map[autopilot.NodeID]struct{}{
+		autopilot.NewNodeID(pub1): {},
+	}
+	addrs, err = bootstrapper.SampleNodeAddrs(1, ignore)
+	if err != nil {
+		t.Fatalf("unable to sample addrs: %v", err)
+	}
+	if len(addrs) != 1 {
+		t.Fatalf("expected 1 addr, got %d", len(addrs))
+	}
+	if addrs[0].IdentityKey.IsEqual(pub1) {
+		t.Fatalf("ignored node was sampled")
+	}
+
+	// A missing seed file should result in an error.
+	missing := NewFileBootstrapper(
+		seedFile.Name()+".missing", "9735", &tor.ClearNet{},
+	)
+	if _, err := missing.SampleNodeAddrs(10, nil); err == nil {
+		t.Fatalf("expected error for missing seed file")
+	}
+}
+
+// TestLinkNodeBootstrapper ensures that the LinkNodeBootstrapper samples the
+// first usable address of each link node, skipping nodes without one.
+func TestLinkNodeBootstrapper(t *testing.T) {
+	t.Parallel()
+
+	pub1, pub2, pub3 := randPubKey(t), randPubKey(t), randPubKey(t)
+	tcpAddr := &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 10011}
+	onionAddr := &tor.OnionAddr{
+		OnionService: "3g2upl4pq6kufc4m.onion",
+		Port:         9735,
+	}
+
+	linkNodes := []*channeldb.LinkNode{
+		{
+			IdentityPub: pub1,
+			Addresses:   []net.Addr{tcpAddr},
+		},
+		{
+			IdentityPub: pub2,
+			Addresses: []net.Addr{
+				&net.UnixAddr{Name: "/tmp/lnd.sock"}, onionAddr,
+			},
+		},
+		{
+			IdentityPub: pub3,
+		},
+	}
+	bootstrapper := NewLinkNodeBootstrapper(
+		func() ([]*channeldb.LinkNode, error) {
+			return linkNodes, nil
+		},
+	)
+
+	addrs, err := bootstrapper.SampleNodeAddrs(10, nil)
+	if err != nil {
+		t.Fatalf("unable to sample addrs: %v", err)
+	}
+	assertSampledNodes(t, addrs, map[autopilot.NodeID]struct{}{
+		autopilot.NewNodeID(pub1): {},
+		autopilot.NewNodeID(pub2): {},
+	})
+	for _, addr := range addrs {
+		switch {
+		case addr.IdentityKey.IsEqual(pub1) && addr.Address != tcpAddr:
+			t.Fatalf("expected %v, got %v", tcpAddr, addr.Address)
+		case addr.IdentityKey.IsEqual(pub2) && addr.Address != onionAddr:
+			t.Fatalf("expected %v, got %v", onionAddr, addr.Address)
+		}
+	}
+
+	addrs, err = bootstrapper.SampleNodeAddrs(
+		10, map[autopilot.NodeID]struct{}{
+			autopilot.NewNodeID(pub2): {},
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to sample addrs: %v", err)
+	}
+	assertSampledNodes(t, addrs, map[autopilot.NodeID]struct{}{
+		autopilot.NewNodeID(pub1): {},
+	})
+}
@@ -57,6 +57,28 @@ type ChannelGraphTimeSeries interface {
 	// channel, then an empty slice will be returned.
 	FetchChanUpdates(chain chainhash.Hash,
 		shortChanID lnwire.ShortChannelID) ([]*lnwire.ChannelUpdate, error)
+
+	// FetchChanUpdateInfos returns the timestamps and checksums of the
+	// latest channel updates of each of the specified short channel ID's
+	// that we know of. Unknown channels are omitted from the result. We'll
+	// use this to extend our replies to a remote peer's
+	// QueryChannelRange, and to determine which of the channels within
+	// their replies have updates newer than ours.
+	FetchChanUpdateInfos(chain chainhash.Hash,
+		shortChanIDs []lnwire.ShortChannelID) ([]ChanUpdateInfo, error)
+}
+
+// ChanUpdateInfo houses the timestamps and checksums of the latest channel
+// updates of both directions of a channel.
+type ChanUpdateInfo struct {
+	// ShortChannelID is the short channel ID of the channel.
+	ShortChannelID lnwire.ShortChannelID
+
+	// Timestamps are the timestamps of the latest channel updates.
+	Timestamps lnwire.ChanUpdateTimestamps
+
+	// Checksums are the checksums of the latest channel updates.
+	Checksums lnwire.ChanUpdateChecksums
 }
 
 // ChanSeries is an implementation of the ChannelGraphTimeSeries
@@ -368,6 +390,88 @@ func (c *ChanSeries) FetchChanUpdates(chain chainhash.Hash,
 	return chanUpdates, nil
 }
 
+// FetchChanUpdateInfos returns the timestamps and checksums of the latest
+// channel updates of each of the specified short channel ID's that we know of.
+// Unknown channels are omitted from the result.
+//
+// NOTE: This is part of the ChannelGraphTimeSeries interface.
+func (c *ChanSeries) FetchChanUpdateInfos(chain chainhash.Hash,
+	shortChanIDs []lnwire.ShortChannelID) ([]ChanUpdateInfo, error) {
+
+	chanIDs := make([]uint64, 0, len(shortChanIDs))
+	for _, chanID := range shortChanIDs {
+		chanIDs = append(chanIDs, chanID.ToUint64())
+	}
+
+	channels, err := c.graph.FetchChanInfos(chanIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]ChanUpdateInfo, 0, len(channels))
+	for _, channel := range channels {
+		info := ChanUpdateInfo{
+			ShortChannelID: lnwire.NewShortChanIDFromInt(
+				channel.Info.ChannelID,
+			),
+		}
+
+		if channel.Policy1 != nil {
+			ts, checksum, err := policyTimestampAndChecksum(
+				channel.Info, channel.Policy1,
+			)
+			if err != nil {
+				return nil, err
+			}
+			info.Timestamps.Timestamp1 = ts
+			info.Checksums.Checksum1 = checksum
+		}
+		if channel.Policy2 != nil {
+			ts, checksum, err := policyTimestampAndChecksum(
+				channel.Info, channel.Policy2,
+			)
+			if err != nil {
+				return nil, err
+			}
+			info.Timestamps.Timestamp2 = ts
+			info.Checksums.Checksum2 = checksum
+		}
+
+		infos = append(infos, info)
+	}
+
+	return infos, nil
+}
+
+// policyTimestampAndChecksum returns the timestamp and checksum of the channel
+// update that corresponds to the given policy.
+func policyTimestampAndChecksum(info *channeldb.ChannelEdgeInfo,
+	policy *channeldb.ChannelEdgePolicy) (uint32, uint32, error) {
+
+	// The signature isn't covered by the checksum, so there's no need to
+	// parse it.
+	chanUpdate := &lnwire.ChannelUpdate{
+		ChainHash:       info.ChainHash,
+		ShortChannelID:  lnwire.NewShortChanIDFromInt(policy.ChannelID),
+		Timestamp:       uint32(policy.LastUpdate.Unix()),
+		MessageFlags:    policy.MessageFlags,
+		ChannelFlags:    policy.ChannelFlags,
+		TimeLockDelta:   policy.TimeLockDelta,
+		HtlcMinimumMsat: policy.MinHTLC,
+		HtlcMaximumMsat: policy.MaxHTLC,
+		BaseFee:         uint32(policy.FeeBaseMSat),
+		FeeRate:         uint32(policy.FeeProportionalMillionths),
+		ExtraOpaqueData: policy.ExtraOpaqueData,
+	}
+
+	checksum, err := lnwire.ChanUpdateChecksum(chanUpdate)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return chanUpdate.Timestamp, checksum, nil
+}
+
 // A compile-time assertion to ensure that ChanSeries meets the
 // ChannelGraphTimeSeries interface.
 var _ ChannelGraphTimeSeries = (*ChanSeries)(nil)
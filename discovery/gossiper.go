@@ -72,6 +72,12 @@ func ChannelPoint(op wire.OutPoint) OptionalMsgField {
 	}
 }
 
+// rebroadcastInterval is the maximum age of our own channel updates and node
+// announcement before we'll refresh and rebroadcast them. This keeps them well
+// within the two week horizon after which other nodes prune them from their
+// graph.
+const rebroadcastInterval = time.Hour * 24
+
 // networkMsg couples a routing related wire message with the peer that
 // originally sent it.
 type networkMsg struct {
@@ -175,6 +181,19 @@ type Config struct {
 	// use to determine which messages need to be resent for a given peer.
 	MessageStore GossipMessageStore
 
+	// BroadcastStore is a persistent storage of the announcements pending
+	// broadcast. The batch that hasn't been flushed yet when the gossiper
+	// shuts down is written to it, such that it can be broadcast after a
+	// restart. If nil, pending announcements are only kept in memory.
+	BroadcastStore PendingBroadcastStore
+
+	// CurrentNodeAnnouncement should return a freshly signed version of
+	// our node announcement. It's used to periodically refresh our node
+	// announcement throughout the network, such that we aren't pruned
+	// from the graphs of other nodes. If nil, our node announcement is only
+	// refreshed by the caller.
+	CurrentNodeAnnouncement func() (lnwire.NodeAnnouncement, error)
+
 	// AnnSigner is an instance of the MessageSigner interface which will
 	// be used to manually sign any outgoing channel updates. The signer
 	// implementation should be backed by the public key of the backing
@@ -205,6 +224,53 @@ type Config struct {
 	// activeSyncer due to the current one not completing its state machine
 	// within the timeout.
 	ActiveSyncerTimeoutTicker ticker.Ticker
+
+	// EncodingType is the encoding type our gossip syncers will use for
+	// the short channel IDs of both their queries and their replies.
+	EncodingType lnwire.ShortChanIDEncoding
+
+	// QueryOptions is the set of optional extensions our gossip syncers
+	// will request within their channel range queries, such as the
+	// timestamps and checksums of each channel's latest updates.
+	QueryOptions lnwire.QueryOptions
+
+	// ChannelUpdateInterval is the minimum average interval between the
+	// ChannelUpdates we accept for a single direction of a channel. Any
+	// excess updates are dropped rather than applied and relayed, unless
+	// they merely refresh the previous policy. A zero interval disables
+	// rate limiting by channel.
+	ChannelUpdateInterval time.Duration
+
+	// ChannelUpdateBurst is the number of ChannelUpdates a single
+	// direction of a channel may receive in quick succession before being
+	// rate limited.
+	ChannelUpdateBurst int
+
+	// PeerUpdateInterval is the minimum average interval between the
+	// ChannelUpdates we accept from a single peer. A zero interval
+	// disables rate limiting by peer.
+	PeerUpdateInterval time.Duration
+
+	// PeerUpdateBurst is the number of ChannelUpdates a single peer may
+	// send in quick succession before being rate limited.
+	PeerUpdateBurst int
+
+	// BanList is an optional set of nodes and channels whose
+	// announcements received from the network are dropped rather than
+	// validated and relayed.
+	BanList *routing.BanList
+}
+
+// RateLimitStats houses the number of ChannelUpdates that have been dropped by
+// the gossiper for exceeding the configured rate limits.
+type RateLimitStats struct {
+	// ThrottledByChannel is the number of updates dropped for exceeding
+	// the rate limit of their channel.
+	ThrottledByChannel uint64
+
+	// ThrottledByPeer is the number of updates dropped for exceeding the
+	// rate limit of the peer that sent them.
+	ThrottledByPeer uint64
 }
 
 // AuthenticatedGossiper is a subsystem which is responsible for receiving
@@ -216,6 +282,12 @@ type Config struct {
 // incoming message are expected to be well formed and signed. Invalid messages
 // will be rejected by this struct.
 type AuthenticatedGossiper struct {
+	// numThrottledByChan and numThrottledByPeer are the number of
+	// ChannelUpdates that have been dropped for exceeding the rate limit
+	// of their channel or peer respectively. To be used atomically.
+	numThrottledByChan uint64
+	numThrottledByPeer uint64
+
 	// Parameters which are needed to properly handle the start and stop of
 	// the service. To be used atomically.
 	started uint32
@@ -290,6 +362,14 @@ type AuthenticatedGossiper struct {
 	// network.
 	reliableSender *reliableSender
 
+	// chanUpdateLimiter rate limits the ChannelUpdates we accept for each
+	// direction of a channel.
+	chanUpdateLimiter *updateRateLimiter
+
+	// peerUpdateLimiter rate limits the ChannelUpdates we accept from each
+	// of our peers.
+	peerUpdateLimiter *updateRateLimiter
+
 	sync.Mutex
 }
 
@@ -306,12 +386,20 @@ func New(cfg Config, selfKey *btcec.PublicKey) *AuthenticatedGossiper {
 		prematureChannelUpdates: make(map[uint64][]*networkMsg),
 		channelMtx:              multimutex.NewMutex(),
 		recentRejects:           make(map[uint64]struct{}),
+		chanUpdateLimiter: newUpdateRateLimiter(
+			cfg.ChannelUpdateInterval, cfg.ChannelUpdateBurst,
+		),
+		peerUpdateLimiter: newUpdateRateLimiter(
+			cfg.PeerUpdateInterval, cfg.PeerUpdateBurst,
+		),
 		syncMgr: newSyncManager(&SyncManagerCfg{
 			ChainHash:            cfg.ChainHash,
 			ChanSeries:           cfg.ChanSeries,
 			RotateTicker:         cfg.RotateTicker,
 			HistoricalSyncTicker: cfg.HistoricalSyncTicker,
 			NumActiveSyncers:     cfg.NumActiveSyncers,
+			EncodingType:         cfg.EncodingType,
+			QueryOptions:         cfg.QueryOptions,
 		}),
 	}
 
@@ -900,11 +988,20 @@ func (d *AuthenticatedGossiper) networkHandler() {
 	trickleTimer := time.NewTicker(d.cfg.TrickleDelay)
 	defer trickleTimer.Stop()
 
+	// Any announcements that were pending broadcast when we last shut
+	// down will be part of our first batch. They're removed from the
+	// store once that batch has been flushed.
+	restoredBroadcasts := d.loadPendingBroadcasts(&announcements)
+
 	// To start, we'll first check to see if there are any stale channels
 	// that we need to re-transmit.
 	if err := d.retransmitStaleChannels(); err != nil {
 		log.Errorf("Unable to rebroadcast stale channels: %v", err)
 	}
+	if err := d.retransmitStaleNodeAnn(); err != nil {
+		log.Errorf("Unable to rebroadcast stale node "+
+			"announcement: %v", err)
+	}
 
 	// We'll use this validation to ensure that we process jobs in their
 	// dependency order during parallel validation.
@@ -1106,6 +1203,14 @@ func (d *AuthenticatedGossiper) networkHandler() {
 				}
 			}
 
+			// If the batch included the announcements restored
+			// from the store, they no longer need to survive a
+			// restart.
+			if len(restoredBroadcasts) > 0 {
+				d.deletePendingBroadcasts(restoredBroadcasts)
+				restoredBroadcasts = nil
+			}
+
 		// The retransmission timer has ticked which indicates that we
 		// should check if we need to prune or re-broadcast any of our
 		// personal channels. This addresses the case of "zombie"
@@ -1116,15 +1221,163 @@ func (d *AuthenticatedGossiper) networkHandler() {
 				log.Errorf("unable to rebroadcast stale "+
 					"channels: %v", err)
 			}
+			if err := d.retransmitStaleNodeAnn(); err != nil {
+				log.Errorf("unable to rebroadcast stale "+
+					"node announcement: %v", err)
+			}
+
+			// We'll also use this opportunity to free the rate
+			// limits of channels that have been quiet for a while.
+			d.chanUpdateLimiter.prune()
 
 		// The gossiper has been signalled to exit, to we exit our
 		// main loop so the wait group can be decremented.
 		case <-d.quit:
+			// Persist the batch we haven't been able to flush,
+			// such that it's broadcast once we're back up.
+			d.persistPendingBroadcasts(announcements.Emit())
 			return
 		}
 	}
 }
 
+// persistPendingBroadcasts writes the announcements of a batch that couldn't
+// be flushed to the persistent store in a single transaction.
+func (d *AuthenticatedGossiper) persistPendingBroadcasts(
+	batch []msgWithSenders) {
+
+	if d.cfg.BroadcastStore == nil || len(batch) == 0 {
+		return
+	}
+
+	wireMsgs := make([]lnwire.Message, 0, len(batch))
+	for _, msgChunk := range batch {
+		wireMsgs = append(wireMsgs, msgChunk.msg)
+	}
+	err := d.cfg.BroadcastStore.AddPendingBroadcasts(wireMsgs...)
+	if err != nil {
+		log.Errorf("Unable to persist pending broadcasts: %v", err)
+	}
+}
+
+// deletePendingBroadcasts removes the given announcements, which were restored
+// from the persistent store and have since been flushed, from the store.
+func (d *AuthenticatedGossiper) deletePendingBroadcasts(
+	msgs []lnwire.Message) {
+
+	if d.cfg.BroadcastStore == nil {
+		return
+	}
+
+	err := d.cfg.BroadcastStore.DeletePendingBroadcasts(msgs...)
+	if err != nil {
+		log.Errorf("Unable to delete pending broadcasts: %v", err)
+	}
+}
+
+// loadPendingBroadcasts adds the announcements that were pending broadcast
+// before our last shutdown to the given batch, and returns them.
+func (d *AuthenticatedGossiper) loadPendingBroadcasts(
+	batch *deDupedAnnouncements) []lnwire.Message {
+
+	if d.cfg.BroadcastStore == nil {
+		return nil
+	}
+
+	pending, err := d.cfg.BroadcastStore.PendingBroadcasts()
+	if err != nil {
+		log.Errorf("Unable to fetch pending broadcasts: %v", err)
+		return nil
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	log.Infof("Restoring %v announcements pending broadcast",
+		len(pending))
+
+	// As we no longer know who sent us these announcements, we'll mark
+	// ourselves as their source, such that they're broadcast to all of
+	// our peers.
+	msgs := make([]networkMsg, 0, len(pending))
+	for _, msg := range pending {
+		msgs = append(msgs, networkMsg{
+			source: d.selfKey,
+			msg:    msg,
+		})
+	}
+	batch.AddMsgs(msgs...)
+
+	return pending
+}
+
+// retransmitStaleNodeAnn refreshes and rebroadcasts our node announcement if
+// it's older than the rebroadcastInterval. This ensures our node isn't pruned
+// from the graphs of other nodes, even if none of its details change.
+func (d *AuthenticatedGossiper) retransmitStaleNodeAnn() error {
+	if d.cfg.CurrentNodeAnnouncement == nil {
+		return nil
+	}
+
+	// Our node announcement is only propagated if we have any public
+	// channels, so there's nothing to keep alive otherwise.
+	selfVertex := route.NewVertex(d.selfKey)
+	isPublic, err := d.cfg.Router.IsPublicNode(selfVertex)
+	if err != nil {
+		return fmt.Errorf("unable to determine if we're advertised: "+
+			"%v", err)
+	}
+	if !isPublic {
+		return nil
+	}
+
+	selfNode, err := d.cfg.Router.FetchLightningNode(selfVertex)
+	switch {
+	case err == channeldb.ErrGraphNodeNotFound:
+	case err != nil:
+		return fmt.Errorf("unable to fetch our node: %v", err)
+	case selfNode.HaveNodeAnnouncement &&
+		time.Since(selfNode.LastUpdate) < rebroadcastInterval:
+
+		return nil
+	}
+
+	nodeAnn, err := d.cfg.CurrentNodeAnnouncement()
+	if err != nil {
+		return fmt.Errorf("unable to generate node announcement: %v",
+			err)
+	}
+
+	// We'll process the refreshed announcement as any other, such that
+	// our graph reflects its new timestamp, and broadcast it right away.
+	nMsg := &networkMsg{
+		source: d.selfKey,
+		msg:    &nodeAnn,
+		err:    make(chan error, 1),
+	}
+	emittedAnnouncements := d.processNetworkAnnouncement(nMsg)
+	if err := <-nMsg.err; err != nil {
+		return fmt.Errorf("unable to process node announcement: %v",
+			err)
+	}
+	if len(emittedAnnouncements) == 0 {
+		return nil
+	}
+
+	log.Infof("Retransmitting stale node announcement")
+
+	msgs := make([]lnwire.Message, 0, len(emittedAnnouncements))
+	for _, emitted := range emittedAnnouncements {
+		msgs = append(msgs, emitted.msg)
+	}
+	if err := d.cfg.Broadcast(nil, msgs...); err != nil {
+		return fmt.Errorf("unable to re-broadcast node announcement: "+
+			"%v", err)
+	}
+
+	return nil
+}
+
 // TODO(roasbeef): d/c peers that send updates not on our chain
 
 // InitSyncState is called by outside sub-systems when a connection is
@@ -1140,6 +1393,101 @@ func (d *AuthenticatedGossiper) InitSyncState(syncPeer lnpeer.Peer) {
 // existing GossipSyncer assigned to the peer and free up resources.
 func (d *AuthenticatedGossiper) PruneSyncState(peer route.Vertex) {
 	d.syncMgr.PruneSyncState(peer)
+	d.peerUpdateLimiter.remove(peer)
+}
+
+// RateLimitStats returns the number of ChannelUpdates that have been dropped
+// for exceeding the configured rate limits.
+func (d *AuthenticatedGossiper) RateLimitStats() RateLimitStats {
+	return RateLimitStats{
+		ThrottledByChannel: atomic.LoadUint64(&d.numThrottledByChan),
+		ThrottledByPeer:    atomic.LoadUint64(&d.numThrottledByPeer),
+	}
+}
+
+// isRateLimitedUpdate returns true if the given remote ChannelUpdate exceeds
+// the rate limit of either its channel direction or the peer that sent it.
+// Updates which merely refresh the previous policy of their channel
+// direction are exempt, as they're needed to keep the channel from being
+// pruned.
+func (d *AuthenticatedGossiper) isRateLimitedUpdate(nMsg *networkMsg,
+	upd *lnwire.ChannelUpdate, prev *channeldb.ChannelEdgePolicy) bool {
+
+	if isKeepAliveUpdate(upd, prev) {
+		return false
+	}
+
+	chanKey := channelUpdateID{
+		channelID: upd.ShortChannelID,
+		flags:     upd.ChannelFlags & lnwire.ChanUpdateDirection,
+	}
+	if !d.chanUpdateLimiter.allow(chanKey) {
+		log.Debugf("Dropping ChannelUpdate for short_chan_id=%v "+
+			"exceeding channel rate limit", upd.ShortChannelID)
+
+		atomic.AddUint64(&d.numThrottledByChan, 1)
+		return true
+	}
+
+	if nMsg.source != nil {
+		peerKey := route.NewVertex(nMsg.source)
+		if !d.peerUpdateLimiter.allow(peerKey) {
+			log.Debugf("Dropping ChannelUpdate for "+
+				"short_chan_id=%v from %x exceeding peer "+
+				"rate limit", upd.ShortChannelID, peerKey)
+
+			atomic.AddUint64(&d.numThrottledByPeer, 1)
+			return true
+		}
+	}
+
+	return false
+}
+
+// isBannedMsg returns true if the given announcement is for a node or channel
+// within our ban list.
+func (d *AuthenticatedGossiper) isBannedMsg(msg lnwire.Message) bool {
+	if d.cfg.BanList == nil {
+		return false
+	}
+
+	// Our own channels are never banned, just like the router keeps them
+	// in the graph.
+	banList := d.cfg.BanList
+	selfVertex := route.NewVertex(d.selfKey)
+	switch m := msg.(type) {
+	case *lnwire.NodeAnnouncement:
+		return banList.IsNodeBanned(m.NodeID)
+
+	case *lnwire.ChannelAnnouncement:
+		if m.NodeID1 == selfVertex || m.NodeID2 == selfVertex {
+			return false
+		}
+
+		return banList.IsChannelBanned(m.ShortChannelID.ToUint64()) ||
+			banList.IsNodeBanned(m.NodeID1) ||
+			banList.IsNodeBanned(m.NodeID2)
+
+	case *lnwire.ChannelUpdate:
+		chanID := m.ShortChannelID.ToUint64()
+		if !banList.IsChannelBanned(chanID) {
+			return false
+		}
+
+		// The channel is banned, unless it's one of ours.
+		chanInfo, _, _, err := d.cfg.Router.GetChannelByID(
+			m.ShortChannelID,
+		)
+		if err != nil {
+			return true
+		}
+
+		return chanInfo.NodeKey1Bytes != selfVertex &&
+			chanInfo.NodeKey2Bytes != selfVertex
+
+	default:
+		return false
+	}
 }
 
 // isRecentlyRejectedMsg returns true if we recently rejected a message, and
@@ -1165,7 +1513,7 @@ func (d *AuthenticatedGossiper) isRecentlyRejectedMsg(msg lnwire.Message) bool {
 // retransmitStaleChannels examines all outgoing channels that the source node
 // is known to maintain to check to see if any of them are "stale". A channel
 // is stale iff, the last timestamp of its rebroadcast is older then
-// broadcastInterval.
+// rebroadcastInterval.
 func (d *AuthenticatedGossiper) retransmitStaleChannels() error {
 	// Iterate over all of our channels and check if any of them fall
 	// within the prune interval or re-broadcast interval.
@@ -1200,14 +1548,12 @@ func (d *AuthenticatedGossiper) retransmitStaleChannels() error {
 			return nil
 		}
 
-		const broadcastInterval = time.Hour * 24
-
 		timeElapsed := time.Since(edge.LastUpdate)
 
 		// If it's been a full day since we've re-broadcasted the
 		// channel, add the channel to the set of edges we need to
 		// update.
-		if timeElapsed >= broadcastInterval {
+		if timeElapsed >= rebroadcastInterval {
 			edgesToUpdate = append(edgesToUpdate, updateTuple{
 				info: info,
 				edge: edge,
@@ -1468,6 +1814,15 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 
 	var announcements []networkMsg
 
+	// Announcements from the network for banned nodes and channels are
+	// dropped before we spend any effort validating them.
+	if nMsg.isRemote && d.isBannedMsg(nMsg.msg) {
+		log.Debugf("Dropping %v for banned node or channel",
+			nMsg.msg.MsgType())
+		nMsg.err <- nil
+		return nil
+	}
+
 	switch msg := nMsg.msg.(type) {
 
 	// A new node announcement has arrived which either presents new
@@ -1838,7 +2193,9 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 		// point and when we call UpdateEdge() later.
 		d.channelMtx.Lock(msg.ShortChannelID.ToUint64())
 		defer d.channelMtx.Unlock(msg.ShortChannelID.ToUint64())
-		chanInfo, _, _, err := d.cfg.Router.GetChannelByID(msg.ShortChannelID)
+		chanInfo, edge1, edge2, err := d.cfg.Router.GetChannelByID(
+			msg.ShortChannelID,
+		)
 		switch err {
 		// No error, break.
 		case nil:
@@ -1937,12 +2294,17 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 		// The least-significant bit in the flag on the channel update
 		// announcement tells us "which" side of the channels directed
 		// edge is being updated.
-		var pubKey *btcec.PublicKey
+		var (
+			pubKey     *btcec.PublicKey
+			prevPolicy *channeldb.ChannelEdgePolicy
+		)
 		switch {
 		case msg.ChannelFlags&lnwire.ChanUpdateDirection == 0:
 			pubKey, _ = chanInfo.NodeKey1()
+			prevPolicy = edge1
 		case msg.ChannelFlags&lnwire.ChanUpdateDirection == 1:
 			pubKey, _ = chanInfo.NodeKey2()
+			prevPolicy = edge2
 		}
 
 		// Validate the channel announcement with the expected public key and
@@ -1959,6 +2321,16 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 			return nil
 		}
 
+		// Now that we know the update is valid, we'll make sure the
+		// remote update doesn't exceed our rate limits. If it does,
+		// we'll drop it without applying or relaying it. We only do so
+		// after validating the update, to prevent invalid updates from
+		// consuming the tokens of legitimate ones.
+		if nMsg.isRemote && d.isRateLimitedUpdate(nMsg, msg, prevPolicy) {
+			nMsg.err <- nil
+			return nil
+		}
+
 		update := &channeldb.ChannelEdgePolicy{
 			SigBytes:                  msg.Signature.ToSignatureBytes(),
 			ChannelID:                 shortChanID,
@@ -3544,3 +3544,196 @@ func assertMessage(t *testing.T, expected, got lnwire.Message) {
 			spew.Sdump(got))
 	}
 }
+
+// TestChannelUpdateRateLimit ensures that remote ChannelUpdates exceeding the
+// rate limit of their channel are dropped, while keep-alive updates which
+// merely refresh the previous policy are exempt.
+func TestChannelUpdateRateLimit(t *testing.T) {
+	t.Parallel()
+
+	ctx, cleanup, err := createTestCtx(0)
+	if err != nil {
+		t.Fatalf("can't create context: %v", err)
+	}
+	defer cleanup()
+
+	// We'll only allow a single update per channel direction, such that
+	// any further update exceeds the limit.
+	ctx.gossiper.chanUpdateLimiter = newUpdateRateLimiter(time.Hour, 1)
+
+	nodePeer := &mockPeer{nodeKeyPriv1.PubKey(), nil, nil}
+	processRemote := func(msg lnwire.Message) {
+		t.Helper()
+
+		select {
+		case err := <-ctx.gossiper.ProcessRemoteAnnouncement(
+			msg, nodePeer,
+		):
+			if err != nil {
+				t.Fatalf("can't process remote "+
+					"announcement: %v", err)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatal("remote announcement not processed")
+		}
+	}
+	assertPolicyTimestamp := func(chanID lnwire.ShortChannelID,
+		timestamp uint32) {
+
+		t.Helper()
+
+		_, edge1, _, err := ctx.router.GetChannelByID(chanID)
+		if err != nil {
+			t.Fatalf("unable to fetch channel: %v", err)
+		}
+		if edge1 == nil || edge1.LastUpdate.Unix() != int64(timestamp) {
+			t.Fatalf("expected policy with timestamp %d, got %v",
+				timestamp, spew.Sdump(edge1))
+		}
+	}
+
+	chanAnn, err := createRemoteChannelAnnouncement(0)
+	if err != nil {
+		t.Fatalf("can't create channel announcement: %v", err)
+	}
+	processRemote(chanAnn)
+
+	// The first update is within the limit, and should be applied.
+	update1, err := createUpdateAnnouncement(0, 0, nodeKeyPriv1, 1000)
+	if err != nil {
+		t.Fatalf("can't create update announcement: %v", err)
+	}
+	processRemote(update1)
+	assertPolicyTimestamp(update1.ShortChannelID, update1.Timestamp)
+
+	// The second update changes the channel's policy, and should be
+	// dropped for exceeding the limit.
+	update2, err := createUpdateAnnouncement(0, 0, nodeKeyPriv1, 1001)
+	if err != nil {
+		t.Fatalf("can't create update announcement: %v", err)
+	}
+	processRemote(update2)
+	assertPolicyTimestamp(update1.ShortChannelID, update1.Timestamp)
+
+	stats := ctx.gossiper.RateLimitStats()
+	if stats.ThrottledByChannel != 1 || stats.ThrottledByPeer != 0 {
+		t.Fatalf("unexpected rate limit stats: %v", spew.Sdump(stats))
+	}
+
+	// A keep-alive update only refreshing the timestamp of the first
+	// update is exempt from the limit, and should be applied.
+	keepAlive := *update1
+	keepAlive.Timestamp = 1002
+	if err := signUpdate(nodeKeyPriv1, &keepAlive); err != nil {
+		t.Fatalf("unable to sign update: %v", err)
+	}
+	processRemote(&keepAlive)
+	assertPolicyTimestamp(keepAlive.ShortChannelID, keepAlive.Timestamp)
+
+	stats = ctx.gossiper.RateLimitStats()
+	if stats.ThrottledByChannel != 1 {
+		t.Fatalf("unexpected rate limit stats: %v", spew.Sdump(stats))
+	}
+}
+
+// TestIsBannedMsgOwnChannels ensures that announcements for our own channels
+// are never considered banned, even if the channel or its peer is.
+func TestIsBannedMsgOwnChannels(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to create db: %v", err)
+	}
+	defer cleanUp()
+
+	// We'll ban our peer, as well as both our own channel with them and a
+	// channel between two other nodes.
+	selfVertex := route.NewVertex(nodeKeyPub1)
+	peerVertex := route.NewVertex(nodeKeyPub2)
+	otherVertex := route.Vertex{1}
+	banList, err := routing.NewBanList(
+		db, []route.Vertex{peerVertex}, []uint64{1, 2},
+	)
+	if err != nil {
+		t.Fatalf("unable to create ban list: %v", err)
+	}
+
+	router := newMockRouter(0)
+	err = router.AddEdge(&channeldb.ChannelEdgeInfo{
+		ChannelID:     1,
+		NodeKey1Bytes: selfVertex,
+		NodeKey2Bytes: peerVertex,
+	})
+	if err != nil {
+		t.Fatalf("unable to add edge: %v", err)
+	}
+	err = router.AddEdge(&channeldb.ChannelEdgeInfo{
+		ChannelID:     2,
+		NodeKey1Bytes: otherVertex,
+		NodeKey2Bytes: peerVertex,
+	})
+	if err != nil {
+		t.Fatalf("unable to add edge: %v", err)
+	}
+
+	gossiper := &AuthenticatedGossiper{
+		cfg: &Config{
+			BanList: banList,
+			Router:  router,
+		},
+		selfKey: nodeKeyPub1,
+	}
+
+	tests := []struct {
+		name   string
+		msg    lnwire.Message
+		banned bool
+	}{
+		{
+			name: "own channel announcement",
+			msg: &lnwire.ChannelAnnouncement{
+				ShortChannelID: lnwire.NewShortChanIDFromInt(1),
+				NodeID1:        selfVertex,
+				NodeID2:        peerVertex,
+			},
+		},
+		{
+			name: "own channel update",
+			msg: &lnwire.ChannelUpdate{
+				ShortChannelID: lnwire.NewShortChanIDFromInt(1),
+			},
+		},
+		{
+			name: "other channel announcement",
+			msg: &lnwire.ChannelAnnouncement{
+				ShortChannelID: lnwire.NewShortChanIDFromInt(2),
+				NodeID1:        otherVertex,
+				NodeID2:        peerVertex,
+			},
+			banned: true,
+		},
+		{
+			name: "other channel update",
+			msg: &lnwire.ChannelUpdate{
+				ShortChannelID: lnwire.NewShortChanIDFromInt(2),
+			},
+			banned: true,
+		},
+		{
+			name: "peer node announcement",
+			msg: &lnwire.NodeAnnouncement{
+				NodeID: peerVertex,
+			},
+			banned: true,
+		},
+	}
+
+	for _, test := range tests {
+		banned := gossiper.isBannedMsg(test.msg)
+		if banned != test.banned {
+			t.Fatalf("%v: expected banned=%v, got %v", test.name,
+				test.banned, banned)
+		}
+	}
+}
@@ -21,6 +21,16 @@ var (
 	//   pubKey (33 bytes) + msgShortChanID (8 bytes) + msgType (2 bytes) -> msg
 	messageStoreBucket = []byte("message-store")
 
+	// pendingBroadcastBucket is a key used to create a top level bucket in
+	// the gossiper database, used for storing the announcements of the
+	// current broadcast batch that have yet to be flushed to the network.
+	// Upon restarts, these announcements will be read and added to the
+	// first batch again.
+	//
+	// maps:
+	//   msgType (2 bytes) + dedup key -> msg
+	pendingBroadcastBucket = []byte("pending-broadcast-store")
+
 	// ErrUnsupportedMessage is an error returned when we attempt to add a
 	// message to the store that is not supported.
 	ErrUnsupportedMessage = errors.New("unsupported message type")
@@ -54,6 +64,23 @@ type GossipMessageStore interface {
 	MessagesForPeer([33]byte) ([]lnwire.Message, error)
 }
 
+// PendingBroadcastStore is a store responsible for persisting the
+// announcements that are pending broadcast, such that they aren't lost across
+// restarts. Like the broadcast batch, the store only keeps the latest version
+// of an announcement.
+type PendingBroadcastStore interface {
+	// AddPendingBroadcasts adds the given announcements to the store,
+	// replacing any older versions of them.
+	AddPendingBroadcasts(...lnwire.Message) error
+
+	// DeletePendingBroadcasts removes the given announcements from the
+	// store, unless they've been replaced by a different version.
+	DeletePendingBroadcasts(...lnwire.Message) error
+
+	// PendingBroadcasts returns all announcements within the store.
+	PendingBroadcasts() ([]lnwire.Message, error)
+}
+
 // MessageStore is an implementation of the GossipMessageStore interface backed
 // by a channeldb instance. By design, this store will only keep the latest
 // version of a message (like in the case of multiple ChannelUpdate's) for a
@@ -66,10 +93,19 @@ type MessageStore struct {
 // GossipMessageStore interface.
 var _ GossipMessageStore = (*MessageStore)(nil)
 
+// A compile-time assertion to ensure messageStore implements the
+// PendingBroadcastStore interface.
+var _ PendingBroadcastStore = (*MessageStore)(nil)
+
 // NewMessageStore creates a new message store backed by a channeldb instance.
 func NewMessageStore(db *channeldb.DB) (*MessageStore, error) {
 	err := db.Update(func(tx *bbolt.Tx) error {
 		_, err := tx.CreateBucketIfNotExists(messageStoreBucket)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateBucketIfNotExists(pendingBroadcastBucket)
 		return err
 	})
 	if err != nil {
@@ -292,3 +328,193 @@ func (s *MessageStore) Peers() (map[[33]byte]struct{}, error) {
 
 	return peers, nil
 }
+
+// pendingBroadcastKey constructs the database key for an announcement pending
+// broadcast. The key mirrors how announcements are de-duplicated within the
+// broadcast batch.
+func pendingBroadcastKey(msg lnwire.Message) ([]byte, error) {
+	var b bytes.Buffer
+
+	var msgType [2]byte
+	binary.BigEndian.PutUint16(msgType[:], uint16(msg.MsgType()))
+	b.Write(msgType[:])
+
+	var scid [8]byte
+	switch msg := msg.(type) {
+	case *lnwire.ChannelAnnouncement:
+		binary.BigEndian.PutUint64(scid[:], msg.ShortChannelID.ToUint64())
+		b.Write(scid[:])
+
+	case *lnwire.ChannelUpdate:
+		binary.BigEndian.PutUint64(scid[:], msg.ShortChannelID.ToUint64())
+		b.Write(scid[:])
+		b.WriteByte(byte(msg.ChannelFlags))
+
+	case *lnwire.NodeAnnouncement:
+		b.Write(msg.NodeID[:])
+
+	default:
+		return nil, ErrUnsupportedMessage
+	}
+
+	return b.Bytes(), nil
+}
+
+// announcementTimestamp returns the timestamp of the given announcement, or
+// false if the announcement doesn't carry one.
+func announcementTimestamp(msg lnwire.Message) (uint32, bool) {
+	switch msg := msg.(type) {
+	case *lnwire.ChannelUpdate:
+		return msg.Timestamp, true
+
+	case *lnwire.NodeAnnouncement:
+		return msg.Timestamp, true
+
+	default:
+		return 0, false
+	}
+}
+
+// AddPendingBroadcasts adds the given announcements to the store, replacing
+// any older versions of them. Announcements that can't be part of a broadcast
+// batch are ignored.
+//
+// NOTE: Part of the PendingBroadcastStore interface.
+func (s *MessageStore) AddPendingBroadcasts(msgs ...lnwire.Message) error {
+	type keyedMsg struct {
+		key []byte
+		msg lnwire.Message
+		raw []byte
+	}
+
+	keyedMsgs := make([]keyedMsg, 0, len(msgs))
+	for _, msg := range msgs {
+		key, err := pendingBroadcastKey(msg)
+		if err == ErrUnsupportedMessage {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		var b bytes.Buffer
+		if _, err := lnwire.WriteMessage(&b, msg, 0); err != nil {
+			return err
+		}
+
+		keyedMsgs = append(keyedMsgs, keyedMsg{
+			key: key,
+			msg: msg,
+			raw: b.Bytes(),
+		})
+	}
+
+	if len(keyedMsgs) == 0 {
+		return nil
+	}
+
+	return s.db.Batch(func(tx *bbolt.Tx) error {
+		pendingStore := tx.Bucket(pendingBroadcastBucket)
+		if pendingStore == nil {
+			return ErrCorruptedMessageStore
+		}
+
+		for _, keyed := range keyedMsgs {
+			// As announcements may be added concurrently, we'll
+			// make sure not to replace a newer version of an
+			// announcement with an older one.
+			newTimestamp, ok := announcementTimestamp(keyed.msg)
+			if v := pendingStore.Get(keyed.key); v != nil && ok {
+				dbMsg, err := lnwire.ReadMessage(
+					bytes.NewReader(v), 0,
+				)
+				if err != nil {
+					return err
+				}
+
+				oldTimestamp, _ := announcementTimestamp(dbMsg)
+				if oldTimestamp > newTimestamp {
+					continue
+				}
+			}
+
+			err := pendingStore.Put(keyed.key, keyed.raw)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// DeletePendingBroadcasts removes the given announcements from the store. An
+// announcement is only removed if the stored version matches the given one,
+// such that newer versions which are still pending broadcast are kept.
+//
+// NOTE: Part of the PendingBroadcastStore interface.
+func (s *MessageStore) DeletePendingBroadcasts(msgs ...lnwire.Message) error {
+	return s.db.Batch(func(tx *bbolt.Tx) error {
+		pendingStore := tx.Bucket(pendingBroadcastBucket)
+		if pendingStore == nil {
+			return ErrCorruptedMessageStore
+		}
+
+		for _, msg := range msgs {
+			key, err := pendingBroadcastKey(msg)
+			if err == ErrUnsupportedMessage {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			v := pendingStore.Get(key)
+			if v == nil {
+				continue
+			}
+
+			var b bytes.Buffer
+			if _, err := lnwire.WriteMessage(&b, msg, 0); err != nil {
+				return err
+			}
+			if !bytes.Equal(v, b.Bytes()) {
+				continue
+			}
+
+			if err := pendingStore.Delete(key); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// PendingBroadcasts returns all announcements within the store.
+//
+// NOTE: Part of the PendingBroadcastStore interface.
+func (s *MessageStore) PendingBroadcasts() ([]lnwire.Message, error) {
+	var msgs []lnwire.Message
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		pendingStore := tx.Bucket(pendingBroadcastBucket)
+		if pendingStore == nil {
+			return ErrCorruptedMessageStore
+		}
+
+		return pendingStore.ForEach(func(_, v []byte) error {
+			msg, err := lnwire.ReadMessage(bytes.NewReader(v), 0)
+			if err != nil {
+				return err
+			}
+
+			msgs = append(msgs, msg)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return msgs, nil
+}
@@ -349,3 +349,92 @@ func TestMessageStoreDeleteMessage(t *testing.T) {
 	}
 	assertMsg(newChanUpdate, peer, false)
 }
+
+// TestPendingBroadcasts ensures that announcements pending broadcast can be
+// added to and deleted from the store, and that only the latest version of an
+// announcement is kept.
+func TestPendingBroadcasts(t *testing.T) {
+	t.Parallel()
+
+	msgStore, cleanUp := createTestMessageStore(t)
+	defer cleanUp()
+
+	chanAnn := &lnwire.ChannelAnnouncement{
+		ShortChannelID: lnwire.NewShortChanIDFromInt(rand.Uint64()),
+		Features:       lnwire.NewRawFeatureVector(),
+	}
+	nodeAnn := &lnwire.NodeAnnouncement{
+		Timestamp: 5,
+		Features:  lnwire.NewRawFeatureVector(),
+	}
+	copy(nodeAnn.NodeID[:], randPubKey(t).SerializeCompressed())
+
+	oldUpdate := randChannelUpdate()
+	oldUpdate.Timestamp = 1
+	newUpdate := *oldUpdate
+	newUpdate.Timestamp = 2
+
+	// Announcements that aren't part of broadcast batches should be
+	// ignored by the store.
+	err := msgStore.AddPendingBroadcasts(
+		chanAnn, nodeAnn, &newUpdate, randAnnounceSignatures(),
+	)
+	if err != nil {
+		t.Fatalf("unable to add pending broadcasts: %v", err)
+	}
+
+	// An older version of an announcement shouldn't replace the newer one
+	// that's already stored.
+	if err := msgStore.AddPendingBroadcasts(oldUpdate); err != nil {
+		t.Fatalf("unable to add pending broadcasts: %v", err)
+	}
+
+	// assertPending is a helper closure that ensures the store contains
+	// the expected announcement types, with the expected timestamp for the
+	// channel update.
+	assertPending := func(expected map[lnwire.MessageType]struct{}) {
+		t.Helper()
+
+		pending, err := msgStore.PendingBroadcasts()
+		if err != nil {
+			t.Fatalf("unable to fetch pending broadcasts: %v", err)
+		}
+		if len(pending) != len(expected) {
+			t.Fatalf("expected %d pending broadcasts, got %d",
+				len(expected), len(pending))
+		}
+		for _, msg := range pending {
+			if _, ok := expected[msg.MsgType()]; !ok {
+				t.Fatalf("unexpected pending broadcast: %v",
+					spew.Sdump(msg))
+			}
+
+			update, ok := msg.(*lnwire.ChannelUpdate)
+			if ok && update.Timestamp != newUpdate.Timestamp {
+				t.Fatalf("expected channel update with "+
+					"timestamp %d, got %d",
+					newUpdate.Timestamp, update.Timestamp)
+			}
+		}
+	}
+	assertPending(map[lnwire.MessageType]struct{}{
+		lnwire.MsgChannelAnnouncement: {},
+		lnwire.MsgNodeAnnouncement:    {},
+		lnwire.MsgChannelUpdate:       {},
+	})
+
+	// Deleting an outdated version of an announcement shouldn't remove the
+	// stored one, while the others should be removed.
+	err = msgStore.DeletePendingBroadcasts(oldUpdate, chanAnn, nodeAnn)
+	if err != nil {
+		t.Fatalf("unable to delete pending broadcasts: %v", err)
+	}
+	assertPending(map[lnwire.MessageType]struct{}{
+		lnwire.MsgChannelUpdate: {},
+	})
+
+	if err := msgStore.DeletePendingBroadcasts(&newUpdate); err != nil {
+		t.Fatalf("unable to delete pending broadcasts: %v", err)
+	}
+	assertPending(map[lnwire.MessageType]struct{}{})
+}
@@ -0,0 +1,108 @@
+package discovery
+
+import (
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"golang.org/x/time/rate"
+)
+
+// updateBucket is the token bucket of a single rate limited source, along
+// with the last time it was used.
+type updateBucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// updateRateLimiter applies a token bucket rate limit to the gossip messages
+// of a set of sources, such as the channel a ChannelUpdate is for, or the peer
+// that sent it. Each source gets its own bucket, which is created lazily.
+type updateRateLimiter struct {
+	// interval is the minimum average interval between the messages of a
+	// source. A zero interval disables the limiter.
+	interval time.Duration
+
+	// burst is the number of messages a source may send in quick
+	// succession before being rate limited.
+	burst int
+
+	mu      sync.Mutex
+	buckets map[interface{}]*updateBucket
+}
+
+// newUpdateRateLimiter creates a new updateRateLimiter with the given limits.
+func newUpdateRateLimiter(interval time.Duration,
+	burst int) *updateRateLimiter {
+
+	return &updateRateLimiter{
+		interval: interval,
+		burst:    burst,
+		buckets:  make(map[interface{}]*updateBucket),
+	}
+}
+
+// allow returns true if a message of the given source is within the rate
+// limit, consuming a token of its bucket if so.
+func (u *updateRateLimiter) allow(source interface{}) bool {
+	if u.interval == 0 {
+		return true
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	bucket, ok := u.buckets[source]
+	if !ok {
+		bucket = &updateBucket{
+			limiter: rate.NewLimiter(rate.Every(u.interval), u.burst),
+		}
+		u.buckets[source] = bucket
+	}
+	bucket.lastSeen = time.Now()
+
+	return bucket.limiter.Allow()
+}
+
+// remove removes the bucket of the given source.
+func (u *updateRateLimiter) remove(source interface{}) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	delete(u.buckets, source)
+}
+
+// prune removes the buckets of all sources that have been idle for long
+// enough for their bucket to be full again, as a fresh bucket would behave
+// identically.
+func (u *updateRateLimiter) prune() {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	refillTime := u.interval * time.Duration(u.burst)
+	for source, bucket := range u.buckets {
+		if time.Since(bucket.lastSeen) > refillTime {
+			delete(u.buckets, source)
+		}
+	}
+}
+
+// isKeepAliveUpdate returns true if the given ChannelUpdate only refreshes the
+// timestamp of the previous policy of its channel direction, without changing
+// any of the policy's parameters.
+func isKeepAliveUpdate(upd *lnwire.ChannelUpdate,
+	prev *channeldb.ChannelEdgePolicy) bool {
+
+	if prev == nil {
+		return false
+	}
+
+	return upd.MessageFlags == prev.MessageFlags &&
+		upd.ChannelFlags == prev.ChannelFlags &&
+		upd.TimeLockDelta == prev.TimeLockDelta &&
+		upd.HtlcMinimumMsat == prev.MinHTLC &&
+		upd.HtlcMaximumMsat == prev.MaxHTLC &&
+		lnwire.MilliSatoshi(upd.BaseFee) == prev.FeeBaseMSat &&
+		lnwire.MilliSatoshi(upd.FeeRate) == prev.FeeProportionalMillionths
+}
@@ -82,6 +82,15 @@ type SyncManagerCfg struct {
 	// SyncManager when it should attempt a historical sync with a gossip
 	// sync peer.
 	HistoricalSyncTicker ticker.Ticker
+
+	// EncodingType is the encoding type the gossip syncers will use for
+	// the short channel IDs of both their queries and their replies.
+	EncodingType lnwire.ShortChanIDEncoding
+
+	// QueryOptions is the set of optional extensions the gossip syncers
+	// will request within their channel range queries, allowing them to
+	// only query for the channels with updates newer than ours.
+	QueryOptions lnwire.QueryOptions
 }
 
 // SyncManager is a subsystem of the gossiper that manages the gossip syncers
@@ -375,12 +384,13 @@ func (m *SyncManager) createGossipSyncer(peer lnpeer.Peer) *GossipSyncer {
 	nodeID := route.Vertex(peer.PubKey())
 	log.Infof("Creating new GossipSyncer for peer=%x", nodeID[:])
 
-	encoding := lnwire.EncodingSortedPlain
+	encoding := m.cfg.EncodingType
 	s := newGossipSyncer(gossipSyncerCfg{
 		chainHash:     m.cfg.ChainHash,
 		peerPub:       nodeID,
 		channelSeries: m.cfg.ChanSeries,
 		encodingType:  encoding,
+		queryOptions:  m.cfg.QueryOptions,
 		chunkSize:     encodingTypeToChunkSize[encoding],
 		batchSize:     requestBatchSize,
 		sendToPeer: func(msgs ...lnwire.Message) error {
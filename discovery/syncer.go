@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -140,14 +141,26 @@ const (
 	// requestBatchSize is the maximum number of channels we will query the
 	// remote peer for in a QueryShortChanIDs message.
 	requestBatchSize = 500
+
+	// keepAliveQueryAge is the age after which we'll query a remote peer
+	// for a channel update that merely refreshes the timestamp of the one
+	// we already have. Until then, we'll skip such updates to save
+	// bandwidth, but we do need them eventually to prevent the channel
+	// from being pruned as a zombie after two weeks without an update.
+	keepAliveQueryAge = 7 * 24 * time.Hour
 )
 
 var (
 	// encodingTypeToChunkSize maps an encoding type, to the max number of
 	// short chan ID's using the encoding type that we can fit into a
 	// single message safely.
+	//
+	// NOTE: As zlib may fail to compress a set of short chan ID's, we
+	// can't fit any more of them into a message than with the plain
+	// encoding.
 	encodingTypeToChunkSize = map[lnwire.ShortChanIDEncoding]int32{
 		lnwire.EncodingSortedPlain: 8000,
+		lnwire.EncodingSortedZlib:  8000,
 	}
 
 	// ErrGossipSyncerExiting signals that the syncer has been killed.
@@ -177,6 +190,16 @@ type historicalSyncReq struct {
 	doneChan chan struct{}
 }
 
+// remoteChanUpdateInfo houses the update timestamps and optional checksums of
+// a channel included within a remote peer's reply to our channel range query.
+type remoteChanUpdateInfo struct {
+	timestamps lnwire.ChanUpdateTimestamps
+
+	// checksums is only set if the remote peer included checksums within
+	// its reply.
+	checksums *lnwire.ChanUpdateChecksums
+}
+
 // gossipSyncerCfg is a struct that packages all the information a GossipSyncer
 // needs to carry out its duties.
 type gossipSyncerCfg struct {
@@ -195,10 +218,15 @@ type gossipSyncerCfg struct {
 	// our queries and respond to the queries of the remote peer.
 	channelSeries ChannelGraphTimeSeries
 
-	// encodingType is the current encoding type we're aware of. Requests
-	// with different encoding types will be rejected.
+	// encodingType is the encoding type we'll use for the short chan ID's
+	// of both our queries and our replies to the remote peer.
 	encodingType lnwire.ShortChanIDEncoding
 
+	// queryOptions is the set of optional extensions we'll request the
+	// remote peer to include within its replies to our channel range
+	// queries.
+	queryOptions lnwire.QueryOptions
+
 	// chunkSize is the max number of short chan IDs using the syncer's
 	// encoding type that we can fit into a single message safely.
 	chunkSize int32
@@ -300,6 +328,11 @@ type GossipSyncer struct {
 	// buffer all the chunked response to our query.
 	bufferedChanRangeReplies []lnwire.ShortChannelID
 
+	// bufferedChanUpdateInfos is used in the waitingQueryChanReply to
+	// buffer the update timestamps and checksums the remote peer included
+	// within its chunked response to our query, if any.
+	bufferedChanUpdateInfos map[lnwire.ShortChannelID]remoteChanUpdateInfo
+
 	// newChansToQuery is used to pass the set of channels we should query
 	// for from the waitingQueryChanReply state to the queryNewChannels
 	// state.
@@ -657,7 +690,7 @@ func (g *GossipSyncer) synchronizeChanIDs() (bool, error) {
 	// false indicating that we're net yet fully synced.
 	err := g.cfg.sendToPeer(&lnwire.QueryShortChanIDs{
 		ChainHash:    g.cfg.chainHash,
-		EncodingType: lnwire.EncodingSortedPlain,
+		EncodingType: g.cfg.encodingType,
 		ShortChanIDs: queryChunk,
 	})
 
@@ -675,6 +708,29 @@ func (g *GossipSyncer) processChanRangeReply(msg *lnwire.ReplyChannelRange) erro
 	log.Infof("GossipSyncer(%x): buffering chan range reply of size=%v",
 		g.cfg.peerPub[:], len(msg.ShortChanIDs))
 
+	// If the remote peer included the timestamps of the latest updates of
+	// each channel, we'll buffer those as well, such that we can later
+	// also query for the channels we know of for which they have newer
+	// updates.
+	if len(msg.Timestamps) > 0 {
+		if g.bufferedChanUpdateInfos == nil {
+			g.bufferedChanUpdateInfos = make(
+				map[lnwire.ShortChannelID]remoteChanUpdateInfo,
+			)
+		}
+
+		for i, chanID := range msg.ShortChanIDs {
+			info := remoteChanUpdateInfo{
+				timestamps: msg.Timestamps[i],
+			}
+			if len(msg.Checksums) > 0 {
+				info.checksums = &msg.Checksums[i]
+			}
+
+			g.bufferedChanUpdateInfos[chanID] = info
+		}
+	}
+
 	// If this isn't the last response, then we can exit as we've already
 	// buffered the latest portion of the streaming reply.
 	if msg.Complete == 0 {
@@ -693,10 +749,28 @@ func (g *GossipSyncer) processChanRangeReply(msg *lnwire.ReplyChannelRange) erro
 		return fmt.Errorf("unable to filter chan ids: %v", err)
 	}
 
+	// Along with the channels we don't know of, we'll also query for the
+	// channels that the remote peer has newer updates for.
+	staleChans, err := g.filterStaleChanUpdates(newChans)
+	if err != nil {
+		return fmt.Errorf("unable to filter stale chan updates: %v",
+			err)
+	}
+	if len(staleChans) > 0 {
+		log.Infof("GossipSyncer(%x): remote peer has newer updates "+
+			"for %v known chans", g.cfg.peerPub[:], len(staleChans))
+
+		newChans = append(newChans, staleChans...)
+		sort.Slice(newChans, func(i, j int) bool {
+			return newChans[i].ToUint64() < newChans[j].ToUint64()
+		})
+	}
+
 	// As we've received the entirety of the reply, we no longer need to
 	// hold on to the set of buffered replies, so we'll let that be garbage
 	// collected now.
 	g.bufferedChanRangeReplies = nil
+	g.bufferedChanUpdateInfos = nil
 
 	// If there aren't any channels that we don't know of, then we can
 	// switch straight to our terminal state.
@@ -719,6 +793,99 @@ func (g *GossipSyncer) processChanRangeReply(msg *lnwire.ReplyChannelRange) erro
 	return nil
 }
 
+// filterStaleChanUpdates returns the channels within the remote peer's reply to
+// our channel range query that we already know of, but for which the remote
+// peer has newer updates according to the timestamps and checksums it
+// included. The passed set of new channels, which we'll query for anyway, is
+// skipped. Known zombie channels aren't part of our graph, and will therefore
+// never be returned.
+func (g *GossipSyncer) filterStaleChanUpdates(
+	newChans []lnwire.ShortChannelID) ([]lnwire.ShortChannelID, error) {
+
+	if len(g.bufferedChanUpdateInfos) == 0 {
+		return nil, nil
+	}
+
+	isNew := make(map[lnwire.ShortChannelID]struct{}, len(newChans))
+	for _, chanID := range newChans {
+		isNew[chanID] = struct{}{}
+	}
+
+	knownChans := make(
+		[]lnwire.ShortChannelID, 0, len(g.bufferedChanUpdateInfos),
+	)
+	for chanID := range g.bufferedChanUpdateInfos {
+		if _, ok := isNew[chanID]; ok {
+			continue
+		}
+		knownChans = append(knownChans, chanID)
+	}
+	if len(knownChans) == 0 {
+		return nil, nil
+	}
+
+	localInfos, err := g.cfg.channelSeries.FetchChanUpdateInfos(
+		g.cfg.chainHash, knownChans,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var staleChans []lnwire.ShortChannelID
+	for _, local := range localInfos {
+		remote, ok := g.bufferedChanUpdateInfos[local.ShortChannelID]
+		if !ok {
+			continue
+		}
+
+		if hasNewerChanUpdate(local, remote) {
+			staleChans = append(staleChans, local.ShortChannelID)
+		}
+	}
+
+	return staleChans, nil
+}
+
+// hasNewerChanUpdate returns true if the remote peer has a newer update for
+// either direction of the channel that's worth querying for.
+func hasNewerChanUpdate(local ChanUpdateInfo,
+	remote remoteChanUpdateInfo) bool {
+
+	isNewer := func(localTimestamp, remoteTimestamp uint32,
+		sameChecksum bool) bool {
+
+		if remoteTimestamp <= localTimestamp {
+			return false
+		}
+
+		// If the remote update carries the same policy as ours, it
+		// only refreshes the timestamp, which we'll only need once
+		// our own update is at risk of becoming stale.
+		if sameChecksum {
+			lastUpdate := time.Unix(int64(localTimestamp), 0)
+			return time.Since(lastUpdate) > keepAliveQueryAge
+		}
+
+		return true
+	}
+
+	var sameChecksum1, sameChecksum2 bool
+	if remote.checksums != nil {
+		sameChecksum1 = remote.checksums.Checksum1 ==
+			local.Checksums.Checksum1
+		sameChecksum2 = remote.checksums.Checksum2 ==
+			local.Checksums.Checksum2
+	}
+
+	return isNewer(
+		local.Timestamps.Timestamp1, remote.timestamps.Timestamp1,
+		sameChecksum1,
+	) || isNewer(
+		local.Timestamps.Timestamp2, remote.timestamps.Timestamp2,
+		sameChecksum2,
+	)
+}
+
 // genChanRangeQuery generates the initial message we'll send to the remote
 // party when we're kicking off the channel graph synchronization upon
 // connection. The historicalQuery boolean can be used to generate a query from
@@ -759,6 +926,7 @@ func (g *GossipSyncer) genChanRangeQuery(
 		ChainHash:        g.cfg.chainHash,
 		FirstBlockHeight: startHeight,
 		NumBlocks:        math.MaxUint32 - startHeight,
+		QueryOptions:     g.cfg.queryOptions,
 	}, nil
 }
 
@@ -822,6 +990,22 @@ func (g *GossipSyncer) replyChanRangeQuery(query *lnwire.QueryChannelRange) erro
 	// TODO(roasbeef): means can't send max uint above?
 	//  * or make internal 64
 
+	// Each of the optional extensions requested by the remote peer takes
+	// up as much space as the short chan ID's themselves, so we'll shrink
+	// our chunks accordingly.
+	chunkSize := g.cfg.chunkSize
+	numExtensions := int32(1)
+	if query.QueryOptions.WantTimestamps() {
+		numExtensions++
+	}
+	if query.QueryOptions.WantChecksums() {
+		numExtensions++
+	}
+	chunkSize /= numExtensions
+	if chunkSize == 0 {
+		chunkSize = 1
+	}
+
 	numChannels := int32(len(channelRange))
 	numChansSent := int32(0)
 	for {
@@ -833,7 +1017,7 @@ func (g *GossipSyncer) replyChanRangeQuery(query *lnwire.QueryChannelRange) erro
 		// We know this is the final chunk, if the difference between
 		// the total number of channels, and the number of channels
 		// we've sent is less-than-or-equal to the chunk size.
-		isFinalChunk := (numChannels - numChansSent) <= g.cfg.chunkSize
+		isFinalChunk := (numChannels - numChansSent) <= chunkSize
 
 		// If this is indeed the last chunk, then we'll send the
 		// remainder of the channels.
@@ -846,7 +1030,7 @@ func (g *GossipSyncer) replyChanRangeQuery(query *lnwire.QueryChannelRange) erro
 		} else {
 			// Otherwise, we'll only send off a fragment exactly
 			// sized to the proper chunk size.
-			channelChunk = channelRange[numChansSent : numChansSent+g.cfg.chunkSize]
+			channelChunk = channelRange[numChansSent : numChansSent+chunkSize]
 
 			log.Infof("GossipSyncer(%x): sending range chunk of "+
 				"size=%v", g.cfg.peerPub[:], len(channelChunk))
@@ -863,6 +1047,10 @@ func (g *GossipSyncer) replyChanRangeQuery(query *lnwire.QueryChannelRange) erro
 		if isFinalChunk {
 			replyChunk.Complete = 1
 		}
+		err := g.addChanUpdateInfos(&replyChunk, query.QueryOptions)
+		if err != nil {
+			return err
+		}
 		if err := g.cfg.sendToPeerSync(&replyChunk); err != nil {
 			return err
 		}
@@ -877,6 +1065,52 @@ func (g *GossipSyncer) replyChanRangeQuery(query *lnwire.QueryChannelRange) erro
 	}
 }
 
+// addChanUpdateInfos adds the update timestamps and checksums requested by the
+// remote peer's query options to the given reply chunk.
+func (g *GossipSyncer) addChanUpdateInfos(reply *lnwire.ReplyChannelRange,
+	options lnwire.QueryOptions) error {
+
+	if !options.WantTimestamps() && !options.WantChecksums() {
+		return nil
+	}
+
+	infos, err := g.cfg.channelSeries.FetchChanUpdateInfos(
+		reply.ChainHash, reply.ShortChanIDs,
+	)
+	if err != nil {
+		return err
+	}
+
+	// A channel may have been removed from our graph since we fetched the
+	// range, in which case we'll report it as having no updates.
+	infoIndex := make(map[lnwire.ShortChannelID]ChanUpdateInfo, len(infos))
+	for _, info := range infos {
+		infoIndex[info.ShortChannelID] = info
+	}
+
+	if options.WantTimestamps() {
+		reply.Timestamps = make(
+			[]lnwire.ChanUpdateTimestamps, len(reply.ShortChanIDs),
+		)
+	}
+	if options.WantChecksums() {
+		reply.Checksums = make(
+			[]lnwire.ChanUpdateChecksums, len(reply.ShortChanIDs),
+		)
+	}
+	for i, chanID := range reply.ShortChanIDs {
+		info := infoIndex[chanID]
+		if reply.Timestamps != nil {
+			reply.Timestamps[i] = info.Timestamps
+		}
+		if reply.Checksums != nil {
+			reply.Checksums[i] = info.Checksums
+		}
+	}
+
+	return nil
+}
+
 // replyShortChanIDs will be dispatched in response to a query by the remote
 // node for information concerning a set of short channel ID's. Our response
 // will be sent in a streaming chunked manner to ensure that we remain below
@@ -48,6 +48,9 @@ type mockChannelGraphTimeSeries struct {
 
 	updateReq  chan lnwire.ShortChannelID
 	updateResp chan []*lnwire.ChannelUpdate
+
+	updateInfoReq  chan []lnwire.ShortChannelID
+	updateInfoResp chan []ChanUpdateInfo
 }
 
 func newMockChannelGraphTimeSeries(
@@ -70,6 +73,9 @@ func newMockChannelGraphTimeSeries(
 
 		updateReq:  make(chan lnwire.ShortChannelID, 1),
 		updateResp: make(chan []*lnwire.ChannelUpdate, 1),
+
+		updateInfoReq:  make(chan []lnwire.ShortChannelID, 1),
+		updateInfoResp: make(chan []ChanUpdateInfo, 1),
 	}
 }
 
@@ -114,6 +120,14 @@ func (m *mockChannelGraphTimeSeries) FetchChanUpdates(chain chainhash.Hash,
 	return <-m.updateResp, nil
 }
 
+func (m *mockChannelGraphTimeSeries) FetchChanUpdateInfos(chain chainhash.Hash,
+	shortChanIDs []lnwire.ShortChannelID) ([]ChanUpdateInfo, error) {
+
+	m.updateInfoReq <- shortChanIDs
+
+	return <-m.updateInfoResp, nil
+}
+
 var _ ChannelGraphTimeSeries = (*mockChannelGraphTimeSeries)(nil)
 
 // newTestSyncer creates a new test instance of a GossipSyncer. A buffered
@@ -915,6 +929,235 @@ func TestGossipSyncerProcessChanRangeReply(t *testing.T) {
 	}
 }
 
+// TestGossipSyncerReplyChanRangeQueryExtensions tests that we include the
+// update timestamps and checksums requested by the remote peer within our
+// replies to its channel range query, shrinking our chunks accordingly.
+func TestGossipSyncerReplyChanRangeQueryExtensions(t *testing.T) {
+	t.Parallel()
+
+	// With both extensions requested, a chunk size of 6 results in chunks
+	// of 2 channels.
+	const chunkSize = 6
+
+	msgChan, syncer, chanSeries := newTestSyncer(
+		lnwire.NewShortChanIDFromInt(10), defaultEncoding, chunkSize,
+	)
+
+	query := &lnwire.QueryChannelRange{
+		FirstBlockHeight: 100,
+		NumBlocks:        50,
+		QueryOptions: lnwire.QueryOptionTimestamps |
+			lnwire.QueryOptionChecksums,
+	}
+
+	chanIDs := []lnwire.ShortChannelID{
+		lnwire.NewShortChanIDFromInt(1),
+		lnwire.NewShortChanIDFromInt(2),
+		lnwire.NewShortChanIDFromInt(3),
+	}
+	chanSeries.filterRangeResp <- chanIDs
+
+	// We'll reply with the update infos of each chunk as they're
+	// requested. The second channel was removed from our graph in the
+	// meantime, so no info is known for it.
+	infos := []ChanUpdateInfo{
+		{
+			ShortChannelID: chanIDs[0],
+			Timestamps: lnwire.ChanUpdateTimestamps{
+				Timestamp1: 100, Timestamp2: 200,
+			},
+			Checksums: lnwire.ChanUpdateChecksums{
+				Checksum1: 1, Checksum2: 2,
+			},
+		},
+		{
+			ShortChannelID: chanIDs[2],
+			Timestamps: lnwire.ChanUpdateTimestamps{
+				Timestamp1: 300,
+			},
+			Checksums: lnwire.ChanUpdateChecksums{
+				Checksum1: 3,
+			},
+		},
+	}
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- syncer.replyChanRangeQuery(query)
+	}()
+	for _, resp := range [][]ChanUpdateInfo{infos[:1], infos[1:]} {
+		select {
+		case <-chanSeries.updateInfoReq:
+			chanSeries.updateInfoResp <- resp
+		case <-time.After(time.Second * 5):
+			t.Fatalf("no update info request received")
+		}
+	}
+	select {
+	case err := <-errChan:
+		if err != nil {
+			t.Fatalf("unable to reply to query: %v", err)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("query reply not completed")
+	}
+
+	expectedReplies := []*lnwire.ReplyChannelRange{
+		{
+			QueryChannelRange: *query,
+			EncodingType:      defaultEncoding,
+			ShortChanIDs:      chanIDs[:2],
+			Timestamps: []lnwire.ChanUpdateTimestamps{
+				infos[0].Timestamps, {},
+			},
+			Checksums: []lnwire.ChanUpdateChecksums{
+				infos[0].Checksums, {},
+			},
+		},
+		{
+			QueryChannelRange: *query,
+			Complete:          1,
+			EncodingType:      defaultEncoding,
+			ShortChanIDs:      chanIDs[2:],
+			Timestamps: []lnwire.ChanUpdateTimestamps{
+				infos[1].Timestamps,
+			},
+			Checksums: []lnwire.ChanUpdateChecksums{
+				infos[1].Checksums,
+			},
+		},
+	}
+	for _, expected := range expectedReplies {
+		select {
+		case msgs := <-msgChan:
+			if !reflect.DeepEqual(msgs[0], expected) {
+				t.Fatalf("expected reply %v, got %v",
+					spew.Sdump(expected), spew.Sdump(msgs[0]))
+			}
+		case <-time.After(time.Second * 5):
+			t.Fatalf("no reply received")
+		}
+	}
+}
+
+// TestGossipSyncerProcessChanRangeReplyStaleUpdates tests that we'll query the
+// remote peer for the channels we already know of if its reply includes newer
+// updates for them, skipping those that merely refresh a recent update of
+// ours.
+func TestGossipSyncerProcessChanRangeReplyStaleUpdates(t *testing.T) {
+	t.Parallel()
+
+	_, syncer, chanSeries := newTestSyncer(
+		lnwire.NewShortChanIDFromInt(10), defaultEncoding,
+		defaultChunkSize,
+	)
+
+	now := uint32(time.Now().Unix())
+	old := uint32(time.Now().Add(-2 * keepAliveQueryAge).Unix())
+
+	var (
+		newChan       = lnwire.NewShortChanIDFromInt(1)
+		upToDateChan  = lnwire.NewShortChanIDFromInt(2)
+		newPolicyChan = lnwire.NewShortChanIDFromInt(3)
+		keepAliveChan = lnwire.NewShortChanIDFromInt(4)
+		staleChan     = lnwire.NewShortChanIDFromInt(5)
+	)
+	reply := &lnwire.ReplyChannelRange{
+		Complete: 1,
+		ShortChanIDs: []lnwire.ShortChannelID{
+			newChan, upToDateChan, newPolicyChan, keepAliveChan,
+			staleChan,
+		},
+		Timestamps: []lnwire.ChanUpdateTimestamps{
+			{Timestamp1: now},
+			{Timestamp1: now - 10, Timestamp2: now - 10},
+			{Timestamp1: now - 10, Timestamp2: now},
+			{Timestamp1: now},
+			{Timestamp2: now},
+		},
+		Checksums: []lnwire.ChanUpdateChecksums{
+			{Checksum1: 1},
+			{Checksum1: 1, Checksum2: 2},
+			{Checksum1: 1, Checksum2: 3},
+			{Checksum1: 1},
+			{Checksum2: 2},
+		},
+	}
+
+	// Only the first channel is unknown to us. For the others, our own
+	// updates are either as recent as theirs, carry a different policy,
+	// or carry the same policy and are either recent or old.
+	chanSeries.filterResp <- []lnwire.ShortChannelID{newChan}
+	chanSeries.updateInfoResp <- []ChanUpdateInfo{
+		{
+			ShortChannelID: upToDateChan,
+			Timestamps: lnwire.ChanUpdateTimestamps{
+				Timestamp1: now - 10, Timestamp2: now - 10,
+			},
+			Checksums: lnwire.ChanUpdateChecksums{
+				Checksum1: 1, Checksum2: 2,
+			},
+		},
+		{
+			ShortChannelID: newPolicyChan,
+			Timestamps: lnwire.ChanUpdateTimestamps{
+				Timestamp1: now - 10, Timestamp2: now - 10,
+			},
+			Checksums: lnwire.ChanUpdateChecksums{
+				Checksum1: 1, Checksum2: 2,
+			},
+		},
+		{
+			ShortChannelID: keepAliveChan,
+			Timestamps: lnwire.ChanUpdateTimestamps{
+				Timestamp1: now - 10,
+			},
+			Checksums: lnwire.ChanUpdateChecksums{
+				Checksum1: 1,
+			},
+		},
+		{
+			ShortChannelID: staleChan,
+			Timestamps: lnwire.ChanUpdateTimestamps{
+				Timestamp2: old,
+			},
+			Checksums: lnwire.ChanUpdateChecksums{
+				Checksum2: 2,
+			},
+		},
+	}
+
+	if err := syncer.processChanRangeReply(reply); err != nil {
+		t.Fatalf("unable to process reply: %v", err)
+	}
+
+	// We should only look up our own updates for the channels we know.
+	infoReq := <-chanSeries.updateInfoReq
+	if len(infoReq) != 4 {
+		t.Fatalf("expected update info request for 4 chans, got %v",
+			infoReq)
+	}
+	for _, chanID := range infoReq {
+		if chanID == newChan {
+			t.Fatalf("update info requested for new chan")
+		}
+	}
+
+	expectedQuery := []lnwire.ShortChannelID{
+		newChan, newPolicyChan, staleChan,
+	}
+	if syncer.syncState() != queryNewChannels {
+		t.Fatalf("wrong state: expected %v instead got %v",
+			queryNewChannels, syncer.syncState())
+	}
+	if !reflect.DeepEqual(syncer.newChansToQuery, expectedQuery) {
+		t.Fatalf("wrong set of chans to query: expected %v, got %v",
+			expectedQuery, syncer.newChansToQuery)
+	}
+	if syncer.bufferedChanUpdateInfos != nil {
+		t.Fatalf("buffered update infos weren't released")
+	}
+}
+
 // TestGossipSyncerSynchronizeChanIDs tests that we properly request chunks of
 // the short chan ID's which were unknown to us. We'll ensure that we request
 // chunk by chunk, and after the last chunk, we return true indicating that we
@@ -0,0 +1,382 @@
+package htlcswitch
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// HtlcQuotaConfig bounds the share of an outgoing channel's htlc slots and
+// value in flight that htlcs forwarded from a single incoming channel may
+// occupy. Without these bounds, a single peer is able to jam all our outgoing
+// channels by filling their slots with htlcs that are held for a long time.
+type HtlcQuotaConfig struct {
+	// MaxSlotPercent is the maximum percentage of the htlc slots of an
+	// outgoing channel that may be occupied by htlcs forwarded from a
+	// single incoming channel. A zero value disables the slot quota.
+	MaxSlotPercent uint32
+
+	// MaxValuePercent is the maximum percentage of the maximum value in
+	// flight of an outgoing channel that may be occupied by htlcs
+	// forwarded from a single incoming channel. A zero value disables the
+	// value quota.
+	MaxValuePercent uint32
+
+	// MaxHoldTime is the maximum duration that an htlc forwarded from an
+	// incoming channel may remain unresolved. As long as an incoming
+	// channel has an htlc in flight for longer than this, no further htlcs
+	// from it will be forwarded. A zero value disables the limit.
+	MaxHoldTime time.Duration
+}
+
+// HtlcQuotaStats holds the quota counters of a single incoming channel.
+type HtlcQuotaStats struct {
+	// ChanID is the short channel id of the incoming channel.
+	ChanID lnwire.ShortChannelID
+
+	// NumInFlight is the number of htlcs from the incoming channel that
+	// are currently forwarded over any of our outgoing channels.
+	NumInFlight uint32
+
+	// ValueInFlight is the total value of the htlcs from the incoming
+	// channel that are currently in flight.
+	ValueInFlight lnwire.MilliSatoshi
+
+	// NumSlotRejects is the number of htlcs that were rejected because the
+	// incoming channel exceeded its share of outgoing htlc slots.
+	NumSlotRejects uint64
+
+	// NumValueRejects is the number of htlcs that were rejected because
+	// the incoming channel exceeded its share of outgoing value in flight.
+	NumValueRejects uint64
+
+	// NumHoldTimeRejects is the number of htlcs that were rejected because
+	// the incoming channel had an htlc in flight for longer than the
+	// maximum hold time.
+	NumHoldTimeRejects uint64
+
+	// NumSlowResolved is the number of htlcs from the incoming channel
+	// that were resolved only after the maximum hold time had passed.
+	NumSlowResolved uint64
+}
+
+// quotaViolation describes which quota an htlc would exceed if it were
+// forwarded.
+type quotaViolation uint8
+
+const (
+	// quotaOK indicates that the htlc doesn't exceed any quota.
+	quotaOK quotaViolation = iota
+
+	// quotaSlots indicates that the htlc would exceed the share of htlc
+	// slots of its incoming channel.
+	quotaSlots
+
+	// quotaValue indicates that the htlc would exceed the share of value
+	// in flight of its incoming channel.
+	quotaValue
+
+	// quotaHoldTime indicates that the incoming channel has an htlc in
+	// flight for longer than the maximum hold time.
+	quotaHoldTime
+)
+
+// String returns a human readable description of the violation.
+func (v quotaViolation) String() string {
+	switch v {
+	case quotaOK:
+		return "ok"
+	case quotaSlots:
+		return "htlc slot quota exceeded"
+	case quotaValue:
+		return "value in flight quota exceeded"
+	case quotaHoldTime:
+		return "htlc held beyond max hold time"
+	default:
+		return "unknown"
+	}
+}
+
+// quotaError is returned when an htlc isn't forwarded because its incoming
+// channel exceeded one of its quotas on the outgoing channel.
+type quotaError struct {
+	paymentHash [32]byte
+	incoming    lnwire.ShortChannelID
+	outgoing    lnwire.ShortChannelID
+	violation   quotaViolation
+}
+
+// Error returns a human readable description of the error.
+func (e *quotaError) Error() string {
+	return fmt.Sprintf("incoming HTLC(%x) from link (id=%v) rejected by "+
+		"target outgoing link (id=%v): %v", e.paymentHash[:],
+		e.incoming, e.outgoing, e.violation)
+}
+
+// quotaHtlc is an htlc that has been forwarded and is tracked against the
+// quota of its incoming channel.
+type quotaHtlc struct {
+	outgoing lnwire.ShortChannelID
+	amount   lnwire.MilliSatoshi
+	addedAt  time.Time
+}
+
+// quotaChanPair identifies the htlcs forwarded from one incoming channel to
+// one outgoing channel.
+type quotaChanPair struct {
+	incoming lnwire.ShortChannelID
+	outgoing lnwire.ShortChannelID
+}
+
+// quotaUsage is the number and total value of the htlcs in flight between a
+// pair of channels.
+type quotaUsage struct {
+	num   uint32
+	value lnwire.MilliSatoshi
+}
+
+// htlcQuotas enforces the HtlcQuotaConfig for all htlcs forwarded by the
+// switch, and keeps track of per incoming channel counters so that jamming
+// attempts can be spotted.
+type htlcQuotas struct {
+	cfg HtlcQuotaConfig
+
+	// now returns the current time. It's overridden in tests.
+	now func() time.Time
+
+	mu sync.Mutex
+
+	// htlcs holds all forwarded htlcs that are unresolved, indexed by
+	// their incoming channel and htlc id. This allows the htlcs of a
+	// single incoming channel to be inspected without walking those of
+	// all other channels.
+	htlcs map[lnwire.ShortChannelID]map[uint64]*quotaHtlc
+
+	// usage holds the slots and value in flight for each pair of channels
+	// that currently have htlcs in flight between them.
+	usage map[quotaChanPair]*quotaUsage
+
+	// stats holds the counters of every incoming channel that we have
+	// forwarded or rejected htlcs for.
+	stats map[lnwire.ShortChannelID]*HtlcQuotaStats
+}
+
+// newHtlcQuotas creates a new quota tracker for the given configuration.
+func newHtlcQuotas(cfg HtlcQuotaConfig) *htlcQuotas {
+	return &htlcQuotas{
+		cfg:   cfg,
+		now:   time.Now,
+		htlcs: make(map[lnwire.ShortChannelID]map[uint64]*quotaHtlc),
+		usage: make(map[quotaChanPair]*quotaUsage),
+		stats: make(map[lnwire.ShortChannelID]*HtlcQuotaStats),
+	}
+}
+
+// fetchStats returns the counters of the given incoming channel, creating
+// them if needed. The mutex MUST be held when calling this method.
+func (q *htlcQuotas) fetchStats(
+	chanID lnwire.ShortChannelID) *HtlcQuotaStats {
+
+	stats, ok := q.stats[chanID]
+	if !ok {
+		stats = &HtlcQuotaStats{ChanID: chanID}
+		q.stats[chanID] = stats
+	}
+
+	return stats
+}
+
+// check returns whether an htlc of the given amount that came in over the
+// incoming channel would exceed any quota if it were forwarded over the
+// outgoing channel, which accepts at most maxHtlcs htlcs with a total value of
+// maxValue.
+func (q *htlcQuotas) check(incoming, outgoing lnwire.ShortChannelID,
+	amt lnwire.MilliSatoshi, maxHtlcs uint16,
+	maxValue lnwire.MilliSatoshi) quotaViolation {
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.cfg.MaxHoldTime > 0 {
+		now := q.now()
+		for _, htlc := range q.htlcs[incoming] {
+			if now.Sub(htlc.addedAt) > q.cfg.MaxHoldTime {
+				return quotaHoldTime
+			}
+		}
+	}
+
+	var usage quotaUsage
+	if u, ok := q.usage[quotaChanPair{incoming, outgoing}]; ok {
+		usage = *u
+	}
+
+	if q.cfg.MaxSlotPercent > 0 {
+		// Every incoming channel is allowed at least a single slot, so
+		// that small channels remain usable.
+		maxSlots := uint32(maxHtlcs) * q.cfg.MaxSlotPercent / 100
+		if maxSlots == 0 {
+			maxSlots = 1
+		}
+
+		if usage.num+1 > maxSlots {
+			return quotaSlots
+		}
+	}
+
+	if q.cfg.MaxValuePercent > 0 {
+		limit := maxValue * lnwire.MilliSatoshi(q.cfg.MaxValuePercent) /
+			100
+
+		if usage.value+amt > limit {
+			return quotaValue
+		}
+	}
+
+	return quotaOK
+}
+
+// reject counts an htlc from the incoming channel that was rejected because of
+// the given violation.
+func (q *htlcQuotas) reject(incoming lnwire.ShortChannelID,
+	violation quotaViolation) {
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	stats := q.fetchStats(incoming)
+	switch violation {
+	case quotaSlots:
+		stats.NumSlotRejects++
+	case quotaValue:
+		stats.NumValueRejects++
+	case quotaHoldTime:
+		stats.NumHoldTimeRejects++
+	}
+}
+
+// add starts tracking an htlc that was forwarded from the incoming circuit
+// key over the outgoing channel. Adding an htlc that is already tracked has
+// no effect.
+func (q *htlcQuotas) add(incoming CircuitKey, outgoing lnwire.ShortChannelID,
+	amt lnwire.MilliSatoshi) {
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	chanHtlcs, ok := q.htlcs[incoming.ChanID]
+	if !ok {
+		chanHtlcs = make(map[uint64]*quotaHtlc)
+		q.htlcs[incoming.ChanID] = chanHtlcs
+	}
+
+	if _, ok := chanHtlcs[incoming.HtlcID]; ok {
+		return
+	}
+
+	chanHtlcs[incoming.HtlcID] = &quotaHtlc{
+		outgoing: outgoing,
+		amount:   amt,
+		addedAt:  q.now(),
+	}
+
+	pair := quotaChanPair{incoming.ChanID, outgoing}
+	usage, ok := q.usage[pair]
+	if !ok {
+		usage = &quotaUsage{}
+		q.usage[pair] = usage
+	}
+	usage.num++
+	usage.value += amt
+
+	stats := q.fetchStats(incoming.ChanID)
+	stats.NumInFlight++
+	stats.ValueInFlight += amt
+}
+
+// remove stops tracking the htlc with the given incoming circuit key, as it
+// has been resolved. Removing an htlc that isn't tracked has no effect.
+func (q *htlcQuotas) remove(incoming CircuitKey) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	chanHtlcs := q.htlcs[incoming.ChanID]
+	htlc, ok := chanHtlcs[incoming.HtlcID]
+	if !ok {
+		return
+	}
+	delete(chanHtlcs, incoming.HtlcID)
+	if len(chanHtlcs) == 0 {
+		delete(q.htlcs, incoming.ChanID)
+	}
+
+	pair := quotaChanPair{incoming.ChanID, htlc.outgoing}
+	if usage, ok := q.usage[pair]; ok {
+		usage.num--
+		usage.value -= htlc.amount
+		if usage.num == 0 {
+			delete(q.usage, pair)
+		}
+	}
+
+	stats := q.fetchStats(incoming.ChanID)
+	stats.NumInFlight--
+	stats.ValueInFlight -= htlc.amount
+
+	if q.cfg.MaxHoldTime > 0 &&
+		q.now().Sub(htlc.addedAt) > q.cfg.MaxHoldTime {
+
+		stats.NumSlowResolved++
+	}
+}
+
+// idleChannels returns the incoming channels that we have counters for, but
+// that currently have no htlcs in flight.
+func (q *htlcQuotas) idleChannels() []lnwire.ShortChannelID {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var idle []lnwire.ShortChannelID
+	for chanID, stats := range q.stats {
+		if stats.NumInFlight == 0 {
+			idle = append(idle, chanID)
+		}
+	}
+
+	return idle
+}
+
+// forget removes the counters of the given incoming channels, as they've been
+// closed. Channels that have htlcs in flight keep their counters.
+func (q *htlcQuotas) forget(chanIDs ...lnwire.ShortChannelID) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for _, chanID := range chanIDs {
+		stats, ok := q.stats[chanID]
+		if ok && stats.NumInFlight == 0 {
+			delete(q.stats, chanID)
+		}
+	}
+}
+
+// snapshot returns a copy of the counters of all incoming channels, sorted by
+// channel id.
+func (q *htlcQuotas) snapshot() []HtlcQuotaStats {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	stats := make([]HtlcQuotaStats, 0, len(q.stats))
+	for _, s := range q.stats {
+		stats = append(stats, *s)
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].ChanID.ToUint64() < stats[j].ChanID.ToUint64()
+	})
+
+	return stats
+}
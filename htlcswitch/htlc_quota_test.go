@@ -0,0 +1,107 @@
+package htlcswitch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestHtlcQuotas tests that the value and hold time quotas are enforced per
+// incoming channel, and that slow htlcs are counted once resolved.
+func TestHtlcQuotas(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	quotas := newHtlcQuotas(HtlcQuotaConfig{
+		MaxValuePercent: 25,
+		MaxHoldTime:     time.Minute,
+	})
+	quotas.now = func() time.Time {
+		return now
+	}
+
+	var (
+		incoming1 = lnwire.NewShortChanIDFromInt(1)
+		incoming2 = lnwire.NewShortChanIDFromInt(2)
+		outgoing  = lnwire.NewShortChanIDFromInt(3)
+
+		maxHtlcs = uint16(483)
+		maxValue = lnwire.MilliSatoshi(1000)
+	)
+
+	check := func(incoming lnwire.ShortChannelID, amt lnwire.MilliSatoshi,
+		expected quotaViolation) {
+
+		t.Helper()
+
+		violation := quotas.check(
+			incoming, outgoing, amt, maxHtlcs, maxValue,
+		)
+		if violation != expected {
+			t.Fatalf("expected %v, got %v", expected, violation)
+		}
+	}
+
+	// The first channel may have at most a quarter of the maximum value in
+	// flight on the outgoing channel.
+	check(incoming1, 200, quotaOK)
+	quotas.add(CircuitKey{ChanID: incoming1, HtlcID: 0}, outgoing, 200)
+	check(incoming1, 51, quotaValue)
+	check(incoming1, 50, quotaOK)
+
+	// The quota of the second channel is unaffected by the htlcs of the
+	// first one.
+	check(incoming2, 250, quotaOK)
+
+	// Once the htlc of the first channel is held for too long, no more
+	// htlcs are accepted from it, while the second channel can still
+	// forward.
+	now = now.Add(2 * time.Minute)
+	check(incoming1, 1, quotaHoldTime)
+	check(incoming2, 1, quotaOK)
+
+	// Resolving the htlc lifts the restriction, and counts it as slow.
+	quotas.remove(CircuitKey{ChanID: incoming1, HtlcID: 0})
+	check(incoming1, 250, quotaOK)
+
+	stats := quotas.snapshot()
+	if len(stats) != 1 {
+		t.Fatalf("expected stats for 1 channel, got %v", len(stats))
+	}
+	if stats[0].NumSlowResolved != 1 || stats[0].NumInFlight != 0 {
+		t.Fatalf("unexpected stats: %+v", stats[0])
+	}
+}
+
+// TestHtlcQuotasForget tests that the counters of closed channels are only
+// removed once they no longer have any htlcs in flight.
+func TestHtlcQuotasForget(t *testing.T) {
+	t.Parallel()
+
+	quotas := newHtlcQuotas(HtlcQuotaConfig{MaxHoldTime: time.Minute})
+
+	var (
+		incoming1 = lnwire.NewShortChanIDFromInt(1)
+		incoming2 = lnwire.NewShortChanIDFromInt(2)
+		outgoing  = lnwire.NewShortChanIDFromInt(3)
+	)
+
+	quotas.add(CircuitKey{ChanID: incoming1, HtlcID: 0}, outgoing, 100)
+	quotas.add(CircuitKey{ChanID: incoming2, HtlcID: 0}, outgoing, 100)
+	quotas.remove(CircuitKey{ChanID: incoming2, HtlcID: 0})
+
+	// Only the second channel is idle, so forgetting both channels only
+	// removes its counters.
+	idle := quotas.idleChannels()
+	if len(idle) != 1 || idle[0] != incoming2 {
+		t.Fatalf("expected only %v to be idle, got %v", incoming2, idle)
+	}
+
+	quotas.forget(incoming1, incoming2)
+	stats := quotas.snapshot()
+	if len(stats) != 1 || stats[0].ChanID != incoming1 {
+		t.Fatalf("expected only stats of %v, got %+v", incoming1,
+			stats)
+	}
+}
@@ -0,0 +1,373 @@
+package htlcswitch
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/subscribe"
+)
+
+// htlcNotifier is the interface the switch and its links use to report the
+// progress of the htlcs that move through them.
+type htlcNotifier interface {
+	// NotifyForwardingEvent notifies that an htlc has been added to the
+	// outgoing link's commitment, and is now in flight.
+	NotifyForwardingEvent(key HtlcKey, info HtlcInfo,
+		eventType HtlcEventType)
+
+	// NotifyLinkFailEvent notifies that an htlc was failed by one of our
+	// own links, either on its way in or on its way out.
+	NotifyLinkFailEvent(key HtlcKey, info HtlcInfo,
+		eventType HtlcEventType, failure lnwire.FailureMessage,
+		detail string, incoming bool)
+
+	// NotifyQuotaFailEvent notifies that a forwarded htlc was failed by
+	// the switch because its incoming channel exceeded its htlc quota on
+	// the outgoing channel.
+	NotifyQuotaFailEvent(key HtlcKey, info HtlcInfo,
+		failure lnwire.FailureMessage, detail string)
+
+	// NotifyForwardingFailEvent notifies that an htlc we forwarded or sent
+	// was failed by a node further along the route.
+	NotifyForwardingFailEvent(key HtlcKey, eventType HtlcEventType,
+		failure lnwire.FailureMessage)
+
+	// NotifySettleEvent notifies that an htlc has been settled.
+	NotifySettleEvent(key HtlcKey, eventType HtlcEventType)
+}
+
+// HtlcNotifier is a subsystem which all htlc events pipe through. It takes
+// subscriptions for its events, and whenever it receives a new event it
+// notifies its subscribers over the proper channel.
+type HtlcNotifier struct {
+	started uint32
+	stopped uint32
+
+	// now returns the current time, it is set in the notifier to allow
+	// for timestamp mocking in tests.
+	now func() time.Time
+
+	ntfnServer *subscribe.Server
+}
+
+// A compile time check to ensure that HtlcNotifier satisfies the htlcNotifier
+// interface.
+var _ htlcNotifier = (*HtlcNotifier)(nil)
+
+// NewHtlcNotifier creates a new HtlcNotifier which gets htlc events from the
+// switch and its links, and dispatches them to its clients.
+func NewHtlcNotifier(now func() time.Time) *HtlcNotifier {
+	return &HtlcNotifier{
+		now:        now,
+		ntfnServer: subscribe.NewServer(),
+	}
+}
+
+// Start starts the HtlcNotifier and all goroutines it needs to carry out its
+// task.
+func (h *HtlcNotifier) Start() error {
+	if !atomic.CompareAndSwapUint32(&h.started, 0, 1) {
+		return nil
+	}
+
+	log.Trace("HtlcNotifier starting")
+
+	return h.ntfnServer.Start()
+}
+
+// Stop signals the notifier for a graceful shutdown.
+func (h *HtlcNotifier) Stop() {
+	if !atomic.CompareAndSwapUint32(&h.stopped, 0, 1) {
+		return
+	}
+
+	h.ntfnServer.Stop()
+}
+
+// SubscribeHtlcEvents returns a subscribe.Client that will receive updates
+// any time the notifier is made aware of a new htlc event.
+func (h *HtlcNotifier) SubscribeHtlcEvents() (*subscribe.Client, error) {
+	return h.ntfnServer.Subscribe()
+}
+
+// HtlcEventType represents the role our node plays in an htlc.
+type HtlcEventType uint8
+
+const (
+	// HtlcEventTypeSend represents an htlc that was sent by our node.
+	HtlcEventTypeSend HtlcEventType = iota
+
+	// HtlcEventTypeReceive represents an htlc that our node is the final
+	// hop of.
+	HtlcEventTypeReceive
+
+	// HtlcEventTypeForward represents an htlc that our node forwards from
+	// one of our channels to another.
+	HtlcEventTypeForward
+)
+
+// String returns a human readable description of the event type.
+func (h HtlcEventType) String() string {
+	switch h {
+	case HtlcEventTypeSend:
+		return "send"
+
+	case HtlcEventTypeReceive:
+		return "receive"
+
+	case HtlcEventTypeForward:
+		return "forward"
+
+	default:
+		return "unknown"
+	}
+}
+
+// HtlcKey uniquely identifies an htlc event by the circuit keys of its
+// incoming and outgoing htlcs. Htlcs that we send have a blank incoming
+// channel, while htlcs that we receive have a blank outgoing circuit. If an
+// htlc was failed before it was added to the outgoing channel, its outgoing
+// circuit only identifies the channel.
+type HtlcKey struct {
+	// IncomingCircuit is the channel and htlc index of the incoming htlc.
+	IncomingCircuit CircuitKey
+
+	// OutgoingCircuit is the channel and htlc index of the outgoing htlc.
+	OutgoingCircuit CircuitKey
+}
+
+// String returns a human readable representation of the key.
+func (k HtlcKey) String() string {
+	return fmt.Sprintf("%v -> %v", k.IncomingCircuit, k.OutgoingCircuit)
+}
+
+// HtlcInfo provides the details of an htlc that are known at the time it is
+// added to or failed by one of our links. Values that are not known, such as
+// the outgoing amount of an htlc that failed on the incoming link, are zero.
+type HtlcInfo struct {
+	// IncomingTimeLock is the time lock of the incoming htlc.
+	IncomingTimeLock uint32
+
+	// OutgoingTimeLock is the time lock of the outgoing htlc.
+	OutgoingTimeLock uint32
+
+	// IncomingAmt is the amount of the incoming htlc.
+	IncomingAmt lnwire.MilliSatoshi
+
+	// OutgoingAmt is the amount of the outgoing htlc.
+	OutgoingAmt lnwire.MilliSatoshi
+}
+
+// ForwardingEvent represents an htlc that has been added to the commitment of
+// its outgoing link, and is now in flight.
+type ForwardingEvent struct {
+	HtlcKey
+	HtlcInfo
+
+	// HtlcEventType classifies the event as a send or a forward.
+	HtlcEventType
+
+	// Timestamp is the time when the htlc was added to the outgoing link.
+	Timestamp time.Time
+}
+
+// LinkFailEvent represents an htlc that was failed by one of our own links,
+// for instance because of insufficient balance on the outgoing channel, a
+// violation of its forwarding policy, or an invalid final hop payload.
+type LinkFailEvent struct {
+	HtlcKey
+	HtlcInfo
+
+	// HtlcEventType classifies the event as a send, receive or forward.
+	HtlcEventType
+
+	// FailureMessage is the wire failure that was sent back to the sender
+	// of the htlc.
+	FailureMessage lnwire.FailureMessage
+
+	// FailureDetail is an optional human readable description of why the
+	// htlc was failed, which provides more context than the wire failure.
+	FailureDetail string
+
+	// Incoming is true if the htlc was failed by its incoming link, and
+	// false if it was failed on its way out.
+	Incoming bool
+
+	// QuotaExceeded is true if the htlc was failed because its incoming
+	// channel exceeded its htlc quota on the outgoing channel, rather than
+	// for lack of liquidity or a policy violation.
+	QuotaExceeded bool
+
+	// Timestamp is the time when the htlc was failed.
+	Timestamp time.Time
+}
+
+// ForwardingFailEvent represents an htlc that we forwarded or sent which was
+// failed by a node further along the route.
+type ForwardingFailEvent struct {
+	HtlcKey
+
+	// HtlcEventType classifies the event as a send or a forward.
+	HtlcEventType
+
+	// FailureMessage is the failure returned by the downstream node. It is
+	// only populated for htlcs that we sent, as the failures of forwarded
+	// htlcs are encrypted for their original sender.
+	FailureMessage lnwire.FailureMessage
+
+	// Timestamp is the time when the failure was received.
+	Timestamp time.Time
+}
+
+// SettleEvent represents an htlc that was settled. For forwarded and sent
+// htlcs, this is when the preimage is received from the outgoing channel,
+// for received htlcs, when we settle it as the final hop.
+type SettleEvent struct {
+	HtlcKey
+
+	// HtlcEventType classifies the event as a send, receive or forward.
+	HtlcEventType
+
+	// Timestamp is the time when the htlc was settled.
+	Timestamp time.Time
+}
+
+// NotifyForwardingEvent notifies the HtlcNotifier than an htlc has been added
+// to its outgoing link.
+//
+// NOTE: This is part of the htlcNotifier interface.
+func (h *HtlcNotifier) NotifyForwardingEvent(key HtlcKey, info HtlcInfo,
+	eventType HtlcEventType) {
+
+	event := &ForwardingEvent{
+		HtlcKey:       key,
+		HtlcInfo:      info,
+		HtlcEventType: eventType,
+		Timestamp:     h.now(),
+	}
+
+	log.Tracef("Notifying forward event: %v(%v)", eventType, key)
+
+	if err := h.ntfnServer.SendUpdate(event); err != nil {
+		log.Warnf("Unable to send forwarding event: %v", err)
+	}
+}
+
+// NotifyLinkFailEvent notifies that an htlc has failed on our own link.
+//
+// NOTE: This is part of the htlcNotifier interface.
+func (h *HtlcNotifier) NotifyLinkFailEvent(key HtlcKey, info HtlcInfo,
+	eventType HtlcEventType, failure lnwire.FailureMessage, detail string,
+	incoming bool) {
+
+	event := &LinkFailEvent{
+		HtlcKey:        key,
+		HtlcInfo:       info,
+		HtlcEventType:  eventType,
+		FailureMessage: failure,
+		FailureDetail:  detail,
+		Incoming:       incoming,
+		Timestamp:      h.now(),
+	}
+
+	log.Tracef("Notifying link failure event: %v(%v)", eventType, key)
+
+	if err := h.ntfnServer.SendUpdate(event); err != nil {
+		log.Warnf("Unable to send link fail event: %v", err)
+	}
+}
+
+// NotifyQuotaFailEvent notifies that a forwarded htlc was failed because its
+// incoming channel exceeded its htlc quota on the outgoing channel.
+//
+// NOTE: This is part of the htlcNotifier interface.
+func (h *HtlcNotifier) NotifyQuotaFailEvent(key HtlcKey, info HtlcInfo,
+	failure lnwire.FailureMessage, detail string) {
+
+	event := &LinkFailEvent{
+		HtlcKey:        key,
+		HtlcInfo:       info,
+		HtlcEventType:  HtlcEventTypeForward,
+		FailureMessage: failure,
+		FailureDetail:  detail,
+		QuotaExceeded:  true,
+		Timestamp:      h.now(),
+	}
+
+	log.Tracef("Notifying quota failure event: %v", key)
+
+	if err := h.ntfnServer.SendUpdate(event); err != nil {
+		log.Warnf("Unable to send quota fail event: %v", err)
+	}
+}
+
+// NotifyForwardingFailEvent notifies the HtlcNotifier that an htlc we
+// forwarded or sent has failed downstream.
+//
+// NOTE: This is part of the htlcNotifier interface.
+func (h *HtlcNotifier) NotifyForwardingFailEvent(key HtlcKey,
+	eventType HtlcEventType, failure lnwire.FailureMessage) {
+
+	event := &ForwardingFailEvent{
+		HtlcKey:        key,
+		HtlcEventType:  eventType,
+		FailureMessage: failure,
+		Timestamp:      h.now(),
+	}
+
+	log.Tracef("Notifying forward fail event: %v(%v)", eventType, key)
+
+	if err := h.ntfnServer.SendUpdate(event); err != nil {
+		log.Warnf("Unable to send forward fail event: %v", err)
+	}
+}
+
+// NotifySettleEvent notifies the HtlcNotifier that an htlc has been settled.
+//
+// NOTE: This is part of the htlcNotifier interface.
+func (h *HtlcNotifier) NotifySettleEvent(key HtlcKey,
+	eventType HtlcEventType) {
+
+	event := &SettleEvent{
+		HtlcKey:       key,
+		HtlcEventType: eventType,
+		Timestamp:     h.now(),
+	}
+
+	log.Tracef("Notifying settle event: %v(%v)", eventType, key)
+
+	if err := h.ntfnServer.SendUpdate(event); err != nil {
+		log.Warnf("Unable to send settle event: %v", err)
+	}
+}
+
+// newHtlcKey returns the key identifying the htlc carried by a packet.
+func newHtlcKey(pkt *htlcPacket) HtlcKey {
+	return HtlcKey{
+		IncomingCircuit: pkt.inKey(),
+		OutgoingCircuit: pkt.outKey(),
+	}
+}
+
+// newHtlcInfo returns the htlc details carried by a packet.
+func newHtlcInfo(pkt *htlcPacket) HtlcInfo {
+	return HtlcInfo{
+		IncomingTimeLock: pkt.incomingTimeout,
+		OutgoingTimeLock: pkt.outgoingTimeout,
+		IncomingAmt:      pkt.incomingAmount,
+		OutgoingAmt:      pkt.amount,
+	}
+}
+
+// getEventType returns the htlc type of a packet based on its incoming
+// channel. Packets we receive never pass through the switch, so they're
+// either sends or forwards.
+func getEventType(pkt *htlcPacket) HtlcEventType {
+	if pkt.incomingChanID == sourceHop {
+		return HtlcEventTypeSend
+	}
+
+	return HtlcEventTypeForward
+}
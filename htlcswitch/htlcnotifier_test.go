@@ -0,0 +1,233 @@
+package htlcswitch
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/fastsha256"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/subscribe"
+)
+
+// waitHtlcEvent waits for the next event delivered to the given htlc event
+// subscription.
+func waitHtlcEvent(t *testing.T, client *subscribe.Client) interface{} {
+	t.Helper()
+
+	select {
+	case event := <-client.Updates():
+		return event
+	case <-time.After(time.Second):
+		t.Fatalf("htlc event not received")
+	}
+
+	return nil
+}
+
+// TestSwitchHtlcNotifications asserts that the switch notifies the htlc
+// notifier of forwards that fail on their outgoing link, and of forwards that
+// are settled downstream.
+func TestSwitchHtlcNotifications(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+	bobPeer, err := newMockServer(t, "bob", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create bob server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+
+	now := time.Unix(1, 0)
+	notifier := NewHtlcNotifier(func() time.Time {
+		return now
+	})
+	if err := notifier.Start(); err != nil {
+		t.Fatalf("unable to start htlc notifier: %v", err)
+	}
+	defer notifier.Stop()
+
+	s.cfg.HtlcNotifier = notifier
+
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	client, err := notifier.SubscribeHtlcEvents()
+	if err != nil {
+		t.Fatalf("unable to subscribe to htlc events: %v", err)
+	}
+	defer client.Cancel()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, bobPeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+	if err := s.AddLink(bobChannelLink); err != nil {
+		t.Fatalf("unable to add bob link: %v", err)
+	}
+
+	preimage, err := genPreimage()
+	if err != nil {
+		t.Fatalf("unable to generate preimage: %v", err)
+	}
+	rhash := fastsha256.Sum256(preimage[:])
+
+	// First, we'll forward an htlc towards a channel that we don't have,
+	// which should fail on its way out of the switch.
+	unknownChanID := lnwire.NewShortChanIDFromInt(1337)
+	packet := &htlcPacket{
+		incomingChanID:  aliceChannelLink.ShortChanID(),
+		incomingHTLCID:  0,
+		outgoingChanID:  unknownChanID,
+		incomingAmount:  2,
+		amount:          1,
+		incomingTimeout: 150,
+		outgoingTimeout: 100,
+		obfuscator:      NewMockObfuscator(),
+		htlc: &lnwire.UpdateAddHTLC{
+			PaymentHash: rhash,
+			Amount:      1,
+		},
+	}
+	if err := s.forward(packet); err == nil {
+		t.Fatalf("expected forward to unknown link to fail")
+	}
+
+	select {
+	case <-aliceChannelLink.packets:
+	case <-time.After(time.Second):
+		t.Fatal("failure was not propagated to alice")
+	}
+
+	event := waitHtlcEvent(t, client)
+	failEvent, ok := event.(*LinkFailEvent)
+	if !ok {
+		t.Fatalf("expected link fail event, got %T", event)
+	}
+
+	expectedKey := HtlcKey{
+		IncomingCircuit: CircuitKey{
+			ChanID: aliceChannelLink.ShortChanID(),
+			HtlcID: 0,
+		},
+		OutgoingCircuit: CircuitKey{
+			ChanID: unknownChanID,
+		},
+	}
+	expectedInfo := HtlcInfo{
+		IncomingTimeLock: 150,
+		OutgoingTimeLock: 100,
+		IncomingAmt:      2,
+		OutgoingAmt:      1,
+	}
+	switch {
+	case failEvent.HtlcKey != expectedKey:
+		t.Fatalf("expected key %v, got %v", expectedKey,
+			failEvent.HtlcKey)
+
+	case failEvent.HtlcInfo != expectedInfo:
+		t.Fatalf("expected info %v, got %v", expectedInfo,
+			failEvent.HtlcInfo)
+
+	case failEvent.HtlcEventType != HtlcEventTypeForward:
+		t.Fatalf("expected forward event, got %v",
+			failEvent.HtlcEventType)
+
+	case failEvent.Incoming:
+		t.Fatalf("expected outgoing failure")
+
+	case !reflect.DeepEqual(
+		failEvent.FailureMessage, &lnwire.FailUnknownNextPeer{},
+	):
+		t.Fatalf("expected unknown next peer failure, got %v",
+			failEvent.FailureMessage)
+
+	case !failEvent.Timestamp.Equal(now):
+		t.Fatalf("expected timestamp %v, got %v", now,
+			failEvent.Timestamp)
+	}
+
+	// Next, we'll forward an htlc to bob, and settle it back, which should
+	// be notified with the full circuit of the htlc.
+	packet = &htlcPacket{
+		incomingChanID: aliceChannelLink.ShortChanID(),
+		incomingHTLCID: 1,
+		outgoingChanID: bobChannelLink.ShortChanID(),
+		obfuscator:     NewMockObfuscator(),
+		htlc: &lnwire.UpdateAddHTLC{
+			PaymentHash: rhash,
+			Amount:      1,
+		},
+	}
+	if err := s.forward(packet); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-bobChannelLink.packets:
+		if err := bobChannelLink.completeCircuit(packet); err != nil {
+			t.Fatalf("unable to complete payment circuit: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("request was not propagated to destination")
+	}
+
+	packet = &htlcPacket{
+		outgoingChanID: bobChannelLink.ShortChanID(),
+		outgoingHTLCID: 0,
+		amount:         1,
+		htlc: &lnwire.UpdateFulfillHTLC{
+			PaymentPreimage: preimage,
+		},
+	}
+	if err := s.forward(packet); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-aliceChannelLink.packets:
+	case <-time.After(time.Second):
+		t.Fatal("settle was not propagated to alice")
+	}
+
+	event = waitHtlcEvent(t, client)
+	settleEvent, ok := event.(*SettleEvent)
+	if !ok {
+		t.Fatalf("expected settle event, got %T", event)
+	}
+
+	expectedKey = HtlcKey{
+		IncomingCircuit: CircuitKey{
+			ChanID: aliceChannelLink.ShortChanID(),
+			HtlcID: 1,
+		},
+		OutgoingCircuit: CircuitKey{
+			ChanID: bobChannelLink.ShortChanID(),
+			HtlcID: 0,
+		},
+	}
+	if settleEvent.HtlcKey != expectedKey {
+		t.Fatalf("expected key %v, got %v", expectedKey,
+			settleEvent.HtlcKey)
+	}
+	if settleEvent.HtlcEventType != HtlcEventTypeForward {
+		t.Fatalf("expected forward event, got %v",
+			settleEvent.HtlcEventType)
+	}
+}
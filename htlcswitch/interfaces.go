@@ -122,6 +122,11 @@ type ChannelLink interface {
 	// HTLC's which have been set to the over flow queue.
 	Bandwidth() lnwire.MilliSatoshi
 
+	// HtlcLimits returns the maximum number of htlcs and the maximum total
+	// value of htlcs that the remote party allows us to have in flight on
+	// the channel.
+	HtlcLimits() (uint16, lnwire.MilliSatoshi)
+
 	// Stats return the statistics of channel link. Number of updates,
 	// total sent/received milli-satoshis.
 	Stats() (uint64, lnwire.MilliSatoshi, lnwire.MilliSatoshi)
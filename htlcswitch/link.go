@@ -247,6 +247,10 @@ type ChannelLinkConfig struct {
 	// the outgoing broadcast delta, because in any case we don't want to
 	// risk offering an htlc that triggers channel closure.
 	OutgoingCltvRejectDelta uint32
+
+	// HtlcNotifier is an instance of a htlcNotifier which we will pipe htlc
+	// events through.
+	HtlcNotifier htlcNotifier
 }
 
 // channelLink is the service which drives a channel's commitment update
@@ -1194,8 +1198,7 @@ func (l *channelLink) processHodlEvent(hodlEvent invoices.HodlEvent,
 				htlc.pd.Amount,
 			)
 			l.sendHTLCError(
-				htlc.pd.HtlcIndex, failure, htlc.obfuscator,
-				htlc.pd.SourceRef,
+				htlc.pd, failure, htlc.obfuscator, true,
 			)
 			return nil
 		}
@@ -1267,6 +1270,7 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 			// cancel the pending payment.
 			default:
 				l.warnf("Unable to handle downstream add HTLC: %v", err)
+				addErr := err
 
 				var (
 					localFailure = false
@@ -1319,6 +1323,16 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 					},
 				}
 
+				// The htlc never made it onto our commitment,
+				// so its outgoing circuit only identifies our
+				// channel.
+				pkt.outgoingChanID = l.ShortChanID()
+				l.cfg.HtlcNotifier.NotifyLinkFailEvent(
+					newHtlcKey(pkt), newHtlcInfo(pkt),
+					getEventType(pkt), failure,
+					addErr.Error(), false,
+				)
+
 				go l.forwardBatch(failPkt)
 
 				// Remove this packet from the link's mailbox,
@@ -1352,6 +1366,10 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 
 		l.cfg.Peer.SendMessage(false, htlc)
 
+		l.cfg.HtlcNotifier.NotifyForwardingEvent(
+			newHtlcKey(pkt), newHtlcInfo(pkt), getEventType(pkt),
+		)
+
 	case *lnwire.UpdateFulfillHTLC:
 		// If hodl.SettleOutgoing mode is active, we exit early to
 		// simulate arbitrary delays between the switch adding the
@@ -2038,6 +2056,15 @@ func (l *channelLink) Bandwidth() lnwire.MilliSatoshi {
 	return linkBandwidth - reserve
 }
 
+// HtlcLimits returns the maximum number of htlcs and the maximum total value
+// of htlcs that the remote party allows us to have in flight on the channel.
+//
+// NOTE: Part of the ChannelLink interface.
+func (l *channelLink) HtlcLimits() (uint16, lnwire.MilliSatoshi) {
+	constraints := l.channel.State().RemoteChanCfg.ChannelConstraints
+	return constraints.MaxAcceptedHtlcs, constraints.MaxPendingAmount
+}
+
 // AttachMailBox updates the current mailbox used by this link, and hooks up
 // the mailbox's message and packet outboxes to the link's upstream and
 // downstream chans, respectively.
@@ -2052,24 +2079,25 @@ func (l *channelLink) AttachMailBox(mailbox MailBox) {
 // UpdateForwardingPolicy updates the forwarding policy for the target
 // ChannelLink. Once updated, the link will use the new forwarding policy to
 // govern if it an incoming HTLC should be forwarded or not. Note that this
-// processing of the new policy will ensure that uninitialized fields in the
-// passed policy won't override already initialized fields in the current
-// policy.
+// processing of the new policy will ensure that an uninitialized time lock
+// delta or min HTLC in the passed policy won't override already initialized
+// fields in the current policy. The fees are always applied, as zero fees are
+// a valid policy.
 //
 // NOTE: Part of the ChannelLink interface.
 func (l *channelLink) UpdateForwardingPolicy(newPolicy ForwardingPolicy) {
 	l.Lock()
 	defer l.Unlock()
 
+	// The fees are always set by the callers, and may be zero, so
+	// they're applied as is. This ensures we charge the fees we announce
+	// to the network.
+	l.cfg.FwrdingPolicy.BaseFee = newPolicy.BaseFee
+	l.cfg.FwrdingPolicy.FeeRate = newPolicy.FeeRate
+
 	// In order to avoid overriding a valid policy with a "null" field in
 	// the new policy, we'll only update to the set sub policy if the new
 	// value isn't uninitialized.
-	if newPolicy.BaseFee != 0 {
-		l.cfg.FwrdingPolicy.BaseFee = newPolicy.BaseFee
-	}
-	if newPolicy.FeeRate != 0 {
-		l.cfg.FwrdingPolicy.FeeRate = newPolicy.FeeRate
-	}
 	if newPolicy.TimeLockDelta != 0 {
 		l.cfg.FwrdingPolicy.TimeLockDelta = newPolicy.TimeLockDelta
 	}
@@ -2521,8 +2549,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 			// If we're unable to process the onion blob than we
 			// should send the malformed htlc error to payment
 			// sender.
-			l.sendMalformedHTLCError(pd.HtlcIndex, failureCode,
-				onionBlob[:], pd.SourceRef)
+			l.sendMalformedHTLCError(pd, failureCode, onionBlob[:])
 			needUpdate = true
 
 			log.Errorf("unable to decode onion hop "+
@@ -2539,8 +2566,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 			// If we're unable to process the onion blob than we
 			// should send the malformed htlc error to payment
 			// sender.
-			l.sendMalformedHTLCError(pd.HtlcIndex, failureCode,
-				onionBlob[:], pd.SourceRef)
+			l.sendMalformedHTLCError(pd, failureCode, onionBlob[:])
 			needUpdate = true
 
 			log.Errorf("unable to decode onion "+
@@ -2661,9 +2687,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 					)
 				}
 
-				l.sendHTLCError(
-					pd.HtlcIndex, failure, obfuscator, pd.SourceRef,
-				)
+				l.sendHTLCError(pd, failure, obfuscator, false)
 				needUpdate = true
 				continue
 			}
@@ -2746,7 +2770,7 @@ func (l *channelLink) processExitHop(pd *lnwallet.PaymentDescriptor,
 			", best_height=%v", pd.RHash[:], pd.Timeout, heightNow)
 
 		failure := lnwire.NewFinalExpiryTooSoon()
-		l.sendHTLCError(pd.HtlcIndex, failure, obfuscator, pd.SourceRef)
+		l.sendHTLCError(pd, failure, obfuscator, true)
 
 		return true, nil
 	}
@@ -2763,7 +2787,7 @@ func (l *channelLink) processExitHop(pd *lnwallet.PaymentDescriptor,
 	if err != nil {
 		log.Errorf("unable to query invoice registry: %v", err)
 		failure := lnwire.NewFailUnknownPaymentHash(pd.Amount)
-		l.sendHTLCError(pd.HtlcIndex, failure, obfuscator, pd.SourceRef)
+		l.sendHTLCError(pd, failure, obfuscator, true)
 
 		return true, nil
 	}
@@ -2808,7 +2832,7 @@ func (l *channelLink) processExitHop(pd *lnwallet.PaymentDescriptor,
 			"%v, received %v", invoice.Terms.Value, pd.Amount)
 
 		failure := lnwire.NewFailUnknownPaymentHash(pd.Amount)
-		l.sendHTLCError(pd.HtlcIndex, failure, obfuscator, pd.SourceRef)
+		l.sendHTLCError(pd, failure, obfuscator, true)
 
 		return true, nil
 	}
@@ -2829,7 +2853,7 @@ func (l *channelLink) processExitHop(pd *lnwallet.PaymentDescriptor,
 			invoice.Terms.Value, fwdInfo.AmountToForward)
 
 		failure := lnwire.NewFailUnknownPaymentHash(pd.Amount)
-		l.sendHTLCError(pd.HtlcIndex, failure, obfuscator, pd.SourceRef)
+		l.sendHTLCError(pd, failure, obfuscator, true)
 
 		return true, nil
 	}
@@ -2849,7 +2873,7 @@ func (l *channelLink) processExitHop(pd *lnwallet.PaymentDescriptor,
 			pd.RHash[:], expectedHeight, pd.Timeout)
 
 		failure := lnwire.FailFinalExpiryTooSoon{}
-		l.sendHTLCError(pd.HtlcIndex, failure, obfuscator, pd.SourceRef)
+		l.sendHTLCError(pd, failure, obfuscator, true)
 
 		return true, nil
 
@@ -2860,7 +2884,7 @@ func (l *channelLink) processExitHop(pd *lnwallet.PaymentDescriptor,
 		failure := lnwire.NewFinalIncorrectCltvExpiry(
 			fwdInfo.OutgoingCTLV,
 		)
-		l.sendHTLCError(pd.HtlcIndex, failure, obfuscator, pd.SourceRef)
+		l.sendHTLCError(pd, failure, obfuscator, true)
 
 		return true, nil
 	}
@@ -2928,6 +2952,10 @@ func (l *channelLink) settleHTLC(preimage lntypes.Preimage, htlcIndex uint64,
 		PaymentPreimage: preimage,
 	})
 
+	l.cfg.HtlcNotifier.NotifySettleEvent(
+		l.incomingHtlcKey(htlcIndex), HtlcEventTypeReceive,
+	)
+
 	return nil
 }
 
@@ -2972,9 +3000,10 @@ func (l *channelLink) handleBatchFwdErrs(errChan chan error) {
 }
 
 // sendHTLCError functions cancels HTLC and send cancel message back to the
-// peer from which HTLC was received.
-func (l *channelLink) sendHTLCError(htlcIndex uint64, failure lnwire.FailureMessage,
-	e ErrorEncrypter, sourceRef *channeldb.AddRef) {
+// peer from which HTLC was received. The isReceive flag indicates whether we
+// are the final hop of the htlc, and is used to notify the failure.
+func (l *channelLink) sendHTLCError(pd *lnwallet.PaymentDescriptor,
+	failure lnwire.FailureMessage, e ErrorEncrypter, isReceive bool) {
 
 	reason, err := e.EncryptFirstHop(failure)
 	if err != nil {
@@ -2982,7 +3011,7 @@ func (l *channelLink) sendHTLCError(htlcIndex uint64, failure lnwire.FailureMess
 		return
 	}
 
-	err = l.channel.FailHTLC(htlcIndex, reason, sourceRef, nil, nil)
+	err = l.channel.FailHTLC(pd.HtlcIndex, reason, pd.SourceRef, nil, nil)
 	if err != nil {
 		log.Errorf("unable cancel htlc: %v", err)
 		return
@@ -2990,18 +3019,30 @@ func (l *channelLink) sendHTLCError(htlcIndex uint64, failure lnwire.FailureMess
 
 	l.cfg.Peer.SendMessage(false, &lnwire.UpdateFailHTLC{
 		ChanID: l.ChanID(),
-		ID:     htlcIndex,
+		ID:     pd.HtlcIndex,
 		Reason: reason,
 	})
+
+	eventType := HtlcEventTypeForward
+	if isReceive {
+		eventType = HtlcEventTypeReceive
+	}
+
+	l.cfg.HtlcNotifier.NotifyLinkFailEvent(
+		l.incomingHtlcKey(pd.HtlcIndex), l.incomingHtlcInfo(pd),
+		eventType, failure, "", true,
+	)
 }
 
 // sendMalformedHTLCError helper function which sends the malformed HTLC update
 // to the payment sender.
-func (l *channelLink) sendMalformedHTLCError(htlcIndex uint64,
-	code lnwire.FailCode, onionBlob []byte, sourceRef *channeldb.AddRef) {
+func (l *channelLink) sendMalformedHTLCError(pd *lnwallet.PaymentDescriptor,
+	code lnwire.FailCode, onionBlob []byte) {
 
 	shaOnionBlob := sha256.Sum256(onionBlob)
-	err := l.channel.MalformedFailHTLC(htlcIndex, code, shaOnionBlob, sourceRef)
+	err := l.channel.MalformedFailHTLC(
+		pd.HtlcIndex, code, shaOnionBlob, pd.SourceRef,
+	)
 	if err != nil {
 		log.Errorf("unable cancel htlc: %v", err)
 		return
@@ -3009,10 +3050,42 @@ func (l *channelLink) sendMalformedHTLCError(htlcIndex uint64,
 
 	l.cfg.Peer.SendMessage(false, &lnwire.UpdateFailMalformedHTLC{
 		ChanID:       l.ChanID(),
-		ID:           htlcIndex,
+		ID:           pd.HtlcIndex,
 		ShaOnionBlob: shaOnionBlob,
 		FailureCode:  code,
 	})
+
+	// As we were unable to decode the onion, we can't tell whether we are
+	// the final hop of the htlc, so we'll report it as a forward. There's
+	// no failure message to report either, as malformed htlcs are failed
+	// with only a failure code.
+	l.cfg.HtlcNotifier.NotifyLinkFailEvent(
+		l.incomingHtlcKey(pd.HtlcIndex), l.incomingHtlcInfo(pd),
+		HtlcEventTypeForward, nil,
+		fmt.Sprintf("malformed onion: %v", code), true,
+	)
+}
+
+// incomingHtlcKey returns the key identifying the htlc with the given index
+// that was offered to us over this link.
+func (l *channelLink) incomingHtlcKey(htlcIndex uint64) HtlcKey {
+	return HtlcKey{
+		IncomingCircuit: CircuitKey{
+			ChanID: l.ShortChanID(),
+			HtlcID: htlcIndex,
+		},
+	}
+}
+
+// incomingHtlcInfo returns the details of an htlc that was offered to us over
+// this link.
+func (l *channelLink) incomingHtlcInfo(
+	pd *lnwallet.PaymentDescriptor) HtlcInfo {
+
+	return HtlcInfo{
+		IncomingTimeLock: pd.Timeout,
+		IncomingAmt:      pd.Amount,
+	}
 }
 
 // fail is a function which is used to encapsulate the action necessary for
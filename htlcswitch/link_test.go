@@ -1665,6 +1665,7 @@ func newSingleLinkTestHarness(chanAmt, chanReserve btcutil.Amount) (
 		BatchSize:           10000,
 		MinFeeUpdateTimeout: 30 * time.Minute,
 		MaxFeeUpdateTimeout: 40 * time.Minute,
+		HtlcNotifier:        aliceSwitch.cfg.HtlcNotifier,
 	}
 
 	const startingHeight = 100
@@ -4184,8 +4185,9 @@ func restartLink(aliceChannel *lnwallet.LightningChannel, aliceSwitch *Switch,
 		MinFeeUpdateTimeout: 30 * time.Minute,
 		MaxFeeUpdateTimeout: 40 * time.Minute,
 		// Set any hodl flags requested for the new link.
-		HodlMask:  hodl.MaskFromFlags(hodlFlags...),
-		DebugHTLC: len(hodlFlags) > 0,
+		HodlMask:     hodl.MaskFromFlags(hodlFlags...),
+		DebugHTLC:    len(hodlFlags) > 0,
+		HtlcNotifier: aliceSwitch.cfg.HtlcNotifier,
 	}
 
 	const startingHeight = 100
@@ -5461,9 +5463,9 @@ func TestForwardingAsymmetricTimeLockPolicies(t *testing.T) {
 	// Now that each of the links are up, we'll modify the link from Alice
 	// -> Bob to have a greater time lock delta than that of the link of
 	// Bob -> Carol.
-	n.firstBobChannelLink.UpdateForwardingPolicy(ForwardingPolicy{
-		TimeLockDelta: 7,
-	})
+	newPolicy := n.globalPolicy
+	newPolicy.TimeLockDelta = 7
+	n.firstBobChannelLink.UpdateForwardingPolicy(newPolicy)
 
 	// Now that the Alice -> Bob link has been updated, we'll craft and
 	// send a payment from Alice -> Carol. This should succeed as normal,
@@ -177,6 +177,7 @@ func initSwitchWithDB(startingHeight uint32, db *channeldb.DB) (*Switch, error)
 		LogEventTicker:        ticker.NewForce(DefaultLogInterval),
 		NotifyActiveChannel:   func(wire.OutPoint) {},
 		NotifyInactiveChannel: func(wire.OutPoint) {},
+		HtlcNotifier:          &mockHTLCNotifier{},
 	}
 
 	return New(cfg, startingHeight)
@@ -623,6 +624,10 @@ type mockChannelLink struct {
 	htlcID uint64
 
 	htlcSatifiesPolicyLocalResult lnwire.FailureMessage
+
+	maxHtlcs uint16
+
+	maxPendingAmt lnwire.MilliSatoshi
 }
 
 // completeCircuit is a helper method for adding the finalized payment circuit
@@ -697,6 +702,14 @@ func (f *mockChannelLink) Stats() (uint64, lnwire.MilliSatoshi, lnwire.MilliSato
 	return 0, 0, 0
 }
 
+func (f *mockChannelLink) HtlcLimits() (uint16, lnwire.MilliSatoshi) {
+	if f.maxHtlcs == 0 {
+		return 483, 99999999
+	}
+
+	return f.maxHtlcs, f.maxPendingAmt
+}
+
 func (f *mockChannelLink) AttachMailBox(mailBox MailBox) {
 	f.mailBox = mailBox
 	f.packets = mailBox.PacketOutBox()
@@ -905,3 +918,26 @@ func (m *mockNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint, _ []byte,
 		Spend: make(chan *chainntnfs.SpendDetail),
 	}, nil
 }
+
+type mockHTLCNotifier struct{}
+
+func (h *mockHTLCNotifier) NotifyForwardingEvent(key HtlcKey, info HtlcInfo,
+	eventType HtlcEventType) {
+}
+
+func (h *mockHTLCNotifier) NotifyLinkFailEvent(key HtlcKey, info HtlcInfo,
+	eventType HtlcEventType, failure lnwire.FailureMessage, detail string,
+	incoming bool) {
+}
+
+func (h *mockHTLCNotifier) NotifyQuotaFailEvent(key HtlcKey, info HtlcInfo,
+	failure lnwire.FailureMessage, detail string) {
+}
+
+func (h *mockHTLCNotifier) NotifyForwardingFailEvent(key HtlcKey,
+	eventType HtlcEventType, failure lnwire.FailureMessage) {
+}
+
+func (h *mockHTLCNotifier) NotifySettleEvent(key HtlcKey,
+	eventType HtlcEventType) {
+}
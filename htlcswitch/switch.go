@@ -77,6 +77,10 @@ type pendingPayment struct {
 	// an error, it deobfuscates the onion failure blob, and extracts the
 	// exact error from it.
 	deobfuscator ErrorDecrypter
+
+	// probe indicates that this payment is a probe, which is never meant
+	// to settle and therefore isn't tracked by the control tower.
+	probe bool
 }
 
 // plexPacket encapsulates switch packet and adds error channel to receive
@@ -180,6 +184,15 @@ type Config struct {
 	// the ChannelNotifier when channels become active and inactive.
 	NotifyActiveChannel   func(wire.OutPoint)
 	NotifyInactiveChannel func(wire.OutPoint)
+
+	// HtlcNotifier is an instance of a htlcNotifier which we will pipe htlc
+	// events through.
+	HtlcNotifier htlcNotifier
+
+	// HtlcQuotas bounds the share of the htlc slots and value in flight
+	// of our outgoing channels that htlcs from a single incoming channel
+	// may occupy.
+	HtlcQuotas HtlcQuotaConfig
 }
 
 // Switch is the central messaging bus for all incoming/outgoing HTLCs.
@@ -281,6 +294,10 @@ type Switch struct {
 	// active ChainNotifier instance. This will be used to retrieve the
 	// lastest height of the chain.
 	blockEpochStream *chainntnfs.BlockEpochEvent
+
+	// quotas tracks the forwarded htlcs of every incoming channel, and
+	// enforces the configured htlc quotas.
+	quotas *htlcQuotas
 }
 
 // New creates the new instance of htlc switch.
@@ -313,6 +330,7 @@ func New(cfg Config, currentHeight uint32) (*Switch, error) {
 		htlcPlex:          make(chan *plexPacket),
 		chanCloseRequests: make(chan *ChanClose),
 		resolutionMsgs:    make(chan *resolutionMsg),
+		quotas:            newHtlcQuotas(cfg.HtlcQuotas),
 		quit:              make(chan struct{}),
 	}, nil
 }
@@ -359,11 +377,34 @@ func (s *Switch) SendHTLC(firstHop lnwire.ShortChannelID,
 	htlc *lnwire.UpdateAddHTLC,
 	deobfuscator ErrorDecrypter) ([sha256.Size]byte, error) {
 
+	return s.sendHTLC(firstHop, htlc, deobfuscator, false)
+}
+
+// SendProbe sends a probe htlc with a random payment hash to the first hop.
+// In contrast to SendHTLC, the payment status of a probe isn't persisted by
+// the control tower, as the probe is expected to fail and its payment hash
+// won't ever be used again.
+func (s *Switch) SendProbe(firstHop lnwire.ShortChannelID,
+	htlc *lnwire.UpdateAddHTLC,
+	deobfuscator ErrorDecrypter) ([sha256.Size]byte, error) {
+
+	return s.sendHTLC(firstHop, htlc, deobfuscator, true)
+}
+
+// sendHTLC sends the htlc update to the first hop and waits for its result.
+// Unless the htlc is a probe, the payment is tracked by the control tower to
+// prevent duplicate payments to the same payment hash.
+func (s *Switch) sendHTLC(firstHop lnwire.ShortChannelID,
+	htlc *lnwire.UpdateAddHTLC, deobfuscator ErrorDecrypter,
+	probe bool) ([sha256.Size]byte, error) {
+
 	// Before sending, double check that we don't already have 1) an
 	// in-flight payment to this payment hash, or 2) a complete payment for
 	// the same hash.
-	if err := s.control.ClearForTakeoff(htlc); err != nil {
-		return zeroPreimage, err
+	if !probe {
+		if err := s.control.ClearForTakeoff(htlc); err != nil {
+			return zeroPreimage, err
+		}
 	}
 
 	// Create payment and add to the map of payment in order later to be
@@ -374,6 +415,7 @@ func (s *Switch) SendHTLC(firstHop lnwire.ShortChannelID,
 		paymentHash:  htlc.PaymentHash,
 		amount:       htlc.Amount,
 		deobfuscator: deobfuscator,
+		probe:        probe,
 	}
 
 	paymentID, err := s.paymentSequencer.NextID()
@@ -397,6 +439,9 @@ func (s *Switch) SendHTLC(firstHop lnwire.ShortChannelID,
 
 	if err := s.forward(packet); err != nil {
 		s.removePendingPayment(paymentID)
+		if probe {
+			return zeroPreimage, err
+		}
 		if err := s.control.Fail(htlc.PaymentHash); err != nil {
 			return zeroPreimage, err
 		}
@@ -778,10 +823,10 @@ func (s *Switch) handleLocalDispatch(pkt *htlcPacket) error {
 		s.indexMtx.RUnlock()
 		if err != nil {
 			log.Errorf("Link %v not found", pkt.outgoingChanID)
-			return &ForwardingError{
+			return s.failLocalDispatch(pkt, &ForwardingError{
 				ErrorSource:    s.cfg.SelfKey,
 				FailureMessage: &lnwire.FailUnknownNextPeer{},
-			}
+			})
 		}
 
 		if !link.EligibleToForward() {
@@ -792,11 +837,11 @@ func (s *Switch) handleLocalDispatch(pkt *htlcPacket) error {
 			// The update does not need to be populated as the error
 			// will be returned back to the router.
 			htlcErr := lnwire.NewTemporaryChannelFailure(nil)
-			return &ForwardingError{
+			return s.failLocalDispatch(pkt, &ForwardingError{
 				ErrorSource:    s.cfg.SelfKey,
 				ExtraMsg:       err.Error(),
 				FailureMessage: htlcErr,
-			}
+			})
 		}
 
 		// Ensure that the htlc satisfies the outgoing channel policy.
@@ -810,10 +855,10 @@ func (s *Switch) handleLocalDispatch(pkt *htlcPacket) error {
 			log.Errorf("Link %v policy for local forward not "+
 				"satisfied", pkt.outgoingChanID)
 
-			return &ForwardingError{
+			return s.failLocalDispatch(pkt, &ForwardingError{
 				ErrorSource:    s.cfg.SelfKey,
 				FailureMessage: htlcErr,
-			}
+			})
 		}
 
 		if link.Bandwidth() < htlc.Amount {
@@ -825,11 +870,11 @@ func (s *Switch) handleLocalDispatch(pkt *htlcPacket) error {
 			// The update does not need to be populated as the error
 			// will be returned back to the router.
 			htlcErr := lnwire.NewTemporaryChannelFailure(nil)
-			return &ForwardingError{
+			return s.failLocalDispatch(pkt, &ForwardingError{
 				ErrorSource:    s.cfg.SelfKey,
 				ExtraMsg:       err.Error(),
 				FailureMessage: htlcErr,
-			}
+			})
 		}
 
 		return link.HandleSwitchPacket(pkt)
@@ -841,6 +886,20 @@ func (s *Switch) handleLocalDispatch(pkt *htlcPacket) error {
 	return nil
 }
 
+// failLocalDispatch notifies the htlc notifier that a locally-initiated
+// payment could not be dispatched over its outgoing link, and returns the
+// forwarding error that is to be handed back to the router.
+func (s *Switch) failLocalDispatch(pkt *htlcPacket,
+	fwdErr *ForwardingError) error {
+
+	s.cfg.HtlcNotifier.NotifyLinkFailEvent(
+		newHtlcKey(pkt), newHtlcInfo(pkt), HtlcEventTypeSend,
+		fwdErr.FailureMessage, fwdErr.ExtraMsg, false,
+	)
+
+	return fwdErr
+}
+
 // handleLocalResponse processes a Settle or Fail responding to a
 // locally-initiated payment. This is handled asynchronously to avoid blocking
 // the main event loop within the switch, as these operations can require
@@ -894,6 +953,10 @@ func (s *Switch) handleLocalResponse(pkt *htlcPacket) {
 		paymentErr error
 	)
 
+	// Probes never passed through the control tower, so there's no
+	// payment status to transition for them.
+	probe := payment != nil && payment.probe
+
 	switch htlc := pkt.htlc.(type) {
 
 	// We've received a settle update which means we can finalize the user
@@ -902,7 +965,10 @@ func (s *Switch) handleLocalResponse(pkt *htlcPacket) {
 		// Persistently mark that a payment to this payment hash
 		// succeeded. This will prevent us from ever making another
 		// payment to this hash.
-		err := s.control.Success(pkt.circuit.PaymentHash)
+		var err error
+		if !probe {
+			err = s.control.Success(pkt.circuit.PaymentHash)
+		}
 		if err != nil && err != ErrPaymentAlreadyCompleted {
 			log.Warnf("Unable to mark completed payment %x: %v",
 				pkt.circuit.PaymentHash, err)
@@ -911,20 +977,38 @@ func (s *Switch) handleLocalResponse(pkt *htlcPacket) {
 
 		preimage = htlc.PaymentPreimage
 
+		s.cfg.HtlcNotifier.NotifySettleEvent(
+			newHtlcKey(pkt), HtlcEventTypeSend,
+		)
+
 	// We've received a fail update which means we can finalize the user
 	// payment and return fail response.
 	case *lnwire.UpdateFailHTLC:
 		// Persistently mark that a payment to this payment hash failed.
 		// This will permit us to make another attempt at a successful
 		// payment.
-		err := s.control.Fail(pkt.circuit.PaymentHash)
+		var err error
+		if !probe {
+			err = s.control.Fail(pkt.circuit.PaymentHash)
+		}
 		if err != nil && err != ErrPaymentAlreadyCompleted {
 			log.Warnf("Unable to ground payment %x: %v",
 				pkt.circuit.PaymentHash, err)
 			return
 		}
 
-		paymentErr = s.parseFailedPayment(payment, pkt, htlc)
+		fwdErr := s.parseFailedPayment(payment, pkt, htlc)
+		paymentErr = fwdErr
+
+		// Failures that originate from our own outgoing link have
+		// already been reported by it, so we only notify those that
+		// were returned from further along the route.
+		if !pkt.hasSource {
+			s.cfg.HtlcNotifier.NotifyForwardingFailEvent(
+				newHtlcKey(pkt), HtlcEventTypeSend,
+				fwdErr.FailureMessage,
+			)
+		}
 
 	default:
 		log.Warnf("Received unknown response type: %T", pkt.htlc)
@@ -1025,6 +1109,64 @@ func (s *Switch) parseFailedPayment(payment *pendingPayment, pkt *htlcPacket,
 	return failure
 }
 
+// temporaryChannelFailure returns a TemporaryChannelFailure carrying the latest
+// channel update of the given channel. If the update can't be retrieved, a
+// TemporaryNodeFailure is returned instead.
+func (s *Switch) temporaryChannelFailure(
+	chanID lnwire.ShortChannelID) lnwire.FailureMessage {
+
+	update, err := s.cfg.FetchLastChannelUpdate(chanID)
+	if err != nil {
+		return &lnwire.FailTemporaryNodeFailure{}
+	}
+
+	return lnwire.NewTemporaryChannelFailure(update)
+}
+
+// HtlcQuotaStats returns the htlc quota counters of all incoming channels that
+// htlcs have been forwarded or rejected for since startup.
+func (s *Switch) HtlcQuotaStats() []HtlcQuotaStats {
+	return s.quotas.snapshot()
+}
+
+// pruneQuotaStats removes the htlc quota counters of incoming channels that
+// have been closed and no longer have any htlcs in flight.
+func (s *Switch) pruneQuotaStats() error {
+	// Channels with an active link are still open, so we only need to
+	// consult the database for the remaining ones, if any.
+	var candidates []lnwire.ShortChannelID
+	s.indexMtx.RLock()
+	for _, chanID := range s.quotas.idleChannels() {
+		if _, ok := s.forwardingIndex[chanID]; !ok {
+			candidates = append(candidates, chanID)
+		}
+	}
+	s.indexMtx.RUnlock()
+
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	channels, err := s.cfg.DB.FetchAllChannels()
+	if err != nil {
+		return err
+	}
+	open := make(map[lnwire.ShortChannelID]struct{}, len(channels))
+	for _, channel := range channels {
+		open[channel.ShortChanID()] = struct{}{}
+	}
+
+	var closed []lnwire.ShortChannelID
+	for _, chanID := range candidates {
+		if _, ok := open[chanID]; !ok {
+			closed = append(closed, chanID)
+		}
+	}
+	s.quotas.forget(closed...)
+
+	return nil
+}
+
 // handlePacketForward is used in cases when we need forward the htlc update
 // from one channel link to another and be able to propagate the settle/fail
 // updates back. This behaviour is achieved by creation of payment circuits.
@@ -1065,6 +1207,10 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 		// trying all links to utilize our available bandwidth.
 		linkErrs := make(map[lnwire.ShortChannelID]lnwire.FailureMessage)
 
+		// We'll also track the links that rejected the HTLC because
+		// its incoming channel exceeded its quota.
+		quotaErrs := make(map[lnwire.ShortChannelID]quotaViolation)
+
 		// Try to find destination channel link with appropriate
 		// bandwidth.
 		var destination ChannelLink
@@ -1095,6 +1241,23 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 				continue
 			}
 
+			// We'll also ensure that the incoming channel doesn't
+			// exceed its share of the slots and value in flight of
+			// the target link. Otherwise, a single peer would be
+			// able to jam the link.
+			maxHtlcs, maxValue := link.HtlcLimits()
+			violation := s.quotas.check(
+				packet.incomingChanID, link.ShortChanID(),
+				htlc.Amount, maxHtlcs, maxValue,
+			)
+			if violation != quotaOK {
+				chanID := link.ShortChanID()
+				failure := s.temporaryChannelFailure(chanID)
+				linkErrs[chanID] = failure
+				quotaErrs[chanID] = violation
+				continue
+			}
+
 			if link.Bandwidth() >= htlc.Amount {
 				destination = link
 
@@ -1111,15 +1274,9 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 			// If packet was forwarded from another channel link
 			// than we should notify this link that some error
 			// occurred.
-			var failure lnwire.FailureMessage
-			update, err := s.cfg.FetchLastChannelUpdate(
+			failure := s.temporaryChannelFailure(
 				packet.outgoingChanID,
 			)
-			if err != nil {
-				failure = &lnwire.FailTemporaryNodeFailure{}
-			} else {
-				failure = lnwire.NewTemporaryChannelFailure(update)
-			}
 
 			addErr := fmt.Errorf("unable to find appropriate "+
 				"channel link insufficient capacity, need "+
@@ -1153,13 +1310,38 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 				htlc.PaymentHash[:], packet.outgoingChanID,
 				linkErr)
 
+			// If the HTLC was rejected because its incoming
+			// channel exceeded its quota, we'll count it so that
+			// jamming attempts can be spotted.
+			violation, ok := quotaErrs[packet.outgoingChanID]
+			if ok {
+				s.quotas.reject(
+					packet.incomingChanID, violation,
+				)
+
+				addErr = &quotaError{
+					paymentHash: htlc.PaymentHash,
+					incoming:    packet.incomingChanID,
+					outgoing:    packet.outgoingChanID,
+					violation:   violation,
+				}
+			}
+
 			return s.failAddPacket(packet, linkErr, addErr)
 		}
 
 		// Send the packet to the destination channel link which
 		// manages the channel.
 		packet.outgoingChanID = destination.ShortChanID()
-		return destination.HandleSwitchPacket(packet)
+		if err := destination.HandleSwitchPacket(packet); err != nil {
+			return err
+		}
+
+		// Now that the htlc has been handed off, it counts towards the
+		// quota of its incoming channel until it's resolved.
+		s.quotas.add(packet.inKey(), packet.outgoingChanID, htlc.Amount)
+
+		return nil
 
 	case *lnwire.UpdateFailHTLC, *lnwire.UpdateFulfillHTLC:
 		// If the source of this packet has not been set, use the
@@ -1169,6 +1351,10 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 			return err
 		}
 
+		// The htlc no longer occupies a slot of the outgoing channel,
+		// so we'll release it from the quota of its incoming channel.
+		s.quotas.remove(circuit.Incoming)
+
 		fail, isFail := htlc.(*lnwire.UpdateFailHTLC)
 		if isFail && !packet.hasSource {
 			switch {
@@ -1246,6 +1432,21 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 			return s.handleLocalDispatch(packet)
 		}
 
+		// Otherwise, we'll notify the resolution of the forwarded htlc.
+		// Failures that originate from our own outgoing link have
+		// already been reported by it.
+		switch {
+		case !isFail:
+			s.cfg.HtlcNotifier.NotifySettleEvent(
+				newHtlcKey(packet), HtlcEventTypeForward,
+			)
+
+		case !packet.hasSource:
+			s.cfg.HtlcNotifier.NotifyForwardingFailEvent(
+				newHtlcKey(packet), HtlcEventTypeForward, nil,
+			)
+		}
+
 		// Check to see that the source link is online before removing
 		// the circuit.
 		return s.mailOrchestrator.Deliver(packet.incomingChanID, packet)
@@ -1274,6 +1475,21 @@ func (s *Switch) failAddPacket(packet *htlcPacket,
 
 	log.Error(failErr)
 
+	// Htlcs rejected because of the quota of their incoming channel are
+	// reported separately, as they don't reflect the liquidity of the
+	// outgoing channel.
+	if _, ok := failErr.(*quotaError); ok {
+		s.cfg.HtlcNotifier.NotifyQuotaFailEvent(
+			newHtlcKey(packet), newHtlcInfo(packet), failure,
+			failErr.Error(),
+		)
+	} else {
+		s.cfg.HtlcNotifier.NotifyLinkFailEvent(
+			newHtlcKey(packet), newHtlcInfo(packet),
+			HtlcEventTypeForward, failure, failErr.Error(), false,
+		)
+	}
+
 	failPkt := &htlcPacket{
 		sourceRef:      packet.sourceRef,
 		incomingChanID: packet.incomingChanID,
@@ -1566,6 +1782,13 @@ out:
 
 			atomic.StoreUint32(&s.bestHeight, uint32(blockEpoch.Height))
 
+			// We'll also use this opportunity to drop the quota
+			// counters of channels that have been closed.
+			if err := s.pruneQuotaStats(); err != nil {
+				log.Errorf("Unable to prune htlc quota "+
+					"stats: %v", err)
+			}
+
 		// A local close request has arrived, we'll forward this to the
 		// relevant link (if it exists) so the channel can be
 		// cooperatively closed (if possible).
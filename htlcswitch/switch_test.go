@@ -1,6 +1,7 @@
 package htlcswitch
 
 import (
+	"bytes"
 	"crypto/rand"
 	"crypto/sha256"
 	"fmt"
@@ -1830,6 +1831,95 @@ func TestSwitchSendPayment(t *testing.T) {
 	}
 }
 
+// TestSwitchSendProbe asserts that probes aren't tracked by the control tower,
+// such that no payment status is persisted for their payment hashes.
+func TestSwitchSendProbe(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, _, aliceChanID, _ := genIDs()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add link: %v", err)
+	}
+
+	preimage, err := genPreimage()
+	if err != nil {
+		t.Fatalf("unable to generate preimage: %v", err)
+	}
+	rhash := fastsha256.Sum256(preimage[:])
+	update := &lnwire.UpdateAddHTLC{
+		PaymentHash: rhash,
+		Amount:      1,
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		_, err := s.SendProbe(
+			aliceChannelLink.ShortChanID(), update,
+			newMockDeobfuscator(),
+		)
+		errChan <- err
+	}()
+
+	select {
+	case packet := <-aliceChannelLink.packets:
+		if err := aliceChannelLink.completeCircuit(packet); err != nil {
+			t.Fatalf("unable to complete payment circuit: %v", err)
+		}
+
+	case err := <-errChan:
+		t.Fatalf("unable to send probe: %v", err)
+	case <-time.After(time.Second):
+		t.Fatal("probe was not propagated to destination")
+	}
+
+	// Settle the probe, which would mark a regular payment to this payment
+	// hash as completed.
+	packet := &htlcPacket{
+		outgoingChanID: aliceChannelLink.ShortChanID(),
+		outgoingHTLCID: 0,
+		amount:         1,
+		htlc: &lnwire.UpdateFulfillHTLC{
+			PaymentPreimage: preimage,
+		},
+	}
+	if err := s.forward(packet); err != nil {
+		t.Fatalf("can't forward htlc packet: %v", err)
+	}
+
+	select {
+	case err := <-errChan:
+		if err != nil {
+			t.Fatalf("unexpected probe error: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("probe result wasn't received")
+	}
+
+	// As the probe never passed through the control tower, a payment to
+	// the same payment hash must still be allowed.
+	if err := s.control.ClearForTakeoff(update); err != nil {
+		t.Fatalf("expected payment hash to be untracked, got: %v", err)
+	}
+}
+
 // TestLocalPaymentNoForwardingEvents tests that if we send a series of locally
 // initiated payments, then they aren't reflected in the forwarding log.
 func TestLocalPaymentNoForwardingEvents(t *testing.T) {
@@ -2122,3 +2212,152 @@ func TestUpdateFailMalformedHTLCErrorConversion(t *testing.T) {
 		assertPaymentFailure(t)
 	})
 }
+
+// TestSwitchHtlcQuota tests that the switch rejects htlcs from an incoming
+// channel that exceed its share of the slots of the outgoing channel, and that
+// slots are released once the forwarded htlcs are resolved.
+func TestSwitchHtlcQuota(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+	bobPeer, err := newMockServer(t, "bob", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create bob server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+
+	// Htlcs from a single incoming channel may only occupy half of the
+	// slots of the outgoing channel.
+	s.quotas = newHtlcQuotas(HtlcQuotaConfig{MaxSlotPercent: 50})
+
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+
+	// Bob's link only accepts two htlcs, which leaves a single slot for
+	// the htlcs coming from Alice.
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, bobPeer, true,
+	)
+	bobChannelLink.maxHtlcs = 2
+	bobChannelLink.maxPendingAmt = 99999999
+
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+	if err := s.AddLink(bobChannelLink); err != nil {
+		t.Fatalf("unable to add bob link: %v", err)
+	}
+
+	preimage := [sha256.Size]byte{1}
+	rhash := fastsha256.Sum256(preimage[:])
+	newAddPacket := func(htlcID uint64) *htlcPacket {
+		return &htlcPacket{
+			incomingChanID: aliceChannelLink.ShortChanID(),
+			incomingHTLCID: htlcID,
+			outgoingChanID: bobChannelLink.ShortChanID(),
+			obfuscator:     NewMockObfuscator(),
+			htlc: &lnwire.UpdateAddHTLC{
+				PaymentHash: rhash,
+				Amount:      1,
+			},
+		}
+	}
+
+	// The first htlc fits within Alice's quota, so it should be forwarded
+	// to Bob.
+	packet := newAddPacket(0)
+	if err := s.forward(packet); err != nil {
+		t.Fatalf("unable to forward htlc: %v", err)
+	}
+
+	select {
+	case <-bobChannelLink.packets:
+		if err := bobChannelLink.completeCircuit(packet); err != nil {
+			t.Fatalf("unable to complete payment circuit: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("request was not propagated to destination")
+	}
+
+	// The second htlc exceeds the quota, so it should be failed back to
+	// Alice with a temporary channel failure.
+	if err := s.forward(newAddPacket(1)); err == nil {
+		t.Fatal("expected htlc exceeding quota to be rejected")
+	}
+
+	select {
+	case pkt := <-aliceChannelLink.packets:
+		fail, ok := pkt.htlc.(*lnwire.UpdateFailHTLC)
+		if !ok {
+			t.Fatalf("expected fail htlc, got %T", pkt.htlc)
+		}
+
+		failure, err := lnwire.DecodeFailure(
+			bytes.NewReader(fail.Reason), 0,
+		)
+		if err != nil {
+			t.Fatalf("unable to decode failure: %v", err)
+		}
+		if _, ok := failure.(*lnwire.FailTemporaryChannelFailure); !ok {
+			t.Fatalf("expected temporary channel failure, got %T",
+				failure)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("failure was not propagated to alice")
+	}
+
+	stats := s.HtlcQuotaStats()
+	if len(stats) != 1 {
+		t.Fatalf("expected stats for 1 channel, got %v", len(stats))
+	}
+	if stats[0].NumInFlight != 1 || stats[0].NumSlotRejects != 1 {
+		t.Fatalf("unexpected quota stats: %v", spew.Sdump(stats[0]))
+	}
+
+	// Once Bob settles the first htlc, its slot is released.
+	packet = &htlcPacket{
+		outgoingChanID: bobChannelLink.ShortChanID(),
+		outgoingHTLCID: 0,
+		amount:         1,
+		htlc: &lnwire.UpdateFulfillHTLC{
+			PaymentPreimage: preimage,
+		},
+	}
+	if err := s.forward(packet); err != nil {
+		t.Fatalf("unable to forward settle: %v", err)
+	}
+
+	select {
+	case pkt := <-aliceChannelLink.packets:
+		if err := aliceChannelLink.deleteCircuit(pkt); err != nil {
+			t.Fatalf("unable to remove circuit: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("settle was not propagated to alice")
+	}
+
+	stats = s.HtlcQuotaStats()
+	if stats[0].NumInFlight != 0 || stats[0].ValueInFlight != 0 {
+		t.Fatalf("unexpected quota stats: %v", spew.Sdump(stats[0]))
+	}
+
+	// This leaves room for a new htlc from Alice.
+	if err := s.forward(newAddPacket(2)); err != nil {
+		t.Fatalf("unable to forward htlc: %v", err)
+	}
+}
@@ -58,6 +58,12 @@ type InvoiceRegistry struct {
 	// that *all* nodes are able to fully settle.
 	debugInvoices map[lntypes.Hash]*channeldb.Invoice
 
+	// internalInvoices is a map which stores invoices that the daemon adds
+	// to settle payments to itself, such as the circular payments that
+	// rebalance our channels. Like debug invoices, they're only kept in
+	// memory, and are never exposed to invoice clients.
+	internalInvoices map[lntypes.Hash]*internalInvoice
+
 	// decodeFinalCltvExpiry is a function used to decode the final expiry
 	// value from the payment request.
 	decodeFinalCltvExpiry func(invoice string) (uint32, error)
@@ -84,6 +90,7 @@ func NewRegistry(cdb *channeldb.DB, decodeFinalCltvExpiry func(invoice string) (
 	return &InvoiceRegistry{
 		cdb:                       cdb,
 		debugInvoices:             make(map[lntypes.Hash]*channeldb.Invoice),
+		internalInvoices:          make(map[lntypes.Hash]*internalInvoice),
 		notificationClients:       make(map[uint32]*InvoiceSubscription),
 		singleNotificationClients: make(map[uint32]*SingleInvoiceSubscription),
 		newSubscriptions:          make(chan *InvoiceSubscription),
@@ -394,6 +401,59 @@ func (i *InvoiceRegistry) AddDebugInvoice(amt btcutil.Amount,
 	}))
 }
 
+// internalInvoice is an invoice added by the daemon to settle a payment to
+// itself.
+type internalInvoice struct {
+	invoice channeldb.Invoice
+
+	// finalCltvDelta is the minimum final CLTV delta of the payment.
+	finalCltvDelta uint32
+}
+
+// AddInternalInvoice adds an internal invoice for the specified amount,
+// identified by the passed preimage. Internal invoices allow the daemon to pay
+// itself, and are neither stored on disk nor reported to invoice clients.
+// Once the payment has completed or failed, the invoice must be removed
+// using RemoveInternalInvoice.
+func (i *InvoiceRegistry) AddInternalInvoice(amt lnwire.MilliSatoshi,
+	preimage lntypes.Preimage, finalCltvDelta uint32) error {
+
+	paymentHash := preimage.Hash()
+
+	i.Lock()
+	defer i.Unlock()
+
+	if _, ok := i.internalInvoices[paymentHash]; ok {
+		return channeldb.ErrDuplicateInvoice
+	}
+
+	i.internalInvoices[paymentHash] = &internalInvoice{
+		invoice: channeldb.Invoice{
+			CreationDate: time.Now(),
+			Terms: channeldb.ContractTerm{
+				Value:           amt,
+				PaymentPreimage: preimage,
+			},
+		},
+		finalCltvDelta: finalCltvDelta,
+	}
+
+	log.Debugf("Invoice(%v): added internal invoice for %v", paymentHash,
+		amt)
+
+	return nil
+}
+
+// RemoveInternalInvoice removes the internal invoice identified by the passed
+// payment hash, after which any htlc paying it is rejected.
+func (i *InvoiceRegistry) RemoveInternalInvoice(paymentHash lntypes.Hash) {
+	i.Lock()
+	delete(i.internalInvoices, paymentHash)
+	i.Unlock()
+
+	log.Debugf("Invoice(%v): removed internal invoice", paymentHash)
+}
+
 // AddInvoice adds a regular invoice for the specified amount, identified by
 // the passed preimage. Additionally, any memo or receipt data provided will
 // also be stored on-disk. Once this invoice is added, subsystems within the
@@ -445,6 +505,15 @@ func (i *InvoiceRegistry) LookupInvoice(rHash lntypes.Hash) (channeldb.Invoice,
 		return *debugInv, 0, nil
 	}
 
+	// The same goes for internal invoices, which are kept in memory as
+	// well.
+	i.RLock()
+	internalInv, ok := i.internalInvoices[rHash]
+	i.RUnlock()
+	if ok {
+		return internalInv.invoice, internalInv.finalCltvDelta, nil
+	}
+
 	// Otherwise, we'll check the database to see if there's an existing
 	// matching invoice.
 	invoice, err := i.cdb.LookupInvoice(rHash)
@@ -495,6 +564,15 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 		return createEvent(&invoice.Terms.PaymentPreimage), nil
 	}
 
+	// Internal invoices are never stored on disk, so the htlc is settled
+	// directly as well.
+	if internalInv, ok := i.internalInvoices[rHash]; ok {
+		log.Debugf("Invoice(%x): internal invoice settled", rHash[:])
+
+		preimage := internalInv.invoice.Terms.PaymentPreimage
+		return createEvent(&preimage), nil
+	}
+
 	// If this isn't a debug invoice, then we'll attempt to settle an
 	// invoice matching this rHash on disk (if one exists).
 	invoice, err := i.cdb.AcceptOrSettleInvoice(rHash, amtPaid)
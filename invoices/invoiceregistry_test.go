@@ -434,3 +434,61 @@ func newDB() (*channeldb.DB, func(), error) {
 
 	return cdb, cleanUp, nil
 }
+
+// TestInternalInvoice tests that internal invoices settle htlcs without being
+// stored on disk or reported to invoice clients, and that they can no longer
+// be paid once removed.
+func TestInternalInvoice(t *testing.T) {
+	registry, cleanup := newTestContext(t)
+	defer cleanup()
+
+	allSubscriptions := registry.SubscribeNotifications(0, 0)
+	defer allSubscriptions.Cancel()
+
+	amt := lnwire.MilliSatoshi(100500)
+	err := registry.AddInternalInvoice(amt, preimage, 40)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The invoice should be found with its exact amount and final cltv
+	// delta, without being stored on disk.
+	invoice, finalCltvDelta, err := registry.LookupInvoice(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if invoice.Terms.Value != amt || finalCltvDelta != 40 {
+		t.Fatalf("unexpected invoice of %v with final cltv delta %v",
+			invoice.Terms.Value, finalCltvDelta)
+	}
+	if _, err := registry.cdb.LookupInvoice(hash); err == nil {
+		t.Fatalf("expected internal invoice not to be stored")
+	}
+
+	hodlChan := make(chan interface{}, 1)
+	event, err := registry.NotifyExitHopHtlc(hash, amt, hodlChan)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event == nil || event.Preimage == nil {
+		t.Fatalf("expected htlc to be settled")
+	}
+	if *event.Preimage != preimage {
+		t.Fatalf("expected preimage %v, got %v", preimage,
+			*event.Preimage)
+	}
+
+	select {
+	case update := <-allSubscriptions.NewInvoices:
+		t.Fatalf("unexpected invoice notification: %v", update)
+	case update := <-allSubscriptions.SettledInvoices:
+		t.Fatalf("unexpected invoice notification: %v", update)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Once removed, the invoice can no longer be paid.
+	registry.RemoveInternalInvoice(hash)
+	if _, _, err := registry.LookupInvoice(hash); err == nil {
+		t.Fatalf("expected removed invoice not to be found")
+	}
+}
@@ -96,6 +96,11 @@ const (
 	// session keys are limited to the lifetime of the session and are used
 	// to increase privacy in the watchtower protocol.
 	KeyFamilyTowerSession KeyFamily = 8
+
+	// KeyFamilyTorOnion is the family of keys that will be used to derive
+	// the key we use to encrypt and decrypt the private keys of our onion
+	// services at rest.
+	KeyFamilyTorOnion KeyFamily = 9
 )
 
 // KeyLocator is a two-tuple that can be used to derive *any* key that has ever
@@ -0,0 +1,83 @@
+package lncfg
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultIPConnInterval is the default minimum average interval
+	// between inbound connections from a single IP address.
+	DefaultIPConnInterval = 10 * time.Second
+
+	// DefaultIPConnBurst is the default number of inbound connections a
+	// single IP address may make in quick succession.
+	DefaultIPConnBurst = 5
+
+	// DefaultSubnetConnInterval is the default minimum average interval
+	// between inbound connections from a single subnet.
+	DefaultSubnetConnInterval = time.Second
+
+	// DefaultSubnetConnBurst is the default number of inbound connections
+	// a single subnet may make in quick succession.
+	DefaultSubnetConnBurst = 20
+
+	// DefaultMaxChanlessPeers is the default maximum number of inbound
+	// peers we don't have any channels with.
+	DefaultMaxChanlessPeers = 100
+)
+
+// ConnLimit exposes CLI configuration for limiting the resources consumed by
+// inbound connections.
+type ConnLimit struct {
+	// IPInterval is the minimum average interval between inbound
+	// connections from a single IP address.
+	IPInterval time.Duration `long:"ip-interval" description:"The minimum average interval between inbound connections accepted from a single IP address, connections exceeding this rate are dropped before the handshake. Set to 0 to disable rate limiting by IP address. Valid time units are {ms, s, m, h}."`
+
+	// IPBurst is the number of inbound connections a single IP address
+	// may make in quick succession.
+	IPBurst int `long:"ip-burst" description:"The number of inbound connections a single IP address may make in quick succession before being rate limited."`
+
+	// SubnetInterval is the minimum average interval between inbound
+	// connections from a single subnet.
+	SubnetInterval time.Duration `long:"subnet-interval" description:"The minimum average interval between inbound connections accepted from a single /24 IPv4 or /48 IPv6 subnet, connections exceeding this rate are dropped before the handshake. Set to 0 to disable rate limiting by subnet. Valid time units are {ms, s, m, h}."`
+
+	// SubnetBurst is the number of inbound connections a single subnet
+	// may make in quick succession.
+	SubnetBurst int `long:"subnet-burst" description:"The number of inbound connections a single subnet may make in quick succession before being rate limited."`
+
+	// MaxChanlessPeers is the maximum number of inbound peers we don't
+	// have any channels with.
+	MaxChanlessPeers int `long:"max-chanless-peers" description:"The maximum number of connected inbound peers we don't have any channels with. Once reached, the oldest of these peers is disconnected to make room for a new one. Set to 0 for no limit."`
+}
+
+// Validate checks the ConnLimit configuration to ensure that the input values
+// are sane.
+func (c *ConnLimit) Validate() error {
+	if c.IPInterval < 0 {
+		return fmt.Errorf("ip connection interval (%v) must not be "+
+			"negative", c.IPInterval)
+	}
+	if c.IPInterval > 0 && c.IPBurst <= 0 {
+		return fmt.Errorf("ip connection burst (%d) must be positive",
+			c.IPBurst)
+	}
+	if c.SubnetInterval < 0 {
+		return fmt.Errorf("subnet connection interval (%v) must not "+
+			"be negative", c.SubnetInterval)
+	}
+	if c.SubnetInterval > 0 && c.SubnetBurst <= 0 {
+		return fmt.Errorf("subnet connection burst (%d) must be "+
+			"positive", c.SubnetBurst)
+	}
+	if c.MaxChanlessPeers < 0 {
+		return fmt.Errorf("max chanless peers (%d) must not be "+
+			"negative", c.MaxChanlessPeers)
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure ConnLimit implements the Validator
+// interface.
+var _ Validator = (*ConnLimit)(nil)
@@ -0,0 +1,79 @@
+package lncfg
+
+import (
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultChanUpdateInterval is the default minimum average interval
+	// between the ChannelUpdates we accept for a single direction of a
+	// channel.
+	DefaultChanUpdateInterval = time.Minute
+
+	// DefaultChanUpdateBurst is the default number of ChannelUpdates a
+	// single direction of a channel may receive in quick succession.
+	DefaultChanUpdateBurst = 10
+)
+
+// Gossip exposes CLI configuration for limiting the gossip messages we accept
+// from the network.
+type Gossip struct {
+	// ChanUpdateInterval is the minimum average interval between the
+	// ChannelUpdates we accept for a single direction of a channel.
+	ChanUpdateInterval time.Duration `long:"chan-update-interval" description:"The minimum average interval between the channel updates accepted for a single direction of a channel. Excess updates are dropped rather than applied and relayed, unless they merely refresh the channel's previous policy. Set to 0 to disable rate limiting by channel. Valid time units are {ms, s, m, h}."`
+
+	// ChanUpdateBurst is the number of ChannelUpdates a single direction
+	// of a channel may receive in quick succession.
+	ChanUpdateBurst int `long:"chan-update-burst" description:"The number of channel updates a single direction of a channel may receive in quick succession before being rate limited."`
+
+	// PeerUpdateInterval is the minimum average interval between the
+	// ChannelUpdates we accept from a single peer.
+	PeerUpdateInterval time.Duration `long:"peer-update-interval" description:"The minimum average interval between the channel updates accepted from a single peer. Set to 0 to disable rate limiting by peer. Note that peers relay the updates of the entire network, so this limit should allow for initial graph syncs. Valid time units are {ms, s, m, h}."`
+
+	// PeerUpdateBurst is the number of ChannelUpdates a single peer may
+	// send in quick succession.
+	PeerUpdateBurst int `long:"peer-update-burst" description:"The number of channel updates a single peer may send in quick succession before being rate limited."`
+
+	// BannedNodes is the set of hex encoded public keys of the nodes whose
+	// graph data we ignore.
+	BannedNodes []string `long:"ban-node" description:"The hex encoded public key of a node whose announcements are dropped, and which is purged from the graph and never used for path finding, along with its channels. Can be specified multiple times. Nodes can also be banned at runtime through RPC."`
+
+	// BannedChannels is the set of short channel IDs of the channels whose
+	// graph data we ignore.
+	BannedChannels []uint64 `long:"ban-channel" description:"The short channel ID of a channel whose announcements are dropped, and which is purged from the graph and never used for path finding. Can be specified multiple times. Channels can also be banned at runtime through RPC."`
+}
+
+// Validate checks the Gossip configuration to ensure that the input values are
+// sane.
+func (g *Gossip) Validate() error {
+	if g.ChanUpdateInterval < 0 {
+		return fmt.Errorf("channel update interval (%v) must not be "+
+			"negative", g.ChanUpdateInterval)
+	}
+	if g.ChanUpdateInterval > 0 && g.ChanUpdateBurst <= 0 {
+		return fmt.Errorf("channel update burst (%d) must be "+
+			"positive", g.ChanUpdateBurst)
+	}
+	if g.PeerUpdateInterval < 0 {
+		return fmt.Errorf("peer update interval (%v) must not be "+
+			"negative", g.PeerUpdateInterval)
+	}
+	if g.PeerUpdateInterval > 0 && g.PeerUpdateBurst <= 0 {
+		return fmt.Errorf("peer update burst (%d) must be positive",
+			g.PeerUpdateBurst)
+	}
+	for _, node := range g.BannedNodes {
+		pubKey, err := hex.DecodeString(node)
+		if err != nil || len(pubKey) != 33 {
+			return fmt.Errorf("banned node (%v) must be a hex "+
+				"encoded compressed public key", node)
+		}
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure Gossip implements the Validator interface.
+var _ Validator = (*Gossip)(nil)
@@ -0,0 +1,60 @@
+package lncfg
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultHtlcSlotPercent is the default maximum percentage of the htlc
+	// slots of an outgoing channel that htlcs from a single incoming
+	// channel may occupy.
+	DefaultHtlcSlotPercent = 50
+
+	// DefaultHtlcValuePercent is the default maximum percentage of the
+	// maximum value in flight of an outgoing channel that htlcs from a
+	// single incoming channel may occupy.
+	DefaultHtlcValuePercent = 50
+)
+
+// HtlcQuota exposes CLI configuration for limiting the share of our outgoing
+// channels that htlcs forwarded from a single incoming channel may occupy.
+type HtlcQuota struct {
+	// MaxSlotPercent is the maximum percentage of the htlc slots of an
+	// outgoing channel that htlcs from a single incoming channel may
+	// occupy.
+	MaxSlotPercent uint32 `long:"max-slot-percent" description:"The maximum percentage of the htlc slots of an outgoing channel that may be occupied by htlcs forwarded from a single incoming channel. Htlcs exceeding this quota are failed with a temporary channel failure. An incoming channel is always allowed at least one slot. Set to 0 to disable the slot quota."`
+
+	// MaxValuePercent is the maximum percentage of the maximum value in
+	// flight of an outgoing channel that htlcs from a single incoming
+	// channel may occupy.
+	MaxValuePercent uint32 `long:"max-value-percent" description:"The maximum percentage of the maximum value in flight of an outgoing channel that may be occupied by htlcs forwarded from a single incoming channel. Htlcs exceeding this quota are failed with a temporary channel failure. Set to 0 to disable the value quota."`
+
+	// MaxHoldTime is the maximum duration an htlc forwarded from an
+	// incoming channel may remain unresolved before further htlcs from
+	// that channel are rejected.
+	MaxHoldTime time.Duration `long:"max-hold-time" description:"The maximum duration an htlc forwarded from an incoming channel may remain unresolved. As long as an incoming channel has an htlc in flight for longer, further htlcs from it are failed with a temporary channel failure. Set to 0 to disable the limit. Valid time units are {ms, s, m, h}."`
+}
+
+// Validate checks the HtlcQuota configuration to ensure that the input values
+// are sane.
+func (h *HtlcQuota) Validate() error {
+	if h.MaxSlotPercent > 100 {
+		return fmt.Errorf("max slot percent (%d) must not exceed 100",
+			h.MaxSlotPercent)
+	}
+	if h.MaxValuePercent > 100 {
+		return fmt.Errorf("max value percent (%d) must not exceed 100",
+			h.MaxValuePercent)
+	}
+	if h.MaxHoldTime < 0 {
+		return fmt.Errorf("max hold time (%v) must not be negative",
+			h.MaxHoldTime)
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure HtlcQuota implements the Validator
+// interface.
+var _ Validator = (*HtlcQuota)(nil)
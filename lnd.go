@@ -246,6 +246,9 @@ func Main() error {
 		// Create the macaroon authentication/authorization service.
 		macaroonService, err = macaroons.NewService(
 			networkDir, macaroons.IPLockChecker,
+			macaroons.MethodChecker, macaroons.PaymentLimitChecker,
+			macaroons.SpendLimitChecker,
+			macaroons.InvoiceLimitChecker,
 		)
 		if err != nil {
 			srvrLog.Errorf("unable to create macaroon service: %v", err)
@@ -309,8 +312,9 @@ func Main() error {
 
 	if cfg.Tor.Active {
 		srvrLog.Infof("Proxying all network traffic via Tor "+
-			"(stream_isolation=%v)! NOTE: Ensure the backend node "+
-			"is proxying over Tor as well", cfg.Tor.StreamIsolation)
+			"(stream_isolation=%v, peer_isolation=%v)! NOTE: "+
+			"Ensure the backend node is proxying over Tor as well",
+			cfg.Tor.StreamIsolation, cfg.Tor.PeerIsolation)
 	}
 
 	// Set up the core server which will listen for incoming peer
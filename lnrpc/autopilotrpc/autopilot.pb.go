@@ -23,6 +23,37 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type Decision_DecisionType int32
+
+const (
+	// / The agent decided to open a channel to the node.
+	Decision_OPEN Decision_DecisionType = 0
+	// *
+	// The agent decided not to open a channel. If no pubkey is set, the
+	// agent skipped opening channels altogether.
+	Decision_SKIP Decision_DecisionType = 1
+	// / The agent failed to connect to the node, or to open the channel.
+	Decision_OPEN_FAILED Decision_DecisionType = 2
+)
+
+var Decision_DecisionType_name = map[int32]string{
+	0: "OPEN",
+	1: "SKIP",
+	2: "OPEN_FAILED",
+}
+var Decision_DecisionType_value = map[string]int32{
+	"OPEN":        0,
+	"SKIP":        1,
+	"OPEN_FAILED": 2,
+}
+
+func (x Decision_DecisionType) String() string {
+	return proto.EnumName(Decision_DecisionType_name, int32(x))
+}
+func (Decision_DecisionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_autopilot_f6d63b5d7b6ce5f4, []int{11, 0}
+}
+
 type StatusRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -33,7 +64,7 @@ func (m *StatusRequest) Reset()         { *m = StatusRequest{} }
 func (m *StatusRequest) String() string { return proto.CompactTextString(m) }
 func (*StatusRequest) ProtoMessage()    {}
 func (*StatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_autopilot_1f7ac28ef56bb20c, []int{0}
+	return fileDescriptor_autopilot_f6d63b5d7b6ce5f4, []int{0}
 }
 func (m *StatusRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatusRequest.Unmarshal(m, b)
@@ -65,7 +96,7 @@ func (m *StatusResponse) Reset()         { *m = StatusResponse{} }
 func (m *StatusResponse) String() string { return proto.CompactTextString(m) }
 func (*StatusResponse) ProtoMessage()    {}
 func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_autopilot_1f7ac28ef56bb20c, []int{1}
+	return fileDescriptor_autopilot_f6d63b5d7b6ce5f4, []int{1}
 }
 func (m *StatusResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatusResponse.Unmarshal(m, b)
@@ -104,7 +135,7 @@ func (m *ModifyStatusRequest) Reset()         { *m = ModifyStatusRequest{} }
 func (m *ModifyStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyStatusRequest) ProtoMessage()    {}
 func (*ModifyStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_autopilot_1f7ac28ef56bb20c, []int{2}
+	return fileDescriptor_autopilot_f6d63b5d7b6ce5f4, []int{2}
 }
 func (m *ModifyStatusRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ModifyStatusRequest.Unmarshal(m, b)
@@ -141,7 +172,7 @@ func (m *ModifyStatusResponse) Reset()         { *m = ModifyStatusResponse{} }
 func (m *ModifyStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*ModifyStatusResponse) ProtoMessage()    {}
 func (*ModifyStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_autopilot_1f7ac28ef56bb20c, []int{3}
+	return fileDescriptor_autopilot_f6d63b5d7b6ce5f4, []int{3}
 }
 func (m *ModifyStatusResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ModifyStatusResponse.Unmarshal(m, b)
@@ -174,7 +205,7 @@ func (m *QueryScoresRequest) Reset()         { *m = QueryScoresRequest{} }
 func (m *QueryScoresRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryScoresRequest) ProtoMessage()    {}
 func (*QueryScoresRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_autopilot_1f7ac28ef56bb20c, []int{4}
+	return fileDescriptor_autopilot_f6d63b5d7b6ce5f4, []int{4}
 }
 func (m *QueryScoresRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryScoresRequest.Unmarshal(m, b)
@@ -219,7 +250,7 @@ func (m *QueryScoresResponse) Reset()         { *m = QueryScoresResponse{} }
 func (m *QueryScoresResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryScoresResponse) ProtoMessage()    {}
 func (*QueryScoresResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_autopilot_1f7ac28ef56bb20c, []int{5}
+	return fileDescriptor_autopilot_f6d63b5d7b6ce5f4, []int{5}
 }
 func (m *QueryScoresResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryScoresResponse.Unmarshal(m, b)
@@ -258,7 +289,7 @@ func (m *QueryScoresResponse_HeuristicResult) Reset()         { *m = QueryScores
 func (m *QueryScoresResponse_HeuristicResult) String() string { return proto.CompactTextString(m) }
 func (*QueryScoresResponse_HeuristicResult) ProtoMessage()    {}
 func (*QueryScoresResponse_HeuristicResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_autopilot_1f7ac28ef56bb20c, []int{5, 0}
+	return fileDescriptor_autopilot_f6d63b5d7b6ce5f4, []int{5, 0}
 }
 func (m *QueryScoresResponse_HeuristicResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryScoresResponse_HeuristicResult.Unmarshal(m, b)
@@ -308,7 +339,7 @@ func (m *SetScoresRequest) Reset()         { *m = SetScoresRequest{} }
 func (m *SetScoresRequest) String() string { return proto.CompactTextString(m) }
 func (*SetScoresRequest) ProtoMessage()    {}
 func (*SetScoresRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_autopilot_1f7ac28ef56bb20c, []int{6}
+	return fileDescriptor_autopilot_f6d63b5d7b6ce5f4, []int{6}
 }
 func (m *SetScoresRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetScoresRequest.Unmarshal(m, b)
@@ -352,7 +383,7 @@ func (m *SetScoresResponse) Reset()         { *m = SetScoresResponse{} }
 func (m *SetScoresResponse) String() string { return proto.CompactTextString(m) }
 func (*SetScoresResponse) ProtoMessage()    {}
 func (*SetScoresResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_autopilot_1f7ac28ef56bb20c, []int{7}
+	return fileDescriptor_autopilot_f6d63b5d7b6ce5f4, []int{7}
 }
 func (m *SetScoresResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetScoresResponse.Unmarshal(m, b)
@@ -372,6 +403,218 @@ func (m *SetScoresResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SetScoresResponse proto.InternalMessageInfo
 
+type DryRunRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DryRunRequest) Reset()         { *m = DryRunRequest{} }
+func (m *DryRunRequest) String() string { return proto.CompactTextString(m) }
+func (*DryRunRequest) ProtoMessage()    {}
+func (*DryRunRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_autopilot_f6d63b5d7b6ce5f4, []int{8}
+}
+func (m *DryRunRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DryRunRequest.Unmarshal(m, b)
+}
+func (m *DryRunRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DryRunRequest.Marshal(b, m, deterministic)
+}
+func (dst *DryRunRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DryRunRequest.Merge(dst, src)
+}
+func (m *DryRunRequest) XXX_Size() int {
+	return xxx_messageInfo_DryRunRequest.Size(m)
+}
+func (m *DryRunRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DryRunRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DryRunRequest proto.InternalMessageInfo
+
+type DryRunResponse struct {
+	// / The wallet balance in satoshis used for the dry run.
+	WalletBalance int64 `protobuf:"varint,1,opt,name=wallet_balance,proto3" json:"wallet_balance,omitempty"`
+	// *
+	// The amount of funds in satoshis the agent's constraints allow it to commit
+	// towards new channels.
+	AvailableFunds int64 `protobuf:"varint,2,opt,name=available_funds,proto3" json:"available_funds,omitempty"`
+	// / The number of additional channels the agent is allowed to open.
+	NumChans uint32 `protobuf:"varint,3,opt,name=num_chans,proto3" json:"num_chans,omitempty"`
+	// / The decisions the agent would make.
+	Decisions            []*Decision `protobuf:"bytes,4,rep,name=decisions,proto3" json:"decisions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *DryRunResponse) Reset()         { *m = DryRunResponse{} }
+func (m *DryRunResponse) String() string { return proto.CompactTextString(m) }
+func (*DryRunResponse) ProtoMessage()    {}
+func (*DryRunResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_autopilot_f6d63b5d7b6ce5f4, []int{9}
+}
+func (m *DryRunResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DryRunResponse.Unmarshal(m, b)
+}
+func (m *DryRunResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DryRunResponse.Marshal(b, m, deterministic)
+}
+func (dst *DryRunResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DryRunResponse.Merge(dst, src)
+}
+func (m *DryRunResponse) XXX_Size() int {
+	return xxx_messageInfo_DryRunResponse.Size(m)
+}
+func (m *DryRunResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DryRunResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DryRunResponse proto.InternalMessageInfo
+
+func (m *DryRunResponse) GetWalletBalance() int64 {
+	if m != nil {
+		return m.WalletBalance
+	}
+	return 0
+}
+
+func (m *DryRunResponse) GetAvailableFunds() int64 {
+	if m != nil {
+		return m.AvailableFunds
+	}
+	return 0
+}
+
+func (m *DryRunResponse) GetNumChans() uint32 {
+	if m != nil {
+		return m.NumChans
+	}
+	return 0
+}
+
+func (m *DryRunResponse) GetDecisions() []*Decision {
+	if m != nil {
+		return m.Decisions
+	}
+	return nil
+}
+
+type SubscribeDecisionsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeDecisionsRequest) Reset()         { *m = SubscribeDecisionsRequest{} }
+func (m *SubscribeDecisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeDecisionsRequest) ProtoMessage()    {}
+func (*SubscribeDecisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_autopilot_f6d63b5d7b6ce5f4, []int{10}
+}
+func (m *SubscribeDecisionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeDecisionsRequest.Unmarshal(m, b)
+}
+func (m *SubscribeDecisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeDecisionsRequest.Marshal(b, m, deterministic)
+}
+func (dst *SubscribeDecisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeDecisionsRequest.Merge(dst, src)
+}
+func (m *SubscribeDecisionsRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeDecisionsRequest.Size(m)
+}
+func (m *SubscribeDecisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeDecisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeDecisionsRequest proto.InternalMessageInfo
+
+type Decision struct {
+	// / The kind of decision that was made.
+	Type Decision_DecisionType `protobuf:"varint,1,opt,name=type,proto3,enum=autopilotrpc.Decision_DecisionType" json:"type,omitempty"`
+	// / The unix timestamp at which the decision was made.
+	Timestamp int64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// / The hex-encoded public key of the node the decision applies to, if any.
+	Pubkey string `protobuf:"bytes,3,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// / The size of the channel in satoshis the agent decided to open.
+	ChanAmt int64 `protobuf:"varint,4,opt,name=chan_amt,proto3" json:"chan_amt,omitempty"`
+	// / The score the agent's heuristic assigned to the node.
+	Score float64 `protobuf:"fixed64,5,opt,name=score,proto3" json:"score,omitempty"`
+	// / A human readable explanation of the decision.
+	Reason               string   `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Decision) Reset()         { *m = Decision{} }
+func (m *Decision) String() string { return proto.CompactTextString(m) }
+func (*Decision) ProtoMessage()    {}
+func (*Decision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_autopilot_f6d63b5d7b6ce5f4, []int{11}
+}
+func (m *Decision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Decision.Unmarshal(m, b)
+}
+func (m *Decision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Decision.Marshal(b, m, deterministic)
+}
+func (dst *Decision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Decision.Merge(dst, src)
+}
+func (m *Decision) XXX_Size() int {
+	return xxx_messageInfo_Decision.Size(m)
+}
+func (m *Decision) XXX_DiscardUnknown() {
+	xxx_messageInfo_Decision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Decision proto.InternalMessageInfo
+
+func (m *Decision) GetType() Decision_DecisionType {
+	if m != nil {
+		return m.Type
+	}
+	return Decision_OPEN
+}
+
+func (m *Decision) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *Decision) GetPubkey() string {
+	if m != nil {
+		return m.Pubkey
+	}
+	return ""
+}
+
+func (m *Decision) GetChanAmt() int64 {
+	if m != nil {
+		return m.ChanAmt
+	}
+	return 0
+}
+
+func (m *Decision) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *Decision) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*StatusRequest)(nil), "autopilotrpc.StatusRequest")
 	proto.RegisterType((*StatusResponse)(nil), "autopilotrpc.StatusResponse")
@@ -384,6 +627,11 @@ func init() {
 	proto.RegisterType((*SetScoresRequest)(nil), "autopilotrpc.SetScoresRequest")
 	proto.RegisterMapType((map[string]float64)(nil), "autopilotrpc.SetScoresRequest.ScoresEntry")
 	proto.RegisterType((*SetScoresResponse)(nil), "autopilotrpc.SetScoresResponse")
+	proto.RegisterType((*DryRunRequest)(nil), "autopilotrpc.DryRunRequest")
+	proto.RegisterType((*DryRunResponse)(nil), "autopilotrpc.DryRunResponse")
+	proto.RegisterType((*SubscribeDecisionsRequest)(nil), "autopilotrpc.SubscribeDecisionsRequest")
+	proto.RegisterType((*Decision)(nil), "autopilotrpc.Decision")
+	proto.RegisterEnum("autopilotrpc.Decision_DecisionType", Decision_DecisionType_name, Decision_DecisionType_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -414,6 +662,16 @@ type AutopilotClient interface {
 	// SetScores attempts to set the scores used by the running autopilot agent,
 	// if the external scoring heuristic is enabled.
 	SetScores(ctx context.Context, in *SetScoresRequest, opts ...grpc.CallOption) (*SetScoresResponse, error)
+	// *
+	// DryRun runs the autopilot heuristics and constraints against the current
+	// channel graph and wallet balance, and returns the channels the agent would
+	// open, along with the nodes it would skip and why. No channels are opened.
+	DryRun(ctx context.Context, in *DryRunRequest, opts ...grpc.CallOption) (*DryRunResponse, error)
+	// *
+	// SubscribeDecisions returns a uni-directional stream (server -> client) of
+	// the decisions made by the running autopilot agent, including failed
+	// attempts at opening channels.
+	SubscribeDecisions(ctx context.Context, in *SubscribeDecisionsRequest, opts ...grpc.CallOption) (Autopilot_SubscribeDecisionsClient, error)
 }
 
 type autopilotClient struct {
@@ -460,6 +718,47 @@ func (c *autopilotClient) SetScores(ctx context.Context, in *SetScoresRequest, o
 	return out, nil
 }
 
+func (c *autopilotClient) DryRun(ctx context.Context, in *DryRunRequest, opts ...grpc.CallOption) (*DryRunResponse, error) {
+	out := new(DryRunResponse)
+	err := c.cc.Invoke(ctx, "/autopilotrpc.Autopilot/DryRun", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autopilotClient) SubscribeDecisions(ctx context.Context, in *SubscribeDecisionsRequest, opts ...grpc.CallOption) (Autopilot_SubscribeDecisionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Autopilot_serviceDesc.Streams[0], "/autopilotrpc.Autopilot/SubscribeDecisions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &autopilotSubscribeDecisionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Autopilot_SubscribeDecisionsClient interface {
+	Recv() (*Decision, error)
+	grpc.ClientStream
+}
+
+type autopilotSubscribeDecisionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *autopilotSubscribeDecisionsClient) Recv() (*Decision, error) {
+	m := new(Decision)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // AutopilotServer is the server API for Autopilot service.
 type AutopilotServer interface {
 	// *
@@ -478,6 +777,16 @@ type AutopilotServer interface {
 	// SetScores attempts to set the scores used by the running autopilot agent,
 	// if the external scoring heuristic is enabled.
 	SetScores(context.Context, *SetScoresRequest) (*SetScoresResponse, error)
+	// *
+	// DryRun runs the autopilot heuristics and constraints against the current
+	// channel graph and wallet balance, and returns the channels the agent would
+	// open, along with the nodes it would skip and why. No channels are opened.
+	DryRun(context.Context, *DryRunRequest) (*DryRunResponse, error)
+	// *
+	// SubscribeDecisions returns a uni-directional stream (server -> client) of
+	// the decisions made by the running autopilot agent, including failed
+	// attempts at opening channels.
+	SubscribeDecisions(*SubscribeDecisionsRequest, Autopilot_SubscribeDecisionsServer) error
 }
 
 func RegisterAutopilotServer(s *grpc.Server, srv AutopilotServer) {
@@ -556,6 +865,45 @@ func _Autopilot_SetScores_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Autopilot_DryRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DryRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutopilotServer).DryRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/autopilotrpc.Autopilot/DryRun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutopilotServer).DryRun(ctx, req.(*DryRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Autopilot_SubscribeDecisions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeDecisionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AutopilotServer).SubscribeDecisions(m, &autopilotSubscribeDecisionsServer{stream})
+}
+
+type Autopilot_SubscribeDecisionsServer interface {
+	Send(*Decision) error
+	grpc.ServerStream
+}
+
+type autopilotSubscribeDecisionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *autopilotSubscribeDecisionsServer) Send(m *Decision) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Autopilot_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "autopilotrpc.Autopilot",
 	HandlerType: (*AutopilotServer)(nil),
@@ -576,44 +924,71 @@ var _Autopilot_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetScores",
 			Handler:    _Autopilot_SetScores_Handler,
 		},
+		{
+			MethodName: "DryRun",
+			Handler:    _Autopilot_DryRun_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeDecisions",
+			Handler:       _Autopilot_SubscribeDecisions_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "autopilotrpc/autopilot.proto",
 }
 
 func init() {
-	proto.RegisterFile("autopilotrpc/autopilot.proto", fileDescriptor_autopilot_1f7ac28ef56bb20c)
-}
-
-var fileDescriptor_autopilot_1f7ac28ef56bb20c = []byte{
-	// 463 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x95, 0x4c, 0x74, 0xcb, 0xeb, 0x60, 0xc3, 0x9d, 0xa6, 0x28, 0x54, 0xd0, 0x45, 0x1c,
-	0x2a, 0x24, 0x52, 0x51, 0x38, 0x00, 0x12, 0x07, 0x86, 0x90, 0x90, 0x80, 0x03, 0x2e, 0xbb, 0x70,
-	0x99, 0xd2, 0xcc, 0xb4, 0x56, 0x8d, 0x1d, 0xec, 0xe7, 0xa1, 0xfc, 0x43, 0x5c, 0xf9, 0x1b, 0x38,
-	0xf2, 0x5f, 0xa1, 0xc5, 0x49, 0x49, 0xaa, 0x12, 0x84, 0xb4, 0x5b, 0xde, 0x8f, 0x7c, 0x9e, 0xdf,
-	0xd7, 0x5f, 0x19, 0x86, 0xa9, 0x45, 0x95, 0x73, 0xa1, 0x50, 0xe7, 0xd9, 0x64, 0x1d, 0x24, 0xb9,
-	0x56, 0xa8, 0xc8, 0x7e, 0xb3, 0x1a, 0x1f, 0xc0, 0xcd, 0x19, 0xa6, 0x68, 0x0d, 0x65, 0x5f, 0x2d,
-	0x33, 0x18, 0x8f, 0xe1, 0x56, 0x9d, 0x30, 0xb9, 0x92, 0x86, 0x91, 0x63, 0xe8, 0xa5, 0x19, 0xf2,
-	0x4b, 0x16, 0x7a, 0x23, 0x6f, 0xbc, 0x47, 0xab, 0x28, 0x7e, 0x08, 0x83, 0xf7, 0xea, 0x82, 0x7f,
-	0x2e, 0x5a, 0x80, 0xab, 0x76, 0x26, 0xd3, 0xb9, 0x58, 0xb7, 0xbb, 0x28, 0x3e, 0x86, 0xa3, 0x76,
-	0xbb, 0xc3, 0xc7, 0x1f, 0x81, 0x7c, 0xb0, 0x4c, 0x17, 0xb3, 0x4c, 0x69, 0xb6, 0xa6, 0x84, 0xb0,
-	0x9b, 0xdb, 0xf9, 0x8a, 0x15, 0x26, 0xf4, 0x46, 0x3b, 0xe3, 0x80, 0xd6, 0x21, 0xb9, 0x0f, 0x84,
-	0x2f, 0xa4, 0xd2, 0xec, 0x5c, 0xa8, 0x2c, 0x15, 0xe7, 0x06, 0x53, 0x64, 0xa1, 0x5f, 0xce, 0xda,
-	0x93, 0xca, 0xc5, 0xf1, 0x77, 0x1f, 0x06, 0x2d, 0x6c, 0xb5, 0xcc, 0x5b, 0xd8, 0xd5, 0xcc, 0x58,
-	0x81, 0x8e, 0xdb, 0x9f, 0x3e, 0x4a, 0x9a, 0x7a, 0x24, 0x5b, 0xfe, 0x49, 0xde, 0x30, 0xab, 0xb9,
-	0x41, 0x9e, 0xd1, 0xf2, 0x4f, 0x5a, 0x13, 0xa2, 0x9f, 0x1e, 0x1c, 0x6c, 0x14, 0xc9, 0x10, 0x82,
-	0x65, 0x9d, 0x2a, 0x15, 0x08, 0xe8, 0x9f, 0x04, 0x39, 0x83, 0x9e, 0x29, 0xe1, 0xa1, 0x5f, 0x4e,
-	0x7f, 0xf1, 0xdf, 0xd3, 0x13, 0x57, 0x7e, 0x2d, 0x51, 0x17, 0xb4, 0x82, 0x45, 0xcf, 0xa0, 0xdf,
-	0x48, 0x93, 0x43, 0xd8, 0x59, 0xb1, 0xa2, 0x9a, 0x7e, 0xf5, 0x49, 0x8e, 0xe0, 0xc6, 0x65, 0x2a,
-	0xac, 0xd3, 0xc9, 0xa3, 0x2e, 0x78, 0xee, 0x3f, 0xf5, 0xe2, 0x1f, 0x1e, 0x1c, 0xce, 0x18, 0xb6,
-	0xd5, 0xef, 0x5e, 0xe2, 0x74, 0x63, 0x89, 0x07, 0xed, 0x25, 0x36, 0x69, 0xd7, 0x7d, 0xe2, 0x01,
-	0xdc, 0x6e, 0x8c, 0x70, 0x2a, 0x4d, 0x7f, 0xf9, 0x10, 0xbc, 0xac, 0x4f, 0x41, 0x5e, 0x41, 0xcf,
-	0xb9, 0x8c, 0xdc, 0xd9, 0x38, 0x5b, 0xd3, 0xaa, 0xd1, 0x70, 0x7b, 0xb1, 0xb2, 0xca, 0x19, 0xec,
-	0x37, 0x0d, 0x4b, 0x4e, 0xda, 0xdd, 0x5b, 0xbc, 0x1f, 0xc5, 0x5d, 0x2d, 0x15, 0x96, 0x42, 0xbf,
-	0x71, 0xcd, 0x64, 0xd4, 0xe1, 0x00, 0x07, 0x3d, 0xf9, 0xa7, 0x47, 0xc8, 0x3b, 0x08, 0xd6, 0x92,
-	0x90, 0xbb, 0xdd, 0xd7, 0x11, 0xdd, 0xfb, 0x6b, 0xdd, 0xd1, 0x4e, 0x9f, 0x7c, 0x9a, 0x2e, 0x38,
-	0x2e, 0xed, 0x3c, 0xc9, 0xd4, 0x97, 0x89, 0xe0, 0x8b, 0x25, 0x4a, 0x2e, 0x17, 0x92, 0xe1, 0x37,
-	0xa5, 0x57, 0x13, 0x21, 0x2f, 0x26, 0x42, 0xb6, 0x9e, 0x16, 0x9d, 0x67, 0xf3, 0x5e, 0xf9, 0xbc,
-	0x3c, 0xfe, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xb1, 0x4e, 0x74, 0x27, 0x7e, 0x04, 0x00, 0x00,
+	proto.RegisterFile("autopilotrpc/autopilot.proto", fileDescriptor_autopilot_f6d63b5d7b6ce5f4)
+}
+
+var fileDescriptor_autopilot_f6d63b5d7b6ce5f4 = []byte{
+	// 721 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0x49, 0x9a, 0x26, 0x93, 0x36, 0x09, 0xdb, 0xaa, 0x32, 0x6e, 0x04, 0xa9, 0x41, 0x10,
+	0x21, 0x91, 0x40, 0x5a, 0x89, 0x1f, 0x89, 0x43, 0xff, 0x10, 0x55, 0x0b, 0x94, 0x4d, 0x2b, 0x24,
+	0x2e, 0xd1, 0xc6, 0xd9, 0x26, 0x56, 0x9d, 0xb5, 0xf1, 0xae, 0x5b, 0xf9, 0x85, 0xb8, 0x72, 0xe3,
+	0xce, 0xd3, 0xf0, 0x0a, 0x1c, 0x91, 0xbd, 0x76, 0x62, 0x9b, 0x34, 0x08, 0x89, 0x9b, 0xbf, 0x6f,
+	0x66, 0xbf, 0xd9, 0x99, 0x9d, 0x19, 0x43, 0x83, 0x78, 0xc2, 0x76, 0x4c, 0xcb, 0x16, 0xae, 0x63,
+	0x74, 0xa6, 0xa0, 0xed, 0xb8, 0xb6, 0xb0, 0xd1, 0x4a, 0xd2, 0xaa, 0xd7, 0x60, 0xb5, 0x27, 0x88,
+	0xf0, 0x38, 0xa6, 0x5f, 0x3c, 0xca, 0x85, 0xde, 0x82, 0x6a, 0x4c, 0x70, 0xc7, 0x66, 0x9c, 0xa2,
+	0x0d, 0x28, 0x12, 0x43, 0x98, 0x57, 0x54, 0x55, 0x9a, 0x4a, 0xab, 0x84, 0x23, 0xa4, 0x3f, 0x81,
+	0xb5, 0x77, 0xf6, 0xd0, 0xbc, 0xf0, 0x53, 0x02, 0x81, 0x3b, 0x65, 0x64, 0x60, 0x4d, 0xdd, 0x25,
+	0xd2, 0x37, 0x60, 0x3d, 0xed, 0x2e, 0xe5, 0xf5, 0x33, 0x40, 0x1f, 0x3d, 0xea, 0xfa, 0x3d, 0xc3,
+	0x76, 0xe9, 0x54, 0x45, 0x85, 0x65, 0xc7, 0x1b, 0x5c, 0x52, 0x9f, 0xab, 0x4a, 0x33, 0xdf, 0x2a,
+	0xe3, 0x18, 0xa2, 0x07, 0x80, 0xcc, 0x11, 0xb3, 0x5d, 0xda, 0xb7, 0x6c, 0x83, 0x58, 0x7d, 0x2e,
+	0x88, 0xa0, 0x6a, 0x2e, 0x8c, 0x55, 0x62, 0xb6, 0xc4, 0xfa, 0xd7, 0x1c, 0xac, 0xa5, 0x64, 0xa3,
+	0x64, 0x8e, 0x61, 0xd9, 0xa5, 0xdc, 0xb3, 0x84, 0xd4, 0xad, 0x74, 0x9f, 0xb5, 0x93, 0xf5, 0x68,
+	0xcf, 0x39, 0xd3, 0x7e, 0x4b, 0x3d, 0xd7, 0xe4, 0xc2, 0x34, 0x70, 0x78, 0x12, 0xc7, 0x0a, 0xda,
+	0x0f, 0x05, 0x6a, 0x19, 0x23, 0x6a, 0x40, 0x79, 0x1c, 0x53, 0x61, 0x05, 0xca, 0x78, 0x46, 0xa0,
+	0x73, 0x28, 0xf2, 0x50, 0x5c, 0xcd, 0x85, 0xd1, 0x5f, 0xff, 0x73, 0xf4, 0xb6, 0x34, 0x1f, 0x32,
+	0xe1, 0xfa, 0x38, 0x12, 0xd3, 0x5e, 0x42, 0x25, 0x41, 0xa3, 0x3a, 0xe4, 0x2f, 0xa9, 0x1f, 0x45,
+	0x0f, 0x3e, 0xd1, 0x3a, 0x2c, 0x5d, 0x11, 0xcb, 0x93, 0x75, 0x52, 0xb0, 0x04, 0xaf, 0x72, 0x2f,
+	0x14, 0xfd, 0x9b, 0x02, 0xf5, 0x1e, 0x15, 0xe9, 0xea, 0x2f, 0x4e, 0x62, 0x2f, 0x93, 0xc4, 0xe3,
+	0x74, 0x12, 0x59, 0xb5, 0xff, 0x7d, 0xe3, 0x35, 0xb8, 0x9d, 0x08, 0x11, 0x75, 0x51, 0x0d, 0x56,
+	0x0f, 0x5c, 0x1f, 0x7b, 0x2c, 0xee, 0xe3, 0xef, 0x0a, 0x54, 0x63, 0x26, 0x7a, 0xfb, 0x87, 0x50,
+	0xbd, 0x26, 0x96, 0x45, 0x45, 0x7f, 0x40, 0x2c, 0xc2, 0x0c, 0xd9, 0xa1, 0x79, 0x9c, 0x61, 0x51,
+	0x0b, 0x6a, 0xe4, 0x8a, 0x98, 0x56, 0xd0, 0xb6, 0xfd, 0x0b, 0x8f, 0x0d, 0x79, 0x78, 0x89, 0x3c,
+	0xce, 0xd2, 0x41, 0x9d, 0x98, 0x37, 0xe9, 0x1b, 0x63, 0xc2, 0xb8, 0x9a, 0x6f, 0x2a, 0xad, 0x55,
+	0x3c, 0x23, 0xd0, 0x0e, 0x94, 0x87, 0xd4, 0x30, 0xb9, 0x69, 0x33, 0xae, 0x16, 0xc2, 0x52, 0x6d,
+	0xa4, 0x4b, 0x75, 0x10, 0x99, 0xf1, 0xcc, 0x51, 0xdf, 0x84, 0x3b, 0x3d, 0x6f, 0xc0, 0x0d, 0xd7,
+	0x1c, 0xd0, 0xd8, 0x3e, 0x9d, 0xce, 0x5f, 0x0a, 0x94, 0x62, 0x12, 0x3d, 0x87, 0x82, 0xf0, 0x1d,
+	0x99, 0x45, 0xb5, 0x7b, 0x7f, 0xbe, 0xf4, 0xf4, 0xe3, 0xcc, 0x77, 0x28, 0x0e, 0x0f, 0x04, 0xd7,
+	0x16, 0xe6, 0x84, 0x72, 0x41, 0x26, 0x4e, 0x94, 0xda, 0x8c, 0x08, 0x06, 0x58, 0xce, 0x5a, 0x98,
+	0x51, 0x19, 0x47, 0x08, 0x69, 0x50, 0x0a, 0xf2, 0xea, 0x93, 0x89, 0x50, 0x0b, 0xe1, 0xa1, 0x29,
+	0x0e, 0x5e, 0x2b, 0x7c, 0x58, 0x75, 0x49, 0xbe, 0x56, 0x08, 0x02, 0x25, 0x97, 0x12, 0x6e, 0x33,
+	0xb5, 0x28, 0x95, 0x24, 0xd2, 0xb7, 0x61, 0x25, 0x79, 0x2b, 0x54, 0x82, 0xc2, 0x87, 0xd3, 0xc3,
+	0xf7, 0xf5, 0x5b, 0xc1, 0x57, 0xef, 0xf8, 0xe8, 0xb4, 0xae, 0xa0, 0x1a, 0x54, 0x02, 0xae, 0xff,
+	0x66, 0xf7, 0xe8, 0xe4, 0xf0, 0xa0, 0x9e, 0xeb, 0xfe, 0xcc, 0x43, 0x79, 0x37, 0xce, 0x10, 0xed,
+	0x43, 0x51, 0xee, 0x11, 0xb4, 0x99, 0xe9, 0xbe, 0xe4, 0x32, 0xd2, 0x1a, 0xf3, 0x8d, 0x51, 0x43,
+	0x9c, 0xc3, 0x4a, 0x72, 0x25, 0xa1, 0xad, 0xb4, 0xf7, 0x9c, 0xed, 0xa6, 0xe9, 0x8b, 0x5c, 0x22,
+	0x59, 0x0c, 0x95, 0xc4, 0x20, 0xa3, 0xe6, 0x82, 0x19, 0x97, 0xa2, 0x5b, 0x7f, 0xdd, 0x02, 0xe8,
+	0x04, 0xca, 0xd3, 0xa6, 0x47, 0x77, 0x17, 0x0f, 0x9c, 0x76, 0xef, 0x46, 0x7b, 0xa4, 0xb6, 0x0f,
+	0x45, 0x39, 0x1b, 0xd9, 0xea, 0xa5, 0x66, 0x48, 0x6b, 0xcc, 0x37, 0x46, 0x22, 0x9f, 0x00, 0xfd,
+	0xd9, 0xa8, 0xe8, 0x51, 0x26, 0xf6, 0x4d, 0xad, 0xac, 0xdd, 0x30, 0x0a, 0x4f, 0x95, 0xbd, 0x9d,
+	0xcf, 0xdd, 0x91, 0x29, 0xc6, 0xde, 0xa0, 0x6d, 0xd8, 0x93, 0x8e, 0x65, 0x8e, 0xc6, 0x82, 0x99,
+	0x6c, 0xc4, 0xa8, 0xb8, 0xb6, 0xdd, 0xcb, 0x8e, 0xc5, 0x86, 0x1d, 0x8b, 0xa5, 0x7e, 0x6d, 0xae,
+	0x63, 0x0c, 0x8a, 0xe1, 0xef, 0x6d, 0xfb, 0xf7, 0x00, 0xb8, 0xd7, 0x08, 0x96, 0xfe, 0x06, 0x00,
+	0x00,
 }
@@ -5,6 +5,7 @@ package autopilotrpc
 import (
 	"context"
 	"encoding/hex"
+	"fmt"
 	"sync/atomic"
 
 	"github.com/btcsuite/btcd/btcec"
@@ -47,6 +48,14 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/autopilotrpc.Autopilot/DryRun": {{
+			Entity: "info",
+			Action: "read",
+		}},
+		"/autopilotrpc.Autopilot/SubscribeDecisions": {{
+			Entity: "info",
+			Action: "read",
+		}},
 	}
 )
 
@@ -242,3 +251,103 @@ func (s *Server) SetScores(ctx context.Context,
 
 	return &SetScoresResponse{}, nil
 }
+
+// DryRun runs the autopilot heuristics and constraints against the current
+// channel graph and wallet balance, and returns the decisions the agent would
+// make without opening any channels.
+//
+// NOTE: Part of the AutopilotServer interface.
+func (s *Server) DryRun(ctx context.Context,
+	in *DryRunRequest) (*DryRunResponse, error) {
+
+	result, err := s.manager.DryRun()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &DryRunResponse{
+		WalletBalance:  int64(result.WalletBalance),
+		AvailableFunds: int64(result.AvailableFunds),
+		NumChans:       result.NumChans,
+	}
+	for _, d := range result.Decisions {
+		decision, err := marshallDecision(d)
+		if err != nil {
+			return nil, err
+		}
+		resp.Decisions = append(resp.Decisions, decision)
+	}
+
+	return resp, nil
+}
+
+// SubscribeDecisions returns a uni-directional stream (server -> client) of
+// the decisions made by the running autopilot agent.
+//
+// NOTE: Part of the AutopilotServer interface.
+func (s *Server) SubscribeDecisions(in *SubscribeDecisionsRequest,
+	updateStream Autopilot_SubscribeDecisionsServer) error {
+
+	decisionSub, err := s.manager.SubscribeDecisions()
+	if err != nil {
+		return err
+	}
+
+	// Ensure that the resources for the client is cleaned up once either
+	// the server, or client exits.
+	defer decisionSub.Cancel()
+
+	for {
+		select {
+		case update := <-decisionSub.Updates():
+			d, ok := update.(*autopilot.Decision)
+			if !ok {
+				return fmt.Errorf("unexpected decision type: "+
+					"%T", update)
+			}
+
+			decision, err := marshallDecision(d)
+			if err != nil {
+				return err
+			}
+
+			if err := updateStream.Send(decision); err != nil {
+				return err
+			}
+
+		case <-decisionSub.Quit():
+			return nil
+
+		case <-updateStream.Context().Done():
+			return updateStream.Context().Err()
+		}
+	}
+}
+
+// marshallDecision converts an autopilot decision into its RPC counterpart.
+func marshallDecision(d *autopilot.Decision) (*Decision, error) {
+	var decisionType Decision_DecisionType
+	switch d.Type {
+	case autopilot.DecisionOpen:
+		decisionType = Decision_OPEN
+	case autopilot.DecisionSkip:
+		decisionType = Decision_SKIP
+	case autopilot.DecisionOpenFailed:
+		decisionType = Decision_OPEN_FAILED
+	default:
+		return nil, fmt.Errorf("unknown decision type: %v", d.Type)
+	}
+
+	decision := &Decision{
+		Type:      decisionType,
+		Timestamp: d.Timestamp.Unix(),
+		ChanAmt:   int64(d.ChanAmt),
+		Score:     d.Score,
+		Reason:    d.Reason,
+	}
+	if d.HasNode() {
+		decision.Pubkey = hex.EncodeToString(d.NodeID[:])
+	}
+
+	return decision, nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
 )
 
 const (
@@ -256,6 +257,18 @@ func (s *Server) AddHoldInvoice(ctx context.Context,
 		ChanDB:            s.cfg.ChanDB,
 	}
 
+	// Make sure the invoice amount is permitted by the caveats of the
+	// caller's macaroon.
+	if s.cfg.MacService != nil {
+		amtMsat := lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(invoice.Value),
+		)
+		err := s.cfg.MacService.CheckInvoiceAmount(ctx, uint64(amtMsat))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	hash, err := lntypes.MakeHash(invoice.Hash)
 	if err != nil {
 		return nil, err
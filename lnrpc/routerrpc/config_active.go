@@ -4,6 +4,7 @@ package routerrpc
 
 import (
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/macaroons"
 	"github.com/lightningnetwork/lnd/routing"
 )
@@ -44,4 +45,8 @@ type Config struct {
 	// RouterBackend contains shared logic between this sub server and the
 	// main rpc server.
 	RouterBackend *RouterBackend
+
+	// HtlcNotifier is the notifier which htlc events are piped through,
+	// which are served by the SubscribeHtlcEvents stream.
+	HtlcNotifier *htlcswitch.HtlcNotifier
 }
@@ -1,11 +1,12 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // source: routerrpc/router.proto
 
-package routerrpc
+package routerrpc // import "github.com/lightningnetwork/lnd/lnrpc/routerrpc"
 
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import lnrpc "github.com/lightningnetwork/lnd/lnrpc"
 
 import (
 	context "golang.org/x/net/context"
@@ -23,6 +24,35 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type HtlcEvent_EventType int32
+
+const (
+	HtlcEvent_UNKNOWN HtlcEvent_EventType = 0
+	HtlcEvent_SEND    HtlcEvent_EventType = 1
+	HtlcEvent_RECEIVE HtlcEvent_EventType = 2
+	HtlcEvent_FORWARD HtlcEvent_EventType = 3
+)
+
+var HtlcEvent_EventType_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "SEND",
+	2: "RECEIVE",
+	3: "FORWARD",
+}
+var HtlcEvent_EventType_value = map[string]int32{
+	"UNKNOWN": 0,
+	"SEND":    1,
+	"RECEIVE": 2,
+	"FORWARD": 3,
+}
+
+func (x HtlcEvent_EventType) String() string {
+	return proto.EnumName(HtlcEvent_EventType_name, int32(x))
+}
+func (HtlcEvent_EventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_router_7d9896ab276e1e4b, []int{5, 0}
+}
+
 type PaymentRequest struct {
 	// *
 	// A serialized BOLT-11 payment request that contains all information
@@ -49,17 +79,30 @@ type PaymentRequest struct {
 	// *
 	// The channel id of the channel that must be taken to the first hop. If zero,
 	// any channel may be used.
-	OutgoingChannelId    int64    `protobuf:"varint,5,opt,name=outgoing_channel_id,json=outgoingChannelId,proto3" json:"outgoing_channel_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	OutgoingChannelId int64 `protobuf:"varint,5,opt,name=outgoing_channel_id,json=outgoingChannelId,proto3" json:"outgoing_channel_id,omitempty"`
+	// *
+	// The pubkey of the last hop of the route. If empty, any hop may be used.
+	LastHopPubkey []byte `protobuf:"bytes,6,opt,name=last_hop_pubkey,json=lastHopPubkey,proto3" json:"last_hop_pubkey,omitempty"`
+	// *
+	// A list of nodes to ignore during path finding.
+	IgnoredNodes [][]byte `protobuf:"bytes,7,rep,name=ignored_nodes,json=ignoredNodes,proto3" json:"ignored_nodes,omitempty"`
+	// *
+	// A list of edges to ignore during path finding.
+	IgnoredEdges []*lnrpc.EdgeLocator `protobuf:"bytes,8,rep,name=ignored_edges,json=ignoredEdges,proto3" json:"ignored_edges,omitempty"`
+	// *
+	// A list of directional node pairs for which path finding must not use any
+	// of the channels between them in the given direction.
+	IgnoredPairs         []*lnrpc.NodePair `protobuf:"bytes,9,rep,name=ignored_pairs,json=ignoredPairs,proto3" json:"ignored_pairs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *PaymentRequest) Reset()         { *m = PaymentRequest{} }
 func (m *PaymentRequest) String() string { return proto.CompactTextString(m) }
 func (*PaymentRequest) ProtoMessage()    {}
 func (*PaymentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_router_e218e7c710fe8172, []int{0}
+	return fileDescriptor_router_7d9896ab276e1e4b, []int{0}
 }
 func (m *PaymentRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentRequest.Unmarshal(m, b)
@@ -114,6 +157,34 @@ func (m *PaymentRequest) GetOutgoingChannelId() int64 {
 	return 0
 }
 
+func (m *PaymentRequest) GetLastHopPubkey() []byte {
+	if m != nil {
+		return m.LastHopPubkey
+	}
+	return nil
+}
+
+func (m *PaymentRequest) GetIgnoredNodes() [][]byte {
+	if m != nil {
+		return m.IgnoredNodes
+	}
+	return nil
+}
+
+func (m *PaymentRequest) GetIgnoredEdges() []*lnrpc.EdgeLocator {
+	if m != nil {
+		return m.IgnoredEdges
+	}
+	return nil
+}
+
+func (m *PaymentRequest) GetIgnoredPairs() []*lnrpc.NodePair {
+	if m != nil {
+		return m.IgnoredPairs
+	}
+	return nil
+}
+
 type PaymentResponse struct {
 	// *
 	// The payment hash that we paid to. Provided so callers are able to map
@@ -134,7 +205,7 @@ func (m *PaymentResponse) Reset()         { *m = PaymentResponse{} }
 func (m *PaymentResponse) String() string { return proto.CompactTextString(m) }
 func (*PaymentResponse) ProtoMessage()    {}
 func (*PaymentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_router_e218e7c710fe8172, []int{1}
+	return fileDescriptor_router_7d9896ab276e1e4b, []int{1}
 }
 func (m *PaymentResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentResponse.Unmarshal(m, b)
@@ -191,7 +262,7 @@ func (m *RouteFeeRequest) Reset()         { *m = RouteFeeRequest{} }
 func (m *RouteFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*RouteFeeRequest) ProtoMessage()    {}
 func (*RouteFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_router_e218e7c710fe8172, []int{2}
+	return fileDescriptor_router_7d9896ab276e1e4b, []int{2}
 }
 func (m *RouteFeeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteFeeRequest.Unmarshal(m, b)
@@ -244,7 +315,7 @@ func (m *RouteFeeResponse) Reset()         { *m = RouteFeeResponse{} }
 func (m *RouteFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*RouteFeeResponse) ProtoMessage()    {}
 func (*RouteFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_router_e218e7c710fe8172, []int{3}
+	return fileDescriptor_router_7d9896ab276e1e4b, []int{3}
 }
 func (m *RouteFeeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteFeeResponse.Unmarshal(m, b)
@@ -278,164 +349,1717 @@ func (m *RouteFeeResponse) GetTimeLockDelay() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*PaymentRequest)(nil), "routerrpc.PaymentRequest")
-	proto.RegisterType((*PaymentResponse)(nil), "routerrpc.PaymentResponse")
-	proto.RegisterType((*RouteFeeRequest)(nil), "routerrpc.RouteFeeRequest")
-	proto.RegisterType((*RouteFeeResponse)(nil), "routerrpc.RouteFeeResponse")
+type SubscribeHtlcEventsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *SubscribeHtlcEventsRequest) Reset()         { *m = SubscribeHtlcEventsRequest{} }
+func (m *SubscribeHtlcEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeHtlcEventsRequest) ProtoMessage()    {}
+func (*SubscribeHtlcEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_7d9896ab276e1e4b, []int{4}
+}
+func (m *SubscribeHtlcEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeHtlcEventsRequest.Unmarshal(m, b)
+}
+func (m *SubscribeHtlcEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeHtlcEventsRequest.Marshal(b, m, deterministic)
+}
+func (dst *SubscribeHtlcEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeHtlcEventsRequest.Merge(dst, src)
+}
+func (m *SubscribeHtlcEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeHtlcEventsRequest.Size(m)
+}
+func (m *SubscribeHtlcEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeHtlcEventsRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_SubscribeHtlcEventsRequest proto.InternalMessageInfo
 
-// RouterClient is the client API for Router service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type RouterClient interface {
+// *
+// HtlcEvent contains the htlc event that was processed. These are served on a
+// best-effort basis; events are not persisted, delivery is not guaranteed
+// (in the event of a crash in the switch, forward events may be lost) and
+// some events may be replayed upon restart. Events consumed from this package
+// should be de-duplicated by the htlc's unique combination of incoming and
+// outgoing channel id and htlc id.
+type HtlcEvent struct {
 	// *
-	// SendPayment attempts to route a payment described by the passed
-	// PaymentRequest to the final destination. If we are unable to route the
-	// payment, or cannot find a route that satisfies the constraints in the
-	// PaymentRequest, then an error will be returned. Otherwise, the payment
-	// pre-image, along with the final route will be returned.
-	SendPayment(ctx context.Context, in *PaymentRequest, opts ...grpc.CallOption) (*PaymentResponse, error)
+	// The short channel id that the incoming htlc arrived at our node on. This
+	// value is zero for sends.
+	IncomingChannelId uint64 `protobuf:"varint,1,opt,name=incoming_channel_id,json=incomingChannelId,proto3" json:"incoming_channel_id,omitempty"`
 	// *
-	// EstimateRouteFee allows callers to obtain a lower bound w.r.t how much it
-	// may cost to send an HTLC to the target end destination.
-	EstimateRouteFee(ctx context.Context, in *RouteFeeRequest, opts ...grpc.CallOption) (*RouteFeeResponse, error)
+	// The short channel id that the outgoing htlc left our node on. This value
+	// is zero for receives.
+	OutgoingChannelId uint64 `protobuf:"varint,2,opt,name=outgoing_channel_id,json=outgoingChannelId,proto3" json:"outgoing_channel_id,omitempty"`
+	// *
+	// Incoming id is the index of the incoming htlc in the incoming channel.
+	// This value is zero for sends.
+	IncomingHtlcId uint64 `protobuf:"varint,3,opt,name=incoming_htlc_id,json=incomingHtlcId,proto3" json:"incoming_htlc_id,omitempty"`
+	// *
+	// Outgoing id is the index of the outgoing htlc in the outgoing channel.
+	// This value is zero for receives, and for htlcs that were failed before
+	// they were added to the outgoing channel.
+	OutgoingHtlcId uint64 `protobuf:"varint,4,opt,name=outgoing_htlc_id,json=outgoingHtlcId,proto3" json:"outgoing_htlc_id,omitempty"`
+	// *
+	// The time in unix nanoseconds that the event occurred.
+	TimestampNs uint64 `protobuf:"varint,5,opt,name=timestamp_ns,json=timestampNs,proto3" json:"timestamp_ns,omitempty"`
+	// *
+	// The event type indicates whether the htlc was part of a send, receive or
+	// forward.
+	EventType HtlcEvent_EventType `protobuf:"varint,6,opt,name=event_type,json=eventType,proto3,enum=routerrpc.HtlcEvent_EventType" json:"event_type,omitempty"`
+	// Types that are valid to be assigned to Event:
+	//	*HtlcEvent_ForwardEvent
+	//	*HtlcEvent_ForwardFailEvent
+	//	*HtlcEvent_SettleEvent
+	//	*HtlcEvent_LinkFailEvent
+	Event                isHtlcEvent_Event `protobuf_oneof:"event"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-type routerClient struct {
-	cc *grpc.ClientConn
+func (m *HtlcEvent) Reset()         { *m = HtlcEvent{} }
+func (m *HtlcEvent) String() string { return proto.CompactTextString(m) }
+func (*HtlcEvent) ProtoMessage()    {}
+func (*HtlcEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_7d9896ab276e1e4b, []int{5}
+}
+func (m *HtlcEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HtlcEvent.Unmarshal(m, b)
+}
+func (m *HtlcEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HtlcEvent.Marshal(b, m, deterministic)
+}
+func (dst *HtlcEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HtlcEvent.Merge(dst, src)
+}
+func (m *HtlcEvent) XXX_Size() int {
+	return xxx_messageInfo_HtlcEvent.Size(m)
+}
+func (m *HtlcEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_HtlcEvent.DiscardUnknown(m)
 }
 
-func NewRouterClient(cc *grpc.ClientConn) RouterClient {
-	return &routerClient{cc}
+var xxx_messageInfo_HtlcEvent proto.InternalMessageInfo
+
+func (m *HtlcEvent) GetIncomingChannelId() uint64 {
+	if m != nil {
+		return m.IncomingChannelId
+	}
+	return 0
 }
 
-func (c *routerClient) SendPayment(ctx context.Context, in *PaymentRequest, opts ...grpc.CallOption) (*PaymentResponse, error) {
-	out := new(PaymentResponse)
-	err := c.cc.Invoke(ctx, "/routerrpc.Router/SendPayment", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *HtlcEvent) GetOutgoingChannelId() uint64 {
+	if m != nil {
+		return m.OutgoingChannelId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *routerClient) EstimateRouteFee(ctx context.Context, in *RouteFeeRequest, opts ...grpc.CallOption) (*RouteFeeResponse, error) {
-	out := new(RouteFeeResponse)
-	err := c.cc.Invoke(ctx, "/routerrpc.Router/EstimateRouteFee", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *HtlcEvent) GetIncomingHtlcId() uint64 {
+	if m != nil {
+		return m.IncomingHtlcId
 	}
-	return out, nil
+	return 0
 }
 
-// RouterServer is the server API for Router service.
-type RouterServer interface {
-	// *
-	// SendPayment attempts to route a payment described by the passed
-	// PaymentRequest to the final destination. If we are unable to route the
-	// payment, or cannot find a route that satisfies the constraints in the
-	// PaymentRequest, then an error will be returned. Otherwise, the payment
-	// pre-image, along with the final route will be returned.
-	SendPayment(context.Context, *PaymentRequest) (*PaymentResponse, error)
-	// *
-	// EstimateRouteFee allows callers to obtain a lower bound w.r.t how much it
-	// may cost to send an HTLC to the target end destination.
-	EstimateRouteFee(context.Context, *RouteFeeRequest) (*RouteFeeResponse, error)
+func (m *HtlcEvent) GetOutgoingHtlcId() uint64 {
+	if m != nil {
+		return m.OutgoingHtlcId
+	}
+	return 0
 }
 
-func RegisterRouterServer(s *grpc.Server, srv RouterServer) {
-	s.RegisterService(&_Router_serviceDesc, srv)
+func (m *HtlcEvent) GetTimestampNs() uint64 {
+	if m != nil {
+		return m.TimestampNs
+	}
+	return 0
 }
 
-func _Router_SendPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PaymentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *HtlcEvent) GetEventType() HtlcEvent_EventType {
+	if m != nil {
+		return m.EventType
 	}
-	if interceptor == nil {
-		return srv.(RouterServer).SendPayment(ctx, in)
+	return HtlcEvent_UNKNOWN
+}
+
+type isHtlcEvent_Event interface {
+	isHtlcEvent_Event()
+}
+
+type HtlcEvent_ForwardEvent struct {
+	ForwardEvent *ForwardEvent `protobuf:"bytes,7,opt,name=forward_event,json=forwardEvent,proto3,oneof"`
+}
+
+type HtlcEvent_ForwardFailEvent struct {
+	ForwardFailEvent *ForwardFailEvent `protobuf:"bytes,8,opt,name=forward_fail_event,json=forwardFailEvent,proto3,oneof"`
+}
+
+type HtlcEvent_SettleEvent struct {
+	SettleEvent *SettleEvent `protobuf:"bytes,9,opt,name=settle_event,json=settleEvent,proto3,oneof"`
+}
+
+type HtlcEvent_LinkFailEvent struct {
+	LinkFailEvent *LinkFailEvent `protobuf:"bytes,10,opt,name=link_fail_event,json=linkFailEvent,proto3,oneof"`
+}
+
+func (*HtlcEvent_ForwardEvent) isHtlcEvent_Event() {}
+
+func (*HtlcEvent_ForwardFailEvent) isHtlcEvent_Event() {}
+
+func (*HtlcEvent_SettleEvent) isHtlcEvent_Event() {}
+
+func (*HtlcEvent_LinkFailEvent) isHtlcEvent_Event() {}
+
+func (m *HtlcEvent) GetEvent() isHtlcEvent_Event {
+	if m != nil {
+		return m.Event
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/routerrpc.Router/SendPayment",
+	return nil
+}
+
+func (m *HtlcEvent) GetForwardEvent() *ForwardEvent {
+	if x, ok := m.GetEvent().(*HtlcEvent_ForwardEvent); ok {
+		return x.ForwardEvent
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouterServer).SendPayment(ctx, req.(*PaymentRequest))
+	return nil
+}
+
+func (m *HtlcEvent) GetForwardFailEvent() *ForwardFailEvent {
+	if x, ok := m.GetEvent().(*HtlcEvent_ForwardFailEvent); ok {
+		return x.ForwardFailEvent
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Router_EstimateRouteFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RouteFeeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *HtlcEvent) GetSettleEvent() *SettleEvent {
+	if x, ok := m.GetEvent().(*HtlcEvent_SettleEvent); ok {
+		return x.SettleEvent
 	}
-	if interceptor == nil {
-		return srv.(RouterServer).EstimateRouteFee(ctx, in)
+	return nil
+}
+
+func (m *HtlcEvent) GetLinkFailEvent() *LinkFailEvent {
+	if x, ok := m.GetEvent().(*HtlcEvent_LinkFailEvent); ok {
+		return x.LinkFailEvent
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/routerrpc.Router/EstimateRouteFee",
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*HtlcEvent) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _HtlcEvent_OneofMarshaler, _HtlcEvent_OneofUnmarshaler, _HtlcEvent_OneofSizer, []interface{}{
+		(*HtlcEvent_ForwardEvent)(nil),
+		(*HtlcEvent_ForwardFailEvent)(nil),
+		(*HtlcEvent_SettleEvent)(nil),
+		(*HtlcEvent_LinkFailEvent)(nil),
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouterServer).EstimateRouteFee(ctx, req.(*RouteFeeRequest))
+}
+
+func _HtlcEvent_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*HtlcEvent)
+	// event
+	switch x := m.Event.(type) {
+	case *HtlcEvent_ForwardEvent:
+		b.EncodeVarint(7<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ForwardEvent); err != nil {
+			return err
+		}
+	case *HtlcEvent_ForwardFailEvent:
+		b.EncodeVarint(8<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ForwardFailEvent); err != nil {
+			return err
+		}
+	case *HtlcEvent_SettleEvent:
+		b.EncodeVarint(9<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SettleEvent); err != nil {
+			return err
+		}
+	case *HtlcEvent_LinkFailEvent:
+		b.EncodeVarint(10<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.LinkFailEvent); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("HtlcEvent.Event has unexpected type %T", x)
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Router_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "routerrpc.Router",
-	HandlerType: (*RouterServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SendPayment",
-			Handler:    _Router_SendPayment_Handler,
-		},
-		{
-			MethodName: "EstimateRouteFee",
-			Handler:    _Router_EstimateRouteFee_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "routerrpc/router.proto",
+func _HtlcEvent_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*HtlcEvent)
+	switch tag {
+	case 7: // event.forward_event
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ForwardEvent)
+		err := b.DecodeMessage(msg)
+		m.Event = &HtlcEvent_ForwardEvent{msg}
+		return true, err
+	case 8: // event.forward_fail_event
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ForwardFailEvent)
+		err := b.DecodeMessage(msg)
+		m.Event = &HtlcEvent_ForwardFailEvent{msg}
+		return true, err
+	case 9: // event.settle_event
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(SettleEvent)
+		err := b.DecodeMessage(msg)
+		m.Event = &HtlcEvent_SettleEvent{msg}
+		return true, err
+	case 10: // event.link_fail_event
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(LinkFailEvent)
+		err := b.DecodeMessage(msg)
+		m.Event = &HtlcEvent_LinkFailEvent{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _HtlcEvent_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*HtlcEvent)
+	// event
+	switch x := m.Event.(type) {
+	case *HtlcEvent_ForwardEvent:
+		s := proto.Size(x.ForwardEvent)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *HtlcEvent_ForwardFailEvent:
+		s := proto.Size(x.ForwardFailEvent)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *HtlcEvent_SettleEvent:
+		s := proto.Size(x.SettleEvent)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *HtlcEvent_LinkFailEvent:
+		s := proto.Size(x.LinkFailEvent)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type HtlcInfo struct {
+	// / The timelock on the incoming htlc.
+	IncomingTimelock uint32 `protobuf:"varint,1,opt,name=incoming_timelock,json=incomingTimelock,proto3" json:"incoming_timelock,omitempty"`
+	// / The timelock on the outgoing htlc.
+	OutgoingTimelock uint32 `protobuf:"varint,2,opt,name=outgoing_timelock,json=outgoingTimelock,proto3" json:"outgoing_timelock,omitempty"`
+	// / The amount of the incoming htlc.
+	IncomingAmtMsat uint64 `protobuf:"varint,3,opt,name=incoming_amt_msat,json=incomingAmtMsat,proto3" json:"incoming_amt_msat,omitempty"`
+	// / The amount of the outgoing htlc.
+	OutgoingAmtMsat      uint64   `protobuf:"varint,4,opt,name=outgoing_amt_msat,json=outgoingAmtMsat,proto3" json:"outgoing_amt_msat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HtlcInfo) Reset()         { *m = HtlcInfo{} }
+func (m *HtlcInfo) String() string { return proto.CompactTextString(m) }
+func (*HtlcInfo) ProtoMessage()    {}
+func (*HtlcInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_7d9896ab276e1e4b, []int{6}
+}
+func (m *HtlcInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HtlcInfo.Unmarshal(m, b)
+}
+func (m *HtlcInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HtlcInfo.Marshal(b, m, deterministic)
+}
+func (dst *HtlcInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HtlcInfo.Merge(dst, src)
+}
+func (m *HtlcInfo) XXX_Size() int {
+	return xxx_messageInfo_HtlcInfo.Size(m)
+}
+func (m *HtlcInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_HtlcInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HtlcInfo proto.InternalMessageInfo
+
+func (m *HtlcInfo) GetIncomingTimelock() uint32 {
+	if m != nil {
+		return m.IncomingTimelock
+	}
+	return 0
+}
+
+func (m *HtlcInfo) GetOutgoingTimelock() uint32 {
+	if m != nil {
+		return m.OutgoingTimelock
+	}
+	return 0
+}
+
+func (m *HtlcInfo) GetIncomingAmtMsat() uint64 {
+	if m != nil {
+		return m.IncomingAmtMsat
+	}
+	return 0
+}
+
+func (m *HtlcInfo) GetOutgoingAmtMsat() uint64 {
+	if m != nil {
+		return m.OutgoingAmtMsat
+	}
+	return 0
+}
+
+type ForwardEvent struct {
+	// / Info contains details about the htlc that was forwarded.
+	Info                 *HtlcInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ForwardEvent) Reset()         { *m = ForwardEvent{} }
+func (m *ForwardEvent) String() string { return proto.CompactTextString(m) }
+func (*ForwardEvent) ProtoMessage()    {}
+func (*ForwardEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_7d9896ab276e1e4b, []int{7}
+}
+func (m *ForwardEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardEvent.Unmarshal(m, b)
+}
+func (m *ForwardEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardEvent.Marshal(b, m, deterministic)
+}
+func (dst *ForwardEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardEvent.Merge(dst, src)
+}
+func (m *ForwardEvent) XXX_Size() int {
+	return xxx_messageInfo_ForwardEvent.Size(m)
+}
+func (m *ForwardEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardEvent proto.InternalMessageInfo
+
+func (m *ForwardEvent) GetInfo() *HtlcInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+type ForwardFailEvent struct {
+	// *
+	// The wire failure code returned by the downstream node. This is only set
+	// for htlcs that we sent, as the failures of forwarded htlcs are encrypted
+	// for their original sender.
+	WireFailureCode uint32 `protobuf:"varint,1,opt,name=wire_failure_code,json=wireFailureCode,proto3" json:"wire_failure_code,omitempty"`
+	// / A human readable representation of the downstream failure, if known.
+	FailureString        string   `protobuf:"bytes,2,opt,name=failure_string,json=failureString,proto3" json:"failure_string,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForwardFailEvent) Reset()         { *m = ForwardFailEvent{} }
+func (m *ForwardFailEvent) String() string { return proto.CompactTextString(m) }
+func (*ForwardFailEvent) ProtoMessage()    {}
+func (*ForwardFailEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_7d9896ab276e1e4b, []int{8}
 }
+func (m *ForwardFailEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardFailEvent.Unmarshal(m, b)
+}
+func (m *ForwardFailEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardFailEvent.Marshal(b, m, deterministic)
+}
+func (dst *ForwardFailEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardFailEvent.Merge(dst, src)
+}
+func (m *ForwardFailEvent) XXX_Size() int {
+	return xxx_messageInfo_ForwardFailEvent.Size(m)
+}
+func (m *ForwardFailEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardFailEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardFailEvent proto.InternalMessageInfo
+
+func (m *ForwardFailEvent) GetWireFailureCode() uint32 {
+	if m != nil {
+		return m.WireFailureCode
+	}
+	return 0
+}
+
+func (m *ForwardFailEvent) GetFailureString() string {
+	if m != nil {
+		return m.FailureString
+	}
+	return ""
+}
+
+type SettleEvent struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SettleEvent) Reset()         { *m = SettleEvent{} }
+func (m *SettleEvent) String() string { return proto.CompactTextString(m) }
+func (*SettleEvent) ProtoMessage()    {}
+func (*SettleEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_7d9896ab276e1e4b, []int{9}
+}
+func (m *SettleEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SettleEvent.Unmarshal(m, b)
+}
+func (m *SettleEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SettleEvent.Marshal(b, m, deterministic)
+}
+func (dst *SettleEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettleEvent.Merge(dst, src)
+}
+func (m *SettleEvent) XXX_Size() int {
+	return xxx_messageInfo_SettleEvent.Size(m)
+}
+func (m *SettleEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettleEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettleEvent proto.InternalMessageInfo
+
+type LinkFailEvent struct {
+	// / Info contains details about the htlc that we failed.
+	Info *HtlcInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	// / The wire failure code that was sent back to the htlc's sender.
+	WireFailureCode uint32 `protobuf:"varint,2,opt,name=wire_failure_code,json=wireFailureCode,proto3" json:"wire_failure_code,omitempty"`
+	// / A human readable representation of the wire failure.
+	FailureString string `protobuf:"bytes,3,opt,name=failure_string,json=failureString,proto3" json:"failure_string,omitempty"`
+	// *
+	// An optional description of why the htlc was failed, which provides more
+	// context than the wire failure.
+	FailureDetail string `protobuf:"bytes,4,opt,name=failure_detail,json=failureDetail,proto3" json:"failure_detail,omitempty"`
+	// *
+	// Whether the htlc was failed on its incoming link, or on its way out of
+	// our node.
+	Incoming             bool     `protobuf:"varint,5,opt,name=incoming,proto3" json:"incoming,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LinkFailEvent) Reset()         { *m = LinkFailEvent{} }
+func (m *LinkFailEvent) String() string { return proto.CompactTextString(m) }
+func (*LinkFailEvent) ProtoMessage()    {}
+func (*LinkFailEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_7d9896ab276e1e4b, []int{10}
+}
+func (m *LinkFailEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LinkFailEvent.Unmarshal(m, b)
+}
+func (m *LinkFailEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LinkFailEvent.Marshal(b, m, deterministic)
+}
+func (dst *LinkFailEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LinkFailEvent.Merge(dst, src)
+}
+func (m *LinkFailEvent) XXX_Size() int {
+	return xxx_messageInfo_LinkFailEvent.Size(m)
+}
+func (m *LinkFailEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_LinkFailEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LinkFailEvent proto.InternalMessageInfo
+
+func (m *LinkFailEvent) GetInfo() *HtlcInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func (m *LinkFailEvent) GetWireFailureCode() uint32 {
+	if m != nil {
+		return m.WireFailureCode
+	}
+	return 0
+}
+
+func (m *LinkFailEvent) GetFailureString() string {
+	if m != nil {
+		return m.FailureString
+	}
+	return ""
+}
+
+func (m *LinkFailEvent) GetFailureDetail() string {
+	if m != nil {
+		return m.FailureDetail
+	}
+	return ""
+}
+
+func (m *LinkFailEvent) GetIncoming() bool {
+	if m != nil {
+		return m.Incoming
+	}
+	return false
+}
+
+type RebalanceRequest struct {
+	// *
+	// The channel id of our channel that the funds are moved out of.
+	OutgoingChanId uint64 `protobuf:"varint,1,opt,name=outgoing_chan_id,json=outgoingChanId,proto3" json:"outgoing_chan_id,omitempty"`
+	// *
+	// The channel id of our channel that the funds are moved into.
+	IncomingChanId uint64 `protobuf:"varint,2,opt,name=incoming_chan_id,json=incomingChanId,proto3" json:"incoming_chan_id,omitempty"`
+	// *
+	// The amount to move, excluding fees.
+	AmtSat int64 `protobuf:"varint,3,opt,name=amt_sat,json=amtSat,proto3" json:"amt_sat,omitempty"`
+	// *
+	// An absolute limit on the total fee we're willing to pay to move the funds.
+	FeeLimitSat int64 `protobuf:"varint,4,opt,name=fee_limit_sat,json=feeLimitSat,proto3" json:"fee_limit_sat,omitempty"`
+	// *
+	// An upper limit on the amount of time we should spend when attempting to
+	// complete the rebalance. This is expressed in seconds.
+	TimeoutSeconds       int32    `protobuf:"varint,5,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RebalanceRequest) Reset()         { *m = RebalanceRequest{} }
+func (m *RebalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*RebalanceRequest) ProtoMessage()    {}
+func (*RebalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_7d9896ab276e1e4b, []int{11}
+}
+func (m *RebalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebalanceRequest.Unmarshal(m, b)
+}
+func (m *RebalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebalanceRequest.Marshal(b, m, deterministic)
+}
+func (dst *RebalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebalanceRequest.Merge(dst, src)
+}
+func (m *RebalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_RebalanceRequest.Size(m)
+}
+func (m *RebalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebalanceRequest proto.InternalMessageInfo
+
+func (m *RebalanceRequest) GetOutgoingChanId() uint64 {
+	if m != nil {
+		return m.OutgoingChanId
+	}
+	return 0
+}
+
+func (m *RebalanceRequest) GetIncomingChanId() uint64 {
+	if m != nil {
+		return m.IncomingChanId
+	}
+	return 0
+}
+
+func (m *RebalanceRequest) GetAmtSat() int64 {
+	if m != nil {
+		return m.AmtSat
+	}
+	return 0
+}
+
+func (m *RebalanceRequest) GetFeeLimitSat() int64 {
+	if m != nil {
+		return m.FeeLimitSat
+	}
+	return 0
+}
+
+func (m *RebalanceRequest) GetTimeoutSeconds() int32 {
+	if m != nil {
+		return m.TimeoutSeconds
+	}
+	return 0
+}
+
+type RebalanceRecord struct {
+	// *
+	// The channel id of our channel that the funds were moved out of.
+	OutgoingChanId uint64 `protobuf:"varint,1,opt,name=outgoing_chan_id,json=outgoingChanId,proto3" json:"outgoing_chan_id,omitempty"`
+	// *
+	// The channel id of our channel that the funds were moved into.
+	IncomingChanId uint64 `protobuf:"varint,2,opt,name=incoming_chan_id,json=incomingChanId,proto3" json:"incoming_chan_id,omitempty"`
+	// *
+	// The amount that was moved, excluding fees.
+	AmtMsat int64 `protobuf:"varint,3,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
+	// *
+	// The total fee paid to the nodes along the route.
+	FeeMsat int64 `protobuf:"varint,4,opt,name=fee_msat,json=feeMsat,proto3" json:"fee_msat,omitempty"`
+	// *
+	// The payment hash of the internal invoice that completed the rebalance.
+	PaymentHash []byte `protobuf:"bytes,5,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	// *
+	// The preimage of the internal invoice that completed the rebalance.
+	Preimage []byte `protobuf:"bytes,6,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	// *
+	// The channel ids of the route the funds took, starting with the outgoing
+	// and ending with the incoming channel.
+	Path []uint64 `protobuf:"varint,7,rep,packed,name=path,proto3" json:"path,omitempty"`
+	// *
+	// The unix timestamp in seconds at which the rebalance completed.
+	Timestamp            int64    `protobuf:"varint,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RebalanceRecord) Reset()         { *m = RebalanceRecord{} }
+func (m *RebalanceRecord) String() string { return proto.CompactTextString(m) }
+func (*RebalanceRecord) ProtoMessage()    {}
+func (*RebalanceRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_7d9896ab276e1e4b, []int{12}
+}
+func (m *RebalanceRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebalanceRecord.Unmarshal(m, b)
+}
+func (m *RebalanceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebalanceRecord.Marshal(b, m, deterministic)
+}
+func (dst *RebalanceRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebalanceRecord.Merge(dst, src)
+}
+func (m *RebalanceRecord) XXX_Size() int {
+	return xxx_messageInfo_RebalanceRecord.Size(m)
+}
+func (m *RebalanceRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebalanceRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebalanceRecord proto.InternalMessageInfo
+
+func (m *RebalanceRecord) GetOutgoingChanId() uint64 {
+	if m != nil {
+		return m.OutgoingChanId
+	}
+	return 0
+}
+
+func (m *RebalanceRecord) GetIncomingChanId() uint64 {
+	if m != nil {
+		return m.IncomingChanId
+	}
+	return 0
+}
+
+func (m *RebalanceRecord) GetAmtMsat() int64 {
+	if m != nil {
+		return m.AmtMsat
+	}
+	return 0
+}
+
+func (m *RebalanceRecord) GetFeeMsat() int64 {
+	if m != nil {
+		return m.FeeMsat
+	}
+	return 0
+}
+
+func (m *RebalanceRecord) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+func (m *RebalanceRecord) GetPreimage() []byte {
+	if m != nil {
+		return m.Preimage
+	}
+	return nil
+}
+
+func (m *RebalanceRecord) GetPath() []uint64 {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (m *RebalanceRecord) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type ListRebalancesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRebalancesRequest) Reset()         { *m = ListRebalancesRequest{} }
+func (m *ListRebalancesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRebalancesRequest) ProtoMessage()    {}
+func (*ListRebalancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_7d9896ab276e1e4b, []int{13}
+}
+func (m *ListRebalancesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRebalancesRequest.Unmarshal(m, b)
+}
+func (m *ListRebalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRebalancesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListRebalancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRebalancesRequest.Merge(dst, src)
+}
+func (m *ListRebalancesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRebalancesRequest.Size(m)
+}
+func (m *ListRebalancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRebalancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRebalancesRequest proto.InternalMessageInfo
+
+type ListRebalancesResponse struct {
+	// *
+	// All completed rebalances, in the order in which they completed.
+	Rebalances           []*RebalanceRecord `protobuf:"bytes,1,rep,name=rebalances,proto3" json:"rebalances,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ListRebalancesResponse) Reset()         { *m = ListRebalancesResponse{} }
+func (m *ListRebalancesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListRebalancesResponse) ProtoMessage()    {}
+func (*ListRebalancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_7d9896ab276e1e4b, []int{14}
+}
+func (m *ListRebalancesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRebalancesResponse.Unmarshal(m, b)
+}
+func (m *ListRebalancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRebalancesResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListRebalancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRebalancesResponse.Merge(dst, src)
+}
+func (m *ListRebalancesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListRebalancesResponse.Size(m)
+}
+func (m *ListRebalancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRebalancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRebalancesResponse proto.InternalMessageInfo
+
+func (m *ListRebalancesResponse) GetRebalances() []*RebalanceRecord {
+	if m != nil {
+		return m.Rebalances
+	}
+	return nil
+}
+
+type BuildRouteRequest struct {
+	// *
+	// The amount to send expressed in msat.
+	AmtMsat int64 `protobuf:"varint,1,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
+	// *
+	// CLTV delta from the current height that should be used for the timelock
+	// of the final hop. If zero, the default final CLTV delta is used.
+	FinalCltvDelta int32 `protobuf:"varint,2,opt,name=final_cltv_delta,json=finalCltvDelta,proto3" json:"final_cltv_delta,omitempty"`
+	// *
+	// The channel id of the channel that must be taken to the first hop. If zero,
+	// any channel may be used.
+	OutgoingChanId uint64 `protobuf:"varint,3,opt,name=outgoing_chan_id,json=outgoingChanId,proto3" json:"outgoing_chan_id,omitempty"`
+	// *
+	// A list of hops that defines the route. This does not include the source
+	// hop pubkey.
+	HopPubkeys           [][]byte `protobuf:"bytes,4,rep,name=hop_pubkeys,json=hopPubkeys,proto3" json:"hop_pubkeys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BuildRouteRequest) Reset()         { *m = BuildRouteRequest{} }
+func (m *BuildRouteRequest) String() string { return proto.CompactTextString(m) }
+func (*BuildRouteRequest) ProtoMessage()    {}
+func (*BuildRouteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_7d9896ab276e1e4b, []int{15}
+}
+func (m *BuildRouteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuildRouteRequest.Unmarshal(m, b)
+}
+func (m *BuildRouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuildRouteRequest.Marshal(b, m, deterministic)
+}
+func (dst *BuildRouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildRouteRequest.Merge(dst, src)
+}
+func (m *BuildRouteRequest) XXX_Size() int {
+	return xxx_messageInfo_BuildRouteRequest.Size(m)
+}
+func (m *BuildRouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildRouteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuildRouteRequest proto.InternalMessageInfo
+
+func (m *BuildRouteRequest) GetAmtMsat() int64 {
+	if m != nil {
+		return m.AmtMsat
+	}
+	return 0
+}
+
+func (m *BuildRouteRequest) GetFinalCltvDelta() int32 {
+	if m != nil {
+		return m.FinalCltvDelta
+	}
+	return 0
+}
+
+func (m *BuildRouteRequest) GetOutgoingChanId() uint64 {
+	if m != nil {
+		return m.OutgoingChanId
+	}
+	return 0
+}
+
+func (m *BuildRouteRequest) GetHopPubkeys() [][]byte {
+	if m != nil {
+		return m.HopPubkeys
+	}
+	return nil
+}
+
+type BuildRouteResponse struct {
+	// *
+	// Fully specified route that can be used to execute the payment.
+	Route                *lnrpc.Route `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *BuildRouteResponse) Reset()         { *m = BuildRouteResponse{} }
+func (m *BuildRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*BuildRouteResponse) ProtoMessage()    {}
+func (*BuildRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_7d9896ab276e1e4b, []int{16}
+}
+func (m *BuildRouteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuildRouteResponse.Unmarshal(m, b)
+}
+func (m *BuildRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuildRouteResponse.Marshal(b, m, deterministic)
+}
+func (dst *BuildRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildRouteResponse.Merge(dst, src)
+}
+func (m *BuildRouteResponse) XXX_Size() int {
+	return xxx_messageInfo_BuildRouteResponse.Size(m)
+}
+func (m *BuildRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuildRouteResponse proto.InternalMessageInfo
+
+func (m *BuildRouteResponse) GetRoute() *lnrpc.Route {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
+type ProbeRouteRequest struct {
+	// *
+	// The route to probe, for example as returned by BuildRoute or QueryRoutes.
+	Route *lnrpc.Route `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	// *
+	// If set, the largest amount up to the amount of the route that the route is
+	// able to carry is searched for, by repeatedly probing the channels of the
+	// route with amounts chosen by binary search. Failures of these probes
+	// aren't reported to mission control.
+	FindMaxAmt           bool     `protobuf:"varint,2,opt,name=find_max_amt,json=findMaxAmt,proto3" json:"find_max_amt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProbeRouteRequest) Reset()         { *m = ProbeRouteRequest{} }
+func (m *ProbeRouteRequest) String() string { return proto.CompactTextString(m) }
+func (*ProbeRouteRequest) ProtoMessage()    {}
+func (*ProbeRouteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_7d9896ab276e1e4b, []int{17}
+}
+func (m *ProbeRouteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProbeRouteRequest.Unmarshal(m, b)
+}
+func (m *ProbeRouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProbeRouteRequest.Marshal(b, m, deterministic)
+}
+func (dst *ProbeRouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProbeRouteRequest.Merge(dst, src)
+}
+func (m *ProbeRouteRequest) XXX_Size() int {
+	return xxx_messageInfo_ProbeRouteRequest.Size(m)
+}
+func (m *ProbeRouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProbeRouteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProbeRouteRequest proto.InternalMessageInfo
+
+func (m *ProbeRouteRequest) GetRoute() *lnrpc.Route {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
+func (m *ProbeRouteRequest) GetFindMaxAmt() bool {
+	if m != nil {
+		return m.FindMaxAmt
+	}
+	return false
+}
+
+type ProbePaymentRequest struct {
+	// / The identity pubkey of the destination to probe.
+	Dest []byte `protobuf:"bytes,1,opt,name=dest,proto3" json:"dest,omitempty"`
+	// / The amount to probe with, expressed in satoshis.
+	AmtSat int64 `protobuf:"varint,2,opt,name=amt_sat,json=amtSat,proto3" json:"amt_sat,omitempty"`
+	// *
+	// An absolute limit on the highest fee of the routes we should probe. If
+	// zero, the amount is used as the limit.
+	FeeLimitSat int64 `protobuf:"varint,3,opt,name=fee_limit_sat,json=feeLimitSat,proto3" json:"fee_limit_sat,omitempty"`
+	// *
+	// CLTV delta from the current height that should be used for the timelock
+	// of the final hop. If zero, the default final CLTV delta is used.
+	FinalCltvDelta int32 `protobuf:"varint,4,opt,name=final_cltv_delta,json=finalCltvDelta,proto3" json:"final_cltv_delta,omitempty"`
+	// *
+	// The channel id of the channel that must be taken to the first hop. If zero,
+	// any channel may be used.
+	OutgoingChanId uint64 `protobuf:"varint,5,opt,name=outgoing_chan_id,json=outgoingChanId,proto3" json:"outgoing_chan_id,omitempty"`
+	// *
+	// An upper limit on the amount of time we should spend probing alternative
+	// routes. This is expressed in seconds.
+	TimeoutSeconds       int32    `protobuf:"varint,6,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProbePaymentRequest) Reset()         { *m = ProbePaymentRequest{} }
+func (m *ProbePaymentRequest) String() string { return proto.CompactTextString(m) }
+func (*ProbePaymentRequest) ProtoMessage()    {}
+func (*ProbePaymentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_7d9896ab276e1e4b, []int{18}
+}
+func (m *ProbePaymentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProbePaymentRequest.Unmarshal(m, b)
+}
+func (m *ProbePaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProbePaymentRequest.Marshal(b, m, deterministic)
+}
+func (dst *ProbePaymentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProbePaymentRequest.Merge(dst, src)
+}
+func (m *ProbePaymentRequest) XXX_Size() int {
+	return xxx_messageInfo_ProbePaymentRequest.Size(m)
+}
+func (m *ProbePaymentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProbePaymentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProbePaymentRequest proto.InternalMessageInfo
+
+func (m *ProbePaymentRequest) GetDest() []byte {
+	if m != nil {
+		return m.Dest
+	}
+	return nil
+}
+
+func (m *ProbePaymentRequest) GetAmtSat() int64 {
+	if m != nil {
+		return m.AmtSat
+	}
+	return 0
+}
+
+func (m *ProbePaymentRequest) GetFeeLimitSat() int64 {
+	if m != nil {
+		return m.FeeLimitSat
+	}
+	return 0
+}
+
+func (m *ProbePaymentRequest) GetFinalCltvDelta() int32 {
+	if m != nil {
+		return m.FinalCltvDelta
+	}
+	return 0
+}
+
+func (m *ProbePaymentRequest) GetOutgoingChanId() uint64 {
+	if m != nil {
+		return m.OutgoingChanId
+	}
+	return 0
+}
+
+func (m *ProbePaymentRequest) GetTimeoutSeconds() int32 {
+	if m != nil {
+		return m.TimeoutSeconds
+	}
+	return 0
+}
+
+type ProbeResult struct {
+	// *
+	// Whether the probe reached the destination, which means that the route is
+	// able to carry the amount of the route.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// *
+	// The route that was probed.
+	Route *lnrpc.Route `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
+	// *
+	// The pubkey of the node that failed the probe. For successful probes, this
+	// is the destination.
+	FailureSourcePubkey []byte `protobuf:"bytes,3,opt,name=failure_source_pubkey,json=failureSourcePubkey,proto3" json:"failure_source_pubkey,omitempty"`
+	// *
+	// The position of the failing node in the route, where zero is our own node
+	// and i is the i-th hop of the route. It's -1 if the failing node isn't part
+	// of the route.
+	FailureSourceIndex int32 `protobuf:"varint,4,opt,name=failure_source_index,json=failureSourceIndex,proto3" json:"failure_source_index,omitempty"`
+	// *
+	// The BOLT #4 failure code reported by the failing node. Successful probes
+	// are failed by the destination with an unknown payment hash failure.
+	FailureCode          uint32   `protobuf:"varint,5,opt,name=failure_code,json=failureCode,proto3" json:"failure_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProbeResult) Reset()         { *m = ProbeResult{} }
+func (m *ProbeResult) String() string { return proto.CompactTextString(m) }
+func (*ProbeResult) ProtoMessage()    {}
+func (*ProbeResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_7d9896ab276e1e4b, []int{19}
+}
+func (m *ProbeResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProbeResult.Unmarshal(m, b)
+}
+func (m *ProbeResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProbeResult.Marshal(b, m, deterministic)
+}
+func (dst *ProbeResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProbeResult.Merge(dst, src)
+}
+func (m *ProbeResult) XXX_Size() int {
+	return xxx_messageInfo_ProbeResult.Size(m)
+}
+func (m *ProbeResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProbeResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProbeResult proto.InternalMessageInfo
+
+func (m *ProbeResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *ProbeResult) GetRoute() *lnrpc.Route {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
+func (m *ProbeResult) GetFailureSourcePubkey() []byte {
+	if m != nil {
+		return m.FailureSourcePubkey
+	}
+	return nil
+}
+
+func (m *ProbeResult) GetFailureSourceIndex() int32 {
+	if m != nil {
+		return m.FailureSourceIndex
+	}
+	return 0
+}
+
+func (m *ProbeResult) GetFailureCode() uint32 {
+	if m != nil {
+		return m.FailureCode
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PaymentRequest)(nil), "routerrpc.PaymentRequest")
+	proto.RegisterType((*PaymentResponse)(nil), "routerrpc.PaymentResponse")
+	proto.RegisterType((*RouteFeeRequest)(nil), "routerrpc.RouteFeeRequest")
+	proto.RegisterType((*RouteFeeResponse)(nil), "routerrpc.RouteFeeResponse")
+	proto.RegisterType((*SubscribeHtlcEventsRequest)(nil), "routerrpc.SubscribeHtlcEventsRequest")
+	proto.RegisterType((*HtlcEvent)(nil), "routerrpc.HtlcEvent")
+	proto.RegisterType((*HtlcInfo)(nil), "routerrpc.HtlcInfo")
+	proto.RegisterType((*ForwardEvent)(nil), "routerrpc.ForwardEvent")
+	proto.RegisterType((*ForwardFailEvent)(nil), "routerrpc.ForwardFailEvent")
+	proto.RegisterType((*SettleEvent)(nil), "routerrpc.SettleEvent")
+	proto.RegisterType((*LinkFailEvent)(nil), "routerrpc.LinkFailEvent")
+	proto.RegisterType((*RebalanceRequest)(nil), "routerrpc.RebalanceRequest")
+	proto.RegisterType((*RebalanceRecord)(nil), "routerrpc.RebalanceRecord")
+	proto.RegisterType((*ListRebalancesRequest)(nil), "routerrpc.ListRebalancesRequest")
+	proto.RegisterType((*ListRebalancesResponse)(nil), "routerrpc.ListRebalancesResponse")
+	proto.RegisterType((*BuildRouteRequest)(nil), "routerrpc.BuildRouteRequest")
+	proto.RegisterType((*BuildRouteResponse)(nil), "routerrpc.BuildRouteResponse")
+	proto.RegisterType((*ProbeRouteRequest)(nil), "routerrpc.ProbeRouteRequest")
+	proto.RegisterType((*ProbePaymentRequest)(nil), "routerrpc.ProbePaymentRequest")
+	proto.RegisterType((*ProbeResult)(nil), "routerrpc.ProbeResult")
+	proto.RegisterEnum("routerrpc.HtlcEvent_EventType", HtlcEvent_EventType_name, HtlcEvent_EventType_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RouterClient is the client API for Router service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RouterClient interface {
+	// *
+	// SendPayment attempts to route a payment described by the passed
+	// PaymentRequest to the final destination. If we are unable to route the
+	// payment, or cannot find a route that satisfies the constraints in the
+	// PaymentRequest, then an error will be returned. Otherwise, the payment
+	// pre-image, along with the final route will be returned.
+	SendPayment(ctx context.Context, in *PaymentRequest, opts ...grpc.CallOption) (*PaymentResponse, error)
+	// *
+	// EstimateRouteFee allows callers to obtain a lower bound w.r.t how much it
+	// may cost to send an HTLC to the target end destination.
+	EstimateRouteFee(ctx context.Context, in *RouteFeeRequest, opts ...grpc.CallOption) (*RouteFeeResponse, error)
+	// *
+	// SubscribeHtlcEvents creates a uni-directional stream from the server to
+	// the client which delivers a stream of htlc events as they move through
+	// the switch and our channel links.
+	SubscribeHtlcEvents(ctx context.Context, in *SubscribeHtlcEventsRequest, opts ...grpc.CallOption) (Router_SubscribeHtlcEventsClient, error)
+	// *
+	// Rebalance moves funds from one of our channels into another one by paying
+	// ourselves over a circular route. The route leaves over the outgoing
+	// channel and returns over the incoming channel. Failed attempts are
+	// retried over alternative routes until the fee limit or timeout is hit.
+	Rebalance(ctx context.Context, in *RebalanceRequest, opts ...grpc.CallOption) (*RebalanceRecord, error)
+	// *
+	// ListRebalances returns all completed rebalances. Rebalances are recorded
+	// apart from regular payments, and don't show up in ListPayments.
+	ListRebalances(ctx context.Context, in *ListRebalancesRequest, opts ...grpc.CallOption) (*ListRebalancesResponse, error)
+	// *
+	// BuildRoute builds a fully specified route based on a list of hop public
+	// keys. For every pair of hops, the channel best able to forward the payment
+	// is looked up in the graph. The fees and time locks of the route are
+	// computed backwards from the destination, such that the returned route is
+	// ready to be passed to SendToRoute.
+	BuildRoute(ctx context.Context, in *BuildRouteRequest, opts ...grpc.CallOption) (*BuildRouteResponse, error)
+	// *
+	// ProbeRoute tests whether a route is able to carry its amount, by sending
+	// an HTLC with a random payment hash over it. A probe succeeds if it's
+	// failed by the destination because of the unknown payment hash. Other
+	// failures are reported to mission control. Probes are never recorded as
+	// payments.
+	ProbeRoute(ctx context.Context, in *ProbeRouteRequest, opts ...grpc.CallOption) (*ProbeResult, error)
+	// *
+	// ProbePayment probes the network for a route to the destination that is
+	// able to carry the amount, without paying. Failed probes are retried over
+	// alternative routes, in the same way as payments.
+	ProbePayment(ctx context.Context, in *ProbePaymentRequest, opts ...grpc.CallOption) (*ProbeResult, error)
+}
+
+type routerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRouterClient(cc *grpc.ClientConn) RouterClient {
+	return &routerClient{cc}
+}
+
+func (c *routerClient) SendPayment(ctx context.Context, in *PaymentRequest, opts ...grpc.CallOption) (*PaymentResponse, error) {
+	out := new(PaymentResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/SendPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) EstimateRouteFee(ctx context.Context, in *RouteFeeRequest, opts ...grpc.CallOption) (*RouteFeeResponse, error) {
+	out := new(RouteFeeResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/EstimateRouteFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) SubscribeHtlcEvents(ctx context.Context, in *SubscribeHtlcEventsRequest, opts ...grpc.CallOption) (Router_SubscribeHtlcEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Router_serviceDesc.Streams[0], "/routerrpc.Router/SubscribeHtlcEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &routerSubscribeHtlcEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Router_SubscribeHtlcEventsClient interface {
+	Recv() (*HtlcEvent, error)
+	grpc.ClientStream
+}
+
+type routerSubscribeHtlcEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *routerSubscribeHtlcEventsClient) Recv() (*HtlcEvent, error) {
+	m := new(HtlcEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *routerClient) Rebalance(ctx context.Context, in *RebalanceRequest, opts ...grpc.CallOption) (*RebalanceRecord, error) {
+	out := new(RebalanceRecord)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/Rebalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) ListRebalances(ctx context.Context, in *ListRebalancesRequest, opts ...grpc.CallOption) (*ListRebalancesResponse, error) {
+	out := new(ListRebalancesResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/ListRebalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) BuildRoute(ctx context.Context, in *BuildRouteRequest, opts ...grpc.CallOption) (*BuildRouteResponse, error) {
+	out := new(BuildRouteResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/BuildRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) ProbeRoute(ctx context.Context, in *ProbeRouteRequest, opts ...grpc.CallOption) (*ProbeResult, error) {
+	out := new(ProbeResult)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/ProbeRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) ProbePayment(ctx context.Context, in *ProbePaymentRequest, opts ...grpc.CallOption) (*ProbeResult, error) {
+	out := new(ProbeResult)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/ProbePayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RouterServer is the server API for Router service.
+type RouterServer interface {
+	// *
+	// SendPayment attempts to route a payment described by the passed
+	// PaymentRequest to the final destination. If we are unable to route the
+	// payment, or cannot find a route that satisfies the constraints in the
+	// PaymentRequest, then an error will be returned. Otherwise, the payment
+	// pre-image, along with the final route will be returned.
+	SendPayment(context.Context, *PaymentRequest) (*PaymentResponse, error)
+	// *
+	// EstimateRouteFee allows callers to obtain a lower bound w.r.t how much it
+	// may cost to send an HTLC to the target end destination.
+	EstimateRouteFee(context.Context, *RouteFeeRequest) (*RouteFeeResponse, error)
+	// *
+	// SubscribeHtlcEvents creates a uni-directional stream from the server to
+	// the client which delivers a stream of htlc events as they move through
+	// the switch and our channel links.
+	SubscribeHtlcEvents(*SubscribeHtlcEventsRequest, Router_SubscribeHtlcEventsServer) error
+	// *
+	// Rebalance moves funds from one of our channels into another one by paying
+	// ourselves over a circular route. The route leaves over the outgoing
+	// channel and returns over the incoming channel. Failed attempts are
+	// retried over alternative routes until the fee limit or timeout is hit.
+	Rebalance(context.Context, *RebalanceRequest) (*RebalanceRecord, error)
+	// *
+	// ListRebalances returns all completed rebalances. Rebalances are recorded
+	// apart from regular payments, and don't show up in ListPayments.
+	ListRebalances(context.Context, *ListRebalancesRequest) (*ListRebalancesResponse, error)
+	// *
+	// BuildRoute builds a fully specified route based on a list of hop public
+	// keys. For every pair of hops, the channel best able to forward the payment
+	// is looked up in the graph. The fees and time locks of the route are
+	// computed backwards from the destination, such that the returned route is
+	// ready to be passed to SendToRoute.
+	BuildRoute(context.Context, *BuildRouteRequest) (*BuildRouteResponse, error)
+	// *
+	// ProbeRoute tests whether a route is able to carry its amount, by sending
+	// an HTLC with a random payment hash over it. A probe succeeds if it's
+	// failed by the destination because of the unknown payment hash. Other
+	// failures are reported to mission control. Probes are never recorded as
+	// payments.
+	ProbeRoute(context.Context, *ProbeRouteRequest) (*ProbeResult, error)
+	// *
+	// ProbePayment probes the network for a route to the destination that is
+	// able to carry the amount, without paying. Failed probes are retried over
+	// alternative routes, in the same way as payments.
+	ProbePayment(context.Context, *ProbePaymentRequest) (*ProbeResult, error)
+}
+
+func RegisterRouterServer(s *grpc.Server, srv RouterServer) {
+	s.RegisterService(&_Router_serviceDesc, srv)
+}
+
+func _Router_SendPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).SendPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/SendPayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).SendPayment(ctx, req.(*PaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_EstimateRouteFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RouteFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).EstimateRouteFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/EstimateRouteFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).EstimateRouteFee(ctx, req.(*RouteFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_SubscribeHtlcEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeHtlcEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RouterServer).SubscribeHtlcEvents(m, &routerSubscribeHtlcEventsServer{stream})
+}
+
+type Router_SubscribeHtlcEventsServer interface {
+	Send(*HtlcEvent) error
+	grpc.ServerStream
+}
+
+type routerSubscribeHtlcEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *routerSubscribeHtlcEventsServer) Send(m *HtlcEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Router_Rebalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).Rebalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/Rebalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).Rebalance(ctx, req.(*RebalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_ListRebalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRebalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).ListRebalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/ListRebalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).ListRebalances(ctx, req.(*ListRebalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_BuildRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).BuildRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/BuildRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).BuildRoute(ctx, req.(*BuildRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_ProbeRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProbeRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).ProbeRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/ProbeRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).ProbeRoute(ctx, req.(*ProbeRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_ProbePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProbePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).ProbePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/ProbePayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).ProbePayment(ctx, req.(*ProbePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Router_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "routerrpc.Router",
+	HandlerType: (*RouterServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendPayment",
+			Handler:    _Router_SendPayment_Handler,
+		},
+		{
+			MethodName: "EstimateRouteFee",
+			Handler:    _Router_EstimateRouteFee_Handler,
+		},
+		{
+			MethodName: "Rebalance",
+			Handler:    _Router_Rebalance_Handler,
+		},
+		{
+			MethodName: "ListRebalances",
+			Handler:    _Router_ListRebalances_Handler,
+		},
+		{
+			MethodName: "BuildRoute",
+			Handler:    _Router_BuildRoute_Handler,
+		},
+		{
+			MethodName: "ProbeRoute",
+			Handler:    _Router_ProbeRoute_Handler,
+		},
+		{
+			MethodName: "ProbePayment",
+			Handler:    _Router_ProbePayment_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeHtlcEvents",
+			Handler:       _Router_SubscribeHtlcEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "routerrpc/router.proto",
+}
+
+func init() { proto.RegisterFile("routerrpc/router.proto", fileDescriptor_router_7d9896ab276e1e4b) }
 
-func init() { proto.RegisterFile("routerrpc/router.proto", fileDescriptor_router_e218e7c710fe8172) }
-
-var fileDescriptor_router_e218e7c710fe8172 = []byte{
-	// 409 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xdf, 0x6e, 0xd3, 0x30,
-	0x14, 0xc6, 0x15, 0xb6, 0x85, 0xe5, 0x74, 0x6b, 0x8b, 0x91, 0x20, 0xeb, 0x84, 0xa8, 0x72, 0x01,
-	0xb9, 0x0a, 0x12, 0xdc, 0x73, 0xc3, 0x36, 0x31, 0x31, 0x24, 0xe4, 0x3e, 0x80, 0x65, 0x92, 0xb3,
-	0x26, 0x34, 0x8e, 0x5d, 0xdb, 0x41, 0xca, 0xb3, 0xf0, 0x3c, 0xbc, 0x17, 0xb2, 0xe3, 0x96, 0x82,
-	0x7a, 0xe7, 0x7c, 0xe7, 0xef, 0xf7, 0x3b, 0x81, 0x17, 0x5a, 0xf6, 0x16, 0xb5, 0x56, 0xe5, 0xbb,
-	0xf1, 0x55, 0x28, 0x2d, 0xad, 0x24, 0xc9, 0x5e, 0xcf, 0x7e, 0x47, 0x30, 0xfd, 0xc6, 0x07, 0x81,
-	0x9d, 0xa5, 0xb8, 0xed, 0xd1, 0x58, 0xf2, 0x12, 0x9e, 0x2a, 0x3e, 0x30, 0x8d, 0xdb, 0x34, 0x5a,
-	0x46, 0x79, 0x42, 0x63, 0xc5, 0x07, 0x8a, 0x5b, 0x92, 0xc1, 0xe5, 0x23, 0x22, 0x6b, 0x1b, 0xd1,
-	0x58, 0x66, 0xb8, 0x4d, 0x9f, 0x2c, 0xa3, 0xfc, 0x84, 0x4e, 0x1e, 0x11, 0x1f, 0x9c, 0xb6, 0xe2,
-	0x96, 0xbc, 0x02, 0x28, 0x5b, 0xfb, 0x73, 0x4c, 0x4a, 0x4f, 0x96, 0x51, 0x7e, 0x46, 0x13, 0xa7,
-	0xf8, 0x0c, 0xf2, 0x16, 0x66, 0xb6, 0x11, 0x28, 0x7b, 0xcb, 0x0c, 0x96, 0xb2, 0xab, 0x4c, 0x7a,
-	0xea, 0x73, 0xa6, 0x41, 0x5e, 0x8d, 0x2a, 0x29, 0xe0, 0xb9, 0xec, 0xed, 0x5a, 0x36, 0xdd, 0x9a,
-	0x95, 0x35, 0xef, 0x3a, 0x6c, 0x59, 0x53, 0xa5, 0x67, 0x7e, 0xe2, 0xb3, 0x5d, 0xe8, 0xd3, 0x18,
-	0xb9, 0xaf, 0xb2, 0x1f, 0x30, 0xdb, 0xdb, 0x30, 0x4a, 0x76, 0x06, 0xc9, 0x15, 0x9c, 0x3b, 0x1f,
-	0x35, 0x37, 0xb5, 0x37, 0x72, 0x41, 0x9d, 0xaf, 0xcf, 0xdc, 0xd4, 0xe4, 0x1a, 0x12, 0xa5, 0x91,
-	0x35, 0x82, 0xaf, 0xd1, 0xbb, 0xb8, 0xa0, 0xe7, 0x4a, 0xe3, 0xbd, 0xfb, 0x26, 0xaf, 0x61, 0xa2,
-	0xc6, 0x56, 0x0c, 0xb5, 0xf6, 0x1e, 0x12, 0x0a, 0x41, 0xba, 0xd5, 0x3a, 0xfb, 0x08, 0x33, 0xea,
-	0x00, 0xde, 0x21, 0xee, 0x98, 0x11, 0x38, 0xad, 0xd0, 0xd8, 0x30, 0xc7, 0xbf, 0x1d, 0x47, 0x2e,
-	0x0e, 0x41, 0xc5, 0x5c, 0x38, 0x46, 0x59, 0x05, 0xf3, 0xbf, 0xf5, 0x61, 0xd9, 0x1c, 0xe6, 0xee,
-	0x28, 0xce, 0xae, 0x63, 0x2c, 0x5c, 0x55, 0xe4, 0xab, 0xa6, 0x41, 0xbf, 0x43, 0xfc, 0x6a, 0xb8,
-	0x25, 0x6f, 0x46, 0x84, 0xac, 0x95, 0xe5, 0x86, 0x55, 0xd8, 0xf2, 0x21, 0xb4, 0xbf, 0x74, 0xf2,
-	0x83, 0x2c, 0x37, 0x37, 0x4e, 0x7c, 0xff, 0x2b, 0x82, 0xd8, 0x8f, 0xd1, 0xe4, 0x06, 0x26, 0x2b,
-	0xec, 0xaa, 0x00, 0x88, 0x5c, 0x15, 0xfb, 0xfb, 0x17, 0xff, 0xde, 0x7e, 0xb1, 0x38, 0x16, 0x0a,
-	0x2b, 0x7e, 0x81, 0xf9, 0xad, 0xb1, 0x8d, 0xe0, 0x16, 0x77, 0xeb, 0x93, 0xc3, 0xfc, 0xff, 0x98,
-	0x2c, 0xae, 0x8f, 0xc6, 0xc6, 0x66, 0xdf, 0x63, 0xff, 0x27, 0x7e, 0xf8, 0x13, 0x00, 0x00, 0xff,
-	0xff, 0x95, 0xc2, 0xf8, 0xec, 0xa3, 0x02, 0x00, 0x00,
+var fileDescriptor_router_7d9896ab276e1e4b = []byte{
+	// 1535 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcb, 0x6e, 0xdb, 0x46,
+	0x17, 0x0e, 0x45, 0xc9, 0x92, 0x8e, 0xae, 0x1e, 0x27, 0x8e, 0x22, 0xe7, 0xa2, 0xf0, 0x47, 0xfe,
+	0x08, 0x29, 0x60, 0xa7, 0x6e, 0x81, 0x14, 0xbd, 0x04, 0x88, 0x6d, 0x19, 0x36, 0xe2, 0x38, 0xc6,
+	0xd8, 0x69, 0xd0, 0x6e, 0x88, 0x31, 0x39, 0x92, 0x58, 0x53, 0x24, 0x33, 0x33, 0x4a, 0xe2, 0x65,
+	0xf7, 0x7d, 0x8b, 0xbe, 0x46, 0x1f, 0xa1, 0xe8, 0xaa, 0x6f, 0x50, 0xf4, 0x15, 0xba, 0x2e, 0x66,
+	0x38, 0xa4, 0x28, 0x89, 0x2e, 0xdc, 0x45, 0x37, 0x86, 0xe6, 0x3b, 0xdf, 0x1c, 0xce, 0xb9, 0x1f,
+	0xc3, 0x3a, 0x0b, 0xa7, 0x82, 0x32, 0x16, 0x39, 0x5b, 0xf1, 0xaf, 0xcd, 0x88, 0x85, 0x22, 0x44,
+	0xd5, 0x14, 0xef, 0x56, 0x59, 0xe4, 0xc4, 0xa8, 0xf5, 0xa3, 0x09, 0xcd, 0x13, 0x72, 0x39, 0xa1,
+	0x81, 0xc0, 0xf4, 0xdd, 0x94, 0x72, 0x81, 0x6e, 0x43, 0x39, 0x22, 0x97, 0x36, 0xa3, 0xef, 0x3a,
+	0x46, 0xcf, 0xe8, 0x57, 0xf1, 0x4a, 0x44, 0x2e, 0x31, 0x7d, 0x87, 0x2c, 0x68, 0x0c, 0x29, 0xb5,
+	0x7d, 0x6f, 0xe2, 0x09, 0x9b, 0x13, 0xd1, 0x29, 0xf4, 0x8c, 0xbe, 0x89, 0x6b, 0x43, 0x4a, 0x8f,
+	0x24, 0x76, 0x4a, 0x04, 0xba, 0x07, 0xe0, 0xf8, 0xe2, 0x7d, 0x4c, 0xea, 0x98, 0x3d, 0xa3, 0x5f,
+	0xc2, 0x55, 0x89, 0x28, 0x06, 0x7a, 0x0c, 0x2d, 0xe1, 0x4d, 0x68, 0x38, 0x15, 0x36, 0xa7, 0x4e,
+	0x18, 0xb8, 0xbc, 0x53, 0x54, 0x9c, 0xa6, 0x86, 0x4f, 0x63, 0x14, 0x6d, 0xc2, 0x5a, 0x38, 0x15,
+	0xa3, 0xd0, 0x0b, 0x46, 0xb6, 0x33, 0x26, 0x41, 0x40, 0x7d, 0xdb, 0x73, 0x3b, 0x25, 0xf5, 0xc5,
+	0xd5, 0x44, 0xb4, 0x1b, 0x4b, 0x0e, 0x5d, 0xf4, 0x7f, 0x68, 0xf9, 0x84, 0x0b, 0x7b, 0x1c, 0x46,
+	0x76, 0x34, 0x3d, 0xbf, 0xa0, 0x97, 0x9d, 0x95, 0x9e, 0xd1, 0xaf, 0xe3, 0x86, 0x84, 0x0f, 0xc2,
+	0xe8, 0x44, 0x81, 0xe8, 0x7f, 0xd0, 0xf0, 0x46, 0x41, 0xc8, 0xa8, 0x6b, 0x07, 0xa1, 0x4b, 0x79,
+	0xa7, 0xdc, 0x33, 0xfb, 0x75, 0x5c, 0xd7, 0xe0, 0xb1, 0xc4, 0xd0, 0xb3, 0x19, 0x89, 0xba, 0x23,
+	0xca, 0x3b, 0x95, 0x9e, 0xd9, 0xaf, 0x6d, 0xa3, 0x4d, 0x3f, 0x90, 0x9e, 0x1b, 0xb8, 0x23, 0x7a,
+	0x14, 0x3a, 0x44, 0x84, 0x2c, 0xbd, 0x28, 0x31, 0x8e, 0x3e, 0x9f, 0x5d, 0x8c, 0x88, 0xc7, 0x78,
+	0xa7, 0xaa, 0x2e, 0xb6, 0xf4, 0x45, 0xa9, 0xfd, 0x84, 0x78, 0xb3, 0x5b, 0xf2, 0xc0, 0xad, 0x1f,
+	0xa0, 0x95, 0x86, 0x80, 0x47, 0x61, 0xc0, 0x29, 0xba, 0x03, 0x15, 0x19, 0x83, 0x31, 0xe1, 0x63,
+	0x15, 0x84, 0x3a, 0x96, 0x31, 0x39, 0x20, 0x7c, 0x8c, 0x36, 0xa0, 0x1a, 0x31, 0x6a, 0x7b, 0x13,
+	0x32, 0xa2, 0x2a, 0x02, 0x75, 0x5c, 0x89, 0x18, 0x3d, 0x94, 0x67, 0xf4, 0x00, 0x6a, 0x51, 0xac,
+	0xca, 0xa6, 0x8c, 0x29, 0xff, 0x57, 0x31, 0x68, 0x68, 0xc0, 0x98, 0xf5, 0x1c, 0x5a, 0x58, 0xe6,
+	0xc1, 0x3e, 0xa5, 0x49, 0xbc, 0x11, 0x14, 0x5d, 0xca, 0x85, 0xfe, 0x4e, 0xd1, 0xd5, 0x39, 0x40,
+	0x26, 0xd9, 0x20, 0xaf, 0x90, 0x89, 0x8c, 0xaf, 0xe5, 0x42, 0x7b, 0x76, 0x5f, 0x3f, 0xb6, 0x0f,
+	0x6d, 0x99, 0x5b, 0x32, 0x54, 0x32, 0x3f, 0x26, 0x9c, 0xc4, 0xca, 0x4c, 0xdc, 0xd4, 0xf8, 0x3e,
+	0xa5, 0xaf, 0x38, 0x11, 0x32, 0x4a, 0x32, 0xce, 0xb6, 0x1f, 0x3a, 0x17, 0xb6, 0x4b, 0x7d, 0x72,
+	0xa9, 0xd5, 0x37, 0x24, 0x7c, 0x14, 0x3a, 0x17, 0x7b, 0x12, 0xb4, 0xee, 0x42, 0xf7, 0x74, 0x7a,
+	0xce, 0x1d, 0xe6, 0x9d, 0xd3, 0x03, 0xe1, 0x3b, 0x83, 0xf7, 0x34, 0x10, 0x5c, 0x3f, 0xd8, 0xfa,
+	0xab, 0x08, 0xd5, 0x14, 0x95, 0x99, 0xe2, 0x05, 0x4e, 0x38, 0x59, 0xc8, 0x14, 0xf9, 0x80, 0x22,
+	0x5e, 0x4d, 0x44, 0xb3, 0x4c, 0xb9, 0x22, 0xb3, 0x0a, 0x31, 0x7f, 0x39, 0xb3, 0xfa, 0xd0, 0x4e,
+	0xf5, 0x8f, 0x85, 0xef, 0x48, 0xb2, 0xa9, 0xc8, 0xcd, 0x04, 0x97, 0x8f, 0x89, 0x99, 0xa9, 0xe6,
+	0x84, 0x59, 0x8c, 0x99, 0x09, 0xae, 0x99, 0x0f, 0xa1, 0x2e, 0x0d, 0xe6, 0x82, 0x4c, 0x22, 0x3b,
+	0xe0, 0x2a, 0xad, 0x8b, 0xb8, 0x96, 0x62, 0xc7, 0x1c, 0x7d, 0x03, 0x40, 0xa5, 0x7d, 0xb6, 0xb8,
+	0x8c, 0xa8, 0xca, 0xe5, 0xe6, 0xf6, 0xfd, 0xcd, 0xb4, 0x86, 0x37, 0x53, 0x07, 0x6c, 0xaa, 0xbf,
+	0x67, 0x97, 0x11, 0xc5, 0x55, 0x9a, 0xfc, 0x44, 0xcf, 0xa1, 0x31, 0x0c, 0xd9, 0x07, 0xc2, 0x5c,
+	0x5b, 0x81, 0x9d, 0x72, 0xcf, 0xe8, 0xd7, 0xb6, 0x6f, 0x67, 0x34, 0xec, 0xc7, 0x72, 0x75, 0xfd,
+	0xe0, 0x06, 0xae, 0x0f, 0x33, 0x67, 0xf4, 0x12, 0x50, 0x72, 0x7f, 0x48, 0x3c, 0x5f, 0x2b, 0xa9,
+	0x28, 0x25, 0x1b, 0xcb, 0x4a, 0xf6, 0x89, 0xe7, 0x27, 0x8a, 0xda, 0xc3, 0x05, 0x0c, 0x7d, 0x05,
+	0x75, 0x4e, 0x85, 0xf0, 0xa9, 0x56, 0x53, 0x55, 0x6a, 0xd6, 0x33, 0x6a, 0x4e, 0x95, 0x38, 0xd1,
+	0x50, 0xe3, 0xb3, 0x23, 0xda, 0x81, 0x96, 0xef, 0x05, 0x17, 0xd9, 0x67, 0x80, 0xba, 0xdf, 0xc9,
+	0xdc, 0x3f, 0xf2, 0x82, 0x8b, 0xec, 0x1b, 0x1a, 0x7e, 0x16, 0xb0, 0xbe, 0x86, 0x6a, 0xea, 0x25,
+	0x54, 0x83, 0xf2, 0x9b, 0xe3, 0x97, 0xc7, 0xaf, 0xdf, 0x1e, 0xb7, 0x6f, 0xa0, 0x0a, 0x14, 0x4f,
+	0x07, 0xc7, 0x7b, 0x6d, 0x43, 0xc2, 0x78, 0xb0, 0x3b, 0x38, 0xfc, 0x76, 0xd0, 0x2e, 0xc8, 0xc3,
+	0xfe, 0x6b, 0xfc, 0xf6, 0x05, 0xde, 0x6b, 0x9b, 0x3b, 0x65, 0x28, 0xa9, 0xef, 0x5a, 0xbf, 0x18,
+	0x50, 0x51, 0x11, 0x0c, 0x86, 0x21, 0xfa, 0x04, 0xd2, 0xe4, 0xb2, 0x65, 0xe0, 0x64, 0x4e, 0xab,
+	0xac, 0x6b, 0xe0, 0x34, 0x61, 0xce, 0x34, 0x2e, 0xc9, 0x69, 0x6a, 0xa4, 0xe4, 0x42, 0x4c, 0x4e,
+	0x04, 0x29, 0xf9, 0x49, 0x46, 0xb3, 0xac, 0x42, 0x55, 0x50, 0x71, 0xca, 0xb5, 0x12, 0xc1, 0x8b,
+	0x89, 0x50, 0x15, 0xf5, 0x24, 0xa3, 0x38, 0xe5, 0xc6, 0x49, 0xd7, 0x4a, 0x04, 0x9a, 0x6b, 0x3d,
+	0x83, 0x7a, 0x36, 0xe6, 0xe8, 0x31, 0x14, 0xbd, 0x60, 0x18, 0xaa, 0x47, 0xd7, 0xb6, 0xd7, 0x16,
+	0x92, 0x4b, 0x1a, 0x89, 0x15, 0xc1, 0xa2, 0xd0, 0x5e, 0x8c, 0xb3, 0xfc, 0xf0, 0x07, 0x8f, 0x51,
+	0x15, 0x96, 0x29, 0xa3, 0xb6, 0x13, 0xba, 0x54, 0x9b, 0xdf, 0x92, 0x82, 0xfd, 0x18, 0xdf, 0x0d,
+	0x5d, 0x8a, 0x1e, 0x41, 0x33, 0xa1, 0x71, 0xc1, 0xbc, 0x60, 0xa4, 0x4c, 0xaf, 0xe2, 0x86, 0x46,
+	0x4f, 0x15, 0x68, 0x35, 0xa0, 0x96, 0xc9, 0x03, 0xeb, 0x37, 0x03, 0x1a, 0x73, 0x71, 0xbd, 0xf6,
+	0x83, 0xf3, 0x1f, 0x57, 0xb8, 0xee, 0xe3, 0xcc, 0x9c, 0xc7, 0x65, 0x69, 0x2e, 0x15, 0xc4, 0xf3,
+	0x3b, 0xc5, 0x39, 0xda, 0x9e, 0x02, 0x51, 0x17, 0x2a, 0x49, 0x88, 0x54, 0x55, 0x57, 0x70, 0x7a,
+	0xb6, 0x7e, 0x35, 0xa0, 0x8d, 0xe9, 0x39, 0xf1, 0x49, 0xe0, 0xa4, 0xdd, 0x37, 0xdb, 0x34, 0x64,
+	0x3b, 0x9a, 0xf5, 0xae, 0x66, 0xb6, 0x17, 0x2d, 0x34, 0xa2, 0x84, 0x59, 0x98, 0x6f, 0x44, 0x9a,
+	0x99, 0xe9, 0xde, 0x66, 0xb6, 0x7b, 0x2f, 0x4f, 0xf0, 0xe2, 0xf2, 0x04, 0xcf, 0x19, 0xd1, 0xa5,
+	0xbc, 0x11, 0x6d, 0xfd, 0x54, 0x80, 0x56, 0xc6, 0x1c, 0x27, 0x64, 0xee, 0x7f, 0x62, 0xcd, 0x1d,
+	0xa8, 0xcc, 0x55, 0x81, 0x89, 0xcb, 0x24, 0xce, 0x68, 0x29, 0x4a, 0x27, 0x4e, 0x6c, 0x4a, 0x79,
+	0xa8, 0x47, 0xcd, 0x43, 0xa8, 0x27, 0x93, 0x50, 0x4d, 0xd1, 0x92, 0x9a, 0x6e, 0xc9, 0x74, 0x54,
+	0x93, 0xb4, 0x0b, 0x72, 0x70, 0xc6, 0x83, 0x74, 0x25, 0x1d, 0xa4, 0xea, 0x2c, 0x87, 0x62, 0x44,
+	0xc4, 0x58, 0xad, 0x07, 0x45, 0xac, 0x7e, 0xa3, 0xbb, 0x50, 0x4d, 0x3b, 0xb4, 0x6a, 0x85, 0x26,
+	0x9e, 0x01, 0xd6, 0x6d, 0xb8, 0x75, 0xe4, 0x71, 0x91, 0x7a, 0x24, 0x1d, 0x57, 0x67, 0xb0, 0xbe,
+	0x28, 0xd0, 0x83, 0xf3, 0x4b, 0x00, 0x96, 0xa2, 0x1d, 0x43, 0xed, 0x0a, 0xdd, 0x4c, 0x56, 0x2f,
+	0x78, 0x17, 0x67, 0xd8, 0xd6, 0xcf, 0x06, 0xac, 0xee, 0x4c, 0x3d, 0xdf, 0x55, 0xe3, 0x38, 0xc9,
+	0xa6, 0xac, 0xaf, 0x8c, 0x79, 0x5f, 0xf5, 0xa1, 0x3d, 0xf4, 0x02, 0xe2, 0xdb, 0x6a, 0x3f, 0x73,
+	0xa9, 0x2f, 0x88, 0x72, 0x78, 0x09, 0x37, 0x15, 0xbe, 0xeb, 0x8b, 0xf7, 0x7b, 0x12, 0xcd, 0x0d,
+	0xa2, 0x99, 0x1b, 0xc4, 0x07, 0x50, 0x9b, 0x2d, 0x5c, 0x72, 0x95, 0x93, 0xbb, 0x14, 0x8c, 0x93,
+	0x6d, 0x8b, 0x5b, 0x5f, 0x00, 0xca, 0x3e, 0x52, 0xdb, 0x6d, 0x41, 0x49, 0x19, 0xa9, 0x0b, 0xb9,
+	0xae, 0xd7, 0xa3, 0x98, 0x14, 0x8b, 0xac, 0xef, 0x60, 0xf5, 0x84, 0x85, 0xe7, 0x74, 0xce, 0xbc,
+	0x6b, 0x5c, 0x44, 0x3d, 0xa8, 0x0f, 0xbd, 0xc0, 0xb5, 0x27, 0xe4, 0xa3, 0xec, 0x88, 0xca, 0xc6,
+	0x0a, 0x06, 0x89, 0xbd, 0x22, 0x1f, 0x5f, 0x4c, 0x84, 0xf5, 0x87, 0x01, 0x6b, 0x4a, 0xf7, 0xc2,
+	0xe2, 0xfb, 0x6f, 0x16, 0xa1, 0xe5, 0x52, 0x32, 0x97, 0x4b, 0x29, 0xcf, 0xe5, 0xc5, 0x6b, 0xbb,
+	0xbc, 0x94, 0xeb, 0xf2, 0x9c, 0xf2, 0x5c, 0xc9, 0x2d, 0xcf, 0xdf, 0x0d, 0xa8, 0xc5, 0x1e, 0xa4,
+	0x7c, 0xea, 0x0b, 0xd4, 0x81, 0x32, 0x9f, 0x3a, 0x0e, 0xe5, 0x5c, 0x19, 0x58, 0xc1, 0xc9, 0x71,
+	0xe6, 0xd5, 0xc2, 0xd5, 0x5e, 0xdd, 0x86, 0x5b, 0x69, 0x97, 0x0c, 0xa7, 0xcc, 0xa1, 0xc9, 0x96,
+	0x6d, 0x2a, 0x67, 0xad, 0x25, 0xcd, 0x52, 0xc9, 0xf4, 0xae, 0xfd, 0x14, 0x6e, 0x2e, 0xdc, 0xf1,
+	0x02, 0x97, 0x7e, 0xd4, 0x2e, 0x40, 0x73, 0x57, 0x0e, 0xa5, 0x44, 0x16, 0xed, 0x5c, 0xcb, 0x2e,
+	0xa9, 0x96, 0x5d, 0x1b, 0xce, 0xda, 0xf5, 0xf6, 0x9f, 0x45, 0x58, 0x51, 0x2f, 0x63, 0x68, 0x4f,
+	0xce, 0x8b, 0xc0, 0xd5, 0x51, 0x44, 0x77, 0x32, 0x95, 0x33, 0x1f, 0xd9, 0x6e, 0x37, 0x4f, 0xa4,
+	0x93, 0xf1, 0x25, 0xb4, 0x07, 0x5c, 0x78, 0x13, 0x22, 0x68, 0xb2, 0xd9, 0xa2, 0xb9, 0x22, 0x9c,
+	0x5f, 0x97, 0xbb, 0x1b, 0xb9, 0x32, 0xad, 0xec, 0x0c, 0xd6, 0x72, 0x16, 0x57, 0xf4, 0x28, 0xbb,
+	0xea, 0x5c, 0xb9, 0xd8, 0x76, 0x6f, 0xe6, 0xed, 0x77, 0x4f, 0x0d, 0xb4, 0x07, 0xd5, 0xb4, 0x15,
+	0xa0, 0x8d, 0xfc, 0x06, 0xb1, 0x6c, 0xe8, 0x62, 0x6f, 0x7e, 0x03, 0xcd, 0xf9, 0x3e, 0x84, 0x7a,
+	0x73, 0x1b, 0x54, 0x4e, 0xef, 0xea, 0x3e, 0xfc, 0x07, 0x86, 0x36, 0xf9, 0x10, 0x60, 0x56, 0xe2,
+	0xe8, 0x6e, 0xe6, 0xc2, 0x52, 0x7b, 0xea, 0xde, 0xbb, 0x42, 0xaa, 0x55, 0xed, 0x00, 0xcc, 0x6a,
+	0x7e, 0x4e, 0xd5, 0x52, 0x2b, 0xe8, 0xae, 0x2f, 0x49, 0xe3, 0x34, 0xdf, 0x87, 0x7a, 0xb6, 0xb6,
+	0xd1, 0xfd, 0x45, 0xde, 0x42, 0x6a, 0x5c, 0xa1, 0x67, 0xe7, 0xd3, 0xef, 0xb7, 0x46, 0x9e, 0x18,
+	0x4f, 0xcf, 0x37, 0x9d, 0x70, 0xb2, 0xe5, 0x7b, 0xa3, 0xb1, 0x08, 0xbc, 0x60, 0x14, 0x50, 0xf1,
+	0x21, 0x64, 0x17, 0x5b, 0x7e, 0xe0, 0x6e, 0xf9, 0xc1, 0xec, 0x9f, 0x6c, 0x16, 0x39, 0xe7, 0x2b,
+	0xea, 0x5f, 0xea, 0xcf, 0xfe, 0x1e, 0x00, 0x22, 0xa6, 0xca, 0x0c, 0x82, 0x0f, 0x00, 0x00,
 }
@@ -26,12 +26,26 @@ type RouterBackend struct {
 	// capacity of a channel to populate in responses.
 	FetchChannelCapacity func(chanID uint64) (btcutil.Amount, error)
 
+	// FetchChannelEndpoints returns the pubkeys of both endpoints of the
+	// given channel id.
+	FetchChannelEndpoints func(chanID uint64) (route.Vertex,
+		route.Vertex, error)
+
 	// FindRoutes is a closure that abstracts away how we locate/query for
 	// routes.
 	FindRoutes func(source, target route.Vertex,
 		amt lnwire.MilliSatoshi, restrictions *routing.RestrictParams,
 		numPaths uint32, finalExpiry ...uint16) (
 		[]*route.Route, error)
+
+	// ReserveSpend checks a payment costing at most maxCost, including
+	// fees, against the spending caveats of the macaroon of the request in
+	// ctx, and reserves the maximum cost against its spend limits. The
+	// returned closure must be called with the amount actually spent once
+	// the payment has completed, which is zero if it failed.
+	ReserveSpend func(ctx context.Context,
+		maxCost lnwire.MilliSatoshi) (func(lnwire.MilliSatoshi) error,
+		error)
 }
 
 // QueryRoutes attempts to query the daemons' Channel Router for a possible
@@ -95,31 +109,33 @@ func (r *RouterBackend) QueryRoutes(ctx context.Context,
 	// Unmarshall restrictions from request.
 	feeLimit := calculateFeeLimit(in.FeeLimit, amtMSat)
 
-	ignoredNodes := make(map[route.Vertex]struct{})
-	for _, ignorePubKey := range in.IgnoredNodes {
-		if len(ignorePubKey) != 33 {
-			return nil, fmt.Errorf("invalid ignore node pubkey")
-		}
-		var ignoreVertex route.Vertex
-		copy(ignoreVertex[:], ignorePubKey)
-		ignoredNodes[ignoreVertex] = struct{}{}
+	ignoredNodes, err := UnmarshallIgnoredNodes(in.IgnoredNodes)
+	if err != nil {
+		return nil, err
 	}
 
-	ignoredEdges := make(map[routing.EdgeLocator]struct{})
-	for _, ignoredEdge := range in.IgnoredEdges {
-		locator := routing.EdgeLocator{
-			ChannelID: ignoredEdge.ChannelId,
-		}
-		if ignoredEdge.DirectionReverse {
-			locator.Direction = 1
-		}
-		ignoredEdges[locator] = struct{}{}
+	ignoredPairs, err := UnmarshallIgnoredPairs(in.IgnoredPairs)
+	if err != nil {
+		return nil, err
+	}
+
+	lastHop, err := UnmarshallLastHop(in.LastHopPubkey)
+	if err != nil {
+		return nil, err
 	}
 
 	restrictions := &routing.RestrictParams{
 		FeeLimit:     feeLimit,
 		IgnoredNodes: ignoredNodes,
-		IgnoredEdges: ignoredEdges,
+		IgnoredEdges: UnmarshallIgnoredEdges(in.IgnoredEdges),
+		IgnoredPairs: ignoredPairs,
+		LastHop:      lastHop,
+	}
+
+	// Pin to an outgoing channel if specified.
+	if in.OutgoingChanId != 0 {
+		outgoingChanID := in.OutgoingChanId
+		restrictions.OutgoingChannelID = &outgoingChanID
 	}
 
 	// numRoutes will default to 10 if not specified explicitly.
@@ -174,6 +190,195 @@ func (r *RouterBackend) QueryRoutes(ctx context.Context,
 	return routeResp, nil
 }
 
+// unmarshallHopByChannelLookup unmarshalls an rpc hop for which the pub key is
+// not known. This function will query the channel graph with channel id to
+// retrieve both endpoints and determine the hop pubkey using the previous hop
+// pubkey. If the channel is unknown, an error is returned.
+func (r *RouterBackend) unmarshallHopByChannelLookup(hop *lnrpc.Hop,
+	prevPubKeyBytes [33]byte) (*route.Hop, error) {
+
+	// Discard edge policies, because they may be nil.
+	node1, node2, err := r.FetchChannelEndpoints(hop.ChanId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch channel edges by "+
+			"channel ID %d: %v", hop.ChanId, err)
+	}
+
+	var pubKeyBytes [33]byte
+	switch {
+	case prevPubKeyBytes == node1:
+		pubKeyBytes = node2
+	case prevPubKeyBytes == node2:
+		pubKeyBytes = node1
+	default:
+		return nil, fmt.Errorf("channel edge does not match expected node")
+	}
+
+	return &route.Hop{
+		OutgoingTimeLock: hop.Expiry,
+		AmtToForward:     lnwire.MilliSatoshi(hop.AmtToForwardMsat),
+		PubKeyBytes:      pubKeyBytes,
+		ChannelID:        hop.ChanId,
+	}, nil
+}
+
+// unmarshallKnownPubkeyHop unmarshalls an rpc hop that contains the hop pubkey.
+// The channel graph doesn't need to be queried because all information required
+// for sending the payment is present.
+func unmarshallKnownPubkeyHop(hop *lnrpc.Hop) (*route.Hop, error) {
+	pubKey, err := hex.DecodeString(hop.PubKey)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode pubkey %s", hop.PubKey)
+	}
+
+	var pubKeyBytes [33]byte
+	copy(pubKeyBytes[:], pubKey)
+
+	return &route.Hop{
+		OutgoingTimeLock: hop.Expiry,
+		AmtToForward:     lnwire.MilliSatoshi(hop.AmtToForwardMsat),
+		PubKeyBytes:      pubKeyBytes,
+		ChannelID:        hop.ChanId,
+	}, nil
+}
+
+// unmarshallHop unmarshalls an rpc hop that may or may not contain a node
+// pubkey.
+func (r *RouterBackend) unmarshallHop(hop *lnrpc.Hop,
+	prevNodePubKey [33]byte) (*route.Hop, error) {
+
+	if hop.PubKey == "" {
+		// If no pub key is given of the hop, the local channel
+		// graph needs to be queried to complete the information
+		// necessary for routing.
+		return r.unmarshallHopByChannelLookup(hop, prevNodePubKey)
+	}
+
+	return unmarshallKnownPubkeyHop(hop)
+}
+
+// UnmarshallRoute unmarshalls an rpc route. For hops that don't specify a
+// pubkey, the channel graph is queried.
+func (r *RouterBackend) UnmarshallRoute(rpcroute *lnrpc.Route) (
+	*route.Route, error) {
+
+	prevNodePubKey := r.SelfNode
+
+	hops := make([]*route.Hop, len(rpcroute.Hops))
+	for i, hop := range rpcroute.Hops {
+		routeHop, err := r.unmarshallHop(hop, prevNodePubKey)
+		if err != nil {
+			return nil, err
+		}
+
+		hops[i] = routeHop
+
+		prevNodePubKey = routeHop.PubKeyBytes
+	}
+
+	route, err := route.NewRouteFromHops(
+		lnwire.MilliSatoshi(rpcroute.TotalAmtMsat),
+		rpcroute.TotalTimeLock,
+		r.SelfNode,
+		hops,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return route, nil
+}
+
+// unmarshallVertex parses a serialized compressed public key into a vertex.
+func unmarshallVertex(key []byte) (route.Vertex, error) {
+	if len(key) != 33 {
+		return route.Vertex{}, errors.New("invalid vertex length")
+	}
+
+	var v route.Vertex
+	copy(v[:], key)
+
+	return v, nil
+}
+
+// UnmarshallIgnoredNodes parses the set of nodes to ignore during path
+// finding.
+func UnmarshallIgnoredNodes(nodes [][]byte) (map[route.Vertex]struct{},
+	error) {
+
+	ignoredNodes := make(map[route.Vertex]struct{})
+	for _, ignorePubKey := range nodes {
+		ignoreVertex, err := unmarshallVertex(ignorePubKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ignore node pubkey: "+
+				"%v", err)
+		}
+		ignoredNodes[ignoreVertex] = struct{}{}
+	}
+
+	return ignoredNodes, nil
+}
+
+// UnmarshallIgnoredEdges parses the set of edges to ignore during path
+// finding.
+func UnmarshallIgnoredEdges(
+	edges []*lnrpc.EdgeLocator) map[routing.EdgeLocator]struct{} {
+
+	ignoredEdges := make(map[routing.EdgeLocator]struct{})
+	for _, ignoredEdge := range edges {
+		locator := routing.EdgeLocator{
+			ChannelID: ignoredEdge.ChannelId,
+		}
+		if ignoredEdge.DirectionReverse {
+			locator.Direction = 1
+		}
+		ignoredEdges[locator] = struct{}{}
+	}
+
+	return ignoredEdges
+}
+
+// UnmarshallIgnoredPairs parses the set of directed node pairs to ignore
+// during path finding.
+func UnmarshallIgnoredPairs(
+	pairs []*lnrpc.NodePair) (map[routing.DirectedNodePair]struct{}, error) {
+
+	ignoredPairs := make(map[routing.DirectedNodePair]struct{})
+	for _, ignorePair := range pairs {
+		from, err := unmarshallVertex(ignorePair.From)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ignore pair from "+
+				"pubkey: %v", err)
+		}
+
+		to, err := unmarshallVertex(ignorePair.To)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ignore pair to "+
+				"pubkey: %v", err)
+		}
+
+		pair := routing.NewDirectedNodePair(from, to)
+		ignoredPairs[pair] = struct{}{}
+	}
+
+	return ignoredPairs, nil
+}
+
+// UnmarshallLastHop parses the optional pubkey of the last hop before the
+// destination. A nil vertex is returned if no last hop is set.
+func UnmarshallLastHop(pubKey []byte) (*route.Vertex, error) {
+	if len(pubKey) == 0 {
+		return nil, nil
+	}
+
+	lastHop, err := unmarshallVertex(pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid last hop pubkey: %v", err)
+	}
+
+	return &lastHop, nil
+}
+
 // calculateFeeLimit returns the fee limit in millisatoshis. If a percentage
 // based fee limit has been requested, we'll factor in the ratio provided with
 // the amount of the payment.
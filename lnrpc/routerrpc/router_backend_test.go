@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"errors"
 	"testing"
 
 	"github.com/btcsuite/btcutil"
@@ -21,6 +22,9 @@ const (
 
 var (
 	sourceKey = route.Vertex{1, 2, 3}
+
+	node1 = route.Vertex{4, 5, 6}
+	node2 = route.Vertex{7, 8, 9}
 )
 
 // TestQueryRoutes asserts that query routes rpc parameters are properly parsed
@@ -54,6 +58,12 @@ func TestQueryRoutes(t *testing.T) {
 			ChannelId:        555,
 			DirectionReverse: true,
 		}},
+		IgnoredPairs: []*lnrpc.NodePair{{
+			From: node1[:],
+			To:   node2[:],
+		}},
+		OutgoingChanId: 333,
+		LastHopPubkey:  node2[:],
 	}
 
 	rt := &route.Route{}
@@ -101,6 +111,25 @@ func TestQueryRoutes(t *testing.T) {
 			t.Fatal("unexpected ignored node")
 		}
 
+		if len(restrictions.IgnoredPairs) != 1 {
+			t.Fatal("unexpected ignored pairs map size")
+		}
+
+		pair := routing.NewDirectedNodePair(node1, node2)
+		if _, ok := restrictions.IgnoredPairs[pair]; !ok {
+			t.Fatal("unexpected ignored pair")
+		}
+
+		if restrictions.OutgoingChannelID == nil ||
+			*restrictions.OutgoingChannelID != 333 {
+
+			t.Fatal("unexpected outgoing channel id")
+		}
+
+		if restrictions.LastHop == nil || *restrictions.LastHop != node2 {
+			t.Fatal("unexpected last hop")
+		}
+
 		return []*route.Route{
 			rt,
 		}, nil
@@ -125,3 +154,61 @@ func TestQueryRoutes(t *testing.T) {
 		t.Fatal("expected a single route response")
 	}
 }
+
+// TestUnmarshallRoute asserts that hops without a pubkey are completed by
+// looking up the endpoints of their channel.
+func TestUnmarshallRoute(t *testing.T) {
+	backend := &RouterBackend{
+		SelfNode: sourceKey,
+		FetchChannelEndpoints: func(chanID uint64) (route.Vertex,
+			route.Vertex, error) {
+
+			switch chanID {
+			case 1:
+				return node1, sourceKey, nil
+			case 2:
+				return node1, node2, nil
+			}
+
+			return route.Vertex{}, route.Vertex{},
+				errors.New("unknown channel")
+		},
+	}
+
+	rpcRoute := &lnrpc.Route{
+		TotalTimeLock: 100,
+		TotalAmtMsat:  1100,
+		Hops: []*lnrpc.Hop{
+			{
+				ChanId:           1,
+				Expiry:           90,
+				AmtToForwardMsat: 1000,
+			},
+			{
+				ChanId:           2,
+				Expiry:           90,
+				AmtToForwardMsat: 1000,
+				PubKey:           hex.EncodeToString(node2[:]),
+			},
+		},
+	}
+
+	rt, err := backend.UnmarshallRoute(rpcRoute)
+	if err != nil {
+		t.Fatalf("unable to unmarshall route: %v", err)
+	}
+
+	if rt.SourcePubKey != sourceKey {
+		t.Fatal("unexpected source key")
+	}
+	if rt.Hops[0].PubKeyBytes != node1 || rt.Hops[1].PubKeyBytes != node2 {
+		t.Fatal("unexpected hop pubkeys")
+	}
+
+	// A hop over a channel that doesn't connect to the previous hop must
+	// be rejected.
+	rpcRoute.Hops[0].ChanId = 2
+	if _, err := backend.UnmarshallRoute(rpcRoute); err == nil {
+		t.Fatal("expected route with disconnected hop to be rejected")
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing"
@@ -48,6 +49,30 @@ var (
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/routerpc.Router/SubscribeHtlcEvents": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/routerpc.Router/Rebalance": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/routerpc.Router/ListRebalances": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/routerpc.Router/BuildRoute": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/routerpc.Router/ProbeRoute": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/routerpc.Router/ProbePayment": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 	}
 
 	// DefaultRouterMacFilename is the default name of the router macaroon
@@ -60,6 +85,8 @@ var (
 // allows clients to route arbitrary payment through the Lightning Network.
 type Server struct {
 	cfg *Config
+
+	quit chan struct{}
 }
 
 // A compile time check to ensure that Server fully implements the RouterServer
@@ -119,7 +146,8 @@ func New(cfg *Config) (*Server, lnrpc.MacaroonPerms, error) {
 	}
 
 	routerServer := &Server{
-		cfg: cfg,
+		cfg:  cfg,
+		quit: make(chan struct{}),
 	}
 
 	return routerServer, macPermissions, nil
@@ -136,6 +164,8 @@ func (s *Server) Start() error {
 //
 // NOTE: This is part of the lnrpc.SubServer interface.
 func (s *Server) Stop() error {
+	close(s.quit)
+
 	return nil
 }
 
@@ -214,7 +244,48 @@ func (s *Server) SendPayment(ctx context.Context,
 		payment.OutgoingChannelID = &chanID
 	}
 
-	preImage, _, err := s.cfg.Router.SendPayment(&payment)
+	payment.LastHop, err = UnmarshallLastHop(req.LastHopPubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	payment.IgnoredNodes, err = UnmarshallIgnoredNodes(req.IgnoredNodes)
+	if err != nil {
+		return nil, err
+	}
+
+	payment.IgnoredEdges = UnmarshallIgnoredEdges(req.IgnoredEdges)
+
+	payment.IgnoredPairs, err = UnmarshallIgnoredPairs(req.IgnoredPairs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Before dispatching the payment, we'll make sure it's permitted by
+	// the spending caveats of the caller's macaroon.
+	var settleSpend func(lnwire.MilliSatoshi) error
+	if s.cfg.RouterBackend.ReserveSpend != nil {
+		settleSpend, err = s.cfg.RouterBackend.ReserveSpend(
+			ctx, payment.Amount+payment.FeeLimit,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	preImage, rt, err := s.cfg.Router.SendPayment(&payment)
+
+	if settleSpend != nil {
+		var spent lnwire.MilliSatoshi
+		if err == nil {
+			spent = rt.TotalAmount
+		}
+		if err := settleSpend(spent); err != nil {
+			log.Errorf("Unable to settle spend of payment %x: %v",
+				payment.PaymentHash[:], err)
+		}
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -266,3 +337,263 @@ func (s *Server) EstimateRouteFee(ctx context.Context,
 		TimeLockDelay:  int64(routes[0].TotalTimeLock),
 	}, nil
 }
+
+// SubscribeHtlcEvents creates a uni-directional stream from the server to
+// the client which delivers a stream of htlc events as they move through the
+// switch and our channel links.
+func (s *Server) SubscribeHtlcEvents(req *SubscribeHtlcEventsRequest,
+	stream Router_SubscribeHtlcEventsServer) error {
+
+	htlcClient, err := s.cfg.HtlcNotifier.SubscribeHtlcEvents()
+	if err != nil {
+		return err
+	}
+	defer htlcClient.Cancel()
+
+	for {
+		select {
+		case event := <-htlcClient.Updates():
+			evt, err := rpcHtlcEvent(event)
+			if err != nil {
+				return err
+			}
+
+			if err := stream.Send(evt); err != nil {
+				return err
+			}
+
+		// If the notifier is shutting down, we'll exit with an error
+		// so that the client knows the stream has ended.
+		case <-htlcClient.Quit():
+			return errors.New("htlc event subscription terminated")
+
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+
+		case <-s.quit:
+			return nil
+		}
+	}
+}
+
+// Rebalance moves funds from one of our channels into another one by paying
+// ourselves over a circular route.
+func (s *Server) Rebalance(ctx context.Context,
+	req *RebalanceRequest) (*RebalanceRecord, error) {
+
+	if req.AmtSat <= 0 {
+		return nil, errors.New("amount must be positive")
+	}
+
+	amt := lnwire.NewMSatFromSatoshis(btcutil.Amount(req.AmtSat))
+	feeLimit := lnwire.NewMSatFromSatoshis(btcutil.Amount(req.FeeLimitSat))
+	timeout := time.Second * time.Duration(req.TimeoutSeconds)
+
+	// The rebalanced amount returns to one of our own channels, so only
+	// the fees count against the spending caveats of the caller's
+	// macaroon.
+	var settleSpend func(lnwire.MilliSatoshi) error
+	if s.cfg.RouterBackend.ReserveSpend != nil {
+		var err error
+		settleSpend, err = s.cfg.RouterBackend.ReserveSpend(
+			ctx, feeLimit,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rebalance, err := s.cfg.Router.Rebalance(&routing.RebalanceRequest{
+		OutgoingChannelID: req.OutgoingChanId,
+		IncomingChannelID: req.IncomingChanId,
+		Amount:            amt,
+		FeeLimit:          feeLimit,
+		PayAttemptTimeout: timeout,
+	})
+
+	if settleSpend != nil {
+		var spent lnwire.MilliSatoshi
+		if err == nil {
+			spent = rebalance.Fee
+		}
+		if err := settleSpend(spent); err != nil {
+			log.Errorf("Unable to settle spend of rebalance: %v",
+				err)
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rpcRebalance(rebalance), nil
+}
+
+// ListRebalances returns all completed rebalances.
+func (s *Server) ListRebalances(ctx context.Context,
+	req *ListRebalancesRequest) (*ListRebalancesResponse, error) {
+
+	rebalances, err := s.cfg.Router.FetchRebalances()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ListRebalancesResponse{
+		Rebalances: make([]*RebalanceRecord, 0, len(rebalances)),
+	}
+	for _, rebalance := range rebalances {
+		resp.Rebalances = append(
+			resp.Rebalances, rpcRebalance(rebalance),
+		)
+	}
+
+	return resp, nil
+}
+
+// BuildRoute builds a fully specified route based on a list of hop public
+// keys, looking up the channels between consecutive hops in the graph.
+func (s *Server) BuildRoute(ctx context.Context,
+	req *BuildRouteRequest) (*BuildRouteResponse, error) {
+
+	if req.AmtMsat <= 0 {
+		return nil, errors.New("amount must be positive")
+	}
+
+	if len(req.HopPubkeys) == 0 {
+		return nil, errors.New("no hops specified")
+	}
+
+	hops := make([]route.Vertex, 0, len(req.HopPubkeys))
+	for _, hopPubKey := range req.HopPubkeys {
+		hop, err := unmarshallVertex(hopPubKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hop pubkey: %v", err)
+		}
+		hops = append(hops, hop)
+	}
+
+	var outgoingChan *uint64
+	if req.OutgoingChanId != 0 {
+		outgoingChan = &req.OutgoingChanId
+	}
+
+	finalCLTVDelta := uint16(zpay32.DefaultFinalCLTVDelta)
+	if req.FinalCltvDelta != 0 {
+		finalCLTVDelta = uint16(req.FinalCltvDelta)
+	}
+
+	rt, err := s.cfg.Router.BuildRoute(
+		lnwire.MilliSatoshi(req.AmtMsat), hops, outgoingChan,
+		finalCLTVDelta,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BuildRouteResponse{
+		Route: s.cfg.RouterBackend.MarshallRoute(rt),
+	}, nil
+}
+
+// ProbeRoute tests whether a route is able to carry its amount, by sending an
+// HTLC with a random payment hash over it.
+func (s *Server) ProbeRoute(ctx context.Context,
+	req *ProbeRouteRequest) (*ProbeResult, error) {
+
+	if req.Route == nil {
+		return nil, errors.New("no route specified")
+	}
+
+	rt, err := s.cfg.RouterBackend.UnmarshallRoute(req.Route)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *routing.ProbeResult
+	if req.FindMaxAmt {
+		result, err = s.cfg.Router.ProbeMaxAmount(rt)
+	} else {
+		result, err = s.cfg.Router.ProbeRoute(rt)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.rpcProbeResult(result), nil
+}
+
+// ProbePayment probes the network for a route to the destination that is
+// able to carry the amount, without paying.
+func (s *Server) ProbePayment(ctx context.Context,
+	req *ProbePaymentRequest) (*ProbeResult, error) {
+
+	destination, err := unmarshallVertex(req.Dest)
+	if err != nil {
+		return nil, fmt.Errorf("invalid destination: %v", err)
+	}
+
+	if req.AmtSat <= 0 {
+		return nil, errors.New("amount must be positive")
+	}
+	amt := lnwire.NewMSatFromSatoshis(btcutil.Amount(req.AmtSat))
+
+	feeLimit := lnwire.NewMSatFromSatoshis(btcutil.Amount(req.FeeLimitSat))
+	if feeLimit == 0 {
+		feeLimit = amt
+	}
+
+	payment := &routing.LightningPayment{
+		Target:            destination,
+		Amount:            amt,
+		FeeLimit:          feeLimit,
+		PayAttemptTimeout: time.Second * time.Duration(req.TimeoutSeconds),
+	}
+
+	if req.FinalCltvDelta != 0 {
+		finalDelta := uint16(req.FinalCltvDelta)
+		payment.FinalCLTVDelta = &finalDelta
+	}
+
+	if req.OutgoingChanId != 0 {
+		payment.OutgoingChannelID = &req.OutgoingChanId
+	}
+
+	result, err := s.cfg.Router.ProbePayment(payment)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.rpcProbeResult(result), nil
+}
+
+// rpcProbeResult converts a probe result to its rpc representation.
+func (s *Server) rpcProbeResult(result *routing.ProbeResult) *ProbeResult {
+	rpcRoute := s.cfg.RouterBackend.MarshallRoute(result.Route)
+
+	return &ProbeResult{
+		Success:             result.Success,
+		Route:               rpcRoute,
+		FailureSourcePubkey: result.FailureSource[:],
+		FailureSourceIndex:  int32(result.FailureSourceIndex),
+		FailureCode:         uint32(result.FailureCode),
+	}
+}
+
+// rpcRebalance converts a completed rebalance to its rpc representation.
+func rpcRebalance(rebalance *channeldb.Rebalance) *RebalanceRecord {
+	path := make([]uint64, 0, len(rebalance.Path))
+	for _, chanID := range rebalance.Path {
+		path = append(path, chanID.ToUint64())
+	}
+
+	return &RebalanceRecord{
+		OutgoingChanId: rebalance.OutgoingChanID.ToUint64(),
+		IncomingChanId: rebalance.IncomingChanID.ToUint64(),
+		AmtMsat:        int64(rebalance.Amount),
+		FeeMsat:        int64(rebalance.Fee),
+		PaymentHash:    rebalance.PaymentHash[:],
+		Preimage:       rebalance.PaymentPreimage[:],
+		Path:           path,
+		Timestamp:      rebalance.Timestamp.Unix(),
+	}
+}
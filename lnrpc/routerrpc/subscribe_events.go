@@ -0,0 +1,114 @@
+// +build routerrpc
+
+package routerrpc
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lightningnetwork/lnd/htlcswitch"
+)
+
+// rpcHtlcEvent returns a rpc htlc event from a htlcswitch event.
+func rpcHtlcEvent(htlcEvent interface{}) (*HtlcEvent, error) {
+	var (
+		key       htlcswitch.HtlcKey
+		eventType htlcswitch.HtlcEventType
+		timestamp time.Time
+		event     *HtlcEvent
+	)
+
+	switch e := htlcEvent.(type) {
+	case *htlcswitch.ForwardingEvent:
+		key, eventType, timestamp = e.HtlcKey, e.HtlcEventType,
+			e.Timestamp
+		event = &HtlcEvent{
+			Event: &HtlcEvent_ForwardEvent{
+				ForwardEvent: &ForwardEvent{
+					Info: rpcInfo(e.HtlcInfo),
+				},
+			},
+		}
+
+	case *htlcswitch.ForwardingFailEvent:
+		key, eventType, timestamp = e.HtlcKey, e.HtlcEventType,
+			e.Timestamp
+
+		failEvent := &ForwardFailEvent{}
+		if failure := e.FailureMessage; failure != nil {
+			failEvent.WireFailureCode = uint32(failure.Code())
+			failEvent.FailureString = failure.Error()
+		}
+
+		event = &HtlcEvent{
+			Event: &HtlcEvent_ForwardFailEvent{
+				ForwardFailEvent: failEvent,
+			},
+		}
+
+	case *htlcswitch.LinkFailEvent:
+		key, eventType, timestamp = e.HtlcKey, e.HtlcEventType,
+			e.Timestamp
+
+		failEvent := &LinkFailEvent{
+			Info:          rpcInfo(e.HtlcInfo),
+			FailureDetail: e.FailureDetail,
+			Incoming:      e.Incoming,
+		}
+		if failure := e.FailureMessage; failure != nil {
+			failEvent.WireFailureCode = uint32(failure.Code())
+			failEvent.FailureString = failure.Error()
+		}
+
+		event = &HtlcEvent{
+			Event: &HtlcEvent_LinkFailEvent{
+				LinkFailEvent: failEvent,
+			},
+		}
+
+	case *htlcswitch.SettleEvent:
+		key, eventType, timestamp = e.HtlcKey, e.HtlcEventType,
+			e.Timestamp
+		event = &HtlcEvent{
+			Event: &HtlcEvent_SettleEvent{
+				SettleEvent: &SettleEvent{},
+			},
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown event type: %T", htlcEvent)
+	}
+
+	switch eventType {
+	case htlcswitch.HtlcEventTypeSend:
+		event.EventType = HtlcEvent_SEND
+
+	case htlcswitch.HtlcEventTypeReceive:
+		event.EventType = HtlcEvent_RECEIVE
+
+	case htlcswitch.HtlcEventTypeForward:
+		event.EventType = HtlcEvent_FORWARD
+
+	default:
+		return nil, fmt.Errorf("unknown event type: %v", eventType)
+	}
+
+	event.IncomingChannelId = key.IncomingCircuit.ChanID.ToUint64()
+	event.OutgoingChannelId = key.OutgoingCircuit.ChanID.ToUint64()
+	event.IncomingHtlcId = key.IncomingCircuit.HtlcID
+	event.OutgoingHtlcId = key.OutgoingCircuit.HtlcID
+	event.TimestampNs = uint64(timestamp.UnixNano())
+
+	return event, nil
+}
+
+// rpcInfo returns a rpc struct containing the htlc information from the
+// switch's htlc info struct.
+func rpcInfo(info htlcswitch.HtlcInfo) *HtlcInfo {
+	return &HtlcInfo{
+		IncomingTimelock: info.IncomingTimeLock,
+		OutgoingTimelock: info.OutgoingTimeLock,
+		IncomingAmtMsat:  uint64(info.IncomingAmt),
+		OutgoingAmtMsat:  uint64(info.OutgoingAmt),
+	}
+}
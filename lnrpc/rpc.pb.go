@@ -55,7 +55,7 @@ func (x AddressType) String() string {
 	return proto.EnumName(AddressType_name, int32(x))
 }
 func (AddressType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{0}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{0}
 }
 
 type ChannelCloseSummary_ClosureType int32
@@ -90,7 +90,7 @@ func (x ChannelCloseSummary_ClosureType) String() string {
 	return proto.EnumName(ChannelCloseSummary_ClosureType_name, int32(x))
 }
 func (ChannelCloseSummary_ClosureType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{41, 0}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{42, 0}
 }
 
 type Peer_SyncType int32
@@ -122,7 +122,7 @@ func (x Peer_SyncType) String() string {
 	return proto.EnumName(Peer_SyncType_name, int32(x))
 }
 func (Peer_SyncType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{44, 0}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{45, 0}
 }
 
 type ChannelEventUpdate_UpdateType int32
@@ -151,7 +151,7 @@ func (x ChannelEventUpdate_UpdateType) String() string {
 	return proto.EnumName(ChannelEventUpdate_UpdateType_name, int32(x))
 }
 func (ChannelEventUpdate_UpdateType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{62, 0}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{68, 0}
 }
 
 type Invoice_InvoiceState int32
@@ -180,7 +180,7 @@ func (x Invoice_InvoiceState) String() string {
 	return proto.EnumName(Invoice_InvoiceState_name, int32(x))
 }
 func (Invoice_InvoiceState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{92, 0}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{105, 0}
 }
 
 type GenSeedRequest struct {
@@ -191,7 +191,18 @@ type GenSeedRequest struct {
 	// *
 	// seed_entropy is an optional 16-bytes generated via CSPRNG. If not
 	// specified, then a fresh set of randomness will be used to create the seed.
-	SeedEntropy          []byte   `protobuf:"bytes,2,opt,name=seed_entropy,json=seedEntropy,proto3" json:"seed_entropy,omitempty"`
+	SeedEntropy []byte `protobuf:"bytes,2,opt,name=seed_entropy,json=seedEntropy,proto3" json:"seed_entropy,omitempty"`
+	// *
+	// share_threshold is the optional number of shares required to recover the
+	// generated cipher seed. If set along with num_shares, then the cipher seed
+	// will be split into num_shares Shamir secret shares, any share_threshold of
+	// which can be combined to recover the cipher seed. In this case only the
+	// shares are returned, and the full cipher seed is never revealed.
+	ShareThreshold uint32 `protobuf:"varint,3,opt,name=share_threshold,json=shareThreshold,proto3" json:"share_threshold,omitempty"`
+	// *
+	// num_shares is the optional number of shares the cipher seed should be split
+	// into. At most 15 shares are supported.
+	NumShares            uint32   `protobuf:"varint,4,opt,name=num_shares,json=numShares,proto3" json:"num_shares,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -201,7 +212,7 @@ func (m *GenSeedRequest) Reset()         { *m = GenSeedRequest{} }
 func (m *GenSeedRequest) String() string { return proto.CompactTextString(m) }
 func (*GenSeedRequest) ProtoMessage()    {}
 func (*GenSeedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{0}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{0}
 }
 func (m *GenSeedRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GenSeedRequest.Unmarshal(m, b)
@@ -235,6 +246,61 @@ func (m *GenSeedRequest) GetSeedEntropy() []byte {
 	return nil
 }
 
+func (m *GenSeedRequest) GetShareThreshold() uint32 {
+	if m != nil {
+		return m.ShareThreshold
+	}
+	return 0
+}
+
+func (m *GenSeedRequest) GetNumShares() uint32 {
+	if m != nil {
+		return m.NumShares
+	}
+	return 0
+}
+
+type CipherSeedShare struct {
+	// *
+	// share_mnemonic is a 30-word mnemonic that encodes a single Shamir secret
+	// share of an aezeed cipher seed.
+	ShareMnemonic        []string `protobuf:"bytes,1,rep,name=share_mnemonic,json=shareMnemonic,proto3" json:"share_mnemonic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CipherSeedShare) Reset()         { *m = CipherSeedShare{} }
+func (m *CipherSeedShare) String() string { return proto.CompactTextString(m) }
+func (*CipherSeedShare) ProtoMessage()    {}
+func (*CipherSeedShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{1}
+}
+func (m *CipherSeedShare) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CipherSeedShare.Unmarshal(m, b)
+}
+func (m *CipherSeedShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CipherSeedShare.Marshal(b, m, deterministic)
+}
+func (dst *CipherSeedShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CipherSeedShare.Merge(dst, src)
+}
+func (m *CipherSeedShare) XXX_Size() int {
+	return xxx_messageInfo_CipherSeedShare.Size(m)
+}
+func (m *CipherSeedShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_CipherSeedShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CipherSeedShare proto.InternalMessageInfo
+
+func (m *CipherSeedShare) GetShareMnemonic() []string {
+	if m != nil {
+		return m.ShareMnemonic
+	}
+	return nil
+}
+
 type GenSeedResponse struct {
 	// *
 	// cipher_seed_mnemonic is a 24-word mnemonic that encodes a prior aezeed
@@ -246,17 +312,22 @@ type GenSeedResponse struct {
 	// *
 	// enciphered_seed are the raw aezeed cipher seed bytes. This is the raw
 	// cipher text before run through our mnemonic encoding scheme.
-	EncipheredSeed       []byte   `protobuf:"bytes,2,opt,name=enciphered_seed,json=encipheredSeed,proto3" json:"enciphered_seed,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	EncipheredSeed []byte `protobuf:"bytes,2,opt,name=enciphered_seed,json=encipheredSeed,proto3" json:"enciphered_seed,omitempty"`
+	// *
+	// cipher_seed_shares are the Shamir secret shares of the cipher seed, if the
+	// seed was requested to be split. If set, then neither cipher_seed_mnemonic
+	// nor enciphered_seed will be populated.
+	CipherSeedShares     []*CipherSeedShare `protobuf:"bytes,3,rep,name=cipher_seed_shares,json=cipherSeedShares,proto3" json:"cipher_seed_shares,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *GenSeedResponse) Reset()         { *m = GenSeedResponse{} }
 func (m *GenSeedResponse) String() string { return proto.CompactTextString(m) }
 func (*GenSeedResponse) ProtoMessage()    {}
 func (*GenSeedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{1}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{2}
 }
 func (m *GenSeedResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GenSeedResponse.Unmarshal(m, b)
@@ -290,6 +361,13 @@ func (m *GenSeedResponse) GetEncipheredSeed() []byte {
 	return nil
 }
 
+func (m *GenSeedResponse) GetCipherSeedShares() []*CipherSeedShare {
+	if m != nil {
+		return m.CipherSeedShares
+	}
+	return nil
+}
+
 type InitWalletRequest struct {
 	// *
 	// wallet_password is the passphrase that should be used to encrypt the
@@ -319,17 +397,24 @@ type InitWalletRequest struct {
 	// total data loss occurred. If specified, then after on-chain recovery of
 	// funds, lnd begin to carry out the data loss recovery protocol in order to
 	// recover the funds in each channel from a remote force closed transaction.
-	ChannelBackups       *ChanBackupSnapshot `protobuf:"bytes,5,opt,name=channel_backups,json=channelBackups,proto3" json:"channel_backups,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	ChannelBackups *ChanBackupSnapshot `protobuf:"bytes,5,opt,name=channel_backups,json=channelBackups,proto3" json:"channel_backups,omitempty"`
+	// *
+	// cipher_seed_shares is an optional set of Shamir secret shares of an aezeed
+	// cipher seed, as returned by GenSeed. If set, then the shares will be
+	// combined to recover the cipher seed, which will then be deciphered using
+	// aezeed_passphrase. This field can't be set along with
+	// cipher_seed_mnemonic.
+	CipherSeedShares     []*CipherSeedShare `protobuf:"bytes,6,rep,name=cipher_seed_shares,json=cipherSeedShares,proto3" json:"cipher_seed_shares,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *InitWalletRequest) Reset()         { *m = InitWalletRequest{} }
 func (m *InitWalletRequest) String() string { return proto.CompactTextString(m) }
 func (*InitWalletRequest) ProtoMessage()    {}
 func (*InitWalletRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{2}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{3}
 }
 func (m *InitWalletRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InitWalletRequest.Unmarshal(m, b)
@@ -384,6 +469,13 @@ func (m *InitWalletRequest) GetChannelBackups() *ChanBackupSnapshot {
 	return nil
 }
 
+func (m *InitWalletRequest) GetCipherSeedShares() []*CipherSeedShare {
+	if m != nil {
+		return m.CipherSeedShares
+	}
+	return nil
+}
+
 type InitWalletResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -394,7 +486,7 @@ func (m *InitWalletResponse) Reset()         { *m = InitWalletResponse{} }
 func (m *InitWalletResponse) String() string { return proto.CompactTextString(m) }
 func (*InitWalletResponse) ProtoMessage()    {}
 func (*InitWalletResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{3}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{4}
 }
 func (m *InitWalletResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InitWalletResponse.Unmarshal(m, b)
@@ -444,7 +536,7 @@ func (m *UnlockWalletRequest) Reset()         { *m = UnlockWalletRequest{} }
 func (m *UnlockWalletRequest) String() string { return proto.CompactTextString(m) }
 func (*UnlockWalletRequest) ProtoMessage()    {}
 func (*UnlockWalletRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{4}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{5}
 }
 func (m *UnlockWalletRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UnlockWalletRequest.Unmarshal(m, b)
@@ -495,7 +587,7 @@ func (m *UnlockWalletResponse) Reset()         { *m = UnlockWalletResponse{} }
 func (m *UnlockWalletResponse) String() string { return proto.CompactTextString(m) }
 func (*UnlockWalletResponse) ProtoMessage()    {}
 func (*UnlockWalletResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{5}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{6}
 }
 func (m *UnlockWalletResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UnlockWalletResponse.Unmarshal(m, b)
@@ -533,7 +625,7 @@ func (m *ChangePasswordRequest) Reset()         { *m = ChangePasswordRequest{} }
 func (m *ChangePasswordRequest) String() string { return proto.CompactTextString(m) }
 func (*ChangePasswordRequest) ProtoMessage()    {}
 func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{6}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{7}
 }
 func (m *ChangePasswordRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChangePasswordRequest.Unmarshal(m, b)
@@ -577,7 +669,7 @@ func (m *ChangePasswordResponse) Reset()         { *m = ChangePasswordResponse{}
 func (m *ChangePasswordResponse) String() string { return proto.CompactTextString(m) }
 func (*ChangePasswordResponse) ProtoMessage()    {}
 func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{7}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{8}
 }
 func (m *ChangePasswordResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChangePasswordResponse.Unmarshal(m, b)
@@ -619,7 +711,7 @@ func (m *Utxo) Reset()         { *m = Utxo{} }
 func (m *Utxo) String() string { return proto.CompactTextString(m) }
 func (*Utxo) ProtoMessage()    {}
 func (*Utxo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{8}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{9}
 }
 func (m *Utxo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Utxo.Unmarshal(m, b)
@@ -707,7 +799,7 @@ func (m *Transaction) Reset()         { *m = Transaction{} }
 func (m *Transaction) String() string { return proto.CompactTextString(m) }
 func (*Transaction) ProtoMessage()    {}
 func (*Transaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{9}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{10}
 }
 func (m *Transaction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Transaction.Unmarshal(m, b)
@@ -793,7 +885,7 @@ func (m *GetTransactionsRequest) Reset()         { *m = GetTransactionsRequest{}
 func (m *GetTransactionsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTransactionsRequest) ProtoMessage()    {}
 func (*GetTransactionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{10}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{11}
 }
 func (m *GetTransactionsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTransactionsRequest.Unmarshal(m, b)
@@ -825,7 +917,7 @@ func (m *TransactionDetails) Reset()         { *m = TransactionDetails{} }
 func (m *TransactionDetails) String() string { return proto.CompactTextString(m) }
 func (*TransactionDetails) ProtoMessage()    {}
 func (*TransactionDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{11}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{12}
 }
 func (m *TransactionDetails) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TransactionDetails.Unmarshal(m, b)
@@ -866,7 +958,7 @@ func (m *FeeLimit) Reset()         { *m = FeeLimit{} }
 func (m *FeeLimit) String() string { return proto.CompactTextString(m) }
 func (*FeeLimit) ProtoMessage()    {}
 func (*FeeLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{12}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{13}
 }
 func (m *FeeLimit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FeeLimit.Unmarshal(m, b)
@@ -1020,17 +1112,30 @@ type SendRequest struct {
 	// *
 	// An optional maximum total time lock for the route. If zero, there is no
 	// maximum enforced.
-	CltvLimit            uint32   `protobuf:"varint,10,opt,name=cltv_limit,json=cltvLimit,proto3" json:"cltv_limit,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	CltvLimit uint32 `protobuf:"varint,10,opt,name=cltv_limit,json=cltvLimit,proto3" json:"cltv_limit,omitempty"`
+	// *
+	// The pubkey of the last hop of the route. If empty, any hop may be used.
+	LastHopPubkey []byte `protobuf:"bytes,11,opt,name=last_hop_pubkey,json=lastHopPubkey,proto3" json:"last_hop_pubkey,omitempty"`
+	// *
+	// A list of nodes to ignore during path finding.
+	IgnoredNodes [][]byte `protobuf:"bytes,12,rep,name=ignored_nodes,json=ignoredNodes,proto3" json:"ignored_nodes,omitempty"`
+	// *
+	// A list of edges to ignore during path finding.
+	IgnoredEdges []*EdgeLocator `protobuf:"bytes,13,rep,name=ignored_edges,json=ignoredEdges,proto3" json:"ignored_edges,omitempty"`
+	// *
+	// A list of directional node pairs for which path finding must not use any
+	// of the channels between them in the given direction.
+	IgnoredPairs         []*NodePair `protobuf:"bytes,14,rep,name=ignored_pairs,json=ignoredPairs,proto3" json:"ignored_pairs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *SendRequest) Reset()         { *m = SendRequest{} }
 func (m *SendRequest) String() string { return proto.CompactTextString(m) }
 func (*SendRequest) ProtoMessage()    {}
 func (*SendRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{13}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{14}
 }
 func (m *SendRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendRequest.Unmarshal(m, b)
@@ -1120,6 +1225,34 @@ func (m *SendRequest) GetCltvLimit() uint32 {
 	return 0
 }
 
+func (m *SendRequest) GetLastHopPubkey() []byte {
+	if m != nil {
+		return m.LastHopPubkey
+	}
+	return nil
+}
+
+func (m *SendRequest) GetIgnoredNodes() [][]byte {
+	if m != nil {
+		return m.IgnoredNodes
+	}
+	return nil
+}
+
+func (m *SendRequest) GetIgnoredEdges() []*EdgeLocator {
+	if m != nil {
+		return m.IgnoredEdges
+	}
+	return nil
+}
+
+func (m *SendRequest) GetIgnoredPairs() []*NodePair {
+	if m != nil {
+		return m.IgnoredPairs
+	}
+	return nil
+}
+
 type SendResponse struct {
 	PaymentError         string   `protobuf:"bytes,1,opt,name=payment_error,proto3" json:"payment_error,omitempty"`
 	PaymentPreimage      []byte   `protobuf:"bytes,2,opt,name=payment_preimage,proto3" json:"payment_preimage,omitempty"`
@@ -1134,7 +1267,7 @@ func (m *SendResponse) Reset()         { *m = SendResponse{} }
 func (m *SendResponse) String() string { return proto.CompactTextString(m) }
 func (*SendResponse) ProtoMessage()    {}
 func (*SendResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{14}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{15}
 }
 func (m *SendResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendResponse.Unmarshal(m, b)
@@ -1204,7 +1337,7 @@ func (m *SendToRouteRequest) Reset()         { *m = SendToRouteRequest{} }
 func (m *SendToRouteRequest) String() string { return proto.CompactTextString(m) }
 func (*SendToRouteRequest) ProtoMessage()    {}
 func (*SendToRouteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{15}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{16}
 }
 func (m *SendToRouteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendToRouteRequest.Unmarshal(m, b)
@@ -1269,7 +1402,7 @@ func (m *ChannelPoint) Reset()         { *m = ChannelPoint{} }
 func (m *ChannelPoint) String() string { return proto.CompactTextString(m) }
 func (*ChannelPoint) ProtoMessage()    {}
 func (*ChannelPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{16}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{17}
 }
 func (m *ChannelPoint) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelPoint.Unmarshal(m, b)
@@ -1415,7 +1548,7 @@ func (m *OutPoint) Reset()         { *m = OutPoint{} }
 func (m *OutPoint) String() string { return proto.CompactTextString(m) }
 func (*OutPoint) ProtoMessage()    {}
 func (*OutPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{17}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{18}
 }
 func (m *OutPoint) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OutPoint.Unmarshal(m, b)
@@ -1470,7 +1603,7 @@ func (m *LightningAddress) Reset()         { *m = LightningAddress{} }
 func (m *LightningAddress) String() string { return proto.CompactTextString(m) }
 func (*LightningAddress) ProtoMessage()    {}
 func (*LightningAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{18}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{19}
 }
 func (m *LightningAddress) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LightningAddress.Unmarshal(m, b)
@@ -1518,7 +1651,7 @@ func (m *EstimateFeeRequest) Reset()         { *m = EstimateFeeRequest{} }
 func (m *EstimateFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimateFeeRequest) ProtoMessage()    {}
 func (*EstimateFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{19}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{20}
 }
 func (m *EstimateFeeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EstimateFeeRequest.Unmarshal(m, b)
@@ -1566,7 +1699,7 @@ func (m *EstimateFeeResponse) Reset()         { *m = EstimateFeeResponse{} }
 func (m *EstimateFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateFeeResponse) ProtoMessage()    {}
 func (*EstimateFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{20}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{21}
 }
 func (m *EstimateFeeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EstimateFeeResponse.Unmarshal(m, b)
@@ -1616,7 +1749,7 @@ func (m *SendManyRequest) Reset()         { *m = SendManyRequest{} }
 func (m *SendManyRequest) String() string { return proto.CompactTextString(m) }
 func (*SendManyRequest) ProtoMessage()    {}
 func (*SendManyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{21}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{22}
 }
 func (m *SendManyRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendManyRequest.Unmarshal(m, b)
@@ -1669,7 +1802,7 @@ func (m *SendManyResponse) Reset()         { *m = SendManyResponse{} }
 func (m *SendManyResponse) String() string { return proto.CompactTextString(m) }
 func (*SendManyResponse) ProtoMessage()    {}
 func (*SendManyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{22}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{23}
 }
 func (m *SendManyResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendManyResponse.Unmarshal(m, b)
@@ -1719,7 +1852,7 @@ func (m *SendCoinsRequest) Reset()         { *m = SendCoinsRequest{} }
 func (m *SendCoinsRequest) String() string { return proto.CompactTextString(m) }
 func (*SendCoinsRequest) ProtoMessage()    {}
 func (*SendCoinsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{23}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{24}
 }
 func (m *SendCoinsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendCoinsRequest.Unmarshal(m, b)
@@ -1786,7 +1919,7 @@ func (m *SendCoinsResponse) Reset()         { *m = SendCoinsResponse{} }
 func (m *SendCoinsResponse) String() string { return proto.CompactTextString(m) }
 func (*SendCoinsResponse) ProtoMessage()    {}
 func (*SendCoinsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{24}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{25}
 }
 func (m *SendCoinsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendCoinsResponse.Unmarshal(m, b)
@@ -1827,7 +1960,7 @@ func (m *ListUnspentRequest) Reset()         { *m = ListUnspentRequest{} }
 func (m *ListUnspentRequest) String() string { return proto.CompactTextString(m) }
 func (*ListUnspentRequest) ProtoMessage()    {}
 func (*ListUnspentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{25}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{26}
 }
 func (m *ListUnspentRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListUnspentRequest.Unmarshal(m, b)
@@ -1873,7 +2006,7 @@ func (m *ListUnspentResponse) Reset()         { *m = ListUnspentResponse{} }
 func (m *ListUnspentResponse) String() string { return proto.CompactTextString(m) }
 func (*ListUnspentResponse) ProtoMessage()    {}
 func (*ListUnspentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{26}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{27}
 }
 func (m *ListUnspentResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListUnspentResponse.Unmarshal(m, b)
@@ -1912,7 +2045,7 @@ func (m *NewAddressRequest) Reset()         { *m = NewAddressRequest{} }
 func (m *NewAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*NewAddressRequest) ProtoMessage()    {}
 func (*NewAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{27}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{28}
 }
 func (m *NewAddressRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NewAddressRequest.Unmarshal(m, b)
@@ -1951,7 +2084,7 @@ func (m *NewAddressResponse) Reset()         { *m = NewAddressResponse{} }
 func (m *NewAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*NewAddressResponse) ProtoMessage()    {}
 func (*NewAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{28}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{29}
 }
 func (m *NewAddressResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NewAddressResponse.Unmarshal(m, b)
@@ -1990,7 +2123,7 @@ func (m *SignMessageRequest) Reset()         { *m = SignMessageRequest{} }
 func (m *SignMessageRequest) String() string { return proto.CompactTextString(m) }
 func (*SignMessageRequest) ProtoMessage()    {}
 func (*SignMessageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{29}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{30}
 }
 func (m *SignMessageRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignMessageRequest.Unmarshal(m, b)
@@ -2029,7 +2162,7 @@ func (m *SignMessageResponse) Reset()         { *m = SignMessageResponse{} }
 func (m *SignMessageResponse) String() string { return proto.CompactTextString(m) }
 func (*SignMessageResponse) ProtoMessage()    {}
 func (*SignMessageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{30}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{31}
 }
 func (m *SignMessageResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignMessageResponse.Unmarshal(m, b)
@@ -2070,7 +2203,7 @@ func (m *VerifyMessageRequest) Reset()         { *m = VerifyMessageRequest{} }
 func (m *VerifyMessageRequest) String() string { return proto.CompactTextString(m) }
 func (*VerifyMessageRequest) ProtoMessage()    {}
 func (*VerifyMessageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{31}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{32}
 }
 func (m *VerifyMessageRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VerifyMessageRequest.Unmarshal(m, b)
@@ -2118,7 +2251,7 @@ func (m *VerifyMessageResponse) Reset()         { *m = VerifyMessageResponse{} }
 func (m *VerifyMessageResponse) String() string { return proto.CompactTextString(m) }
 func (*VerifyMessageResponse) ProtoMessage()    {}
 func (*VerifyMessageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{32}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{33}
 }
 func (m *VerifyMessageResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VerifyMessageResponse.Unmarshal(m, b)
@@ -2167,7 +2300,7 @@ func (m *ConnectPeerRequest) Reset()         { *m = ConnectPeerRequest{} }
 func (m *ConnectPeerRequest) String() string { return proto.CompactTextString(m) }
 func (*ConnectPeerRequest) ProtoMessage()    {}
 func (*ConnectPeerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{33}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{34}
 }
 func (m *ConnectPeerRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConnectPeerRequest.Unmarshal(m, b)
@@ -2211,7 +2344,7 @@ func (m *ConnectPeerResponse) Reset()         { *m = ConnectPeerResponse{} }
 func (m *ConnectPeerResponse) String() string { return proto.CompactTextString(m) }
 func (*ConnectPeerResponse) ProtoMessage()    {}
 func (*ConnectPeerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{34}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{35}
 }
 func (m *ConnectPeerResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConnectPeerResponse.Unmarshal(m, b)
@@ -2243,7 +2376,7 @@ func (m *DisconnectPeerRequest) Reset()         { *m = DisconnectPeerRequest{} }
 func (m *DisconnectPeerRequest) String() string { return proto.CompactTextString(m) }
 func (*DisconnectPeerRequest) ProtoMessage()    {}
 func (*DisconnectPeerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{35}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{36}
 }
 func (m *DisconnectPeerRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DisconnectPeerRequest.Unmarshal(m, b)
@@ -2280,7 +2413,7 @@ func (m *DisconnectPeerResponse) Reset()         { *m = DisconnectPeerResponse{}
 func (m *DisconnectPeerResponse) String() string { return proto.CompactTextString(m) }
 func (*DisconnectPeerResponse) ProtoMessage()    {}
 func (*DisconnectPeerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{36}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{37}
 }
 func (m *DisconnectPeerResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DisconnectPeerResponse.Unmarshal(m, b)
@@ -2314,7 +2447,7 @@ func (m *HTLC) Reset()         { *m = HTLC{} }
 func (m *HTLC) String() string { return proto.CompactTextString(m) }
 func (*HTLC) ProtoMessage()    {}
 func (*HTLC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{37}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{38}
 }
 func (m *HTLC) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HTLC.Unmarshal(m, b)
@@ -2429,7 +2562,7 @@ func (m *Channel) Reset()         { *m = Channel{} }
 func (m *Channel) String() string { return proto.CompactTextString(m) }
 func (*Channel) ProtoMessage()    {}
 func (*Channel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{38}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{39}
 }
 func (m *Channel) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Channel.Unmarshal(m, b)
@@ -2596,7 +2729,7 @@ func (m *ListChannelsRequest) Reset()         { *m = ListChannelsRequest{} }
 func (m *ListChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListChannelsRequest) ProtoMessage()    {}
 func (*ListChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{39}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{40}
 }
 func (m *ListChannelsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListChannelsRequest.Unmarshal(m, b)
@@ -2656,7 +2789,7 @@ func (m *ListChannelsResponse) Reset()         { *m = ListChannelsResponse{} }
 func (m *ListChannelsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListChannelsResponse) ProtoMessage()    {}
 func (*ListChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{40}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{41}
 }
 func (m *ListChannelsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListChannelsResponse.Unmarshal(m, b)
@@ -2713,7 +2846,7 @@ func (m *ChannelCloseSummary) Reset()         { *m = ChannelCloseSummary{} }
 func (m *ChannelCloseSummary) String() string { return proto.CompactTextString(m) }
 func (*ChannelCloseSummary) ProtoMessage()    {}
 func (*ChannelCloseSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{41}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{42}
 }
 func (m *ChannelCloseSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelCloseSummary.Unmarshal(m, b)
@@ -2819,7 +2952,7 @@ func (m *ClosedChannelsRequest) Reset()         { *m = ClosedChannelsRequest{} }
 func (m *ClosedChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*ClosedChannelsRequest) ProtoMessage()    {}
 func (*ClosedChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{42}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{43}
 }
 func (m *ClosedChannelsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClosedChannelsRequest.Unmarshal(m, b)
@@ -2892,7 +3025,7 @@ func (m *ClosedChannelsResponse) Reset()         { *m = ClosedChannelsResponse{}
 func (m *ClosedChannelsResponse) String() string { return proto.CompactTextString(m) }
 func (*ClosedChannelsResponse) ProtoMessage()    {}
 func (*ClosedChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{43}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{44}
 }
 func (m *ClosedChannelsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClosedChannelsResponse.Unmarshal(m, b)
@@ -2937,17 +3070,31 @@ type Peer struct {
 	// / Ping time to this peer
 	PingTime int64 `protobuf:"varint,9,opt,name=ping_time,proto3" json:"ping_time,omitempty"`
 	// The type of sync we are currently performing with this peer.
-	SyncType             Peer_SyncType `protobuf:"varint,10,opt,name=sync_type,proto3,enum=lnrpc.Peer_SyncType" json:"sync_type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	SyncType Peer_SyncType `protobuf:"varint,10,opt,name=sync_type,proto3,enum=lnrpc.Peer_SyncType" json:"sync_type,omitempty"`
+	// / The number of messages sent to this peer over the current connection, by message type
+	MsgsSent map[string]uint64 `protobuf:"bytes,11,rep,name=msgs_sent,proto3" json:"msgs_sent,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// / The number of messages received from this peer over the current connection, by message type
+	MsgsRecv map[string]uint64 `protobuf:"bytes,12,rep,name=msgs_recv,proto3" json:"msgs_recv,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// *
+	// The most recent errors exchanged with this peer, oldest first. Only
+	// populated if include_errors is set in the request.
+	Errors []*TimestampedError `protobuf:"bytes,13,rep,name=errors,proto3" json:"errors,omitempty"`
+	// *
+	// The number of times the connection to this peer went down after having been
+	// established. This is only tracked for peers we have channels with.
+	FlapCount int32 `protobuf:"varint,14,opt,name=flap_count,proto3" json:"flap_count,omitempty"`
+	// / The unix timestamp in nanoseconds of the last flap, 0 if the peer never flapped
+	LastFlapNs           int64    `protobuf:"varint,15,opt,name=last_flap_ns,proto3" json:"last_flap_ns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Peer) Reset()         { *m = Peer{} }
 func (m *Peer) String() string { return proto.CompactTextString(m) }
 func (*Peer) ProtoMessage()    {}
 func (*Peer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{44}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{45}
 }
 func (m *Peer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Peer.Unmarshal(m, b)
@@ -3030,7 +3177,110 @@ func (m *Peer) GetSyncType() Peer_SyncType {
 	return Peer_UNKNOWN_SYNC
 }
 
+func (m *Peer) GetMsgsSent() map[string]uint64 {
+	if m != nil {
+		return m.MsgsSent
+	}
+	return nil
+}
+
+func (m *Peer) GetMsgsRecv() map[string]uint64 {
+	if m != nil {
+		return m.MsgsRecv
+	}
+	return nil
+}
+
+func (m *Peer) GetErrors() []*TimestampedError {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+func (m *Peer) GetFlapCount() int32 {
+	if m != nil {
+		return m.FlapCount
+	}
+	return 0
+}
+
+func (m *Peer) GetLastFlapNs() int64 {
+	if m != nil {
+		return m.LastFlapNs
+	}
+	return 0
+}
+
+type TimestampedError struct {
+	// / The unix timestamp in seconds at which the error was received or sent
+	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// / The error message
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// / The hex-encoded channel ID the error relates to, all-zero if it relates to all channels
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
+	// / Whether the error was sent by us to the peer, rather than received from it
+	Outgoing             bool     `protobuf:"varint,4,opt,name=outgoing,proto3" json:"outgoing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TimestampedError) Reset()         { *m = TimestampedError{} }
+func (m *TimestampedError) String() string { return proto.CompactTextString(m) }
+func (*TimestampedError) ProtoMessage()    {}
+func (*TimestampedError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{46}
+}
+func (m *TimestampedError) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TimestampedError.Unmarshal(m, b)
+}
+func (m *TimestampedError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TimestampedError.Marshal(b, m, deterministic)
+}
+func (dst *TimestampedError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimestampedError.Merge(dst, src)
+}
+func (m *TimestampedError) XXX_Size() int {
+	return xxx_messageInfo_TimestampedError.Size(m)
+}
+func (m *TimestampedError) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimestampedError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimestampedError proto.InternalMessageInfo
+
+func (m *TimestampedError) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *TimestampedError) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *TimestampedError) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *TimestampedError) GetOutgoing() bool {
+	if m != nil {
+		return m.Outgoing
+	}
+	return false
+}
+
 type ListPeersRequest struct {
+	// / If set, the most recent errors exchanged with each peer are included
+	IncludeErrors        bool     `protobuf:"varint,1,opt,name=include_errors,json=includeErrors,proto3" json:"include_errors,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3040,7 +3290,7 @@ func (m *ListPeersRequest) Reset()         { *m = ListPeersRequest{} }
 func (m *ListPeersRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPeersRequest) ProtoMessage()    {}
 func (*ListPeersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{45}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{47}
 }
 func (m *ListPeersRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPeersRequest.Unmarshal(m, b)
@@ -3060,6 +3310,13 @@ func (m *ListPeersRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ListPeersRequest proto.InternalMessageInfo
 
+func (m *ListPeersRequest) GetIncludeErrors() bool {
+	if m != nil {
+		return m.IncludeErrors
+	}
+	return false
+}
+
 type ListPeersResponse struct {
 	// / The list of currently connected peers
 	Peers                []*Peer  `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
@@ -3072,7 +3329,7 @@ func (m *ListPeersResponse) Reset()         { *m = ListPeersResponse{} }
 func (m *ListPeersResponse) String() string { return proto.CompactTextString(m) }
 func (*ListPeersResponse) ProtoMessage()    {}
 func (*ListPeersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{46}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{48}
 }
 func (m *ListPeersResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPeersResponse.Unmarshal(m, b)
@@ -3099,6 +3356,180 @@ func (m *ListPeersResponse) GetPeers() []*Peer {
 	return nil
 }
 
+type SendCustomMessageRequest struct {
+	// / The identity public key of the peer to send the message to.
+	Peer []byte `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
+	// / The message type, which must be within the custom range.
+	Type uint32 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	// / The raw payload of the message.
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SendCustomMessageRequest) Reset()         { *m = SendCustomMessageRequest{} }
+func (m *SendCustomMessageRequest) String() string { return proto.CompactTextString(m) }
+func (*SendCustomMessageRequest) ProtoMessage()    {}
+func (*SendCustomMessageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{49}
+}
+func (m *SendCustomMessageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendCustomMessageRequest.Unmarshal(m, b)
+}
+func (m *SendCustomMessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendCustomMessageRequest.Marshal(b, m, deterministic)
+}
+func (dst *SendCustomMessageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendCustomMessageRequest.Merge(dst, src)
+}
+func (m *SendCustomMessageRequest) XXX_Size() int {
+	return xxx_messageInfo_SendCustomMessageRequest.Size(m)
+}
+func (m *SendCustomMessageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendCustomMessageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendCustomMessageRequest proto.InternalMessageInfo
+
+func (m *SendCustomMessageRequest) GetPeer() []byte {
+	if m != nil {
+		return m.Peer
+	}
+	return nil
+}
+
+func (m *SendCustomMessageRequest) GetType() uint32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *SendCustomMessageRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type SendCustomMessageResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SendCustomMessageResponse) Reset()         { *m = SendCustomMessageResponse{} }
+func (m *SendCustomMessageResponse) String() string { return proto.CompactTextString(m) }
+func (*SendCustomMessageResponse) ProtoMessage()    {}
+func (*SendCustomMessageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{50}
+}
+func (m *SendCustomMessageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendCustomMessageResponse.Unmarshal(m, b)
+}
+func (m *SendCustomMessageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendCustomMessageResponse.Marshal(b, m, deterministic)
+}
+func (dst *SendCustomMessageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendCustomMessageResponse.Merge(dst, src)
+}
+func (m *SendCustomMessageResponse) XXX_Size() int {
+	return xxx_messageInfo_SendCustomMessageResponse.Size(m)
+}
+func (m *SendCustomMessageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendCustomMessageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendCustomMessageResponse proto.InternalMessageInfo
+
+type SubscribeCustomMessagesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeCustomMessagesRequest) Reset()         { *m = SubscribeCustomMessagesRequest{} }
+func (m *SubscribeCustomMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeCustomMessagesRequest) ProtoMessage()    {}
+func (*SubscribeCustomMessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{51}
+}
+func (m *SubscribeCustomMessagesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeCustomMessagesRequest.Unmarshal(m, b)
+}
+func (m *SubscribeCustomMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeCustomMessagesRequest.Marshal(b, m, deterministic)
+}
+func (dst *SubscribeCustomMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeCustomMessagesRequest.Merge(dst, src)
+}
+func (m *SubscribeCustomMessagesRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeCustomMessagesRequest.Size(m)
+}
+func (m *SubscribeCustomMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeCustomMessagesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeCustomMessagesRequest proto.InternalMessageInfo
+
+type CustomMessage struct {
+	// / The identity public key of the peer the message was received from.
+	Peer []byte `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
+	// / The message type.
+	Type uint32 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	// / The raw payload of the message.
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CustomMessage) Reset()         { *m = CustomMessage{} }
+func (m *CustomMessage) String() string { return proto.CompactTextString(m) }
+func (*CustomMessage) ProtoMessage()    {}
+func (*CustomMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{52}
+}
+func (m *CustomMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CustomMessage.Unmarshal(m, b)
+}
+func (m *CustomMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CustomMessage.Marshal(b, m, deterministic)
+}
+func (dst *CustomMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomMessage.Merge(dst, src)
+}
+func (m *CustomMessage) XXX_Size() int {
+	return xxx_messageInfo_CustomMessage.Size(m)
+}
+func (m *CustomMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomMessage proto.InternalMessageInfo
+
+func (m *CustomMessage) GetPeer() []byte {
+	if m != nil {
+		return m.Peer
+	}
+	return nil
+}
+
+func (m *CustomMessage) GetType() uint32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *CustomMessage) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 type GetInfoRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -3109,7 +3540,7 @@ func (m *GetInfoRequest) Reset()         { *m = GetInfoRequest{} }
 func (m *GetInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetInfoRequest) ProtoMessage()    {}
 func (*GetInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{47}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{53}
 }
 func (m *GetInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetInfoRequest.Unmarshal(m, b)
@@ -3159,7 +3590,13 @@ type GetInfoResponse struct {
 	// / Number of inactive channels
 	NumInactiveChannels uint32 `protobuf:"varint,15,opt,name=num_inactive_channels,proto3" json:"num_inactive_channels,omitempty"`
 	// / A list of active chains the node is connected to
-	Chains               []*Chain `protobuf:"bytes,16,rep,name=chains,proto3" json:"chains,omitempty"`
+	Chains []*Chain `protobuf:"bytes,16,rep,name=chains,proto3" json:"chains,omitempty"`
+	// / The URIs of the gRPC and REST interfaces, if exposed over Tor
+	RpcOnionUris []string `protobuf:"bytes,17,rep,name=rpc_onion_uris,proto3" json:"rpc_onion_uris,omitempty"`
+	// / Number of inbound connections dropped by the connection rate limiter
+	NumRateLimitedConns uint64 `protobuf:"varint,18,opt,name=num_rate_limited_conns,proto3" json:"num_rate_limited_conns,omitempty"`
+	// / Number of inbound peers without channels evicted to make room for new ones
+	NumEvictedPeers      uint64   `protobuf:"varint,19,opt,name=num_evicted_peers,proto3" json:"num_evicted_peers,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3169,7 +3606,7 @@ func (m *GetInfoResponse) Reset()         { *m = GetInfoResponse{} }
 func (m *GetInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetInfoResponse) ProtoMessage()    {}
 func (*GetInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{48}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{54}
 }
 func (m *GetInfoResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetInfoResponse.Unmarshal(m, b)
@@ -3288,6 +3725,27 @@ func (m *GetInfoResponse) GetChains() []*Chain {
 	return nil
 }
 
+func (m *GetInfoResponse) GetRpcOnionUris() []string {
+	if m != nil {
+		return m.RpcOnionUris
+	}
+	return nil
+}
+
+func (m *GetInfoResponse) GetNumRateLimitedConns() uint64 {
+	if m != nil {
+		return m.NumRateLimitedConns
+	}
+	return 0
+}
+
+func (m *GetInfoResponse) GetNumEvictedPeers() uint64 {
+	if m != nil {
+		return m.NumEvictedPeers
+	}
+	return 0
+}
+
 type Chain struct {
 	// / The blockchain the node is on (eg bitcoin, litecoin)
 	Chain string `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
@@ -3302,7 +3760,7 @@ func (m *Chain) Reset()         { *m = Chain{} }
 func (m *Chain) String() string { return proto.CompactTextString(m) }
 func (*Chain) ProtoMessage()    {}
 func (*Chain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{49}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{55}
 }
 func (m *Chain) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Chain.Unmarshal(m, b)
@@ -3349,7 +3807,7 @@ func (m *ConfirmationUpdate) Reset()         { *m = ConfirmationUpdate{} }
 func (m *ConfirmationUpdate) String() string { return proto.CompactTextString(m) }
 func (*ConfirmationUpdate) ProtoMessage()    {}
 func (*ConfirmationUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{50}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{56}
 }
 func (m *ConfirmationUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfirmationUpdate.Unmarshal(m, b)
@@ -3401,7 +3859,7 @@ func (m *ChannelOpenUpdate) Reset()         { *m = ChannelOpenUpdate{} }
 func (m *ChannelOpenUpdate) String() string { return proto.CompactTextString(m) }
 func (*ChannelOpenUpdate) ProtoMessage()    {}
 func (*ChannelOpenUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{51}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{57}
 }
 func (m *ChannelOpenUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelOpenUpdate.Unmarshal(m, b)
@@ -3440,7 +3898,7 @@ func (m *ChannelCloseUpdate) Reset()         { *m = ChannelCloseUpdate{} }
 func (m *ChannelCloseUpdate) String() string { return proto.CompactTextString(m) }
 func (*ChannelCloseUpdate) ProtoMessage()    {}
 func (*ChannelCloseUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{52}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{58}
 }
 func (m *ChannelCloseUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelCloseUpdate.Unmarshal(m, b)
@@ -3495,7 +3953,7 @@ func (m *CloseChannelRequest) Reset()         { *m = CloseChannelRequest{} }
 func (m *CloseChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*CloseChannelRequest) ProtoMessage()    {}
 func (*CloseChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{53}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{59}
 }
 func (m *CloseChannelRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CloseChannelRequest.Unmarshal(m, b)
@@ -3557,7 +4015,7 @@ func (m *CloseStatusUpdate) Reset()         { *m = CloseStatusUpdate{} }
 func (m *CloseStatusUpdate) String() string { return proto.CompactTextString(m) }
 func (*CloseStatusUpdate) ProtoMessage()    {}
 func (*CloseStatusUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{54}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{60}
 }
 func (m *CloseStatusUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CloseStatusUpdate.Unmarshal(m, b)
@@ -3700,7 +4158,7 @@ func (m *PendingUpdate) Reset()         { *m = PendingUpdate{} }
 func (m *PendingUpdate) String() string { return proto.CompactTextString(m) }
 func (*PendingUpdate) ProtoMessage()    {}
 func (*PendingUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{55}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{61}
 }
 func (m *PendingUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingUpdate.Unmarshal(m, b)
@@ -3766,7 +4224,7 @@ func (m *OpenChannelRequest) Reset()         { *m = OpenChannelRequest{} }
 func (m *OpenChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*OpenChannelRequest) ProtoMessage()    {}
 func (*OpenChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{56}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{62}
 }
 func (m *OpenChannelRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OpenChannelRequest.Unmarshal(m, b)
@@ -3877,7 +4335,7 @@ func (m *OpenStatusUpdate) Reset()         { *m = OpenStatusUpdate{} }
 func (m *OpenStatusUpdate) String() string { return proto.CompactTextString(m) }
 func (*OpenStatusUpdate) ProtoMessage()    {}
 func (*OpenStatusUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{57}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{63}
 }
 func (m *OpenStatusUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OpenStatusUpdate.Unmarshal(m, b)
@@ -4033,7 +4491,7 @@ func (m *PendingHTLC) Reset()         { *m = PendingHTLC{} }
 func (m *PendingHTLC) String() string { return proto.CompactTextString(m) }
 func (*PendingHTLC) ProtoMessage()    {}
 func (*PendingHTLC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{58}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{64}
 }
 func (m *PendingHTLC) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingHTLC.Unmarshal(m, b)
@@ -4105,7 +4563,7 @@ func (m *PendingChannelsRequest) Reset()         { *m = PendingChannelsRequest{}
 func (m *PendingChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*PendingChannelsRequest) ProtoMessage()    {}
 func (*PendingChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{59}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{65}
 }
 func (m *PendingChannelsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingChannelsRequest.Unmarshal(m, b)
@@ -4145,7 +4603,7 @@ func (m *PendingChannelsResponse) Reset()         { *m = PendingChannelsResponse
 func (m *PendingChannelsResponse) String() string { return proto.CompactTextString(m) }
 func (*PendingChannelsResponse) ProtoMessage()    {}
 func (*PendingChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{60}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{66}
 }
 func (m *PendingChannelsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingChannelsResponse.Unmarshal(m, b)
@@ -4217,7 +4675,7 @@ func (m *PendingChannelsResponse_PendingChannel) Reset() {
 func (m *PendingChannelsResponse_PendingChannel) String() string { return proto.CompactTextString(m) }
 func (*PendingChannelsResponse_PendingChannel) ProtoMessage()    {}
 func (*PendingChannelsResponse_PendingChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{60, 0}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{66, 0}
 }
 func (m *PendingChannelsResponse_PendingChannel) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingChannelsResponse_PendingChannel.Unmarshal(m, b)
@@ -4304,7 +4762,7 @@ func (m *PendingChannelsResponse_PendingOpenChannel) String() string {
 }
 func (*PendingChannelsResponse_PendingOpenChannel) ProtoMessage() {}
 func (*PendingChannelsResponse_PendingOpenChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{60, 1}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{66, 1}
 }
 func (m *PendingChannelsResponse_PendingOpenChannel) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Unmarshal(m, b)
@@ -4377,7 +4835,7 @@ func (m *PendingChannelsResponse_WaitingCloseChannel) String() string {
 }
 func (*PendingChannelsResponse_WaitingCloseChannel) ProtoMessage() {}
 func (*PendingChannelsResponse_WaitingCloseChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{60, 2}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{66, 2}
 }
 func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Unmarshal(m, b)
@@ -4425,7 +4883,7 @@ func (m *PendingChannelsResponse_ClosedChannel) Reset()         { *m = PendingCh
 func (m *PendingChannelsResponse_ClosedChannel) String() string { return proto.CompactTextString(m) }
 func (*PendingChannelsResponse_ClosedChannel) ProtoMessage()    {}
 func (*PendingChannelsResponse_ClosedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{60, 3}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{66, 3}
 }
 func (m *PendingChannelsResponse_ClosedChannel) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Unmarshal(m, b)
@@ -4489,7 +4947,7 @@ func (m *PendingChannelsResponse_ForceClosedChannel) String() string {
 }
 func (*PendingChannelsResponse_ForceClosedChannel) ProtoMessage() {}
 func (*PendingChannelsResponse_ForceClosedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{60, 4}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{66, 4}
 }
 func (m *PendingChannelsResponse_ForceClosedChannel) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Unmarshal(m, b)
@@ -4568,7 +5026,7 @@ func (m *ChannelEventSubscription) Reset()         { *m = ChannelEventSubscripti
 func (m *ChannelEventSubscription) String() string { return proto.CompactTextString(m) }
 func (*ChannelEventSubscription) ProtoMessage()    {}
 func (*ChannelEventSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{61}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{67}
 }
 func (m *ChannelEventSubscription) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelEventSubscription.Unmarshal(m, b)
@@ -4605,7 +5063,7 @@ func (m *ChannelEventUpdate) Reset()         { *m = ChannelEventUpdate{} }
 func (m *ChannelEventUpdate) String() string { return proto.CompactTextString(m) }
 func (*ChannelEventUpdate) ProtoMessage()    {}
 func (*ChannelEventUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{62}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{68}
 }
 func (m *ChannelEventUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelEventUpdate.Unmarshal(m, b)
@@ -4817,7 +5275,7 @@ func (m *WalletBalanceRequest) Reset()         { *m = WalletBalanceRequest{} }
 func (m *WalletBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*WalletBalanceRequest) ProtoMessage()    {}
 func (*WalletBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{63}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{69}
 }
 func (m *WalletBalanceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_WalletBalanceRequest.Unmarshal(m, b)
@@ -4853,7 +5311,7 @@ func (m *WalletBalanceResponse) Reset()         { *m = WalletBalanceResponse{} }
 func (m *WalletBalanceResponse) String() string { return proto.CompactTextString(m) }
 func (*WalletBalanceResponse) ProtoMessage()    {}
 func (*WalletBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{64}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{70}
 }
 func (m *WalletBalanceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_WalletBalanceResponse.Unmarshal(m, b)
@@ -4904,7 +5362,7 @@ func (m *ChannelBalanceRequest) Reset()         { *m = ChannelBalanceRequest{} }
 func (m *ChannelBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*ChannelBalanceRequest) ProtoMessage()    {}
 func (*ChannelBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{65}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{71}
 }
 func (m *ChannelBalanceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelBalanceRequest.Unmarshal(m, b)
@@ -4938,7 +5396,7 @@ func (m *ChannelBalanceResponse) Reset()         { *m = ChannelBalanceResponse{}
 func (m *ChannelBalanceResponse) String() string { return proto.CompactTextString(m) }
 func (*ChannelBalanceResponse) ProtoMessage()    {}
 func (*ChannelBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{66}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{72}
 }
 func (m *ChannelBalanceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelBalanceResponse.Unmarshal(m, b)
@@ -4998,7 +5456,18 @@ type QueryRoutesRequest struct {
 	// *
 	// The source node where the request route should originated from. If empty,
 	// self is assumed.
-	SourcePubKey         string   `protobuf:"bytes,8,opt,name=source_pub_key,json=sourcePubKey,proto3" json:"source_pub_key,omitempty"`
+	SourcePubKey string `protobuf:"bytes,8,opt,name=source_pub_key,json=sourcePubKey,proto3" json:"source_pub_key,omitempty"`
+	// *
+	// A list of directional node pairs for which path finding must not use any
+	// of the channels between them in the given direction.
+	IgnoredPairs []*NodePair `protobuf:"bytes,9,rep,name=ignored_pairs,json=ignoredPairs,proto3" json:"ignored_pairs,omitempty"`
+	// *
+	// The channel id of the channel that must be taken to the first hop. If zero,
+	// any channel may be used.
+	OutgoingChanId uint64 `protobuf:"varint,10,opt,name=outgoing_chan_id,json=outgoingChanId,proto3" json:"outgoing_chan_id,omitempty"`
+	// *
+	// The pubkey of the last hop of the route. If empty, any hop may be used.
+	LastHopPubkey        []byte   `protobuf:"bytes,11,opt,name=last_hop_pubkey,json=lastHopPubkey,proto3" json:"last_hop_pubkey,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -5008,7 +5477,7 @@ func (m *QueryRoutesRequest) Reset()         { *m = QueryRoutesRequest{} }
 func (m *QueryRoutesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRoutesRequest) ProtoMessage()    {}
 func (*QueryRoutesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{67}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{73}
 }
 func (m *QueryRoutesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryRoutesRequest.Unmarshal(m, b)
@@ -5085,6 +5554,79 @@ func (m *QueryRoutesRequest) GetSourcePubKey() string {
 	return ""
 }
 
+func (m *QueryRoutesRequest) GetIgnoredPairs() []*NodePair {
+	if m != nil {
+		return m.IgnoredPairs
+	}
+	return nil
+}
+
+func (m *QueryRoutesRequest) GetOutgoingChanId() uint64 {
+	if m != nil {
+		return m.OutgoingChanId
+	}
+	return 0
+}
+
+func (m *QueryRoutesRequest) GetLastHopPubkey() []byte {
+	if m != nil {
+		return m.LastHopPubkey
+	}
+	return nil
+}
+
+type NodePair struct {
+	// *
+	// The sending node of the pair. When using REST, this field must be encoded
+	// as base64.
+	From []byte `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// *
+	// The receiving node of the pair. When using REST, this field must be encoded
+	// as base64.
+	To                   []byte   `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodePair) Reset()         { *m = NodePair{} }
+func (m *NodePair) String() string { return proto.CompactTextString(m) }
+func (*NodePair) ProtoMessage()    {}
+func (*NodePair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{74}
+}
+func (m *NodePair) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodePair.Unmarshal(m, b)
+}
+func (m *NodePair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodePair.Marshal(b, m, deterministic)
+}
+func (dst *NodePair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodePair.Merge(dst, src)
+}
+func (m *NodePair) XXX_Size() int {
+	return xxx_messageInfo_NodePair.Size(m)
+}
+func (m *NodePair) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodePair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodePair proto.InternalMessageInfo
+
+func (m *NodePair) GetFrom() []byte {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *NodePair) GetTo() []byte {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
 type EdgeLocator struct {
 	// / The short channel id of this edge.
 	ChannelId uint64 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
@@ -5103,7 +5645,7 @@ func (m *EdgeLocator) Reset()         { *m = EdgeLocator{} }
 func (m *EdgeLocator) String() string { return proto.CompactTextString(m) }
 func (*EdgeLocator) ProtoMessage()    {}
 func (*EdgeLocator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{68}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{75}
 }
 func (m *EdgeLocator) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EdgeLocator.Unmarshal(m, b)
@@ -5148,7 +5690,7 @@ func (m *QueryRoutesResponse) Reset()         { *m = QueryRoutesResponse{} }
 func (m *QueryRoutesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRoutesResponse) ProtoMessage()    {}
 func (*QueryRoutesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{69}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{76}
 }
 func (m *QueryRoutesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryRoutesResponse.Unmarshal(m, b)
@@ -5200,7 +5742,7 @@ func (m *Hop) Reset()         { *m = Hop{} }
 func (m *Hop) String() string { return proto.CompactTextString(m) }
 func (*Hop) ProtoMessage()    {}
 func (*Hop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{70}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{77}
 }
 func (m *Hop) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Hop.Unmarshal(m, b)
@@ -5321,7 +5863,7 @@ func (m *Route) Reset()         { *m = Route{} }
 func (m *Route) String() string { return proto.CompactTextString(m) }
 func (*Route) ProtoMessage()    {}
 func (*Route) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{71}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{78}
 }
 func (m *Route) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Route.Unmarshal(m, b)
@@ -5397,7 +5939,7 @@ func (m *NodeInfoRequest) Reset()         { *m = NodeInfoRequest{} }
 func (m *NodeInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*NodeInfoRequest) ProtoMessage()    {}
 func (*NodeInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{72}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{79}
 }
 func (m *NodeInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeInfoRequest.Unmarshal(m, b)
@@ -5442,7 +5984,7 @@ func (m *NodeInfo) Reset()         { *m = NodeInfo{} }
 func (m *NodeInfo) String() string { return proto.CompactTextString(m) }
 func (*NodeInfo) ProtoMessage()    {}
 func (*NodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{73}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{80}
 }
 func (m *NodeInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeInfo.Unmarshal(m, b)
@@ -5503,7 +6045,7 @@ func (m *LightningNode) Reset()         { *m = LightningNode{} }
 func (m *LightningNode) String() string { return proto.CompactTextString(m) }
 func (*LightningNode) ProtoMessage()    {}
 func (*LightningNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{74}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{81}
 }
 func (m *LightningNode) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LightningNode.Unmarshal(m, b)
@@ -5570,7 +6112,7 @@ func (m *NodeAddress) Reset()         { *m = NodeAddress{} }
 func (m *NodeAddress) String() string { return proto.CompactTextString(m) }
 func (*NodeAddress) ProtoMessage()    {}
 func (*NodeAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{75}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{82}
 }
 func (m *NodeAddress) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeAddress.Unmarshal(m, b)
@@ -5620,7 +6162,7 @@ func (m *RoutingPolicy) Reset()         { *m = RoutingPolicy{} }
 func (m *RoutingPolicy) String() string { return proto.CompactTextString(m) }
 func (*RoutingPolicy) ProtoMessage()    {}
 func (*RoutingPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{76}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{83}
 }
 func (m *RoutingPolicy) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RoutingPolicy.Unmarshal(m, b)
@@ -5710,7 +6252,7 @@ func (m *ChannelEdge) Reset()         { *m = ChannelEdge{} }
 func (m *ChannelEdge) String() string { return proto.CompactTextString(m) }
 func (*ChannelEdge) ProtoMessage()    {}
 func (*ChannelEdge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{77}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{84}
 }
 func (m *ChannelEdge) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelEdge.Unmarshal(m, b)
@@ -5801,7 +6343,7 @@ func (m *ChannelGraphRequest) Reset()         { *m = ChannelGraphRequest{} }
 func (m *ChannelGraphRequest) String() string { return proto.CompactTextString(m) }
 func (*ChannelGraphRequest) ProtoMessage()    {}
 func (*ChannelGraphRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{78}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{85}
 }
 func (m *ChannelGraphRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelGraphRequest.Unmarshal(m, b)
@@ -5843,7 +6385,7 @@ func (m *ChannelGraph) Reset()         { *m = ChannelGraph{} }
 func (m *ChannelGraph) String() string { return proto.CompactTextString(m) }
 func (*ChannelGraph) ProtoMessage()    {}
 func (*ChannelGraph) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{79}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{86}
 }
 func (m *ChannelGraph) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelGraph.Unmarshal(m, b)
@@ -5892,7 +6434,7 @@ func (m *ChanInfoRequest) Reset()         { *m = ChanInfoRequest{} }
 func (m *ChanInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*ChanInfoRequest) ProtoMessage()    {}
 func (*ChanInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{80}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{87}
 }
 func (m *ChanInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChanInfoRequest.Unmarshal(m, b)
@@ -5929,7 +6471,7 @@ func (m *NetworkInfoRequest) Reset()         { *m = NetworkInfoRequest{} }
 func (m *NetworkInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*NetworkInfoRequest) ProtoMessage()    {}
 func (*NetworkInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{81}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{88}
 }
 func (m *NetworkInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetworkInfoRequest.Unmarshal(m, b)
@@ -5950,26 +6492,30 @@ func (m *NetworkInfoRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_NetworkInfoRequest proto.InternalMessageInfo
 
 type NetworkInfo struct {
-	GraphDiameter        uint32   `protobuf:"varint,1,opt,name=graph_diameter,proto3" json:"graph_diameter,omitempty"`
-	AvgOutDegree         float64  `protobuf:"fixed64,2,opt,name=avg_out_degree,proto3" json:"avg_out_degree,omitempty"`
-	MaxOutDegree         uint32   `protobuf:"varint,3,opt,name=max_out_degree,proto3" json:"max_out_degree,omitempty"`
-	NumNodes             uint32   `protobuf:"varint,4,opt,name=num_nodes,proto3" json:"num_nodes,omitempty"`
-	NumChannels          uint32   `protobuf:"varint,5,opt,name=num_channels,proto3" json:"num_channels,omitempty"`
-	TotalNetworkCapacity int64    `protobuf:"varint,6,opt,name=total_network_capacity,proto3" json:"total_network_capacity,omitempty"`
-	AvgChannelSize       float64  `protobuf:"fixed64,7,opt,name=avg_channel_size,proto3" json:"avg_channel_size,omitempty"`
-	MinChannelSize       int64    `protobuf:"varint,8,opt,name=min_channel_size,proto3" json:"min_channel_size,omitempty"`
-	MaxChannelSize       int64    `protobuf:"varint,9,opt,name=max_channel_size,proto3" json:"max_channel_size,omitempty"`
-	MedianChannelSizeSat int64    `protobuf:"varint,10,opt,name=median_channel_size_sat,proto3" json:"median_channel_size_sat,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	GraphDiameter        uint32  `protobuf:"varint,1,opt,name=graph_diameter,proto3" json:"graph_diameter,omitempty"`
+	AvgOutDegree         float64 `protobuf:"fixed64,2,opt,name=avg_out_degree,proto3" json:"avg_out_degree,omitempty"`
+	MaxOutDegree         uint32  `protobuf:"varint,3,opt,name=max_out_degree,proto3" json:"max_out_degree,omitempty"`
+	NumNodes             uint32  `protobuf:"varint,4,opt,name=num_nodes,proto3" json:"num_nodes,omitempty"`
+	NumChannels          uint32  `protobuf:"varint,5,opt,name=num_channels,proto3" json:"num_channels,omitempty"`
+	TotalNetworkCapacity int64   `protobuf:"varint,6,opt,name=total_network_capacity,proto3" json:"total_network_capacity,omitempty"`
+	AvgChannelSize       float64 `protobuf:"fixed64,7,opt,name=avg_channel_size,proto3" json:"avg_channel_size,omitempty"`
+	MinChannelSize       int64   `protobuf:"varint,8,opt,name=min_channel_size,proto3" json:"min_channel_size,omitempty"`
+	MaxChannelSize       int64   `protobuf:"varint,9,opt,name=max_channel_size,proto3" json:"max_channel_size,omitempty"`
+	MedianChannelSizeSat int64   `protobuf:"varint,10,opt,name=median_channel_size_sat,proto3" json:"median_channel_size_sat,omitempty"`
+	// / The number of channel updates dropped for exceeding the rate limit of their channel
+	NumThrottledChanUpdates uint64 `protobuf:"varint,11,opt,name=num_throttled_chan_updates,proto3" json:"num_throttled_chan_updates,omitempty"`
+	// / The number of channel updates dropped for exceeding the rate limit of the peer that sent them
+	NumThrottledPeerUpdates uint64   `protobuf:"varint,12,opt,name=num_throttled_peer_updates,proto3" json:"num_throttled_peer_updates,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
+	XXX_unrecognized        []byte   `json:"-"`
+	XXX_sizecache           int32    `json:"-"`
 }
 
 func (m *NetworkInfo) Reset()         { *m = NetworkInfo{} }
 func (m *NetworkInfo) String() string { return proto.CompactTextString(m) }
 func (*NetworkInfo) ProtoMessage()    {}
 func (*NetworkInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{82}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{89}
 }
 func (m *NetworkInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetworkInfo.Unmarshal(m, b)
@@ -6059,6 +6605,299 @@ func (m *NetworkInfo) GetMedianChannelSizeSat() int64 {
 	return 0
 }
 
+func (m *NetworkInfo) GetNumThrottledChanUpdates() uint64 {
+	if m != nil {
+		return m.NumThrottledChanUpdates
+	}
+	return 0
+}
+
+func (m *NetworkInfo) GetNumThrottledPeerUpdates() uint64 {
+	if m != nil {
+		return m.NumThrottledPeerUpdates
+	}
+	return 0
+}
+
+type UpdateGraphBanListRequest struct {
+	// / The hex encoded public keys of the nodes to ban.
+	BanNodes []string `protobuf:"bytes,1,rep,name=ban_nodes,proto3" json:"ban_nodes,omitempty"`
+	// / The hex encoded public keys of the nodes to unban.
+	UnbanNodes []string `protobuf:"bytes,2,rep,name=unban_nodes,proto3" json:"unban_nodes,omitempty"`
+	// / The short channel IDs of the channels to ban.
+	BanChanIds []uint64 `protobuf:"varint,3,rep,packed,name=ban_chan_ids,proto3" json:"ban_chan_ids,omitempty"`
+	// / The short channel IDs of the channels to unban.
+	UnbanChanIds         []uint64 `protobuf:"varint,4,rep,packed,name=unban_chan_ids,proto3" json:"unban_chan_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateGraphBanListRequest) Reset()         { *m = UpdateGraphBanListRequest{} }
+func (m *UpdateGraphBanListRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateGraphBanListRequest) ProtoMessage()    {}
+func (*UpdateGraphBanListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{90}
+}
+func (m *UpdateGraphBanListRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateGraphBanListRequest.Unmarshal(m, b)
+}
+func (m *UpdateGraphBanListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateGraphBanListRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateGraphBanListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateGraphBanListRequest.Merge(dst, src)
+}
+func (m *UpdateGraphBanListRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateGraphBanListRequest.Size(m)
+}
+func (m *UpdateGraphBanListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateGraphBanListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateGraphBanListRequest proto.InternalMessageInfo
+
+func (m *UpdateGraphBanListRequest) GetBanNodes() []string {
+	if m != nil {
+		return m.BanNodes
+	}
+	return nil
+}
+
+func (m *UpdateGraphBanListRequest) GetUnbanNodes() []string {
+	if m != nil {
+		return m.UnbanNodes
+	}
+	return nil
+}
+
+func (m *UpdateGraphBanListRequest) GetBanChanIds() []uint64 {
+	if m != nil {
+		return m.BanChanIds
+	}
+	return nil
+}
+
+func (m *UpdateGraphBanListRequest) GetUnbanChanIds() []uint64 {
+	if m != nil {
+		return m.UnbanChanIds
+	}
+	return nil
+}
+
+type ListGraphBanListRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListGraphBanListRequest) Reset()         { *m = ListGraphBanListRequest{} }
+func (m *ListGraphBanListRequest) String() string { return proto.CompactTextString(m) }
+func (*ListGraphBanListRequest) ProtoMessage()    {}
+func (*ListGraphBanListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{91}
+}
+func (m *ListGraphBanListRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGraphBanListRequest.Unmarshal(m, b)
+}
+func (m *ListGraphBanListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGraphBanListRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListGraphBanListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGraphBanListRequest.Merge(dst, src)
+}
+func (m *ListGraphBanListRequest) XXX_Size() int {
+	return xxx_messageInfo_ListGraphBanListRequest.Size(m)
+}
+func (m *ListGraphBanListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGraphBanListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListGraphBanListRequest proto.InternalMessageInfo
+
+type GraphBanList struct {
+	// / The hex encoded public keys of the banned nodes.
+	Nodes []string `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// / The short channel IDs of the banned channels.
+	ChanIds              []uint64 `protobuf:"varint,2,rep,packed,name=chan_ids,proto3" json:"chan_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GraphBanList) Reset()         { *m = GraphBanList{} }
+func (m *GraphBanList) String() string { return proto.CompactTextString(m) }
+func (*GraphBanList) ProtoMessage()    {}
+func (*GraphBanList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{92}
+}
+func (m *GraphBanList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GraphBanList.Unmarshal(m, b)
+}
+func (m *GraphBanList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GraphBanList.Marshal(b, m, deterministic)
+}
+func (dst *GraphBanList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GraphBanList.Merge(dst, src)
+}
+func (m *GraphBanList) XXX_Size() int {
+	return xxx_messageInfo_GraphBanList.Size(m)
+}
+func (m *GraphBanList) XXX_DiscardUnknown() {
+	xxx_messageInfo_GraphBanList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GraphBanList proto.InternalMessageInfo
+
+func (m *GraphBanList) GetNodes() []string {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *GraphBanList) GetChanIds() []uint64 {
+	if m != nil {
+		return m.ChanIds
+	}
+	return nil
+}
+
+type ExportGraphSnapshotRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportGraphSnapshotRequest) Reset()         { *m = ExportGraphSnapshotRequest{} }
+func (m *ExportGraphSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportGraphSnapshotRequest) ProtoMessage()    {}
+func (*ExportGraphSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{93}
+}
+func (m *ExportGraphSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportGraphSnapshotRequest.Unmarshal(m, b)
+}
+func (m *ExportGraphSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportGraphSnapshotRequest.Marshal(b, m, deterministic)
+}
+func (dst *ExportGraphSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportGraphSnapshotRequest.Merge(dst, src)
+}
+func (m *ExportGraphSnapshotRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportGraphSnapshotRequest.Size(m)
+}
+func (m *ExportGraphSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportGraphSnapshotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportGraphSnapshotRequest proto.InternalMessageInfo
+
+type GraphSnapshotChunk struct {
+	// / The next chunk of the binary graph snapshot.
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GraphSnapshotChunk) Reset()         { *m = GraphSnapshotChunk{} }
+func (m *GraphSnapshotChunk) String() string { return proto.CompactTextString(m) }
+func (*GraphSnapshotChunk) ProtoMessage()    {}
+func (*GraphSnapshotChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{94}
+}
+func (m *GraphSnapshotChunk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GraphSnapshotChunk.Unmarshal(m, b)
+}
+func (m *GraphSnapshotChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GraphSnapshotChunk.Marshal(b, m, deterministic)
+}
+func (dst *GraphSnapshotChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GraphSnapshotChunk.Merge(dst, src)
+}
+func (m *GraphSnapshotChunk) XXX_Size() int {
+	return xxx_messageInfo_GraphSnapshotChunk.Size(m)
+}
+func (m *GraphSnapshotChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_GraphSnapshotChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GraphSnapshotChunk proto.InternalMessageInfo
+
+func (m *GraphSnapshotChunk) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ImportGraphSnapshotResponse struct {
+	// / The number of node announcements within the snapshot.
+	NumNodes uint32 `protobuf:"varint,1,opt,name=num_nodes,proto3" json:"num_nodes,omitempty"`
+	// / The number of channel announcements within the snapshot.
+	NumChannels uint32 `protobuf:"varint,2,opt,name=num_channels,proto3" json:"num_channels,omitempty"`
+	// / The number of channel updates within the snapshot.
+	NumPolicies uint32 `protobuf:"varint,3,opt,name=num_policies,proto3" json:"num_policies,omitempty"`
+	// / The number of records skipped as they were already known or banned.
+	NumSkipped           uint32   `protobuf:"varint,4,opt,name=num_skipped,proto3" json:"num_skipped,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportGraphSnapshotResponse) Reset()         { *m = ImportGraphSnapshotResponse{} }
+func (m *ImportGraphSnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportGraphSnapshotResponse) ProtoMessage()    {}
+func (*ImportGraphSnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{95}
+}
+func (m *ImportGraphSnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportGraphSnapshotResponse.Unmarshal(m, b)
+}
+func (m *ImportGraphSnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportGraphSnapshotResponse.Marshal(b, m, deterministic)
+}
+func (dst *ImportGraphSnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportGraphSnapshotResponse.Merge(dst, src)
+}
+func (m *ImportGraphSnapshotResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportGraphSnapshotResponse.Size(m)
+}
+func (m *ImportGraphSnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportGraphSnapshotResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportGraphSnapshotResponse proto.InternalMessageInfo
+
+func (m *ImportGraphSnapshotResponse) GetNumNodes() uint32 {
+	if m != nil {
+		return m.NumNodes
+	}
+	return 0
+}
+
+func (m *ImportGraphSnapshotResponse) GetNumChannels() uint32 {
+	if m != nil {
+		return m.NumChannels
+	}
+	return 0
+}
+
+func (m *ImportGraphSnapshotResponse) GetNumPolicies() uint32 {
+	if m != nil {
+		return m.NumPolicies
+	}
+	return 0
+}
+
+func (m *ImportGraphSnapshotResponse) GetNumSkipped() uint32 {
+	if m != nil {
+		return m.NumSkipped
+	}
+	return 0
+}
+
 type StopRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -6069,7 +6908,7 @@ func (m *StopRequest) Reset()         { *m = StopRequest{} }
 func (m *StopRequest) String() string { return proto.CompactTextString(m) }
 func (*StopRequest) ProtoMessage()    {}
 func (*StopRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{83}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{96}
 }
 func (m *StopRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StopRequest.Unmarshal(m, b)
@@ -6099,7 +6938,7 @@ func (m *StopResponse) Reset()         { *m = StopResponse{} }
 func (m *StopResponse) String() string { return proto.CompactTextString(m) }
 func (*StopResponse) ProtoMessage()    {}
 func (*StopResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{84}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{97}
 }
 func (m *StopResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StopResponse.Unmarshal(m, b)
@@ -6129,7 +6968,7 @@ func (m *GraphTopologySubscription) Reset()         { *m = GraphTopologySubscrip
 func (m *GraphTopologySubscription) String() string { return proto.CompactTextString(m) }
 func (*GraphTopologySubscription) ProtoMessage()    {}
 func (*GraphTopologySubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{85}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{98}
 }
 func (m *GraphTopologySubscription) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GraphTopologySubscription.Unmarshal(m, b)
@@ -6162,7 +7001,7 @@ func (m *GraphTopologyUpdate) Reset()         { *m = GraphTopologyUpdate{} }
 func (m *GraphTopologyUpdate) String() string { return proto.CompactTextString(m) }
 func (*GraphTopologyUpdate) ProtoMessage()    {}
 func (*GraphTopologyUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{86}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{99}
 }
 func (m *GraphTopologyUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GraphTopologyUpdate.Unmarshal(m, b)
@@ -6217,7 +7056,7 @@ func (m *NodeUpdate) Reset()         { *m = NodeUpdate{} }
 func (m *NodeUpdate) String() string { return proto.CompactTextString(m) }
 func (*NodeUpdate) ProtoMessage()    {}
 func (*NodeUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{87}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{100}
 }
 func (m *NodeUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeUpdate.Unmarshal(m, b)
@@ -6285,7 +7124,7 @@ func (m *ChannelEdgeUpdate) Reset()         { *m = ChannelEdgeUpdate{} }
 func (m *ChannelEdgeUpdate) String() string { return proto.CompactTextString(m) }
 func (*ChannelEdgeUpdate) ProtoMessage()    {}
 func (*ChannelEdgeUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{88}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{101}
 }
 func (m *ChannelEdgeUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelEdgeUpdate.Unmarshal(m, b)
@@ -6365,7 +7204,7 @@ func (m *ClosedChannelUpdate) Reset()         { *m = ClosedChannelUpdate{} }
 func (m *ClosedChannelUpdate) String() string { return proto.CompactTextString(m) }
 func (*ClosedChannelUpdate) ProtoMessage()    {}
 func (*ClosedChannelUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{89}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{102}
 }
 func (m *ClosedChannelUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClosedChannelUpdate.Unmarshal(m, b)
@@ -6435,7 +7274,7 @@ func (m *HopHint) Reset()         { *m = HopHint{} }
 func (m *HopHint) String() string { return proto.CompactTextString(m) }
 func (*HopHint) ProtoMessage()    {}
 func (*HopHint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{90}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{103}
 }
 func (m *HopHint) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HopHint.Unmarshal(m, b)
@@ -6504,7 +7343,7 @@ func (m *RouteHint) Reset()         { *m = RouteHint{} }
 func (m *RouteHint) String() string { return proto.CompactTextString(m) }
 func (*RouteHint) ProtoMessage()    {}
 func (*RouteHint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{91}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{104}
 }
 func (m *RouteHint) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteHint.Unmarshal(m, b)
@@ -6619,7 +7458,7 @@ func (m *Invoice) Reset()         { *m = Invoice{} }
 func (m *Invoice) String() string { return proto.CompactTextString(m) }
 func (*Invoice) ProtoMessage()    {}
 func (*Invoice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{92}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{105}
 }
 func (m *Invoice) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Invoice.Unmarshal(m, b)
@@ -6811,7 +7650,7 @@ func (m *AddInvoiceResponse) Reset()         { *m = AddInvoiceResponse{} }
 func (m *AddInvoiceResponse) String() string { return proto.CompactTextString(m) }
 func (*AddInvoiceResponse) ProtoMessage()    {}
 func (*AddInvoiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{93}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{106}
 }
 func (m *AddInvoiceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddInvoiceResponse.Unmarshal(m, b)
@@ -6868,7 +7707,7 @@ func (m *PaymentHash) Reset()         { *m = PaymentHash{} }
 func (m *PaymentHash) String() string { return proto.CompactTextString(m) }
 func (*PaymentHash) ProtoMessage()    {}
 func (*PaymentHash) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{94}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{107}
 }
 func (m *PaymentHash) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentHash.Unmarshal(m, b)
@@ -6924,7 +7763,7 @@ func (m *ListInvoiceRequest) Reset()         { *m = ListInvoiceRequest{} }
 func (m *ListInvoiceRequest) String() string { return proto.CompactTextString(m) }
 func (*ListInvoiceRequest) ProtoMessage()    {}
 func (*ListInvoiceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{95}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{108}
 }
 func (m *ListInvoiceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListInvoiceRequest.Unmarshal(m, b)
@@ -6994,7 +7833,7 @@ func (m *ListInvoiceResponse) Reset()         { *m = ListInvoiceResponse{} }
 func (m *ListInvoiceResponse) String() string { return proto.CompactTextString(m) }
 func (*ListInvoiceResponse) ProtoMessage()    {}
 func (*ListInvoiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{96}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{109}
 }
 func (m *ListInvoiceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListInvoiceResponse.Unmarshal(m, b)
@@ -7057,7 +7896,7 @@ func (m *InvoiceSubscription) Reset()         { *m = InvoiceSubscription{} }
 func (m *InvoiceSubscription) String() string { return proto.CompactTextString(m) }
 func (*InvoiceSubscription) ProtoMessage()    {}
 func (*InvoiceSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{97}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{110}
 }
 func (m *InvoiceSubscription) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InvoiceSubscription.Unmarshal(m, b)
@@ -7117,7 +7956,7 @@ func (m *Payment) Reset()         { *m = Payment{} }
 func (m *Payment) String() string { return proto.CompactTextString(m) }
 func (*Payment) ProtoMessage()    {}
 func (*Payment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{98}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{111}
 }
 func (m *Payment) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Payment.Unmarshal(m, b)
@@ -7204,7 +8043,7 @@ func (m *ListPaymentsRequest) Reset()         { *m = ListPaymentsRequest{} }
 func (m *ListPaymentsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPaymentsRequest) ProtoMessage()    {}
 func (*ListPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{99}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{112}
 }
 func (m *ListPaymentsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPaymentsRequest.Unmarshal(m, b)
@@ -7236,7 +8075,7 @@ func (m *ListPaymentsResponse) Reset()         { *m = ListPaymentsResponse{} }
 func (m *ListPaymentsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListPaymentsResponse) ProtoMessage()    {}
 func (*ListPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{100}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{113}
 }
 func (m *ListPaymentsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPaymentsResponse.Unmarshal(m, b)
@@ -7273,7 +8112,7 @@ func (m *DeleteAllPaymentsRequest) Reset()         { *m = DeleteAllPaymentsReque
 func (m *DeleteAllPaymentsRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteAllPaymentsRequest) ProtoMessage()    {}
 func (*DeleteAllPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{101}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{114}
 }
 func (m *DeleteAllPaymentsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteAllPaymentsRequest.Unmarshal(m, b)
@@ -7303,7 +8142,7 @@ func (m *DeleteAllPaymentsResponse) Reset()         { *m = DeleteAllPaymentsResp
 func (m *DeleteAllPaymentsResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteAllPaymentsResponse) ProtoMessage()    {}
 func (*DeleteAllPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{102}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{115}
 }
 func (m *DeleteAllPaymentsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteAllPaymentsResponse.Unmarshal(m, b)
@@ -7334,7 +8173,7 @@ func (m *AbandonChannelRequest) Reset()         { *m = AbandonChannelRequest{} }
 func (m *AbandonChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*AbandonChannelRequest) ProtoMessage()    {}
 func (*AbandonChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{103}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{116}
 }
 func (m *AbandonChannelRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AbandonChannelRequest.Unmarshal(m, b)
@@ -7371,7 +8210,7 @@ func (m *AbandonChannelResponse) Reset()         { *m = AbandonChannelResponse{}
 func (m *AbandonChannelResponse) String() string { return proto.CompactTextString(m) }
 func (*AbandonChannelResponse) ProtoMessage()    {}
 func (*AbandonChannelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{104}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{117}
 }
 func (m *AbandonChannelResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AbandonChannelResponse.Unmarshal(m, b)
@@ -7403,7 +8242,7 @@ func (m *DebugLevelRequest) Reset()         { *m = DebugLevelRequest{} }
 func (m *DebugLevelRequest) String() string { return proto.CompactTextString(m) }
 func (*DebugLevelRequest) ProtoMessage()    {}
 func (*DebugLevelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{105}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{118}
 }
 func (m *DebugLevelRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DebugLevelRequest.Unmarshal(m, b)
@@ -7448,7 +8287,7 @@ func (m *DebugLevelResponse) Reset()         { *m = DebugLevelResponse{} }
 func (m *DebugLevelResponse) String() string { return proto.CompactTextString(m) }
 func (*DebugLevelResponse) ProtoMessage()    {}
 func (*DebugLevelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{106}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{119}
 }
 func (m *DebugLevelResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DebugLevelResponse.Unmarshal(m, b)
@@ -7487,7 +8326,7 @@ func (m *PayReqString) Reset()         { *m = PayReqString{} }
 func (m *PayReqString) String() string { return proto.CompactTextString(m) }
 func (*PayReqString) ProtoMessage()    {}
 func (*PayReqString) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{107}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{120}
 }
 func (m *PayReqString) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PayReqString.Unmarshal(m, b)
@@ -7534,7 +8373,7 @@ func (m *PayReq) Reset()         { *m = PayReq{} }
 func (m *PayReq) String() string { return proto.CompactTextString(m) }
 func (*PayReq) ProtoMessage()    {}
 func (*PayReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{108}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{121}
 }
 func (m *PayReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PayReq.Unmarshal(m, b)
@@ -7634,7 +8473,7 @@ func (m *FeeReportRequest) Reset()         { *m = FeeReportRequest{} }
 func (m *FeeReportRequest) String() string { return proto.CompactTextString(m) }
 func (*FeeReportRequest) ProtoMessage()    {}
 func (*FeeReportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{109}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{122}
 }
 func (m *FeeReportRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FeeReportRequest.Unmarshal(m, b)
@@ -7672,7 +8511,7 @@ func (m *ChannelFeeReport) Reset()         { *m = ChannelFeeReport{} }
 func (m *ChannelFeeReport) String() string { return proto.CompactTextString(m) }
 func (*ChannelFeeReport) ProtoMessage()    {}
 func (*ChannelFeeReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{110}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{123}
 }
 func (m *ChannelFeeReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelFeeReport.Unmarshal(m, b)
@@ -7738,7 +8577,7 @@ func (m *FeeReportResponse) Reset()         { *m = FeeReportResponse{} }
 func (m *FeeReportResponse) String() string { return proto.CompactTextString(m) }
 func (*FeeReportResponse) ProtoMessage()    {}
 func (*FeeReportResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{111}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{124}
 }
 func (m *FeeReportResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FeeReportResponse.Unmarshal(m, b)
@@ -7786,72 +8625,279 @@ func (m *FeeReportResponse) GetMonthFeeSum() uint64 {
 	return 0
 }
 
-type PolicyUpdateRequest struct {
-	// Types that are valid to be assigned to Scope:
-	//	*PolicyUpdateRequest_Global
-	//	*PolicyUpdateRequest_ChanPoint
-	Scope isPolicyUpdateRequest_Scope `protobuf_oneof:"scope"`
-	// / The base fee charged regardless of the number of milli-satoshis sent.
-	BaseFeeMsat int64 `protobuf:"varint,3,opt,name=base_fee_msat,proto3" json:"base_fee_msat,omitempty"`
-	// / The effective fee rate in milli-satoshis. The precision of this value goes up to 6 decimal places, so 1e-6.
-	FeeRate float64 `protobuf:"fixed64,4,opt,name=fee_rate,proto3" json:"fee_rate,omitempty"`
-	// / The required timelock delta for HTLCs forwarded over the channel.
-	TimeLockDelta        uint32   `protobuf:"varint,5,opt,name=time_lock_delta,proto3" json:"time_lock_delta,omitempty"`
+type FeeControllerDecisionsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PolicyUpdateRequest) Reset()         { *m = PolicyUpdateRequest{} }
-func (m *PolicyUpdateRequest) String() string { return proto.CompactTextString(m) }
-func (*PolicyUpdateRequest) ProtoMessage()    {}
-func (*PolicyUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{112}
+func (m *FeeControllerDecisionsRequest) Reset()         { *m = FeeControllerDecisionsRequest{} }
+func (m *FeeControllerDecisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*FeeControllerDecisionsRequest) ProtoMessage()    {}
+func (*FeeControllerDecisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{125}
+}
+func (m *FeeControllerDecisionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeeControllerDecisionsRequest.Unmarshal(m, b)
+}
+func (m *FeeControllerDecisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeeControllerDecisionsRequest.Marshal(b, m, deterministic)
+}
+func (dst *FeeControllerDecisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeControllerDecisionsRequest.Merge(dst, src)
+}
+func (m *FeeControllerDecisionsRequest) XXX_Size() int {
+	return xxx_messageInfo_FeeControllerDecisionsRequest.Size(m)
+}
+func (m *FeeControllerDecisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeControllerDecisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeControllerDecisionsRequest proto.InternalMessageInfo
+
+type FeeControllerDecision struct {
+	// / The short channel id of the channel.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
+	// / The channel point of the channel.
+	ChannelPoint string `protobuf:"bytes,2,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
+	// / The unix timestamp in seconds at which the decision was taken.
+	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// / The ratio of the channel capacity that was on our side.
+	LocalBalanceRatio float64 `protobuf:"fixed64,4,opt,name=local_balance_ratio,proto3" json:"local_balance_ratio,omitempty"`
+	// / The amount forwarded out of the channel during the last interval.
+	ForwardVolumeMsat uint64 `protobuf:"varint,5,opt,name=forward_volume_msat,proto3" json:"forward_volume_msat,omitempty"`
+	// / The number of forwards that failed for lack of liquidity on the channel during the last interval.
+	LiquidityFailures uint32 `protobuf:"varint,6,opt,name=liquidity_failures,proto3" json:"liquidity_failures,omitempty"`
+	// / The base fee of the channel before the decision.
+	OldBaseFeeMsat int64 `protobuf:"varint,7,opt,name=old_base_fee_msat,proto3" json:"old_base_fee_msat,omitempty"`
+	// / The fee rate of the channel before the decision, in millionths.
+	OldFeePerMil int64 `protobuf:"varint,8,opt,name=old_fee_per_mil,proto3" json:"old_fee_per_mil,omitempty"`
+	// / The base fee computed for the channel.
+	NewBaseFeeMsat int64 `protobuf:"varint,9,opt,name=new_base_fee_msat,proto3" json:"new_base_fee_msat,omitempty"`
+	// / The fee rate computed for the channel, in millionths.
+	NewFeePerMil int64 `protobuf:"varint,10,opt,name=new_fee_per_mil,proto3" json:"new_fee_per_mil,omitempty"`
+	// / Whether the new fees were applied to the channel.
+	Applied bool `protobuf:"varint,11,opt,name=applied,proto3" json:"applied,omitempty"`
+	// / The reason the new fees were not applied, or why applying them failed.
+	Reason               string   `protobuf:"bytes,12,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *PolicyUpdateRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PolicyUpdateRequest.Unmarshal(m, b)
+
+func (m *FeeControllerDecision) Reset()         { *m = FeeControllerDecision{} }
+func (m *FeeControllerDecision) String() string { return proto.CompactTextString(m) }
+func (*FeeControllerDecision) ProtoMessage()    {}
+func (*FeeControllerDecision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{126}
 }
-func (m *PolicyUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PolicyUpdateRequest.Marshal(b, m, deterministic)
+func (m *FeeControllerDecision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeeControllerDecision.Unmarshal(m, b)
 }
-func (dst *PolicyUpdateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PolicyUpdateRequest.Merge(dst, src)
+func (m *FeeControllerDecision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeeControllerDecision.Marshal(b, m, deterministic)
 }
-func (m *PolicyUpdateRequest) XXX_Size() int {
-	return xxx_messageInfo_PolicyUpdateRequest.Size(m)
+func (dst *FeeControllerDecision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeControllerDecision.Merge(dst, src)
 }
-func (m *PolicyUpdateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PolicyUpdateRequest.DiscardUnknown(m)
+func (m *FeeControllerDecision) XXX_Size() int {
+	return xxx_messageInfo_FeeControllerDecision.Size(m)
+}
+func (m *FeeControllerDecision) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeControllerDecision.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PolicyUpdateRequest proto.InternalMessageInfo
+var xxx_messageInfo_FeeControllerDecision proto.InternalMessageInfo
 
-type isPolicyUpdateRequest_Scope interface {
-	isPolicyUpdateRequest_Scope()
+func (m *FeeControllerDecision) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
 }
 
-type PolicyUpdateRequest_Global struct {
-	Global bool `protobuf:"varint,1,opt,name=global,proto3,oneof"`
+func (m *FeeControllerDecision) GetChannelPoint() string {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return ""
 }
 
-type PolicyUpdateRequest_ChanPoint struct {
-	ChanPoint *ChannelPoint `protobuf:"bytes,2,opt,name=chan_point,proto3,oneof"`
+func (m *FeeControllerDecision) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
 }
 
-func (*PolicyUpdateRequest_Global) isPolicyUpdateRequest_Scope() {}
-
-func (*PolicyUpdateRequest_ChanPoint) isPolicyUpdateRequest_Scope() {}
-
-func (m *PolicyUpdateRequest) GetScope() isPolicyUpdateRequest_Scope {
+func (m *FeeControllerDecision) GetLocalBalanceRatio() float64 {
 	if m != nil {
-		return m.Scope
+		return m.LocalBalanceRatio
 	}
-	return nil
+	return 0
 }
 
-func (m *PolicyUpdateRequest) GetGlobal() bool {
-	if x, ok := m.GetScope().(*PolicyUpdateRequest_Global); ok {
-		return x.Global
+func (m *FeeControllerDecision) GetForwardVolumeMsat() uint64 {
+	if m != nil {
+		return m.ForwardVolumeMsat
+	}
+	return 0
+}
+
+func (m *FeeControllerDecision) GetLiquidityFailures() uint32 {
+	if m != nil {
+		return m.LiquidityFailures
+	}
+	return 0
+}
+
+func (m *FeeControllerDecision) GetOldBaseFeeMsat() int64 {
+	if m != nil {
+		return m.OldBaseFeeMsat
+	}
+	return 0
+}
+
+func (m *FeeControllerDecision) GetOldFeePerMil() int64 {
+	if m != nil {
+		return m.OldFeePerMil
+	}
+	return 0
+}
+
+func (m *FeeControllerDecision) GetNewBaseFeeMsat() int64 {
+	if m != nil {
+		return m.NewBaseFeeMsat
+	}
+	return 0
+}
+
+func (m *FeeControllerDecision) GetNewFeePerMil() int64 {
+	if m != nil {
+		return m.NewFeePerMil
+	}
+	return 0
+}
+
+func (m *FeeControllerDecision) GetApplied() bool {
+	if m != nil {
+		return m.Applied
+	}
+	return false
+}
+
+func (m *FeeControllerDecision) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type FeeControllerDecisionsResponse struct {
+	// / The latest decision for each channel, sorted by channel id.
+	Decisions            []*FeeControllerDecision `protobuf:"bytes,1,rep,name=decisions,proto3" json:"decisions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *FeeControllerDecisionsResponse) Reset()         { *m = FeeControllerDecisionsResponse{} }
+func (m *FeeControllerDecisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*FeeControllerDecisionsResponse) ProtoMessage()    {}
+func (*FeeControllerDecisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{127}
+}
+func (m *FeeControllerDecisionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeeControllerDecisionsResponse.Unmarshal(m, b)
+}
+func (m *FeeControllerDecisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeeControllerDecisionsResponse.Marshal(b, m, deterministic)
+}
+func (dst *FeeControllerDecisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeControllerDecisionsResponse.Merge(dst, src)
+}
+func (m *FeeControllerDecisionsResponse) XXX_Size() int {
+	return xxx_messageInfo_FeeControllerDecisionsResponse.Size(m)
+}
+func (m *FeeControllerDecisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeControllerDecisionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeControllerDecisionsResponse proto.InternalMessageInfo
+
+func (m *FeeControllerDecisionsResponse) GetDecisions() []*FeeControllerDecision {
+	if m != nil {
+		return m.Decisions
+	}
+	return nil
+}
+
+type PolicyUpdateRequest struct {
+	// Types that are valid to be assigned to Scope:
+	//	*PolicyUpdateRequest_Global
+	//	*PolicyUpdateRequest_ChanPoint
+	Scope isPolicyUpdateRequest_Scope `protobuf_oneof:"scope"`
+	// / The base fee charged regardless of the number of milli-satoshis sent.
+	BaseFeeMsat int64 `protobuf:"varint,3,opt,name=base_fee_msat,proto3" json:"base_fee_msat,omitempty"`
+	// / The effective fee rate in milli-satoshis. The precision of this value goes up to 6 decimal places, so 1e-6.
+	FeeRate float64 `protobuf:"fixed64,4,opt,name=fee_rate,proto3" json:"fee_rate,omitempty"`
+	// / The required timelock delta for HTLCs forwarded over the channel.
+	TimeLockDelta        uint32   `protobuf:"varint,5,opt,name=time_lock_delta,proto3" json:"time_lock_delta,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PolicyUpdateRequest) Reset()         { *m = PolicyUpdateRequest{} }
+func (m *PolicyUpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*PolicyUpdateRequest) ProtoMessage()    {}
+func (*PolicyUpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{128}
+}
+func (m *PolicyUpdateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PolicyUpdateRequest.Unmarshal(m, b)
+}
+func (m *PolicyUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PolicyUpdateRequest.Marshal(b, m, deterministic)
+}
+func (dst *PolicyUpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyUpdateRequest.Merge(dst, src)
+}
+func (m *PolicyUpdateRequest) XXX_Size() int {
+	return xxx_messageInfo_PolicyUpdateRequest.Size(m)
+}
+func (m *PolicyUpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyUpdateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicyUpdateRequest proto.InternalMessageInfo
+
+type isPolicyUpdateRequest_Scope interface {
+	isPolicyUpdateRequest_Scope()
+}
+
+type PolicyUpdateRequest_Global struct {
+	Global bool `protobuf:"varint,1,opt,name=global,proto3,oneof"`
+}
+
+type PolicyUpdateRequest_ChanPoint struct {
+	ChanPoint *ChannelPoint `protobuf:"bytes,2,opt,name=chan_point,proto3,oneof"`
+}
+
+func (*PolicyUpdateRequest_Global) isPolicyUpdateRequest_Scope() {}
+
+func (*PolicyUpdateRequest_ChanPoint) isPolicyUpdateRequest_Scope() {}
+
+func (m *PolicyUpdateRequest) GetScope() isPolicyUpdateRequest_Scope {
+	if m != nil {
+		return m.Scope
+	}
+	return nil
+}
+
+func (m *PolicyUpdateRequest) GetGlobal() bool {
+	if x, ok := m.GetScope().(*PolicyUpdateRequest_Global); ok {
+		return x.Global
 	}
 	return false
 }
@@ -7967,7 +9013,7 @@ func (m *PolicyUpdateResponse) Reset()         { *m = PolicyUpdateResponse{} }
 func (m *PolicyUpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*PolicyUpdateResponse) ProtoMessage()    {}
 func (*PolicyUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{113}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{129}
 }
 func (m *PolicyUpdateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PolicyUpdateResponse.Unmarshal(m, b)
@@ -8005,7 +9051,7 @@ func (m *ForwardingHistoryRequest) Reset()         { *m = ForwardingHistoryReque
 func (m *ForwardingHistoryRequest) String() string { return proto.CompactTextString(m) }
 func (*ForwardingHistoryRequest) ProtoMessage()    {}
 func (*ForwardingHistoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{114}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{130}
 }
 func (m *ForwardingHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ForwardingHistoryRequest.Unmarshal(m, b)
@@ -8077,7 +9123,7 @@ func (m *ForwardingEvent) Reset()         { *m = ForwardingEvent{} }
 func (m *ForwardingEvent) String() string { return proto.CompactTextString(m) }
 func (*ForwardingEvent) ProtoMessage()    {}
 func (*ForwardingEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{115}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{131}
 }
 func (m *ForwardingEvent) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ForwardingEvent.Unmarshal(m, b)
@@ -8160,7 +9206,7 @@ func (m *ForwardingHistoryResponse) Reset()         { *m = ForwardingHistoryResp
 func (m *ForwardingHistoryResponse) String() string { return proto.CompactTextString(m) }
 func (*ForwardingHistoryResponse) ProtoMessage()    {}
 func (*ForwardingHistoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{116}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{132}
 }
 func (m *ForwardingHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ForwardingHistoryResponse.Unmarshal(m, b)
@@ -8194,6 +9240,202 @@ func (m *ForwardingHistoryResponse) GetLastOffsetIndex() uint32 {
 	return 0
 }
 
+type HtlcQuotasRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HtlcQuotasRequest) Reset()         { *m = HtlcQuotasRequest{} }
+func (m *HtlcQuotasRequest) String() string { return proto.CompactTextString(m) }
+func (*HtlcQuotasRequest) ProtoMessage()    {}
+func (*HtlcQuotasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{133}
+}
+func (m *HtlcQuotasRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HtlcQuotasRequest.Unmarshal(m, b)
+}
+func (m *HtlcQuotasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HtlcQuotasRequest.Marshal(b, m, deterministic)
+}
+func (dst *HtlcQuotasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HtlcQuotasRequest.Merge(dst, src)
+}
+func (m *HtlcQuotasRequest) XXX_Size() int {
+	return xxx_messageInfo_HtlcQuotasRequest.Size(m)
+}
+func (m *HtlcQuotasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HtlcQuotasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HtlcQuotasRequest proto.InternalMessageInfo
+
+type HtlcQuota struct {
+	// / The short channel id of the incoming channel.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
+	// / The number of htlcs from the channel that are currently in flight.
+	NumInFlight uint32 `protobuf:"varint,2,opt,name=num_in_flight,proto3" json:"num_in_flight,omitempty"`
+	// / The total value of the htlcs from the channel that are in flight.
+	ValueInFlightMsat uint64 `protobuf:"varint,3,opt,name=value_in_flight_msat,proto3" json:"value_in_flight_msat,omitempty"`
+	// / The number of htlcs rejected for exceeding the htlc slot quota.
+	NumSlotRejects uint64 `protobuf:"varint,4,opt,name=num_slot_rejects,proto3" json:"num_slot_rejects,omitempty"`
+	// / The number of htlcs rejected for exceeding the value quota.
+	NumValueRejects uint64 `protobuf:"varint,5,opt,name=num_value_rejects,proto3" json:"num_value_rejects,omitempty"`
+	// *
+	// The number of htlcs rejected because the channel had an htlc in flight for
+	// longer than the maximum hold time.
+	NumHoldTimeRejects uint64 `protobuf:"varint,6,opt,name=num_hold_time_rejects,proto3" json:"num_hold_time_rejects,omitempty"`
+	// / The number of htlcs resolved only after the maximum hold time passed.
+	NumSlowResolved      uint64   `protobuf:"varint,7,opt,name=num_slow_resolved,proto3" json:"num_slow_resolved,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HtlcQuota) Reset()         { *m = HtlcQuota{} }
+func (m *HtlcQuota) String() string { return proto.CompactTextString(m) }
+func (*HtlcQuota) ProtoMessage()    {}
+func (*HtlcQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{134}
+}
+func (m *HtlcQuota) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HtlcQuota.Unmarshal(m, b)
+}
+func (m *HtlcQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HtlcQuota.Marshal(b, m, deterministic)
+}
+func (dst *HtlcQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HtlcQuota.Merge(dst, src)
+}
+func (m *HtlcQuota) XXX_Size() int {
+	return xxx_messageInfo_HtlcQuota.Size(m)
+}
+func (m *HtlcQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_HtlcQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HtlcQuota proto.InternalMessageInfo
+
+func (m *HtlcQuota) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *HtlcQuota) GetNumInFlight() uint32 {
+	if m != nil {
+		return m.NumInFlight
+	}
+	return 0
+}
+
+func (m *HtlcQuota) GetValueInFlightMsat() uint64 {
+	if m != nil {
+		return m.ValueInFlightMsat
+	}
+	return 0
+}
+
+func (m *HtlcQuota) GetNumSlotRejects() uint64 {
+	if m != nil {
+		return m.NumSlotRejects
+	}
+	return 0
+}
+
+func (m *HtlcQuota) GetNumValueRejects() uint64 {
+	if m != nil {
+		return m.NumValueRejects
+	}
+	return 0
+}
+
+func (m *HtlcQuota) GetNumHoldTimeRejects() uint64 {
+	if m != nil {
+		return m.NumHoldTimeRejects
+	}
+	return 0
+}
+
+func (m *HtlcQuota) GetNumSlowResolved() uint64 {
+	if m != nil {
+		return m.NumSlowResolved
+	}
+	return 0
+}
+
+type HtlcQuotasResponse struct {
+	// *
+	// The maximum percentage of the htlc slots of an outgoing channel that htlcs
+	// from a single incoming channel may occupy. Zero if disabled.
+	MaxSlotPercent uint32 `protobuf:"varint,1,opt,name=max_slot_percent,proto3" json:"max_slot_percent,omitempty"`
+	// *
+	// The maximum percentage of the maximum value in flight of an outgoing
+	// channel that htlcs from a single incoming channel may occupy. Zero if
+	// disabled.
+	MaxValuePercent uint32 `protobuf:"varint,2,opt,name=max_value_percent,proto3" json:"max_value_percent,omitempty"`
+	// / The maximum hold time of an htlc in seconds. Zero if disabled.
+	MaxHoldTimeSeconds uint64 `protobuf:"varint,3,opt,name=max_hold_time_seconds,proto3" json:"max_hold_time_seconds,omitempty"`
+	// / The counters of each incoming channel, sorted by channel id.
+	Quotas               []*HtlcQuota `protobuf:"bytes,4,rep,name=quotas,proto3" json:"quotas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *HtlcQuotasResponse) Reset()         { *m = HtlcQuotasResponse{} }
+func (m *HtlcQuotasResponse) String() string { return proto.CompactTextString(m) }
+func (*HtlcQuotasResponse) ProtoMessage()    {}
+func (*HtlcQuotasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{135}
+}
+func (m *HtlcQuotasResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HtlcQuotasResponse.Unmarshal(m, b)
+}
+func (m *HtlcQuotasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HtlcQuotasResponse.Marshal(b, m, deterministic)
+}
+func (dst *HtlcQuotasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HtlcQuotasResponse.Merge(dst, src)
+}
+func (m *HtlcQuotasResponse) XXX_Size() int {
+	return xxx_messageInfo_HtlcQuotasResponse.Size(m)
+}
+func (m *HtlcQuotasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HtlcQuotasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HtlcQuotasResponse proto.InternalMessageInfo
+
+func (m *HtlcQuotasResponse) GetMaxSlotPercent() uint32 {
+	if m != nil {
+		return m.MaxSlotPercent
+	}
+	return 0
+}
+
+func (m *HtlcQuotasResponse) GetMaxValuePercent() uint32 {
+	if m != nil {
+		return m.MaxValuePercent
+	}
+	return 0
+}
+
+func (m *HtlcQuotasResponse) GetMaxHoldTimeSeconds() uint64 {
+	if m != nil {
+		return m.MaxHoldTimeSeconds
+	}
+	return 0
+}
+
+func (m *HtlcQuotasResponse) GetQuotas() []*HtlcQuota {
+	if m != nil {
+		return m.Quotas
+	}
+	return nil
+}
+
 type ExportChannelBackupRequest struct {
 	// / The target chanenl point to obtain a back up for.
 	ChanPoint            *ChannelPoint `protobuf:"bytes,1,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
@@ -8206,7 +9448,7 @@ func (m *ExportChannelBackupRequest) Reset()         { *m = ExportChannelBackupR
 func (m *ExportChannelBackupRequest) String() string { return proto.CompactTextString(m) }
 func (*ExportChannelBackupRequest) ProtoMessage()    {}
 func (*ExportChannelBackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{117}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{136}
 }
 func (m *ExportChannelBackupRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExportChannelBackupRequest.Unmarshal(m, b)
@@ -8251,7 +9493,7 @@ func (m *ChannelBackup) Reset()         { *m = ChannelBackup{} }
 func (m *ChannelBackup) String() string { return proto.CompactTextString(m) }
 func (*ChannelBackup) ProtoMessage()    {}
 func (*ChannelBackup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{118}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{137}
 }
 func (m *ChannelBackup) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelBackup.Unmarshal(m, b)
@@ -8303,7 +9545,7 @@ func (m *MultiChanBackup) Reset()         { *m = MultiChanBackup{} }
 func (m *MultiChanBackup) String() string { return proto.CompactTextString(m) }
 func (*MultiChanBackup) ProtoMessage()    {}
 func (*MultiChanBackup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{119}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{138}
 }
 func (m *MultiChanBackup) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MultiChanBackup.Unmarshal(m, b)
@@ -8347,7 +9589,7 @@ func (m *ChanBackupExportRequest) Reset()         { *m = ChanBackupExportRequest
 func (m *ChanBackupExportRequest) String() string { return proto.CompactTextString(m) }
 func (*ChanBackupExportRequest) ProtoMessage()    {}
 func (*ChanBackupExportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{120}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{139}
 }
 func (m *ChanBackupExportRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChanBackupExportRequest.Unmarshal(m, b)
@@ -8385,7 +9627,7 @@ func (m *ChanBackupSnapshot) Reset()         { *m = ChanBackupSnapshot{} }
 func (m *ChanBackupSnapshot) String() string { return proto.CompactTextString(m) }
 func (*ChanBackupSnapshot) ProtoMessage()    {}
 func (*ChanBackupSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{121}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{140}
 }
 func (m *ChanBackupSnapshot) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChanBackupSnapshot.Unmarshal(m, b)
@@ -8432,7 +9674,7 @@ func (m *ChannelBackups) Reset()         { *m = ChannelBackups{} }
 func (m *ChannelBackups) String() string { return proto.CompactTextString(m) }
 func (*ChannelBackups) ProtoMessage()    {}
 func (*ChannelBackups) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{122}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{141}
 }
 func (m *ChannelBackups) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelBackups.Unmarshal(m, b)
@@ -8473,7 +9715,7 @@ func (m *RestoreChanBackupRequest) Reset()         { *m = RestoreChanBackupReque
 func (m *RestoreChanBackupRequest) String() string { return proto.CompactTextString(m) }
 func (*RestoreChanBackupRequest) ProtoMessage()    {}
 func (*RestoreChanBackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{123}
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{142}
 }
 func (m *RestoreChanBackupRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RestoreChanBackupRequest.Unmarshal(m, b)
@@ -8530,168 +9772,671 @@ func (m *RestoreChanBackupRequest) GetMultiChanBackup() []byte {
 	return nil
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*RestoreChanBackupRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _RestoreChanBackupRequest_OneofMarshaler, _RestoreChanBackupRequest_OneofUnmarshaler, _RestoreChanBackupRequest_OneofSizer, []interface{}{
-		(*RestoreChanBackupRequest_ChanBackups)(nil),
-		(*RestoreChanBackupRequest_MultiChanBackup)(nil),
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*RestoreChanBackupRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _RestoreChanBackupRequest_OneofMarshaler, _RestoreChanBackupRequest_OneofUnmarshaler, _RestoreChanBackupRequest_OneofSizer, []interface{}{
+		(*RestoreChanBackupRequest_ChanBackups)(nil),
+		(*RestoreChanBackupRequest_MultiChanBackup)(nil),
+	}
+}
+
+func _RestoreChanBackupRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*RestoreChanBackupRequest)
+	// backup
+	switch x := m.Backup.(type) {
+	case *RestoreChanBackupRequest_ChanBackups:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ChanBackups); err != nil {
+			return err
+		}
+	case *RestoreChanBackupRequest_MultiChanBackup:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		b.EncodeRawBytes(x.MultiChanBackup)
+	case nil:
+	default:
+		return fmt.Errorf("RestoreChanBackupRequest.Backup has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _RestoreChanBackupRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*RestoreChanBackupRequest)
+	switch tag {
+	case 1: // backup.chan_backups
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ChannelBackups)
+		err := b.DecodeMessage(msg)
+		m.Backup = &RestoreChanBackupRequest_ChanBackups{msg}
+		return true, err
+	case 2: // backup.multi_chan_backup
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.Backup = &RestoreChanBackupRequest_MultiChanBackup{x}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _RestoreChanBackupRequest_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*RestoreChanBackupRequest)
+	// backup
+	switch x := m.Backup.(type) {
+	case *RestoreChanBackupRequest_ChanBackups:
+		s := proto.Size(x.ChanBackups)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *RestoreChanBackupRequest_MultiChanBackup:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.MultiChanBackup)))
+		n += len(x.MultiChanBackup)
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type RestoreBackupResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreBackupResponse) Reset()         { *m = RestoreBackupResponse{} }
+func (m *RestoreBackupResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreBackupResponse) ProtoMessage()    {}
+func (*RestoreBackupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{143}
+}
+func (m *RestoreBackupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreBackupResponse.Unmarshal(m, b)
+}
+func (m *RestoreBackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreBackupResponse.Marshal(b, m, deterministic)
+}
+func (dst *RestoreBackupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreBackupResponse.Merge(dst, src)
+}
+func (m *RestoreBackupResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreBackupResponse.Size(m)
+}
+func (m *RestoreBackupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreBackupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreBackupResponse proto.InternalMessageInfo
+
+type ChannelBackupSubscription struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelBackupSubscription) Reset()         { *m = ChannelBackupSubscription{} }
+func (m *ChannelBackupSubscription) String() string { return proto.CompactTextString(m) }
+func (*ChannelBackupSubscription) ProtoMessage()    {}
+func (*ChannelBackupSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{144}
+}
+func (m *ChannelBackupSubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelBackupSubscription.Unmarshal(m, b)
+}
+func (m *ChannelBackupSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelBackupSubscription.Marshal(b, m, deterministic)
+}
+func (dst *ChannelBackupSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelBackupSubscription.Merge(dst, src)
+}
+func (m *ChannelBackupSubscription) XXX_Size() int {
+	return xxx_messageInfo_ChannelBackupSubscription.Size(m)
+}
+func (m *ChannelBackupSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelBackupSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelBackupSubscription proto.InternalMessageInfo
+
+type VerifyChanBackupResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyChanBackupResponse) Reset()         { *m = VerifyChanBackupResponse{} }
+func (m *VerifyChanBackupResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyChanBackupResponse) ProtoMessage()    {}
+func (*VerifyChanBackupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{145}
+}
+func (m *VerifyChanBackupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyChanBackupResponse.Unmarshal(m, b)
+}
+func (m *VerifyChanBackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyChanBackupResponse.Marshal(b, m, deterministic)
+}
+func (dst *VerifyChanBackupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyChanBackupResponse.Merge(dst, src)
+}
+func (m *VerifyChanBackupResponse) XXX_Size() int {
+	return xxx_messageInfo_VerifyChanBackupResponse.Size(m)
+}
+func (m *VerifyChanBackupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyChanBackupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyChanBackupResponse proto.InternalMessageInfo
+
+type MacaroonPermission struct {
+	// *
+	// The entity the permission is granted for, one of onchain, offchain,
+	// address, message, peers, info, invoices, signer or macaroon.
+	Entity string `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	// / The action that is granted, one of read, write or generate.
+	Action               string   `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MacaroonPermission) Reset()         { *m = MacaroonPermission{} }
+func (m *MacaroonPermission) String() string { return proto.CompactTextString(m) }
+func (*MacaroonPermission) ProtoMessage()    {}
+func (*MacaroonPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{146}
+}
+func (m *MacaroonPermission) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MacaroonPermission.Unmarshal(m, b)
+}
+func (m *MacaroonPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MacaroonPermission.Marshal(b, m, deterministic)
+}
+func (dst *MacaroonPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MacaroonPermission.Merge(dst, src)
+}
+func (m *MacaroonPermission) XXX_Size() int {
+	return xxx_messageInfo_MacaroonPermission.Size(m)
+}
+func (m *MacaroonPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_MacaroonPermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MacaroonPermission proto.InternalMessageInfo
+
+func (m *MacaroonPermission) GetEntity() string {
+	if m != nil {
+		return m.Entity
+	}
+	return ""
+}
+
+func (m *MacaroonPermission) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+type BakeMacaroonRequest struct {
+	// / The list of permissions the new macaroon should grant.
+	Permissions []*MacaroonPermission `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	// *
+	// The ID of the root key the macaroon is minted with. If not set, the default
+	// root key is used, which can't be deleted.
+	RootKeyId            uint64   `protobuf:"varint,2,opt,name=root_key_id,proto3" json:"root_key_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BakeMacaroonRequest) Reset()         { *m = BakeMacaroonRequest{} }
+func (m *BakeMacaroonRequest) String() string { return proto.CompactTextString(m) }
+func (*BakeMacaroonRequest) ProtoMessage()    {}
+func (*BakeMacaroonRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{147}
+}
+func (m *BakeMacaroonRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BakeMacaroonRequest.Unmarshal(m, b)
+}
+func (m *BakeMacaroonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BakeMacaroonRequest.Marshal(b, m, deterministic)
+}
+func (dst *BakeMacaroonRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BakeMacaroonRequest.Merge(dst, src)
+}
+func (m *BakeMacaroonRequest) XXX_Size() int {
+	return xxx_messageInfo_BakeMacaroonRequest.Size(m)
+}
+func (m *BakeMacaroonRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BakeMacaroonRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BakeMacaroonRequest proto.InternalMessageInfo
+
+func (m *BakeMacaroonRequest) GetPermissions() []*MacaroonPermission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *BakeMacaroonRequest) GetRootKeyId() uint64 {
+	if m != nil {
+		return m.RootKeyId
+	}
+	return 0
+}
+
+type BakeMacaroonResponse struct {
+	// / The hex encoded macaroon, serialized in binary format.
+	Macaroon             string   `protobuf:"bytes,1,opt,name=macaroon,proto3" json:"macaroon,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BakeMacaroonResponse) Reset()         { *m = BakeMacaroonResponse{} }
+func (m *BakeMacaroonResponse) String() string { return proto.CompactTextString(m) }
+func (*BakeMacaroonResponse) ProtoMessage()    {}
+func (*BakeMacaroonResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{148}
+}
+func (m *BakeMacaroonResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BakeMacaroonResponse.Unmarshal(m, b)
+}
+func (m *BakeMacaroonResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BakeMacaroonResponse.Marshal(b, m, deterministic)
+}
+func (dst *BakeMacaroonResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BakeMacaroonResponse.Merge(dst, src)
+}
+func (m *BakeMacaroonResponse) XXX_Size() int {
+	return xxx_messageInfo_BakeMacaroonResponse.Size(m)
+}
+func (m *BakeMacaroonResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BakeMacaroonResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BakeMacaroonResponse proto.InternalMessageInfo
+
+func (m *BakeMacaroonResponse) GetMacaroon() string {
+	if m != nil {
+		return m.Macaroon
+	}
+	return ""
+}
+
+type ListMacaroonIDsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListMacaroonIDsRequest) Reset()         { *m = ListMacaroonIDsRequest{} }
+func (m *ListMacaroonIDsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListMacaroonIDsRequest) ProtoMessage()    {}
+func (*ListMacaroonIDsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{149}
+}
+func (m *ListMacaroonIDsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMacaroonIDsRequest.Unmarshal(m, b)
+}
+func (m *ListMacaroonIDsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMacaroonIDsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListMacaroonIDsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMacaroonIDsRequest.Merge(dst, src)
+}
+func (m *ListMacaroonIDsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListMacaroonIDsRequest.Size(m)
+}
+func (m *ListMacaroonIDsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMacaroonIDsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMacaroonIDsRequest proto.InternalMessageInfo
+
+type ListMacaroonIDsResponse struct {
+	// / The IDs of all root keys, sorted in ascending order.
+	RootKeyIds           []uint64 `protobuf:"varint,1,rep,packed,name=root_key_ids,proto3" json:"root_key_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListMacaroonIDsResponse) Reset()         { *m = ListMacaroonIDsResponse{} }
+func (m *ListMacaroonIDsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListMacaroonIDsResponse) ProtoMessage()    {}
+func (*ListMacaroonIDsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{150}
+}
+func (m *ListMacaroonIDsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMacaroonIDsResponse.Unmarshal(m, b)
+}
+func (m *ListMacaroonIDsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMacaroonIDsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListMacaroonIDsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMacaroonIDsResponse.Merge(dst, src)
+}
+func (m *ListMacaroonIDsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListMacaroonIDsResponse.Size(m)
+}
+func (m *ListMacaroonIDsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMacaroonIDsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMacaroonIDsResponse proto.InternalMessageInfo
+
+func (m *ListMacaroonIDsResponse) GetRootKeyIds() []uint64 {
+	if m != nil {
+		return m.RootKeyIds
+	}
+	return nil
+}
+
+type DeleteMacaroonIDRequest struct {
+	// / The ID of the root key to delete.
+	RootKeyId            uint64   `protobuf:"varint,1,opt,name=root_key_id,proto3" json:"root_key_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteMacaroonIDRequest) Reset()         { *m = DeleteMacaroonIDRequest{} }
+func (m *DeleteMacaroonIDRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteMacaroonIDRequest) ProtoMessage()    {}
+func (*DeleteMacaroonIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{151}
+}
+func (m *DeleteMacaroonIDRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteMacaroonIDRequest.Unmarshal(m, b)
+}
+func (m *DeleteMacaroonIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteMacaroonIDRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteMacaroonIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteMacaroonIDRequest.Merge(dst, src)
+}
+func (m *DeleteMacaroonIDRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteMacaroonIDRequest.Size(m)
+}
+func (m *DeleteMacaroonIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteMacaroonIDRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteMacaroonIDRequest proto.InternalMessageInfo
+
+func (m *DeleteMacaroonIDRequest) GetRootKeyId() uint64 {
+	if m != nil {
+		return m.RootKeyId
+	}
+	return 0
+}
+
+type DeleteMacaroonIDResponse struct {
+	// / Whether a root key with the given ID existed and was deleted.
+	Deleted              bool     `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteMacaroonIDResponse) Reset()         { *m = DeleteMacaroonIDResponse{} }
+func (m *DeleteMacaroonIDResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteMacaroonIDResponse) ProtoMessage()    {}
+func (*DeleteMacaroonIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{152}
+}
+func (m *DeleteMacaroonIDResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteMacaroonIDResponse.Unmarshal(m, b)
+}
+func (m *DeleteMacaroonIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteMacaroonIDResponse.Marshal(b, m, deterministic)
+}
+func (dst *DeleteMacaroonIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteMacaroonIDResponse.Merge(dst, src)
+}
+func (m *DeleteMacaroonIDResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteMacaroonIDResponse.Size(m)
+}
+func (m *DeleteMacaroonIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteMacaroonIDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteMacaroonIDResponse proto.InternalMessageInfo
+
+func (m *DeleteMacaroonIDResponse) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
+type AuditLogRequest struct {
+	// / Start time is the starting point of the audit log request. All records beyond this point will be included, respecting the end time, and the index offset.
+	StartTime uint64 `protobuf:"varint,1,opt,name=start_time,proto3" json:"start_time,omitempty"`
+	// / End time is the end point of the audit log request. The response will carry at most 50k records between the start time and the end time. The index offset can be used to implement pagination.
+	EndTime uint64 `protobuf:"varint,2,opt,name=end_time,proto3" json:"end_time,omitempty"`
+	// / Index offset is the offset in the time series to start at. As each response can only contain 50k records, callers can use this to skip around within a packed time series.
+	IndexOffset uint32 `protobuf:"varint,3,opt,name=index_offset,proto3" json:"index_offset,omitempty"`
+	// / The max number of events to return in the response to this query.
+	NumMaxEvents         uint32   `protobuf:"varint,4,opt,name=num_max_events,proto3" json:"num_max_events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditLogRequest) Reset()         { *m = AuditLogRequest{} }
+func (m *AuditLogRequest) String() string { return proto.CompactTextString(m) }
+func (*AuditLogRequest) ProtoMessage()    {}
+func (*AuditLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{153}
+}
+func (m *AuditLogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditLogRequest.Unmarshal(m, b)
+}
+func (m *AuditLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditLogRequest.Marshal(b, m, deterministic)
+}
+func (dst *AuditLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditLogRequest.Merge(dst, src)
+}
+func (m *AuditLogRequest) XXX_Size() int {
+	return xxx_messageInfo_AuditLogRequest.Size(m)
+}
+func (m *AuditLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditLogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditLogRequest proto.InternalMessageInfo
+
+func (m *AuditLogRequest) GetStartTime() uint64 {
+	if m != nil {
+		return m.StartTime
 	}
+	return 0
 }
 
-func _RestoreChanBackupRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*RestoreChanBackupRequest)
-	// backup
-	switch x := m.Backup.(type) {
-	case *RestoreChanBackupRequest_ChanBackups:
-		b.EncodeVarint(1<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.ChanBackups); err != nil {
-			return err
-		}
-	case *RestoreChanBackupRequest_MultiChanBackup:
-		b.EncodeVarint(2<<3 | proto.WireBytes)
-		b.EncodeRawBytes(x.MultiChanBackup)
-	case nil:
-	default:
-		return fmt.Errorf("RestoreChanBackupRequest.Backup has unexpected type %T", x)
+func (m *AuditLogRequest) GetEndTime() uint64 {
+	if m != nil {
+		return m.EndTime
 	}
-	return nil
+	return 0
 }
 
-func _RestoreChanBackupRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*RestoreChanBackupRequest)
-	switch tag {
-	case 1: // backup.chan_backups
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(ChannelBackups)
-		err := b.DecodeMessage(msg)
-		m.Backup = &RestoreChanBackupRequest_ChanBackups{msg}
-		return true, err
-	case 2: // backup.multi_chan_backup
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		x, err := b.DecodeRawBytes(true)
-		m.Backup = &RestoreChanBackupRequest_MultiChanBackup{x}
-		return true, err
-	default:
-		return false, nil
+func (m *AuditLogRequest) GetIndexOffset() uint32 {
+	if m != nil {
+		return m.IndexOffset
 	}
+	return 0
 }
 
-func _RestoreChanBackupRequest_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*RestoreChanBackupRequest)
-	// backup
-	switch x := m.Backup.(type) {
-	case *RestoreChanBackupRequest_ChanBackups:
-		s := proto.Size(x.ChanBackups)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *RestoreChanBackupRequest_MultiChanBackup:
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(len(x.MultiChanBackup)))
-		n += len(x.MultiChanBackup)
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+func (m *AuditLogRequest) GetNumMaxEvents() uint32 {
+	if m != nil {
+		return m.NumMaxEvents
 	}
-	return n
+	return 0
 }
 
-type RestoreBackupResponse struct {
+type AuditEvent struct {
+	// / Timestamp is the time (unix epoch offset in nanoseconds) that the call was made.
+	TimestampNs uint64 `protobuf:"varint,1,opt,name=timestamp_ns,proto3" json:"timestamp_ns,omitempty"`
+	// / The full name of the RPC method that was called.
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// / The hex encoded ID of the macaroon the call was made with.
+	MacaroonId string `protobuf:"bytes,3,opt,name=macaroon_id,proto3" json:"macaroon_id,omitempty"`
+	// / The address of the remote peer that made the call.
+	RemoteAddr string `protobuf:"bytes,4,opt,name=remote_addr,proto3" json:"remote_addr,omitempty"`
+	// / Whether the call succeeded.
+	Success bool `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	// / The error the call failed with, if any.
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	// / The amount moved by the call, for calls that move funds.
+	AmtMsat uint64 `protobuf:"varint,7,opt,name=amt_msat,proto3" json:"amt_msat,omitempty"`
+	// / The destination of the funds moved by the call, for calls that move funds. This is a node public key, an on-chain address or a channel point.
+	Destination          string   `protobuf:"bytes,8,opt,name=destination,proto3" json:"destination,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RestoreBackupResponse) Reset()         { *m = RestoreBackupResponse{} }
-func (m *RestoreBackupResponse) String() string { return proto.CompactTextString(m) }
-func (*RestoreBackupResponse) ProtoMessage()    {}
-func (*RestoreBackupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{124}
+func (m *AuditEvent) Reset()         { *m = AuditEvent{} }
+func (m *AuditEvent) String() string { return proto.CompactTextString(m) }
+func (*AuditEvent) ProtoMessage()    {}
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{154}
 }
-func (m *RestoreBackupResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RestoreBackupResponse.Unmarshal(m, b)
+func (m *AuditEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEvent.Unmarshal(m, b)
 }
-func (m *RestoreBackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RestoreBackupResponse.Marshal(b, m, deterministic)
+func (m *AuditEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEvent.Marshal(b, m, deterministic)
 }
-func (dst *RestoreBackupResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RestoreBackupResponse.Merge(dst, src)
+func (dst *AuditEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEvent.Merge(dst, src)
 }
-func (m *RestoreBackupResponse) XXX_Size() int {
-	return xxx_messageInfo_RestoreBackupResponse.Size(m)
+func (m *AuditEvent) XXX_Size() int {
+	return xxx_messageInfo_AuditEvent.Size(m)
 }
-func (m *RestoreBackupResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RestoreBackupResponse.DiscardUnknown(m)
+func (m *AuditEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RestoreBackupResponse proto.InternalMessageInfo
+var xxx_messageInfo_AuditEvent proto.InternalMessageInfo
 
-type ChannelBackupSubscription struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *AuditEvent) GetTimestampNs() uint64 {
+	if m != nil {
+		return m.TimestampNs
+	}
+	return 0
 }
 
-func (m *ChannelBackupSubscription) Reset()         { *m = ChannelBackupSubscription{} }
-func (m *ChannelBackupSubscription) String() string { return proto.CompactTextString(m) }
-func (*ChannelBackupSubscription) ProtoMessage()    {}
-func (*ChannelBackupSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{125}
+func (m *AuditEvent) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
 }
-func (m *ChannelBackupSubscription) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelBackupSubscription.Unmarshal(m, b)
+
+func (m *AuditEvent) GetMacaroonId() string {
+	if m != nil {
+		return m.MacaroonId
+	}
+	return ""
 }
-func (m *ChannelBackupSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelBackupSubscription.Marshal(b, m, deterministic)
+
+func (m *AuditEvent) GetRemoteAddr() string {
+	if m != nil {
+		return m.RemoteAddr
+	}
+	return ""
 }
-func (dst *ChannelBackupSubscription) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelBackupSubscription.Merge(dst, src)
+
+func (m *AuditEvent) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
 }
-func (m *ChannelBackupSubscription) XXX_Size() int {
-	return xxx_messageInfo_ChannelBackupSubscription.Size(m)
+
+func (m *AuditEvent) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
 }
-func (m *ChannelBackupSubscription) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelBackupSubscription.DiscardUnknown(m)
+
+func (m *AuditEvent) GetAmtMsat() uint64 {
+	if m != nil {
+		return m.AmtMsat
+	}
+	return 0
 }
 
-var xxx_messageInfo_ChannelBackupSubscription proto.InternalMessageInfo
+func (m *AuditEvent) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
 
-type VerifyChanBackupResponse struct {
+type AuditLogResponse struct {
+	// / A list of audit events from the time slice of the time series specified in the request.
+	AuditEvents []*AuditEvent `protobuf:"bytes,1,rep,name=audit_events,proto3" json:"audit_events,omitempty"`
+	// / The index of the last time in the set of returned audit events. Can be used to seek further, pagination style.
+	LastOffsetIndex      uint32   `protobuf:"varint,2,opt,name=last_offset_index,proto3" json:"last_offset_index,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *VerifyChanBackupResponse) Reset()         { *m = VerifyChanBackupResponse{} }
-func (m *VerifyChanBackupResponse) String() string { return proto.CompactTextString(m) }
-func (*VerifyChanBackupResponse) ProtoMessage()    {}
-func (*VerifyChanBackupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_85b0b58f0d9865bd, []int{126}
+func (m *AuditLogResponse) Reset()         { *m = AuditLogResponse{} }
+func (m *AuditLogResponse) String() string { return proto.CompactTextString(m) }
+func (*AuditLogResponse) ProtoMessage()    {}
+func (*AuditLogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_468d8071bcb69afa, []int{155}
 }
-func (m *VerifyChanBackupResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VerifyChanBackupResponse.Unmarshal(m, b)
+func (m *AuditLogResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditLogResponse.Unmarshal(m, b)
 }
-func (m *VerifyChanBackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VerifyChanBackupResponse.Marshal(b, m, deterministic)
+func (m *AuditLogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditLogResponse.Marshal(b, m, deterministic)
 }
-func (dst *VerifyChanBackupResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VerifyChanBackupResponse.Merge(dst, src)
+func (dst *AuditLogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditLogResponse.Merge(dst, src)
 }
-func (m *VerifyChanBackupResponse) XXX_Size() int {
-	return xxx_messageInfo_VerifyChanBackupResponse.Size(m)
+func (m *AuditLogResponse) XXX_Size() int {
+	return xxx_messageInfo_AuditLogResponse.Size(m)
 }
-func (m *VerifyChanBackupResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_VerifyChanBackupResponse.DiscardUnknown(m)
+func (m *AuditLogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditLogResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VerifyChanBackupResponse proto.InternalMessageInfo
+var xxx_messageInfo_AuditLogResponse proto.InternalMessageInfo
+
+func (m *AuditLogResponse) GetAuditEvents() []*AuditEvent {
+	if m != nil {
+		return m.AuditEvents
+	}
+	return nil
+}
+
+func (m *AuditLogResponse) GetLastOffsetIndex() uint32 {
+	if m != nil {
+		return m.LastOffsetIndex
+	}
+	return 0
+}
 
 func init() {
 	proto.RegisterType((*GenSeedRequest)(nil), "lnrpc.GenSeedRequest")
+	proto.RegisterType((*CipherSeedShare)(nil), "lnrpc.CipherSeedShare")
 	proto.RegisterType((*GenSeedResponse)(nil), "lnrpc.GenSeedResponse")
 	proto.RegisterType((*InitWalletRequest)(nil), "lnrpc.InitWalletRequest")
 	proto.RegisterType((*InitWalletResponse)(nil), "lnrpc.InitWalletResponse")
@@ -8738,8 +10483,15 @@ func init() {
 	proto.RegisterType((*ClosedChannelsRequest)(nil), "lnrpc.ClosedChannelsRequest")
 	proto.RegisterType((*ClosedChannelsResponse)(nil), "lnrpc.ClosedChannelsResponse")
 	proto.RegisterType((*Peer)(nil), "lnrpc.Peer")
+	proto.RegisterMapType((map[string]uint64)(nil), "lnrpc.Peer.MsgsRecvEntry")
+	proto.RegisterMapType((map[string]uint64)(nil), "lnrpc.Peer.MsgsSentEntry")
+	proto.RegisterType((*TimestampedError)(nil), "lnrpc.TimestampedError")
 	proto.RegisterType((*ListPeersRequest)(nil), "lnrpc.ListPeersRequest")
 	proto.RegisterType((*ListPeersResponse)(nil), "lnrpc.ListPeersResponse")
+	proto.RegisterType((*SendCustomMessageRequest)(nil), "lnrpc.SendCustomMessageRequest")
+	proto.RegisterType((*SendCustomMessageResponse)(nil), "lnrpc.SendCustomMessageResponse")
+	proto.RegisterType((*SubscribeCustomMessagesRequest)(nil), "lnrpc.SubscribeCustomMessagesRequest")
+	proto.RegisterType((*CustomMessage)(nil), "lnrpc.CustomMessage")
 	proto.RegisterType((*GetInfoRequest)(nil), "lnrpc.GetInfoRequest")
 	proto.RegisterType((*GetInfoResponse)(nil), "lnrpc.GetInfoResponse")
 	proto.RegisterType((*Chain)(nil), "lnrpc.Chain")
@@ -8766,6 +10518,7 @@ func init() {
 	proto.RegisterType((*ChannelBalanceRequest)(nil), "lnrpc.ChannelBalanceRequest")
 	proto.RegisterType((*ChannelBalanceResponse)(nil), "lnrpc.ChannelBalanceResponse")
 	proto.RegisterType((*QueryRoutesRequest)(nil), "lnrpc.QueryRoutesRequest")
+	proto.RegisterType((*NodePair)(nil), "lnrpc.NodePair")
 	proto.RegisterType((*EdgeLocator)(nil), "lnrpc.EdgeLocator")
 	proto.RegisterType((*QueryRoutesResponse)(nil), "lnrpc.QueryRoutesResponse")
 	proto.RegisterType((*Hop)(nil), "lnrpc.Hop")
@@ -8781,6 +10534,12 @@ func init() {
 	proto.RegisterType((*ChanInfoRequest)(nil), "lnrpc.ChanInfoRequest")
 	proto.RegisterType((*NetworkInfoRequest)(nil), "lnrpc.NetworkInfoRequest")
 	proto.RegisterType((*NetworkInfo)(nil), "lnrpc.NetworkInfo")
+	proto.RegisterType((*UpdateGraphBanListRequest)(nil), "lnrpc.UpdateGraphBanListRequest")
+	proto.RegisterType((*ListGraphBanListRequest)(nil), "lnrpc.ListGraphBanListRequest")
+	proto.RegisterType((*GraphBanList)(nil), "lnrpc.GraphBanList")
+	proto.RegisterType((*ExportGraphSnapshotRequest)(nil), "lnrpc.ExportGraphSnapshotRequest")
+	proto.RegisterType((*GraphSnapshotChunk)(nil), "lnrpc.GraphSnapshotChunk")
+	proto.RegisterType((*ImportGraphSnapshotResponse)(nil), "lnrpc.ImportGraphSnapshotResponse")
 	proto.RegisterType((*StopRequest)(nil), "lnrpc.StopRequest")
 	proto.RegisterType((*StopResponse)(nil), "lnrpc.StopResponse")
 	proto.RegisterType((*GraphTopologySubscription)(nil), "lnrpc.GraphTopologySubscription")
@@ -8810,11 +10569,17 @@ func init() {
 	proto.RegisterType((*FeeReportRequest)(nil), "lnrpc.FeeReportRequest")
 	proto.RegisterType((*ChannelFeeReport)(nil), "lnrpc.ChannelFeeReport")
 	proto.RegisterType((*FeeReportResponse)(nil), "lnrpc.FeeReportResponse")
+	proto.RegisterType((*FeeControllerDecisionsRequest)(nil), "lnrpc.FeeControllerDecisionsRequest")
+	proto.RegisterType((*FeeControllerDecision)(nil), "lnrpc.FeeControllerDecision")
+	proto.RegisterType((*FeeControllerDecisionsResponse)(nil), "lnrpc.FeeControllerDecisionsResponse")
 	proto.RegisterType((*PolicyUpdateRequest)(nil), "lnrpc.PolicyUpdateRequest")
 	proto.RegisterType((*PolicyUpdateResponse)(nil), "lnrpc.PolicyUpdateResponse")
 	proto.RegisterType((*ForwardingHistoryRequest)(nil), "lnrpc.ForwardingHistoryRequest")
 	proto.RegisterType((*ForwardingEvent)(nil), "lnrpc.ForwardingEvent")
 	proto.RegisterType((*ForwardingHistoryResponse)(nil), "lnrpc.ForwardingHistoryResponse")
+	proto.RegisterType((*HtlcQuotasRequest)(nil), "lnrpc.HtlcQuotasRequest")
+	proto.RegisterType((*HtlcQuota)(nil), "lnrpc.HtlcQuota")
+	proto.RegisterType((*HtlcQuotasResponse)(nil), "lnrpc.HtlcQuotasResponse")
 	proto.RegisterType((*ExportChannelBackupRequest)(nil), "lnrpc.ExportChannelBackupRequest")
 	proto.RegisterType((*ChannelBackup)(nil), "lnrpc.ChannelBackup")
 	proto.RegisterType((*MultiChanBackup)(nil), "lnrpc.MultiChanBackup")
@@ -8825,6 +10590,16 @@ func init() {
 	proto.RegisterType((*RestoreBackupResponse)(nil), "lnrpc.RestoreBackupResponse")
 	proto.RegisterType((*ChannelBackupSubscription)(nil), "lnrpc.ChannelBackupSubscription")
 	proto.RegisterType((*VerifyChanBackupResponse)(nil), "lnrpc.VerifyChanBackupResponse")
+	proto.RegisterType((*MacaroonPermission)(nil), "lnrpc.MacaroonPermission")
+	proto.RegisterType((*BakeMacaroonRequest)(nil), "lnrpc.BakeMacaroonRequest")
+	proto.RegisterType((*BakeMacaroonResponse)(nil), "lnrpc.BakeMacaroonResponse")
+	proto.RegisterType((*ListMacaroonIDsRequest)(nil), "lnrpc.ListMacaroonIDsRequest")
+	proto.RegisterType((*ListMacaroonIDsResponse)(nil), "lnrpc.ListMacaroonIDsResponse")
+	proto.RegisterType((*DeleteMacaroonIDRequest)(nil), "lnrpc.DeleteMacaroonIDRequest")
+	proto.RegisterType((*DeleteMacaroonIDResponse)(nil), "lnrpc.DeleteMacaroonIDResponse")
+	proto.RegisterType((*AuditLogRequest)(nil), "lnrpc.AuditLogRequest")
+	proto.RegisterType((*AuditEvent)(nil), "lnrpc.AuditEvent")
+	proto.RegisterType((*AuditLogResponse)(nil), "lnrpc.AuditLogResponse")
 	proto.RegisterEnum("lnrpc.AddressType", AddressType_name, AddressType_value)
 	proto.RegisterEnum("lnrpc.ChannelCloseSummary_ClosureType", ChannelCloseSummary_ClosureType_name, ChannelCloseSummary_ClosureType_value)
 	proto.RegisterEnum("lnrpc.Peer_SyncType", Peer_SyncType_name, Peer_SyncType_value)
@@ -9129,6 +10904,17 @@ type LightningClient interface {
 	// * lncli: `listpeers`
 	// ListPeers returns a verbose listing of all currently active peers.
 	ListPeers(ctx context.Context, in *ListPeersRequest, opts ...grpc.CallOption) (*ListPeersResponse, error)
+	// * lncli: `sendcustom`
+	// SendCustomMessage sends a custom peer message to the target peer. The type
+	// of the message must be within the custom range (32768 and above), and the
+	// data is sent as is. The call returns once the message has been written to
+	// the wire.
+	SendCustomMessage(ctx context.Context, in *SendCustomMessageRequest, opts ...grpc.CallOption) (*SendCustomMessageResponse, error)
+	// * lncli: `subscribecustom`
+	// SubscribeCustomMessages creates a uni-directional stream from the server to
+	// the client in which any custom peer messages received from any of our
+	// peers are sent over.
+	SubscribeCustomMessages(ctx context.Context, in *SubscribeCustomMessagesRequest, opts ...grpc.CallOption) (Lightning_SubscribeCustomMessagesClient, error)
 	// * lncli: `getinfo`
 	// GetInfo returns general information concerning the lightning node including
 	// it's identity pubkey, alias, the chains it is connected to, and information
@@ -9274,6 +11060,31 @@ type LightningClient interface {
 	// GetNetworkInfo returns some basic stats about the known channel graph from
 	// the point of view of the node.
 	GetNetworkInfo(ctx context.Context, in *NetworkInfoRequest, opts ...grpc.CallOption) (*NetworkInfo, error)
+	// * lncli: `updatebanlist`
+	// UpdateGraphBanList adds nodes and channels to, or removes them from, the
+	// ban list of the graph. Announcements for banned nodes and channels are
+	// dropped rather than validated and relayed, they're purged from the graph,
+	// and they're never used for path finding. Unbanned nodes and channels are
+	// learned of once again through the gossip network.
+	UpdateGraphBanList(ctx context.Context, in *UpdateGraphBanListRequest, opts ...grpc.CallOption) (*GraphBanList, error)
+	// * lncli: `listbanlist`
+	// ListGraphBanList returns the nodes and channels within the ban list of the
+	// graph, including those banned through the config.
+	ListGraphBanList(ctx context.Context, in *ListGraphBanListRequest, opts ...grpc.CallOption) (*GraphBanList, error)
+	// * lncli: `exportgraph`
+	// ExportGraphSnapshot streams a compact binary snapshot of the public part
+	// of the channel graph, consisting of the signed announcements of all nodes
+	// and channels along with the latest updates of the channels. The snapshot
+	// can be imported by other nodes to bootstrap their graph without syncing it
+	// over the gossip network.
+	ExportGraphSnapshot(ctx context.Context, in *ExportGraphSnapshotRequest, opts ...grpc.CallOption) (Lightning_ExportGraphSnapshotClient, error)
+	// * lncli: `importgraph`
+	// ImportGraphSnapshot adds the contents of a graph snapshot created by
+	// ExportGraphSnapshot to the channel graph. The signature of every
+	// announcement is validated, but as the snapshot is trusted, the existence of
+	// its channels isn't validated against the chain. Records that are already
+	// known, or that are banned, are skipped.
+	ImportGraphSnapshot(ctx context.Context, opts ...grpc.CallOption) (Lightning_ImportGraphSnapshotClient, error)
 	// * lncli: `stop`
 	// StopDaemon will send a shutdown request to the interrupt handler, triggering
 	// a graceful shutdown of the daemon.
@@ -9296,6 +11107,11 @@ type LightningClient interface {
 	// FeeReport allows the caller to obtain a report detailing the current fee
 	// schedule enforced by the node globally for each channel.
 	FeeReport(ctx context.Context, in *FeeReportRequest, opts ...grpc.CallOption) (*FeeReportResponse, error)
+	// * lncli: `feedecisions`
+	// FeeControllerDecisions returns the latest decision taken by the fee
+	// controller for each of our channels. The fee controller is only active if
+	// lnd was started with a fee policy file.
+	FeeControllerDecisions(ctx context.Context, in *FeeControllerDecisionsRequest, opts ...grpc.CallOption) (*FeeControllerDecisionsResponse, error)
 	// * lncli: `updatechanpolicy`
 	// UpdateChannelPolicy allows the caller to update the fee schedule and
 	// channel policies for all channels globally, or a particular channel.
@@ -9312,6 +11128,13 @@ type LightningClient interface {
 	// the index offset of the last entry. The index offset can be provided to the
 	// request to allow the caller to skip a series of records.
 	ForwardingHistory(ctx context.Context, in *ForwardingHistoryRequest, opts ...grpc.CallOption) (*ForwardingHistoryResponse, error)
+	// * lncli: `htlcquotas`
+	// HtlcQuotas returns the htlc quota counters of every incoming channel that
+	// htlcs have been forwarded or rejected for since startup. Htlcs from a
+	// single incoming channel may only occupy a limited share of the htlc slots
+	// and value in flight of each outgoing channel, and may only be held for a
+	// limited time, to protect against channel jamming.
+	HtlcQuotas(ctx context.Context, in *HtlcQuotasRequest, opts ...grpc.CallOption) (*HtlcQuotasResponse, error)
 	// * lncli: `exportchanbackup`
 	// ExportChannelBackup attempts to return an encrypted static channel backup
 	// for the target channel identified by it channel point. The backup is
@@ -9347,6 +11170,29 @@ type LightningClient interface {
 	// ups, but the updated set of encrypted multi-chan backups with the closed
 	// channel(s) removed.
 	SubscribeChannelBackups(ctx context.Context, in *ChannelBackupSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelBackupsClient, error)
+	// * lncli: `bakemacaroon`
+	// BakeMacaroon allows the creation of a new macaroon with custom read and
+	// write permissions. No first-party caveats are added since this can be done
+	// offline. The macaroon is minted with the root key of the given ID, which is
+	// created if it doesn't exist yet, such that it can later be revoked by
+	// deleting its root key through DeleteMacaroonID.
+	BakeMacaroon(ctx context.Context, in *BakeMacaroonRequest, opts ...grpc.CallOption) (*BakeMacaroonResponse, error)
+	// * lncli: `listmacaroonids`
+	// ListMacaroonIDs returns the IDs of all root keys that macaroons have been
+	// minted with.
+	ListMacaroonIDs(ctx context.Context, in *ListMacaroonIDsRequest, opts ...grpc.CallOption) (*ListMacaroonIDsResponse, error)
+	// * lncli: `deletemacaroonid`
+	// DeleteMacaroonID deletes the root key of the given ID, which revokes all
+	// macaroons that were minted with it. The default root key, which the admin,
+	// readonly and invoice macaroons are minted with, can't be deleted.
+	DeleteMacaroonID(ctx context.Context, in *DeleteMacaroonIDRequest, opts ...grpc.CallOption) (*DeleteMacaroonIDResponse, error)
+	// * lncli: `auditlog`
+	// AuditLog allows the caller to query the audit log for a record of all RPC
+	// calls made within the target time range, and integer offset within that
+	// time range. Calls are only recorded if lnd was started with the --auditlog
+	// option. If no time-range is specified, then the first chunk of the past 24
+	// hrs of the audit log is returned.
+	AuditLog(ctx context.Context, in *AuditLogRequest, opts ...grpc.CallOption) (*AuditLogResponse, error)
 }
 
 type lightningClient struct {
@@ -9506,6 +11352,47 @@ func (c *lightningClient) ListPeers(ctx context.Context, in *ListPeersRequest, o
 	return out, nil
 }
 
+func (c *lightningClient) SendCustomMessage(ctx context.Context, in *SendCustomMessageRequest, opts ...grpc.CallOption) (*SendCustomMessageResponse, error) {
+	out := new(SendCustomMessageResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SendCustomMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SubscribeCustomMessages(ctx context.Context, in *SubscribeCustomMessagesRequest, opts ...grpc.CallOption) (Lightning_SubscribeCustomMessagesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[1], "/lnrpc.Lightning/SubscribeCustomMessages", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribeCustomMessagesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeCustomMessagesClient interface {
+	Recv() (*CustomMessage, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeCustomMessagesClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeCustomMessagesClient) Recv() (*CustomMessage, error) {
+	m := new(CustomMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *lightningClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
 	out := new(GetInfoResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetInfo", in, out, opts...)
@@ -9534,7 +11421,7 @@ func (c *lightningClient) ListChannels(ctx context.Context, in *ListChannelsRequ
 }
 
 func (c *lightningClient) SubscribeChannelEvents(ctx context.Context, in *ChannelEventSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelEventsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[1], "/lnrpc.Lightning/SubscribeChannelEvents", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[2], "/lnrpc.Lightning/SubscribeChannelEvents", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -9584,7 +11471,7 @@ func (c *lightningClient) OpenChannelSync(ctx context.Context, in *OpenChannelRe
 }
 
 func (c *lightningClient) OpenChannel(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (Lightning_OpenChannelClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[2], "/lnrpc.Lightning/OpenChannel", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[3], "/lnrpc.Lightning/OpenChannel", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -9616,7 +11503,7 @@ func (x *lightningOpenChannelClient) Recv() (*OpenStatusUpdate, error) {
 }
 
 func (c *lightningClient) CloseChannel(ctx context.Context, in *CloseChannelRequest, opts ...grpc.CallOption) (Lightning_CloseChannelClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[3], "/lnrpc.Lightning/CloseChannel", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[4], "/lnrpc.Lightning/CloseChannel", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -9657,7 +11544,7 @@ func (c *lightningClient) AbandonChannel(ctx context.Context, in *AbandonChannel
 }
 
 func (c *lightningClient) SendPayment(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendPaymentClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[4], "/lnrpc.Lightning/SendPayment", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[5], "/lnrpc.Lightning/SendPayment", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -9697,7 +11584,7 @@ func (c *lightningClient) SendPaymentSync(ctx context.Context, in *SendRequest,
 }
 
 func (c *lightningClient) SendToRoute(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendToRouteClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[5], "/lnrpc.Lightning/SendToRoute", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[6], "/lnrpc.Lightning/SendToRoute", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -9764,7 +11651,7 @@ func (c *lightningClient) LookupInvoice(ctx context.Context, in *PaymentHash, op
 }
 
 func (c *lightningClient) SubscribeInvoices(ctx context.Context, in *InvoiceSubscription, opts ...grpc.CallOption) (Lightning_SubscribeInvoicesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[6], "/lnrpc.Lightning/SubscribeInvoices", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[7], "/lnrpc.Lightning/SubscribeInvoices", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -9840,31 +11727,115 @@ func (c *lightningClient) GetChanInfo(ctx context.Context, in *ChanInfoRequest,
 	return out, nil
 }
 
-func (c *lightningClient) GetNodeInfo(ctx context.Context, in *NodeInfoRequest, opts ...grpc.CallOption) (*NodeInfo, error) {
-	out := new(NodeInfo)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetNodeInfo", in, out, opts...)
-	if err != nil {
+func (c *lightningClient) GetNodeInfo(ctx context.Context, in *NodeInfoRequest, opts ...grpc.CallOption) (*NodeInfo, error) {
+	out := new(NodeInfo)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetNodeInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) QueryRoutes(ctx context.Context, in *QueryRoutesRequest, opts ...grpc.CallOption) (*QueryRoutesResponse, error) {
+	out := new(QueryRoutesResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/QueryRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) GetNetworkInfo(ctx context.Context, in *NetworkInfoRequest, opts ...grpc.CallOption) (*NetworkInfo, error) {
+	out := new(NetworkInfo)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetNetworkInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) UpdateGraphBanList(ctx context.Context, in *UpdateGraphBanListRequest, opts ...grpc.CallOption) (*GraphBanList, error) {
+	out := new(GraphBanList)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/UpdateGraphBanList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListGraphBanList(ctx context.Context, in *ListGraphBanListRequest, opts ...grpc.CallOption) (*GraphBanList, error) {
+	out := new(GraphBanList)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListGraphBanList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ExportGraphSnapshot(ctx context.Context, in *ExportGraphSnapshotRequest, opts ...grpc.CallOption) (Lightning_ExportGraphSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[8], "/lnrpc.Lightning/ExportGraphSnapshot", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningExportGraphSnapshotClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_ExportGraphSnapshotClient interface {
+	Recv() (*GraphSnapshotChunk, error)
+	grpc.ClientStream
+}
+
+type lightningExportGraphSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningExportGraphSnapshotClient) Recv() (*GraphSnapshotChunk, error) {
+	m := new(GraphSnapshotChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *lightningClient) QueryRoutes(ctx context.Context, in *QueryRoutesRequest, opts ...grpc.CallOption) (*QueryRoutesResponse, error) {
-	out := new(QueryRoutesResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/QueryRoutes", in, out, opts...)
+func (c *lightningClient) ImportGraphSnapshot(ctx context.Context, opts ...grpc.CallOption) (Lightning_ImportGraphSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[9], "/lnrpc.Lightning/ImportGraphSnapshot", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &lightningImportGraphSnapshotClient{stream}
+	return x, nil
 }
 
-func (c *lightningClient) GetNetworkInfo(ctx context.Context, in *NetworkInfoRequest, opts ...grpc.CallOption) (*NetworkInfo, error) {
-	out := new(NetworkInfo)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetNetworkInfo", in, out, opts...)
-	if err != nil {
+type Lightning_ImportGraphSnapshotClient interface {
+	Send(*GraphSnapshotChunk) error
+	CloseAndRecv() (*ImportGraphSnapshotResponse, error)
+	grpc.ClientStream
+}
+
+type lightningImportGraphSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningImportGraphSnapshotClient) Send(m *GraphSnapshotChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *lightningImportGraphSnapshotClient) CloseAndRecv() (*ImportGraphSnapshotResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
 		return nil, err
 	}
-	return out, nil
+	m := new(ImportGraphSnapshotResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
 func (c *lightningClient) StopDaemon(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error) {
@@ -9877,7 +11848,7 @@ func (c *lightningClient) StopDaemon(ctx context.Context, in *StopRequest, opts
 }
 
 func (c *lightningClient) SubscribeChannelGraph(ctx context.Context, in *GraphTopologySubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelGraphClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[7], "/lnrpc.Lightning/SubscribeChannelGraph", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[10], "/lnrpc.Lightning/SubscribeChannelGraph", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -9926,6 +11897,15 @@ func (c *lightningClient) FeeReport(ctx context.Context, in *FeeReportRequest, o
 	return out, nil
 }
 
+func (c *lightningClient) FeeControllerDecisions(ctx context.Context, in *FeeControllerDecisionsRequest, opts ...grpc.CallOption) (*FeeControllerDecisionsResponse, error) {
+	out := new(FeeControllerDecisionsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/FeeControllerDecisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) UpdateChannelPolicy(ctx context.Context, in *PolicyUpdateRequest, opts ...grpc.CallOption) (*PolicyUpdateResponse, error) {
 	out := new(PolicyUpdateResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/UpdateChannelPolicy", in, out, opts...)
@@ -9944,6 +11924,15 @@ func (c *lightningClient) ForwardingHistory(ctx context.Context, in *ForwardingH
 	return out, nil
 }
 
+func (c *lightningClient) HtlcQuotas(ctx context.Context, in *HtlcQuotasRequest, opts ...grpc.CallOption) (*HtlcQuotasResponse, error) {
+	out := new(HtlcQuotasResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/HtlcQuotas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) ExportChannelBackup(ctx context.Context, in *ExportChannelBackupRequest, opts ...grpc.CallOption) (*ChannelBackup, error) {
 	out := new(ChannelBackup)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ExportChannelBackup", in, out, opts...)
@@ -9981,7 +11970,7 @@ func (c *lightningClient) RestoreChannelBackups(ctx context.Context, in *Restore
 }
 
 func (c *lightningClient) SubscribeChannelBackups(ctx context.Context, in *ChannelBackupSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelBackupsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[8], "/lnrpc.Lightning/SubscribeChannelBackups", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[11], "/lnrpc.Lightning/SubscribeChannelBackups", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -10012,6 +12001,42 @@ func (x *lightningSubscribeChannelBackupsClient) Recv() (*ChanBackupSnapshot, er
 	return m, nil
 }
 
+func (c *lightningClient) BakeMacaroon(ctx context.Context, in *BakeMacaroonRequest, opts ...grpc.CallOption) (*BakeMacaroonResponse, error) {
+	out := new(BakeMacaroonResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/BakeMacaroon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListMacaroonIDs(ctx context.Context, in *ListMacaroonIDsRequest, opts ...grpc.CallOption) (*ListMacaroonIDsResponse, error) {
+	out := new(ListMacaroonIDsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListMacaroonIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) DeleteMacaroonID(ctx context.Context, in *DeleteMacaroonIDRequest, opts ...grpc.CallOption) (*DeleteMacaroonIDResponse, error) {
+	out := new(DeleteMacaroonIDResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/DeleteMacaroonID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) AuditLog(ctx context.Context, in *AuditLogRequest, opts ...grpc.CallOption) (*AuditLogResponse, error) {
+	out := new(AuditLogResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/AuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LightningServer is the server API for Lightning service.
 type LightningServer interface {
 	// * lncli: `walletbalance`
@@ -10080,6 +12105,17 @@ type LightningServer interface {
 	// * lncli: `listpeers`
 	// ListPeers returns a verbose listing of all currently active peers.
 	ListPeers(context.Context, *ListPeersRequest) (*ListPeersResponse, error)
+	// * lncli: `sendcustom`
+	// SendCustomMessage sends a custom peer message to the target peer. The type
+	// of the message must be within the custom range (32768 and above), and the
+	// data is sent as is. The call returns once the message has been written to
+	// the wire.
+	SendCustomMessage(context.Context, *SendCustomMessageRequest) (*SendCustomMessageResponse, error)
+	// * lncli: `subscribecustom`
+	// SubscribeCustomMessages creates a uni-directional stream from the server to
+	// the client in which any custom peer messages received from any of our
+	// peers are sent over.
+	SubscribeCustomMessages(*SubscribeCustomMessagesRequest, Lightning_SubscribeCustomMessagesServer) error
 	// * lncli: `getinfo`
 	// GetInfo returns general information concerning the lightning node including
 	// it's identity pubkey, alias, the chains it is connected to, and information
@@ -10225,6 +12261,31 @@ type LightningServer interface {
 	// GetNetworkInfo returns some basic stats about the known channel graph from
 	// the point of view of the node.
 	GetNetworkInfo(context.Context, *NetworkInfoRequest) (*NetworkInfo, error)
+	// * lncli: `updatebanlist`
+	// UpdateGraphBanList adds nodes and channels to, or removes them from, the
+	// ban list of the graph. Announcements for banned nodes and channels are
+	// dropped rather than validated and relayed, they're purged from the graph,
+	// and they're never used for path finding. Unbanned nodes and channels are
+	// learned of once again through the gossip network.
+	UpdateGraphBanList(context.Context, *UpdateGraphBanListRequest) (*GraphBanList, error)
+	// * lncli: `listbanlist`
+	// ListGraphBanList returns the nodes and channels within the ban list of the
+	// graph, including those banned through the config.
+	ListGraphBanList(context.Context, *ListGraphBanListRequest) (*GraphBanList, error)
+	// * lncli: `exportgraph`
+	// ExportGraphSnapshot streams a compact binary snapshot of the public part
+	// of the channel graph, consisting of the signed announcements of all nodes
+	// and channels along with the latest updates of the channels. The snapshot
+	// can be imported by other nodes to bootstrap their graph without syncing it
+	// over the gossip network.
+	ExportGraphSnapshot(*ExportGraphSnapshotRequest, Lightning_ExportGraphSnapshotServer) error
+	// * lncli: `importgraph`
+	// ImportGraphSnapshot adds the contents of a graph snapshot created by
+	// ExportGraphSnapshot to the channel graph. The signature of every
+	// announcement is validated, but as the snapshot is trusted, the existence of
+	// its channels isn't validated against the chain. Records that are already
+	// known, or that are banned, are skipped.
+	ImportGraphSnapshot(Lightning_ImportGraphSnapshotServer) error
 	// * lncli: `stop`
 	// StopDaemon will send a shutdown request to the interrupt handler, triggering
 	// a graceful shutdown of the daemon.
@@ -10247,6 +12308,11 @@ type LightningServer interface {
 	// FeeReport allows the caller to obtain a report detailing the current fee
 	// schedule enforced by the node globally for each channel.
 	FeeReport(context.Context, *FeeReportRequest) (*FeeReportResponse, error)
+	// * lncli: `feedecisions`
+	// FeeControllerDecisions returns the latest decision taken by the fee
+	// controller for each of our channels. The fee controller is only active if
+	// lnd was started with a fee policy file.
+	FeeControllerDecisions(context.Context, *FeeControllerDecisionsRequest) (*FeeControllerDecisionsResponse, error)
 	// * lncli: `updatechanpolicy`
 	// UpdateChannelPolicy allows the caller to update the fee schedule and
 	// channel policies for all channels globally, or a particular channel.
@@ -10263,6 +12329,13 @@ type LightningServer interface {
 	// the index offset of the last entry. The index offset can be provided to the
 	// request to allow the caller to skip a series of records.
 	ForwardingHistory(context.Context, *ForwardingHistoryRequest) (*ForwardingHistoryResponse, error)
+	// * lncli: `htlcquotas`
+	// HtlcQuotas returns the htlc quota counters of every incoming channel that
+	// htlcs have been forwarded or rejected for since startup. Htlcs from a
+	// single incoming channel may only occupy a limited share of the htlc slots
+	// and value in flight of each outgoing channel, and may only be held for a
+	// limited time, to protect against channel jamming.
+	HtlcQuotas(context.Context, *HtlcQuotasRequest) (*HtlcQuotasResponse, error)
 	// * lncli: `exportchanbackup`
 	// ExportChannelBackup attempts to return an encrypted static channel backup
 	// for the target channel identified by it channel point. The backup is
@@ -10298,6 +12371,29 @@ type LightningServer interface {
 	// ups, but the updated set of encrypted multi-chan backups with the closed
 	// channel(s) removed.
 	SubscribeChannelBackups(*ChannelBackupSubscription, Lightning_SubscribeChannelBackupsServer) error
+	// * lncli: `bakemacaroon`
+	// BakeMacaroon allows the creation of a new macaroon with custom read and
+	// write permissions. No first-party caveats are added since this can be done
+	// offline. The macaroon is minted with the root key of the given ID, which is
+	// created if it doesn't exist yet, such that it can later be revoked by
+	// deleting its root key through DeleteMacaroonID.
+	BakeMacaroon(context.Context, *BakeMacaroonRequest) (*BakeMacaroonResponse, error)
+	// * lncli: `listmacaroonids`
+	// ListMacaroonIDs returns the IDs of all root keys that macaroons have been
+	// minted with.
+	ListMacaroonIDs(context.Context, *ListMacaroonIDsRequest) (*ListMacaroonIDsResponse, error)
+	// * lncli: `deletemacaroonid`
+	// DeleteMacaroonID deletes the root key of the given ID, which revokes all
+	// macaroons that were minted with it. The default root key, which the admin,
+	// readonly and invoice macaroons are minted with, can't be deleted.
+	DeleteMacaroonID(context.Context, *DeleteMacaroonIDRequest) (*DeleteMacaroonIDResponse, error)
+	// * lncli: `auditlog`
+	// AuditLog allows the caller to query the audit log for a record of all RPC
+	// calls made within the target time range, and integer offset within that
+	// time range. Calls are only recorded if lnd was started with the --auditlog
+	// option. If no time-range is specified, then the first chunk of the past 24
+	// hrs of the audit log is returned.
+	AuditLog(context.Context, *AuditLogRequest) (*AuditLogResponse, error)
 }
 
 func RegisterLightningServer(s *grpc.Server, srv LightningServer) {
@@ -10559,6 +12655,45 @@ func _Lightning_ListPeers_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_SendCustomMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendCustomMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SendCustomMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SendCustomMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SendCustomMessage(ctx, req.(*SendCustomMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SubscribeCustomMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeCustomMessagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).SubscribeCustomMessages(m, &lightningSubscribeCustomMessagesServer{stream})
+}
+
+type Lightning_SubscribeCustomMessagesServer interface {
+	Send(*CustomMessage) error
+	grpc.ServerStream
+}
+
+type lightningSubscribeCustomMessagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSubscribeCustomMessagesServer) Send(m *CustomMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Lightning_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetInfoRequest)
 	if err := dec(in); err != nil {
@@ -11037,6 +13172,89 @@ func _Lightning_GetNetworkInfo_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_UpdateGraphBanList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGraphBanListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).UpdateGraphBanList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/UpdateGraphBanList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).UpdateGraphBanList(ctx, req.(*UpdateGraphBanListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListGraphBanList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGraphBanListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListGraphBanList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListGraphBanList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListGraphBanList(ctx, req.(*ListGraphBanListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ExportGraphSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportGraphSnapshotRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).ExportGraphSnapshot(m, &lightningExportGraphSnapshotServer{stream})
+}
+
+type Lightning_ExportGraphSnapshotServer interface {
+	Send(*GraphSnapshotChunk) error
+	grpc.ServerStream
+}
+
+type lightningExportGraphSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningExportGraphSnapshotServer) Send(m *GraphSnapshotChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Lightning_ImportGraphSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LightningServer).ImportGraphSnapshot(&lightningImportGraphSnapshotServer{stream})
+}
+
+type Lightning_ImportGraphSnapshotServer interface {
+	SendAndClose(*ImportGraphSnapshotResponse) error
+	Recv() (*GraphSnapshotChunk, error)
+	grpc.ServerStream
+}
+
+type lightningImportGraphSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningImportGraphSnapshotServer) SendAndClose(m *ImportGraphSnapshotResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *lightningImportGraphSnapshotServer) Recv() (*GraphSnapshotChunk, error) {
+	m := new(GraphSnapshotChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _Lightning_StopDaemon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StopRequest)
 	if err := dec(in); err != nil {
@@ -11112,6 +13330,24 @@ func _Lightning_FeeReport_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_FeeControllerDecisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeeControllerDecisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).FeeControllerDecisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/FeeControllerDecisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).FeeControllerDecisions(ctx, req.(*FeeControllerDecisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_UpdateChannelPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PolicyUpdateRequest)
 	if err := dec(in); err != nil {
@@ -11148,6 +13384,24 @@ func _Lightning_ForwardingHistory_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_HtlcQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HtlcQuotasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).HtlcQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/HtlcQuotas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).HtlcQuotas(ctx, req.(*HtlcQuotasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_ExportChannelBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExportChannelBackupRequest)
 	if err := dec(in); err != nil {
@@ -11241,6 +13495,78 @@ func (x *lightningSubscribeChannelBackupsServer) Send(m *ChanBackupSnapshot) err
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Lightning_BakeMacaroon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BakeMacaroonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).BakeMacaroon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/BakeMacaroon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).BakeMacaroon(ctx, req.(*BakeMacaroonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListMacaroonIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMacaroonIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListMacaroonIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListMacaroonIDs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListMacaroonIDs(ctx, req.(*ListMacaroonIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_DeleteMacaroonID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMacaroonIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).DeleteMacaroonID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/DeleteMacaroonID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).DeleteMacaroonID(ctx, req.(*DeleteMacaroonIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_AuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).AuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/AuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).AuditLog(ctx, req.(*AuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Lightning_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lnrpc.Lightning",
 	HandlerType: (*LightningServer)(nil),
@@ -11297,6 +13623,10 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListPeers",
 			Handler:    _Lightning_ListPeers_Handler,
 		},
+		{
+			MethodName: "SendCustomMessage",
+			Handler:    _Lightning_SendCustomMessage_Handler,
+		},
 		{
 			MethodName: "GetInfo",
 			Handler:    _Lightning_GetInfo_Handler,
@@ -11373,6 +13703,14 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetNetworkInfo",
 			Handler:    _Lightning_GetNetworkInfo_Handler,
 		},
+		{
+			MethodName: "UpdateGraphBanList",
+			Handler:    _Lightning_UpdateGraphBanList_Handler,
+		},
+		{
+			MethodName: "ListGraphBanList",
+			Handler:    _Lightning_ListGraphBanList_Handler,
+		},
 		{
 			MethodName: "StopDaemon",
 			Handler:    _Lightning_StopDaemon_Handler,
@@ -11385,6 +13723,10 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FeeReport",
 			Handler:    _Lightning_FeeReport_Handler,
 		},
+		{
+			MethodName: "FeeControllerDecisions",
+			Handler:    _Lightning_FeeControllerDecisions_Handler,
+		},
 		{
 			MethodName: "UpdateChannelPolicy",
 			Handler:    _Lightning_UpdateChannelPolicy_Handler,
@@ -11393,6 +13735,10 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ForwardingHistory",
 			Handler:    _Lightning_ForwardingHistory_Handler,
 		},
+		{
+			MethodName: "HtlcQuotas",
+			Handler:    _Lightning_HtlcQuotas_Handler,
+		},
 		{
 			MethodName: "ExportChannelBackup",
 			Handler:    _Lightning_ExportChannelBackup_Handler,
@@ -11409,6 +13755,22 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RestoreChannelBackups",
 			Handler:    _Lightning_RestoreChannelBackups_Handler,
 		},
+		{
+			MethodName: "BakeMacaroon",
+			Handler:    _Lightning_BakeMacaroon_Handler,
+		},
+		{
+			MethodName: "ListMacaroonIDs",
+			Handler:    _Lightning_ListMacaroonIDs_Handler,
+		},
+		{
+			MethodName: "DeleteMacaroonID",
+			Handler:    _Lightning_DeleteMacaroonID_Handler,
+		},
+		{
+			MethodName: "AuditLog",
+			Handler:    _Lightning_AuditLog_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -11416,6 +13778,11 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Lightning_SubscribeTransactions_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeCustomMessages",
+			Handler:       _Lightning_SubscribeCustomMessages_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "SubscribeChannelEvents",
 			Handler:       _Lightning_SubscribeChannelEvents_Handler,
@@ -11448,6 +13815,16 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Lightning_SubscribeInvoices_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ExportGraphSnapshot",
+			Handler:       _Lightning_ExportGraphSnapshot_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportGraphSnapshot",
+			Handler:       _Lightning_ImportGraphSnapshot_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "SubscribeChannelGraph",
 			Handler:       _Lightning_SubscribeChannelGraph_Handler,
@@ -11462,492 +13839,583 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 	Metadata: "rpc.proto",
 }
 
-func init() { proto.RegisterFile("rpc.proto", fileDescriptor_rpc_85b0b58f0d9865bd) }
-
-var fileDescriptor_rpc_85b0b58f0d9865bd = []byte{
-	// 7735 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7c, 0x5b, 0x6c, 0x24, 0xd9,
-	0x59, 0xb0, 0xab, 0x2f, 0x76, 0xf7, 0xd7, 0x6d, 0xbb, 0x7d, 0x7c, 0xeb, 0xe9, 0x99, 0x9d, 0xf5,
-	0x56, 0x26, 0x33, 0x8e, 0x77, 0xff, 0xf1, 0xec, 0x24, 0xd9, 0x4c, 0x76, 0xfe, 0xfc, 0xf9, 0x7d,
-	0x9b, 0xf1, 0x64, 0xbd, 0x1e, 0xa7, 0x3c, 0x93, 0xf9, 0x77, 0x93, 0x5f, 0x9d, 0x72, 0xf7, 0x71,
-	0xbb, 0x76, 0xba, 0xab, 0x7a, 0xab, 0xaa, 0xed, 0x71, 0x96, 0x41, 0x08, 0x21, 0x40, 0x08, 0x84,
-	0x02, 0x02, 0x11, 0x04, 0x42, 0x4a, 0x90, 0x20, 0xe2, 0x89, 0x87, 0x20, 0x24, 0x08, 0xaf, 0x48,
-	0x91, 0x10, 0x42, 0x79, 0x44, 0x02, 0x21, 0x78, 0x41, 0x3c, 0x20, 0x90, 0x78, 0x44, 0x42, 0xe7,
-	0x3b, 0x97, 0x3a, 0xa7, 0xaa, 0x7a, 0x3c, 0x9b, 0x04, 0x9e, 0xba, 0xcf, 0x77, 0xbe, 0x3a, 0xd7,
-	0xef, 0x7e, 0xbe, 0x73, 0xa0, 0x1a, 0x0e, 0x3b, 0x37, 0x87, 0x61, 0x10, 0x07, 0xa4, 0xdc, 0xf7,
-	0xc3, 0x61, 0xa7, 0x75, 0xa5, 0x17, 0x04, 0xbd, 0x3e, 0x5d, 0x77, 0x87, 0xde, 0xba, 0xeb, 0xfb,
-	0x41, 0xec, 0xc6, 0x5e, 0xe0, 0x47, 0x1c, 0xc9, 0xfe, 0x3a, 0xcc, 0xdc, 0xa7, 0xfe, 0x21, 0xa5,
-	0x5d, 0x87, 0x7e, 0x38, 0xa2, 0x51, 0x4c, 0x5e, 0x87, 0x39, 0x97, 0x7e, 0x83, 0xd2, 0x6e, 0x7b,
-	0xe8, 0x46, 0xd1, 0xf0, 0x24, 0x74, 0x23, 0xda, 0xb4, 0x56, 0xac, 0xd5, 0xba, 0xd3, 0xe0, 0x15,
-	0x07, 0x0a, 0x4e, 0x5e, 0x83, 0x7a, 0xc4, 0x50, 0xa9, 0x1f, 0x87, 0xc1, 0xf0, 0xbc, 0x59, 0x40,
-	0xbc, 0x1a, 0x83, 0xed, 0x70, 0x90, 0xdd, 0x87, 0x59, 0xd5, 0x43, 0x34, 0x0c, 0xfc, 0x88, 0x92,
-	0x5b, 0xb0, 0xd0, 0xf1, 0x86, 0x27, 0x34, 0x6c, 0xe3, 0xc7, 0x03, 0x9f, 0x0e, 0x02, 0xdf, 0xeb,
-	0x34, 0xad, 0x95, 0xe2, 0x6a, 0xd5, 0x21, 0xbc, 0x8e, 0x7d, 0xf1, 0xae, 0xa8, 0x21, 0x37, 0x60,
-	0x96, 0xfa, 0x1c, 0x4e, 0xbb, 0xf8, 0x95, 0xe8, 0x6a, 0x26, 0x01, 0xb3, 0x0f, 0xec, 0x5f, 0x2c,
-	0xc0, 0xdc, 0x03, 0xdf, 0x8b, 0x9f, 0xb8, 0xfd, 0x3e, 0x8d, 0xe5, 0x9c, 0x6e, 0xc0, 0xec, 0x19,
-	0x02, 0x70, 0x4e, 0x67, 0x41, 0xd8, 0x15, 0x33, 0x9a, 0xe1, 0xe0, 0x03, 0x01, 0x1d, 0x3b, 0xb2,
-	0xc2, 0xd8, 0x91, 0xe5, 0x2e, 0x57, 0x71, 0xcc, 0x72, 0xdd, 0x80, 0xd9, 0x90, 0x76, 0x82, 0x53,
-	0x1a, 0x9e, 0xb7, 0xcf, 0x3c, 0xbf, 0x1b, 0x9c, 0x35, 0x4b, 0x2b, 0xd6, 0x6a, 0xd9, 0x99, 0x91,
-	0xe0, 0x27, 0x08, 0x25, 0x9b, 0x30, 0xdb, 0x39, 0x71, 0x7d, 0x9f, 0xf6, 0xdb, 0x47, 0x6e, 0xe7,
-	0xe9, 0x68, 0x18, 0x35, 0xcb, 0x2b, 0xd6, 0x6a, 0xed, 0xf6, 0xa5, 0x9b, 0xb8, 0xab, 0x37, 0xb7,
-	0x4e, 0x5c, 0x7f, 0x13, 0x6b, 0x0e, 0x7d, 0x77, 0x18, 0x9d, 0x04, 0xb1, 0x33, 0x23, 0xbe, 0xe0,
-	0xe0, 0xc8, 0x5e, 0x00, 0xa2, 0xaf, 0x04, 0x5f, 0x7b, 0xfb, 0x8f, 0x2c, 0x98, 0x7f, 0xec, 0xf7,
-	0x83, 0xce, 0xd3, 0x1f, 0x71, 0x89, 0x72, 0xe6, 0x50, 0x78, 0xd9, 0x39, 0x14, 0x3f, 0xee, 0x1c,
-	0x96, 0x60, 0xc1, 0x1c, 0xac, 0x98, 0x05, 0x85, 0x45, 0xf6, 0x75, 0x8f, 0xca, 0x61, 0xc9, 0x69,
-	0x7c, 0x0a, 0x1a, 0x9d, 0x51, 0x18, 0x52, 0x3f, 0x33, 0x8f, 0x59, 0x01, 0x57, 0x13, 0x79, 0x0d,
-	0xea, 0x3e, 0x3d, 0x4b, 0xd0, 0x04, 0xed, 0xfa, 0xf4, 0x4c, 0xa2, 0xd8, 0x4d, 0x58, 0x4a, 0x77,
-	0x23, 0x06, 0xf0, 0x0f, 0x16, 0x94, 0x1e, 0xc7, 0xcf, 0x02, 0x72, 0x13, 0x4a, 0xf1, 0xf9, 0x90,
-	0x73, 0xc8, 0xcc, 0x6d, 0x22, 0xa6, 0xb6, 0xd1, 0xed, 0x86, 0x34, 0x8a, 0x1e, 0x9d, 0x0f, 0xa9,
-	0x53, 0x77, 0x79, 0xa1, 0xcd, 0xf0, 0x48, 0x13, 0xa6, 0x44, 0x19, 0x3b, 0xac, 0x3a, 0xb2, 0x48,
-	0xae, 0x02, 0xb8, 0x83, 0x60, 0xe4, 0xc7, 0xed, 0xc8, 0x8d, 0x71, 0xa9, 0x8a, 0x8e, 0x06, 0x21,
-	0x57, 0xa0, 0x3a, 0x7c, 0xda, 0x8e, 0x3a, 0xa1, 0x37, 0x8c, 0x91, 0x6c, 0xaa, 0x4e, 0x02, 0x20,
-	0xaf, 0x43, 0x25, 0x18, 0xc5, 0xc3, 0xc0, 0xf3, 0x63, 0x41, 0x2a, 0xb3, 0x62, 0x2c, 0x0f, 0x47,
-	0xf1, 0x01, 0x03, 0x3b, 0x0a, 0x81, 0x5c, 0x83, 0xe9, 0x4e, 0xe0, 0x1f, 0x7b, 0xe1, 0x80, 0x0b,
-	0x83, 0xe6, 0x24, 0xf6, 0x66, 0x02, 0xed, 0x6f, 0x15, 0xa0, 0xf6, 0x28, 0x74, 0xfd, 0xc8, 0xed,
-	0x30, 0x00, 0x1b, 0x7a, 0xfc, 0xac, 0x7d, 0xe2, 0x46, 0x27, 0x38, 0xdb, 0xaa, 0x23, 0x8b, 0x64,
-	0x09, 0x26, 0xf9, 0x40, 0x71, 0x4e, 0x45, 0x47, 0x94, 0xc8, 0x1b, 0x30, 0xe7, 0x8f, 0x06, 0x6d,
-	0xb3, 0xaf, 0x22, 0x52, 0x4b, 0xb6, 0x82, 0x2d, 0xc0, 0x11, 0xdb, 0x6b, 0xde, 0x05, 0x9f, 0xa1,
-	0x06, 0x21, 0x36, 0xd4, 0x45, 0x89, 0x7a, 0xbd, 0x13, 0x3e, 0xcd, 0xb2, 0x63, 0xc0, 0x58, 0x1b,
-	0xb1, 0x37, 0xa0, 0xed, 0x28, 0x76, 0x07, 0x43, 0x31, 0x2d, 0x0d, 0x82, 0xf5, 0x41, 0xec, 0xf6,
-	0xdb, 0xc7, 0x94, 0x46, 0xcd, 0x29, 0x51, 0xaf, 0x20, 0xe4, 0x3a, 0xcc, 0x74, 0x69, 0x14, 0xb7,
-	0xc5, 0xa6, 0xd0, 0xa8, 0x59, 0x41, 0xd6, 0x4f, 0x41, 0x19, 0x65, 0xdc, 0xa7, 0xb1, 0xb6, 0x3a,
-	0x91, 0xa0, 0x40, 0x7b, 0x0f, 0x88, 0x06, 0xde, 0xa6, 0xb1, 0xeb, 0xf5, 0x23, 0xf2, 0x16, 0xd4,
-	0x63, 0x0d, 0x19, 0x45, 0x5d, 0x4d, 0x91, 0x8b, 0xf6, 0x81, 0x63, 0xe0, 0xd9, 0xf7, 0xa1, 0x72,
-	0x8f, 0xd2, 0x3d, 0x6f, 0xe0, 0xc5, 0x64, 0x09, 0xca, 0xc7, 0xde, 0x33, 0xca, 0x09, 0xba, 0xb8,
-	0x3b, 0xe1, 0xf0, 0x22, 0x69, 0xc1, 0xd4, 0x90, 0x86, 0x1d, 0x2a, 0x97, 0x7f, 0x77, 0xc2, 0x91,
-	0x80, 0xcd, 0x29, 0x28, 0xf7, 0xd9, 0xc7, 0xf6, 0xbf, 0x15, 0xa0, 0x76, 0x48, 0x7d, 0xc5, 0x28,
-	0x04, 0x4a, 0x6c, 0x4a, 0x82, 0x39, 0xf0, 0x3f, 0x79, 0x15, 0x6a, 0x38, 0xcd, 0x28, 0x0e, 0x3d,
-	0xbf, 0x27, 0xe8, 0x13, 0x18, 0xe8, 0x10, 0x21, 0xa4, 0x01, 0x45, 0x77, 0x20, 0x69, 0x93, 0xfd,
-	0x65, 0x4c, 0x34, 0x74, 0xcf, 0x07, 0x8c, 0xdf, 0xd4, 0xae, 0xd5, 0x9d, 0x9a, 0x80, 0xed, 0xb2,
-	0x6d, 0xbb, 0x09, 0xf3, 0x3a, 0x8a, 0x6c, 0xbd, 0x8c, 0xad, 0xcf, 0x69, 0x98, 0xa2, 0x93, 0x1b,
-	0x30, 0x2b, 0xf1, 0x43, 0x3e, 0x58, 0xdc, 0xc7, 0xaa, 0x33, 0x23, 0xc0, 0x72, 0x0a, 0xab, 0xd0,
-	0x38, 0xf6, 0x7c, 0xb7, 0xdf, 0xee, 0xf4, 0xe3, 0xd3, 0x76, 0x97, 0xf6, 0x63, 0x17, 0x77, 0xb4,
-	0xec, 0xcc, 0x20, 0x7c, 0xab, 0x1f, 0x9f, 0x6e, 0x33, 0x28, 0x79, 0x03, 0xaa, 0xc7, 0x94, 0xb6,
-	0x71, 0x25, 0x9a, 0x15, 0x83, 0x3b, 0xe4, 0xea, 0x3a, 0x95, 0x63, 0xb9, 0xce, 0xab, 0xd0, 0x08,
-	0x46, 0x71, 0x2f, 0xf0, 0xfc, 0x5e, 0x9b, 0xc9, 0xa3, 0xb6, 0xd7, 0x6d, 0x56, 0x57, 0xac, 0xd5,
-	0x92, 0x33, 0x23, 0xe1, 0x4c, 0x2a, 0x3c, 0xe8, 0x92, 0x57, 0x00, 0xb0, 0x6f, 0xde, 0x30, 0xac,
-	0x58, 0xab, 0xd3, 0x4e, 0x95, 0x41, 0xb0, 0x21, 0xfb, 0x4f, 0x2d, 0xa8, 0xf3, 0x35, 0x17, 0x8a,
-	0xef, 0x1a, 0x4c, 0xcb, 0xa9, 0xd1, 0x30, 0x0c, 0x42, 0xc1, 0x47, 0x26, 0x90, 0xac, 0x41, 0x43,
-	0x02, 0x86, 0x21, 0xf5, 0x06, 0x6e, 0x8f, 0x0a, 0xe1, 0x94, 0x81, 0x93, 0xdb, 0x49, 0x8b, 0x61,
-	0x30, 0x8a, 0xa9, 0x10, 0xb1, 0x75, 0x31, 0x3b, 0x87, 0xc1, 0x1c, 0x13, 0x85, 0xf1, 0x51, 0xce,
-	0x9e, 0x19, 0x30, 0xfb, 0x7b, 0x16, 0x10, 0x36, 0xf4, 0x47, 0x01, 0x6f, 0x42, 0x2c, 0x79, 0x7a,
-	0xbb, 0xad, 0x97, 0xde, 0xee, 0xc2, 0xb8, 0xed, 0x5e, 0x85, 0x49, 0x1c, 0x16, 0x13, 0x0c, 0xc5,
-	0xf4, 0xd0, 0x37, 0x0b, 0x4d, 0xcb, 0x11, 0xf5, 0xc4, 0x86, 0x32, 0x9f, 0x63, 0x29, 0x67, 0x8e,
-	0xbc, 0xca, 0xfe, 0xb6, 0x05, 0xf5, 0x2d, 0xae, 0x43, 0x50, 0xe8, 0x91, 0x5b, 0x40, 0x8e, 0x47,
-	0x7e, 0x97, 0xed, 0x65, 0xfc, 0xcc, 0xeb, 0xb6, 0x8f, 0xce, 0x59, 0x57, 0x38, 0xee, 0xdd, 0x09,
-	0x27, 0xa7, 0x8e, 0xbc, 0x01, 0x0d, 0x03, 0x1a, 0xc5, 0x21, 0x1f, 0xfd, 0xee, 0x84, 0x93, 0xa9,
-	0x61, 0x8b, 0xc9, 0xc4, 0xea, 0x28, 0x6e, 0x7b, 0x7e, 0x97, 0x3e, 0xc3, 0xf5, 0x9f, 0x76, 0x0c,
-	0xd8, 0xe6, 0x0c, 0xd4, 0xf5, 0xef, 0xec, 0x0f, 0xa0, 0x22, 0x85, 0x32, 0x0a, 0xa4, 0xd4, 0xb8,
-	0x1c, 0x0d, 0x42, 0x5a, 0x50, 0x31, 0x47, 0xe1, 0x54, 0x3e, 0x4e, 0xdf, 0xf6, 0xff, 0x81, 0xc6,
-	0x1e, 0x93, 0x8c, 0xbe, 0xe7, 0xf7, 0x84, 0x56, 0x62, 0xe2, 0x7a, 0x38, 0x3a, 0x7a, 0x4a, 0xcf,
-	0x05, 0xfd, 0x89, 0x12, 0x93, 0x09, 0x27, 0x41, 0x14, 0x8b, 0x7e, 0xf0, 0xbf, 0xfd, 0x97, 0x16,
-	0x90, 0x9d, 0x28, 0xf6, 0x06, 0x6e, 0x4c, 0xef, 0x51, 0x45, 0x08, 0x0f, 0xa1, 0xce, 0x5a, 0x7b,
-	0x14, 0x6c, 0x70, 0xb9, 0xcf, 0xe5, 0xd9, 0xeb, 0x62, 0x4b, 0xb2, 0x1f, 0xdc, 0xd4, 0xb1, 0x99,
-	0x69, 0x78, 0xee, 0x18, 0x0d, 0x30, 0xd9, 0x13, 0xbb, 0x61, 0x8f, 0xc6, 0xa8, 0x14, 0x84, 0x49,
-	0x01, 0x1c, 0xb4, 0x15, 0xf8, 0xc7, 0xad, 0x2f, 0xc2, 0x5c, 0xa6, 0x0d, 0x26, 0x90, 0x92, 0x69,
-	0xb0, 0xbf, 0x64, 0x01, 0xca, 0xa7, 0x6e, 0x7f, 0x44, 0x85, 0x26, 0xe2, 0x85, 0xb7, 0x0b, 0x77,
-	0x2c, 0xbb, 0x03, 0xf3, 0xc6, 0xb8, 0x04, 0x4f, 0x36, 0x61, 0x8a, 0xc9, 0x06, 0xa6, 0x73, 0x51,
-	0xae, 0x3a, 0xb2, 0x48, 0x6e, 0xc3, 0xc2, 0x31, 0xa5, 0xa1, 0x1b, 0x63, 0xb1, 0x3d, 0xa4, 0x21,
-	0xee, 0x89, 0x68, 0x39, 0xb7, 0xce, 0xfe, 0x47, 0x0b, 0x66, 0x19, 0xdf, 0xbc, 0xeb, 0xfa, 0xe7,
-	0x72, 0xad, 0xf6, 0x72, 0xd7, 0x6a, 0x55, 0xac, 0x55, 0x0a, 0xfb, 0xe3, 0x2e, 0x54, 0x31, 0xbd,
-	0x50, 0x64, 0x05, 0xea, 0xc6, 0x70, 0xcb, 0x5c, 0xc9, 0x45, 0x6e, 0x7c, 0x40, 0xc3, 0xcd, 0xf3,
-	0x98, 0xfe, 0xf8, 0x4b, 0x79, 0x1d, 0x1a, 0xc9, 0xb0, 0xc5, 0x3a, 0x12, 0x28, 0x31, 0xc2, 0x14,
-	0x0d, 0xe0, 0x7f, 0xfb, 0x77, 0x2c, 0x8e, 0xb8, 0x15, 0x78, 0x4a, 0x41, 0x32, 0x44, 0xa6, 0x47,
-	0x25, 0x22, 0xfb, 0x3f, 0xd6, 0x80, 0xf8, 0xf1, 0x27, 0x4b, 0x2e, 0x41, 0x25, 0xa2, 0x7e, 0xb7,
-	0xed, 0xf6, 0xfb, 0xa8, 0x47, 0x2a, 0xce, 0x14, 0x2b, 0x6f, 0xf4, 0xfb, 0xf6, 0x0d, 0x98, 0xd3,
-	0x46, 0xf7, 0x82, 0x79, 0xec, 0x03, 0xd9, 0xf3, 0xa2, 0xf8, 0xb1, 0x1f, 0x0d, 0x35, 0xfd, 0x73,
-	0x19, 0xaa, 0x03, 0xcf, 0xc7, 0x91, 0x71, 0xce, 0x2d, 0x3b, 0x95, 0x81, 0xe7, 0xb3, 0x71, 0x45,
-	0x58, 0xe9, 0x3e, 0x13, 0x95, 0x05, 0x51, 0xe9, 0x3e, 0xc3, 0x4a, 0xfb, 0x0e, 0xcc, 0x1b, 0xed,
-	0x89, 0xae, 0x5f, 0x83, 0xf2, 0x28, 0x7e, 0x16, 0x48, 0xeb, 0xa0, 0x26, 0x28, 0x84, 0xd9, 0x99,
-	0x0e, 0xaf, 0xb1, 0xef, 0xc2, 0xdc, 0x3e, 0x3d, 0x13, 0x8c, 0x2c, 0x07, 0x72, 0xfd, 0x42, 0x1b,
-	0x14, 0xeb, 0xed, 0x9b, 0x40, 0xf4, 0x8f, 0x13, 0x06, 0x90, 0x16, 0xa9, 0x65, 0x58, 0xa4, 0xf6,
-	0x75, 0x20, 0x87, 0x5e, 0xcf, 0x7f, 0x97, 0x46, 0x91, 0xdb, 0x53, 0xac, 0xdf, 0x80, 0xe2, 0x20,
-	0xea, 0x09, 0x51, 0xc5, 0xfe, 0xda, 0x9f, 0x86, 0x79, 0x03, 0x4f, 0x34, 0x7c, 0x05, 0xaa, 0x91,
-	0xd7, 0xf3, 0xdd, 0x78, 0x14, 0x52, 0xd1, 0x74, 0x02, 0xb0, 0xef, 0xc1, 0xc2, 0x57, 0x68, 0xe8,
-	0x1d, 0x9f, 0x5f, 0xd4, 0xbc, 0xd9, 0x4e, 0x21, 0xdd, 0xce, 0x0e, 0x2c, 0xa6, 0xda, 0x11, 0xdd,
-	0x73, 0xf2, 0x15, 0x3b, 0x59, 0x71, 0x78, 0x41, 0x93, 0x7d, 0x05, 0x5d, 0xf6, 0xd9, 0x8f, 0x81,
-	0x6c, 0x05, 0xbe, 0x4f, 0x3b, 0xf1, 0x01, 0xa5, 0x61, 0xe2, 0x0c, 0x27, 0xb4, 0x5a, 0xbb, 0xbd,
-	0x2c, 0x56, 0x36, 0x2d, 0x50, 0x05, 0x11, 0x13, 0x28, 0x0d, 0x69, 0x38, 0xc0, 0x86, 0x2b, 0x0e,
-	0xfe, 0xb7, 0x17, 0x61, 0xde, 0x68, 0x56, 0xb8, 0x0f, 0x6f, 0xc2, 0xe2, 0xb6, 0x17, 0x75, 0xb2,
-	0x1d, 0x36, 0x61, 0x6a, 0x38, 0x3a, 0x6a, 0x27, 0x9c, 0x28, 0x8b, 0xcc, 0xe2, 0x4c, 0x7f, 0x22,
-	0x1a, 0xfb, 0x79, 0x0b, 0x4a, 0xbb, 0x8f, 0xf6, 0xb6, 0x98, 0xae, 0xf0, 0xfc, 0x4e, 0x30, 0x60,
-	0xfa, 0x96, 0x4f, 0x5a, 0x95, 0xc7, 0x72, 0xd8, 0x15, 0xa8, 0xa2, 0x9a, 0x66, 0x46, 0xb4, 0xf0,
-	0x5b, 0x13, 0x00, 0x33, 0xe0, 0xe9, 0xb3, 0xa1, 0x17, 0xa2, 0x85, 0x2e, 0xed, 0xee, 0x12, 0xaa,
-	0x99, 0x6c, 0x85, 0xfd, 0x83, 0x32, 0x4c, 0x09, 0xe5, 0x8b, 0xfd, 0x75, 0x62, 0xef, 0x94, 0x8a,
-	0x91, 0x88, 0x12, 0x33, 0x81, 0x42, 0x3a, 0x08, 0x62, 0xda, 0x36, 0xb6, 0xc1, 0x04, 0xa2, 0x83,
-	0x22, 0x7c, 0x47, 0xee, 0xd2, 0x14, 0x39, 0x96, 0x01, 0x64, 0x8b, 0x25, 0xed, 0xb3, 0x12, 0xda,
-	0x67, 0xb2, 0xc8, 0x56, 0xa2, 0xe3, 0x0e, 0xdd, 0x8e, 0x17, 0x9f, 0x0b, 0x91, 0xa0, 0xca, 0xac,
-	0xed, 0x7e, 0xd0, 0x71, 0x99, 0x57, 0xda, 0x77, 0xfd, 0x0e, 0x95, 0xce, 0x8f, 0x01, 0x64, 0x8e,
-	0x80, 0x18, 0x92, 0x44, 0xe3, 0xce, 0x42, 0x0a, 0xca, 0xf4, 0x77, 0x27, 0x18, 0x0c, 0xbc, 0x98,
-	0xf9, 0x0f, 0x68, 0x5b, 0x16, 0x1d, 0x0d, 0xc2, 0x5d, 0x2d, 0x2c, 0x9d, 0xf1, 0xd5, 0xab, 0x4a,
-	0x57, 0x4b, 0x03, 0xb2, 0x56, 0x98, 0xd6, 0x61, 0x62, 0xec, 0xe9, 0x19, 0x1a, 0x92, 0x45, 0x47,
-	0x83, 0xb0, 0x7d, 0x18, 0xf9, 0x11, 0x8d, 0xe3, 0x3e, 0xed, 0xaa, 0x01, 0xd5, 0x10, 0x2d, 0x5b,
-	0x41, 0x6e, 0xc1, 0x3c, 0x77, 0x69, 0x22, 0x37, 0x0e, 0xa2, 0x13, 0x2f, 0x6a, 0x47, 0xcc, 0x39,
-	0xa8, 0x23, 0x7e, 0x5e, 0x15, 0xb9, 0x03, 0xcb, 0x29, 0x70, 0x48, 0x3b, 0xd4, 0x3b, 0xa5, 0xdd,
-	0xe6, 0x34, 0x7e, 0x35, 0xae, 0x9a, 0xac, 0x40, 0x8d, 0x79, 0x72, 0xa3, 0x61, 0xd7, 0x65, 0x06,
-	0xcc, 0x0c, 0xee, 0x83, 0x0e, 0x22, 0x6f, 0xc2, 0xf4, 0x90, 0x72, 0xeb, 0xe7, 0x24, 0xee, 0x77,
-	0xa2, 0xe6, 0xac, 0x21, 0xdd, 0x18, 0xe5, 0x3a, 0x26, 0x06, 0x23, 0xca, 0x4e, 0x84, 0x26, 0xbd,
-	0x7b, 0xde, 0x6c, 0x08, 0xb3, 0x5a, 0x02, 0x90, 0x47, 0x42, 0xef, 0xd4, 0x8d, 0x69, 0x73, 0x8e,
-	0x0b, 0x74, 0x51, 0x64, 0xdf, 0x79, 0xbe, 0x17, 0x7b, 0x6e, 0x1c, 0x84, 0x4d, 0x82, 0x75, 0x09,
-	0x80, 0x2d, 0x22, 0xd2, 0x47, 0x14, 0xbb, 0xf1, 0x28, 0x6a, 0x1f, 0xf7, 0xdd, 0x5e, 0xd4, 0x9c,
-	0xe7, 0x76, 0x69, 0xa6, 0xc2, 0xfe, 0x3d, 0x8b, 0x0b, 0x69, 0x41, 0xd0, 0x4a, 0xd8, 0xbe, 0x0a,
-	0x35, 0x4e, 0xca, 0xed, 0xc0, 0xef, 0x9f, 0x0b, 0xea, 0x06, 0x0e, 0x7a, 0xe8, 0xf7, 0xcf, 0xc9,
-	0x27, 0x60, 0xda, 0xf3, 0x75, 0x14, 0x2e, 0x0f, 0xea, 0x12, 0x88, 0x48, 0xaf, 0x42, 0x6d, 0x38,
-	0x3a, 0xea, 0x7b, 0x1d, 0x8e, 0x52, 0xe4, 0xad, 0x70, 0x10, 0x22, 0x30, 0x4b, 0x9b, 0xcf, 0x8a,
-	0x63, 0x94, 0x10, 0xa3, 0x26, 0x60, 0x0c, 0xc5, 0xde, 0x84, 0x05, 0x73, 0x80, 0x42, 0xf0, 0xad,
-	0x41, 0x45, 0xf0, 0x49, 0xd4, 0xac, 0xe1, 0x5a, 0xcf, 0x68, 0x11, 0x17, 0x9f, 0xf6, 0x1d, 0x55,
-	0x6f, 0xff, 0x49, 0x09, 0xe6, 0x05, 0x74, 0xab, 0x1f, 0x44, 0xf4, 0x70, 0x34, 0x18, 0xb8, 0x61,
-	0x0e, 0x03, 0x5a, 0x17, 0x30, 0x60, 0xc1, 0x64, 0x40, 0xc6, 0x16, 0x27, 0xae, 0xe7, 0x73, 0x37,
-	0x81, 0x73, 0xaf, 0x06, 0x21, 0xab, 0x30, 0xdb, 0xe9, 0x07, 0x11, 0x37, 0x89, 0x75, 0x87, 0x3f,
-	0x0d, 0xce, 0x0a, 0x8c, 0x72, 0x9e, 0xc0, 0xd0, 0x19, 0x7e, 0x32, 0xc5, 0xf0, 0x36, 0xd4, 0x59,
-	0xa3, 0x54, 0xca, 0xaf, 0x29, 0x6e, 0x26, 0xeb, 0x30, 0x36, 0x9e, 0x34, 0x7b, 0x71, 0x5e, 0x9e,
-	0xcd, 0x63, 0x2e, 0x6f, 0x40, 0x51, 0x3e, 0x6a, 0xd8, 0x55, 0xc1, 0x5c, 0xd9, 0x2a, 0x72, 0x8f,
-	0x79, 0x89, 0xac, 0x2f, 0x54, 0xd2, 0x80, 0x4a, 0xfa, 0xba, 0xb9, 0x23, 0xfa, 0xda, 0xdf, 0x64,
-	0x85, 0x51, 0x48, 0x51, 0x71, 0x6b, 0x5f, 0xda, 0xbf, 0x64, 0x41, 0x4d, 0xab, 0x23, 0x8b, 0x30,
-	0xb7, 0xf5, 0xf0, 0xe1, 0xc1, 0x8e, 0xb3, 0xf1, 0xe8, 0xc1, 0x57, 0x76, 0xda, 0x5b, 0x7b, 0x0f,
-	0x0f, 0x77, 0x1a, 0x13, 0x0c, 0xbc, 0xf7, 0x70, 0x6b, 0x63, 0xaf, 0x7d, 0xef, 0xa1, 0xb3, 0x25,
-	0xc1, 0x16, 0x59, 0x02, 0xe2, 0xec, 0xbc, 0xfb, 0xf0, 0xd1, 0x8e, 0x01, 0x2f, 0x90, 0x06, 0xd4,
-	0x37, 0x9d, 0x9d, 0x8d, 0xad, 0x5d, 0x01, 0x29, 0x92, 0x05, 0x68, 0xdc, 0x7b, 0xbc, 0xbf, 0xfd,
-	0x60, 0xff, 0x7e, 0x7b, 0x6b, 0x63, 0x7f, 0x6b, 0x67, 0x6f, 0x67, 0xbb, 0x51, 0x22, 0xd3, 0x50,
-	0xdd, 0xd8, 0xdc, 0xd8, 0xdf, 0x7e, 0xb8, 0xbf, 0xb3, 0xdd, 0x28, 0xdb, 0x7f, 0x67, 0xc1, 0x22,
-	0x8e, 0xba, 0x9b, 0x66, 0x90, 0x15, 0xa8, 0x75, 0x82, 0x60, 0xc8, 0x8c, 0xe3, 0x44, 0xfc, 0xeb,
-	0x20, 0x46, 0xfc, 0x5c, 0xd8, 0x1e, 0x07, 0x61, 0x87, 0x0a, 0xfe, 0x00, 0x04, 0xdd, 0x63, 0x10,
-	0x46, 0xfc, 0x62, 0x7b, 0x39, 0x06, 0x67, 0x8f, 0x1a, 0x87, 0x71, 0x94, 0x25, 0x98, 0x3c, 0x0a,
-	0xa9, 0xdb, 0x39, 0x11, 0x9c, 0x21, 0x4a, 0xe4, 0x53, 0x89, 0xf7, 0xd6, 0x61, 0xab, 0xdf, 0xa7,
-	0x5d, 0xa4, 0x98, 0x8a, 0x33, 0x2b, 0xe0, 0x5b, 0x02, 0xcc, 0xa4, 0x85, 0x7b, 0xe4, 0xfa, 0xdd,
-	0xc0, 0xa7, 0x5d, 0x61, 0x1a, 0x26, 0x00, 0xfb, 0x00, 0x96, 0xd2, 0xf3, 0x13, 0xfc, 0xf5, 0x96,
-	0xc6, 0x5f, 0xdc, 0x52, 0x6b, 0x8d, 0xdf, 0x4d, 0x8d, 0xd7, 0xfe, 0xbe, 0x00, 0x25, 0xa6, 0xb8,
-	0xc7, 0x2b, 0x79, 0xdd, 0x16, 0x2b, 0x66, 0xa2, 0x83, 0xe8, 0x10, 0x72, 0x51, 0xce, 0xd5, 0x9d,
-	0x06, 0x49, 0xea, 0x43, 0xda, 0x39, 0xc5, 0x19, 0xab, 0x7a, 0x06, 0x61, 0x0c, 0xc2, 0x0c, 0x65,
-	0xfc, 0x5a, 0x30, 0x88, 0x2c, 0xcb, 0x3a, 0xfc, 0x72, 0x2a, 0xa9, 0xc3, 0xef, 0x9a, 0x30, 0xe5,
-	0xf9, 0x47, 0xc1, 0xc8, 0xef, 0x22, 0x43, 0x54, 0x1c, 0x59, 0xc4, 0x78, 0x24, 0x32, 0xaa, 0x37,
-	0x90, 0xe4, 0x9f, 0x00, 0xc8, 0x6d, 0xa8, 0x46, 0xe7, 0x7e, 0x47, 0xa7, 0xf9, 0x05, 0xb1, 0x4a,
-	0x6c, 0x0d, 0x6e, 0x1e, 0x9e, 0xfb, 0x1d, 0xa4, 0xf0, 0x04, 0xcd, 0xfe, 0x22, 0x54, 0x24, 0x98,
-	0x91, 0xe5, 0xe3, 0xfd, 0x77, 0xf6, 0x1f, 0x3e, 0xd9, 0x6f, 0x1f, 0xbe, 0xb7, 0xbf, 0xd5, 0x98,
-	0x20, 0xb3, 0x50, 0xdb, 0xd8, 0x42, 0x4a, 0x47, 0x80, 0xc5, 0x50, 0x0e, 0x36, 0x0e, 0x0f, 0x15,
-	0xa4, 0x60, 0x13, 0xe6, 0xec, 0x46, 0x68, 0x1d, 0xa9, 0x78, 0xdc, 0x5b, 0x30, 0xa7, 0xc1, 0x12,
-	0x4b, 0x7b, 0xc8, 0x00, 0x29, 0x4b, 0x1b, 0xcd, 0x2a, 0x5e, 0x63, 0x37, 0x60, 0xe6, 0x3e, 0x8d,
-	0x1f, 0xf8, 0xc7, 0x81, 0x6c, 0xe9, 0x0f, 0x4a, 0x30, 0xab, 0x40, 0xa2, 0xa1, 0x55, 0x98, 0xf5,
-	0xba, 0xd4, 0x8f, 0xbd, 0xf8, 0xbc, 0x6d, 0xf8, 0xd4, 0x69, 0x30, 0x33, 0x47, 0xdd, 0xbe, 0xe7,
-	0xca, 0xb0, 0x2f, 0x2f, 0x30, 0x1f, 0x93, 0xe9, 0x4a, 0xa9, 0xfe, 0x14, 0x5d, 0x71, 0x57, 0x3e,
-	0xb7, 0x8e, 0x49, 0x20, 0x06, 0x17, 0x2a, 0x46, 0x7d, 0xc2, 0xcd, 0xb2, 0xbc, 0x2a, 0xb6, 0x55,
-	0xbc, 0x25, 0x36, 0xe5, 0x32, 0xd7, 0xa7, 0x0a, 0x90, 0x89, 0xab, 0x4e, 0x72, 0xf9, 0x98, 0x8e,
-	0xab, 0x6a, 0xb1, 0xd9, 0x4a, 0x26, 0x36, 0xcb, 0xe4, 0xe7, 0xb9, 0xdf, 0xa1, 0xdd, 0x76, 0x1c,
-	0xb4, 0x51, 0xce, 0x23, 0x49, 0x54, 0x9c, 0x34, 0x98, 0x5c, 0x81, 0xa9, 0x98, 0x46, 0xb1, 0x4f,
-	0x79, 0xc0, 0xac, 0x82, 0x21, 0x1e, 0x09, 0x62, 0x36, 0xf4, 0x28, 0xf4, 0xa2, 0x66, 0x1d, 0xa3,
-	0xae, 0xf8, 0x9f, 0x7c, 0x06, 0x16, 0x8f, 0x68, 0x14, 0xb7, 0x4f, 0xa8, 0xdb, 0xa5, 0x21, 0x92,
-	0x17, 0x0f, 0xef, 0x72, 0xd3, 0x24, 0xbf, 0x92, 0x11, 0xee, 0x29, 0x0d, 0x23, 0x2f, 0xf0, 0xd1,
-	0x28, 0xa9, 0x3a, 0xb2, 0xc8, 0xda, 0x63, 0x93, 0x57, 0x4a, 0x5a, 0xad, 0xe0, 0x2c, 0x4e, 0x3c,
-	0xbf, 0x92, 0x5c, 0x83, 0x49, 0x9c, 0x40, 0xd4, 0x6c, 0x18, 0x71, 0xaa, 0x2d, 0x06, 0x74, 0x44,
-	0xdd, 0x97, 0x4a, 0x95, 0x5a, 0xa3, 0x6e, 0x7f, 0x0e, 0xca, 0x08, 0x66, 0x9b, 0xce, 0x17, 0x83,
-	0x13, 0x05, 0x2f, 0xb0, 0xa1, 0xf9, 0x34, 0x3e, 0x0b, 0xc2, 0xa7, 0xf2, 0x0c, 0x40, 0x14, 0xed,
-	0x6f, 0xa0, 0x17, 0xa2, 0x62, 0xe2, 0x8f, 0xd1, 0x84, 0x62, 0xbe, 0x24, 0x5f, 0xea, 0xe8, 0xc4,
-	0x15, 0x8e, 0x51, 0x05, 0x01, 0x87, 0x27, 0x2e, 0x93, 0x95, 0xc6, 0xee, 0x71, 0x5f, 0xb3, 0x86,
-	0xb0, 0x5d, 0xbe, 0x79, 0xd7, 0x60, 0x46, 0x46, 0xdb, 0xa3, 0x76, 0x9f, 0x1e, 0xc7, 0x32, 0x52,
-	0xe4, 0x8f, 0x06, 0xe8, 0x90, 0xee, 0xd1, 0xe3, 0xd8, 0xde, 0x87, 0x39, 0x21, 0xbf, 0x1e, 0x0e,
-	0xa9, 0xec, 0xfa, 0xf3, 0x79, 0x76, 0x40, 0xed, 0xf6, 0xbc, 0x29, 0xf0, 0xf8, 0xf9, 0x82, 0x89,
-	0x69, 0x3b, 0x40, 0x74, 0x79, 0x28, 0x1a, 0x14, 0xca, 0x58, 0xc6, 0xc2, 0xc4, 0x74, 0x0c, 0x18,
-	0x5b, 0x9f, 0x68, 0xd4, 0xe9, 0xc8, 0x33, 0x12, 0xe6, 0xb1, 0xf3, 0xa2, 0xfd, 0x87, 0x16, 0xcc,
-	0x63, 0x6b, 0xd2, 0x92, 0x11, 0x3a, 0xe7, 0xce, 0xc7, 0x18, 0x66, 0xbd, 0xa3, 0xc7, 0x07, 0x17,
-	0xa0, 0xac, 0x6b, 0x21, 0x5e, 0xf8, 0xf8, 0x71, 0x87, 0x52, 0x3a, 0xee, 0x60, 0xff, 0x96, 0x05,
-	0x73, 0x5c, 0x11, 0xa0, 0x55, 0x29, 0xa6, 0xff, 0xbf, 0x61, 0x9a, 0x6b, 0x74, 0xc1, 0xd5, 0x62,
-	0xa0, 0x89, 0x68, 0x44, 0x28, 0x47, 0xde, 0x9d, 0x70, 0x4c, 0x64, 0x72, 0x17, 0xad, 0x2a, 0xbf,
-	0x8d, 0xd0, 0x9c, 0xd3, 0x34, 0x73, 0xad, 0x77, 0x27, 0x1c, 0x0d, 0x7d, 0xb3, 0x02, 0x93, 0xdc,
-	0x24, 0xb7, 0xef, 0xc3, 0xb4, 0xd1, 0x91, 0x11, 0xf3, 0xa8, 0xf3, 0x98, 0x47, 0x26, 0xb8, 0x58,
-	0xc8, 0x09, 0x2e, 0xfe, 0x71, 0x11, 0x08, 0x23, 0x96, 0xd4, 0x6e, 0x30, 0x9f, 0x20, 0xe8, 0x1a,
-	0x1e, 0x5e, 0xdd, 0xd1, 0x41, 0xe4, 0x26, 0x10, 0xad, 0x28, 0x63, 0xc4, 0x5c, 0xe5, 0xe5, 0xd4,
-	0x30, 0x31, 0x29, 0x2c, 0x06, 0xa1, 0xdb, 0x85, 0x2f, 0xcb, 0x97, 0x3d, 0xb7, 0x8e, 0x69, 0xb5,
-	0xe1, 0x28, 0x3a, 0xc1, 0xc8, 0x9e, 0xf0, 0x01, 0x65, 0x39, 0xbd, 0xbf, 0x93, 0x17, 0xee, 0xef,
-	0x54, 0x26, 0xae, 0xa4, 0x79, 0x21, 0x15, 0xd3, 0x0b, 0xb9, 0x06, 0xd3, 0x03, 0x66, 0xe7, 0xc6,
-	0xfd, 0x4e, 0x7b, 0xc0, 0x7a, 0x17, 0x2e, 0x9f, 0x01, 0x24, 0x6b, 0xd0, 0x10, 0x36, 0x4e, 0xe2,
-	0xea, 0xf0, 0x13, 0x84, 0x0c, 0x9c, 0xc9, 0xef, 0x24, 0xd2, 0x54, 0xc3, 0xc1, 0x26, 0x00, 0xe6,
-	0xd7, 0x44, 0x8c, 0x42, 0xda, 0x23, 0x5f, 0x1c, 0xa8, 0xd1, 0x2e, 0x3a, 0x7b, 0x15, 0x27, 0x5b,
-	0x61, 0xff, 0x9a, 0x05, 0x0d, 0xb6, 0x67, 0x06, 0x59, 0xbe, 0x0d, 0xc8, 0x15, 0x2f, 0x49, 0x95,
-	0x06, 0x2e, 0xb9, 0x03, 0x55, 0x2c, 0x07, 0x43, 0xea, 0x0b, 0x9a, 0x6c, 0x9a, 0x34, 0x99, 0xc8,
-	0x93, 0xdd, 0x09, 0x27, 0x41, 0xd6, 0x28, 0xf2, 0xaf, 0x2d, 0xa8, 0x89, 0x5e, 0x7e, 0xe4, 0x48,
-	0x46, 0x4b, 0x3b, 0x01, 0xe5, 0x94, 0x94, 0x1c, 0x78, 0xae, 0xc2, 0xec, 0xc0, 0x8d, 0x47, 0x21,
-	0xd3, 0xc7, 0x46, 0x14, 0x23, 0x0d, 0x66, 0xca, 0x15, 0x45, 0x67, 0xd4, 0x8e, 0xbd, 0x7e, 0x5b,
-	0xd6, 0x8a, 0xb3, 0xc6, 0xbc, 0x2a, 0x26, 0x41, 0xa2, 0xd8, 0xed, 0x51, 0xa1, 0x37, 0x79, 0xc1,
-	0x6e, 0xc2, 0x92, 0x98, 0x50, 0xca, 0x3e, 0xb6, 0xbf, 0x5f, 0x87, 0xe5, 0x4c, 0x95, 0xca, 0x8c,
-	0x10, 0xee, 0x79, 0xdf, 0x1b, 0x1c, 0x05, 0xca, 0xb9, 0xb0, 0x74, 0xcf, 0xdd, 0xa8, 0x22, 0x3d,
-	0x58, 0x94, 0x06, 0x02, 0x5b, 0xd3, 0x44, 0x99, 0x15, 0x50, 0x4b, 0xbd, 0x69, 0x6e, 0x61, 0xba,
-	0x43, 0x09, 0xd7, 0x99, 0x38, 0xbf, 0x3d, 0x72, 0x02, 0x4d, 0x65, 0x89, 0x08, 0x61, 0xad, 0x59,
-	0x2b, 0xac, 0xaf, 0x37, 0x2e, 0xe8, 0xcb, 0x30, 0xa7, 0x9d, 0xb1, 0xad, 0x91, 0x73, 0xb8, 0x2a,
-	0xeb, 0x50, 0x1a, 0x67, 0xfb, 0x2b, 0xbd, 0xd4, 0xdc, 0xd0, 0x51, 0x30, 0x3b, 0xbd, 0xa0, 0x61,
-	0xf2, 0x01, 0x2c, 0x9d, 0xb9, 0x5e, 0x2c, 0x87, 0xa5, 0xd9, 0x06, 0x65, 0xec, 0xf2, 0xf6, 0x05,
-	0x5d, 0x3e, 0xe1, 0x1f, 0x1b, 0x2a, 0x6a, 0x4c, 0x8b, 0xad, 0x1f, 0x58, 0x30, 0x63, 0xb6, 0xc3,
-	0xc8, 0x54, 0xf0, 0xbe, 0x94, 0x81, 0xd2, 0x9a, 0x4c, 0x81, 0xb3, 0xfe, 0x79, 0x21, 0xcf, 0x3f,
-	0xd7, 0xbd, 0xe2, 0xe2, 0x45, 0x61, 0xb0, 0xd2, 0xcb, 0x85, 0xc1, 0xca, 0x79, 0x61, 0xb0, 0xd6,
-	0x7f, 0x58, 0x40, 0xb2, 0xb4, 0x44, 0xee, 0xf3, 0x00, 0x81, 0x4f, 0xfb, 0x42, 0xa4, 0xfc, 0xaf,
-	0x97, 0xa3, 0x47, 0xb9, 0x76, 0xf2, 0x6b, 0xc6, 0x18, 0x7a, 0xb2, 0x80, 0x6e, 0xec, 0x4c, 0x3b,
-	0x79, 0x55, 0xa9, 0xc0, 0x5c, 0xe9, 0xe2, 0xc0, 0x5c, 0xf9, 0xe2, 0xc0, 0xdc, 0x64, 0x3a, 0x30,
-	0xd7, 0xfa, 0x39, 0x0b, 0xe6, 0x73, 0x36, 0xfd, 0x27, 0x37, 0x71, 0xb6, 0x4d, 0x86, 0x2c, 0x28,
-	0x88, 0x6d, 0xd2, 0x81, 0xad, 0x9f, 0x82, 0x69, 0x83, 0xd0, 0x7f, 0x72, 0xfd, 0xa7, 0xed, 0x35,
-	0x4e, 0x67, 0x06, 0xac, 0xf5, 0x2f, 0x05, 0x20, 0x59, 0x66, 0xfb, 0x1f, 0x1d, 0x43, 0x76, 0x9d,
-	0x8a, 0x39, 0xeb, 0xf4, 0xdf, 0xaa, 0x07, 0xde, 0x80, 0x39, 0x91, 0x01, 0xa5, 0x85, 0x85, 0x38,
-	0xc5, 0x64, 0x2b, 0x98, 0xc5, 0x6a, 0x46, 0x45, 0x2b, 0x46, 0x46, 0x88, 0xa6, 0x0c, 0x53, 0xc1,
-	0x51, 0xbb, 0x05, 0x4d, 0xb1, 0x42, 0x3b, 0xa7, 0xd4, 0x8f, 0x0f, 0x47, 0x47, 0x3c, 0x05, 0xc8,
-	0x0b, 0x7c, 0xfb, 0x7b, 0x45, 0x65, 0x74, 0x63, 0xa5, 0x50, 0xef, 0x9f, 0x81, 0xba, 0x2e, 0xcc,
-	0xc5, 0x76, 0xa4, 0xa2, 0x82, 0x4c, 0xb1, 0xeb, 0x58, 0x64, 0x1b, 0x66, 0x50, 0x64, 0x75, 0xd5,
-	0x77, 0x05, 0xfc, 0xee, 0x05, 0xd1, 0x8e, 0xdd, 0x09, 0x27, 0xf5, 0x0d, 0xf9, 0x02, 0xcc, 0x98,
-	0xae, 0x94, 0xb0, 0x11, 0xf2, 0x6c, 0x73, 0xf6, 0xb9, 0x89, 0x4c, 0x36, 0xa0, 0x91, 0xf6, 0xc5,
-	0xc4, 0xf9, 0xff, 0x98, 0x06, 0x32, 0xe8, 0xe4, 0x8e, 0x38, 0x1e, 0x2b, 0x63, 0x14, 0xe2, 0x9a,
-	0xf9, 0x99, 0xb6, 0x4c, 0x37, 0xf9, 0x8f, 0x76, 0x60, 0xf6, 0x35, 0x80, 0x04, 0x46, 0x1a, 0x50,
-	0x7f, 0x78, 0xb0, 0xb3, 0xdf, 0xde, 0xda, 0xdd, 0xd8, 0xdf, 0xdf, 0xd9, 0x6b, 0x4c, 0x10, 0x02,
-	0x33, 0x18, 0x34, 0xdb, 0x56, 0x30, 0x8b, 0xc1, 0x44, 0x98, 0x42, 0xc2, 0x0a, 0x64, 0x01, 0x1a,
-	0x0f, 0xf6, 0x53, 0xd0, 0xe2, 0x66, 0x55, 0xf1, 0x87, 0xbd, 0x04, 0x0b, 0x3c, 0xc3, 0x6d, 0x93,
-	0x93, 0x87, 0xb4, 0x15, 0x7e, 0xd7, 0x82, 0xc5, 0x54, 0x45, 0x92, 0x4a, 0xc2, 0xcd, 0x01, 0xd3,
-	0x46, 0x30, 0x81, 0x18, 0xf2, 0x96, 0x96, 0x5f, 0x4a, 0x82, 0x64, 0x2b, 0x18, 0xcd, 0x6b, 0x96,
-	0x62, 0x8a, 0x93, 0xf2, 0xaa, 0xec, 0x65, 0x9e, 0x87, 0x87, 0x19, 0x7b, 0xc6, 0xc0, 0x8f, 0x79,
-	0xe6, 0x9c, 0x5e, 0x91, 0x1c, 0x37, 0x9a, 0x43, 0x96, 0x45, 0x66, 0xe4, 0x1b, 0xa6, 0x87, 0x39,
-	0xde, 0xdc, 0x3a, 0xfb, 0x2f, 0x0a, 0x40, 0xbe, 0x3c, 0xa2, 0xe1, 0x39, 0x66, 0x81, 0xa8, 0x18,
-	0xe4, 0x72, 0x3a, 0xc2, 0x36, 0x39, 0x1c, 0x1d, 0xbd, 0x43, 0xcf, 0x65, 0x06, 0x53, 0x41, 0xcf,
-	0x60, 0x02, 0xe6, 0x1c, 0xab, 0x1c, 0x14, 0x6b, 0xb5, 0x8c, 0x21, 0x89, 0xaa, 0x3f, 0x1a, 0xf0,
-	0x46, 0x73, 0x13, 0x8d, 0x4a, 0x17, 0x27, 0x1a, 0x95, 0x2f, 0x4a, 0x34, 0xfa, 0x04, 0x4c, 0x7b,
-	0x3d, 0x3f, 0x60, 0x62, 0x81, 0x29, 0xf6, 0xa8, 0x39, 0xb9, 0x52, 0x64, 0xce, 0xb0, 0x00, 0xee,
-	0x33, 0x18, 0xf9, 0x5c, 0x82, 0x44, 0xbb, 0x3d, 0x4c, 0x5a, 0xd3, 0x05, 0xc5, 0x4e, 0xb7, 0x47,
-	0xf7, 0x82, 0x8e, 0x1b, 0x07, 0xa1, 0xfa, 0x90, 0xc1, 0x22, 0xe6, 0xf5, 0x47, 0xc1, 0x88, 0x99,
-	0x39, 0x72, 0x29, 0x78, 0xd8, 0xa6, 0xce, 0xa1, 0x07, 0xb8, 0x20, 0xf6, 0x7b, 0x50, 0xd3, 0x9a,
-	0xc0, 0x8c, 0x26, 0x61, 0x42, 0x08, 0x7f, 0xb0, 0xc4, 0x2d, 0x76, 0x9f, 0xf6, 0x1f, 0x74, 0xc9,
-	0xeb, 0x30, 0xd7, 0xf5, 0x42, 0x8a, 0xc9, 0x69, 0xed, 0x90, 0x9e, 0xd2, 0x30, 0x92, 0x9e, 0x73,
-	0x43, 0x55, 0x38, 0x1c, 0x6e, 0xdf, 0x85, 0x79, 0x63, 0x6b, 0x14, 0xe5, 0xca, 0x84, 0x1f, 0x2b,
-	0x9b, 0xf0, 0x23, 0x93, 0x7d, 0xec, 0x5f, 0x28, 0x40, 0x71, 0x37, 0x18, 0xea, 0x47, 0x0c, 0x96,
-	0x79, 0xc4, 0x20, 0x4c, 0xa0, 0xb6, 0xb2, 0x70, 0x84, 0x66, 0x34, 0x80, 0x64, 0x0d, 0x66, 0xdc,
-	0x41, 0xdc, 0x8e, 0x03, 0x66, 0xf2, 0x9d, 0xb9, 0x61, 0x97, 0x93, 0x33, 0x6e, 0x71, 0xaa, 0x86,
-	0x2c, 0x40, 0x51, 0xd9, 0x0a, 0x88, 0xc0, 0x8a, 0xcc, 0xdf, 0xc0, 0xa3, 0xce, 0x73, 0x11, 0x39,
-	0x13, 0x25, 0xc6, 0x2d, 0xe6, 0xf7, 0xdc, 0xd9, 0xe3, 0x12, 0x3f, 0xaf, 0x8a, 0x99, 0x63, 0x8c,
-	0x3a, 0x10, 0x4d, 0xc4, 0x59, 0x65, 0x59, 0x8f, 0x09, 0x57, 0xcc, 0x83, 0xdf, 0x7f, 0xb6, 0xa0,
-	0x8c, 0x6b, 0xc3, 0xb4, 0x17, 0x67, 0x6f, 0x75, 0xca, 0x80, 0x6b, 0x32, 0xed, 0xa4, 0xc1, 0xc4,
-	0x36, 0xd2, 0x1c, 0x0b, 0x6a, 0x42, 0x7a, 0xaa, 0xe3, 0x0a, 0x54, 0x79, 0x49, 0xa5, 0xf4, 0x71,
-	0xba, 0x57, 0x40, 0x72, 0x15, 0x4a, 0x27, 0xc1, 0x50, 0x9a, 0xdb, 0x20, 0x0f, 0xec, 0x82, 0xa1,
-	0x83, 0xf0, 0x64, 0x3c, 0xac, 0x3d, 0x3e, 0x2d, 0x6e, 0x44, 0xa5, 0xc1, 0xcc, 0x8c, 0x54, 0xcd,
-	0xea, 0xcb, 0x94, 0x82, 0xda, 0x6b, 0x30, 0xcb, 0xa8, 0x5e, 0x8b, 0xba, 0x8e, 0x65, 0x65, 0xfb,
-	0x67, 0x2c, 0xa8, 0x48, 0x64, 0xb2, 0x0a, 0x25, 0xc6, 0x42, 0x29, 0xc7, 0x55, 0x1d, 0xd4, 0x33,
-	0x3c, 0x07, 0x31, 0x98, 0x31, 0x81, 0xc1, 0xb0, 0xc4, 0x4f, 0x92, 0xa1, 0xb0, 0xc4, 0x0d, 0x50,
-	0xc3, 0x4d, 0x59, 0xcf, 0x29, 0xa8, 0xfd, 0x5d, 0x0b, 0xa6, 0x8d, 0x3e, 0xc8, 0x0a, 0xd4, 0xfa,
-	0x6e, 0x14, 0x8b, 0xc3, 0x4f, 0xb1, 0x3d, 0x3a, 0x48, 0xdf, 0xe8, 0x82, 0x19, 0xfc, 0x57, 0x11,
-	0xe2, 0xa2, 0x1e, 0x21, 0xbe, 0x05, 0xd5, 0x24, 0x19, 0xb5, 0x64, 0xf0, 0x3e, 0xeb, 0x51, 0xa6,
-	0x20, 0x24, 0x48, 0x18, 0x74, 0x0c, 0xfa, 0x41, 0x28, 0x4e, 0xca, 0x78, 0xc1, 0xbe, 0x0b, 0x35,
-	0x0d, 0x5f, 0x8f, 0x41, 0x5a, 0x46, 0x0c, 0x52, 0xe5, 0xe7, 0x14, 0x92, 0xfc, 0x1c, 0xfb, 0x5f,
-	0x2d, 0x98, 0x66, 0x34, 0xe8, 0xf9, 0xbd, 0x83, 0xa0, 0xef, 0x75, 0xce, 0x71, 0xef, 0x25, 0xb9,
-	0x09, 0x91, 0x28, 0x69, 0xd1, 0x04, 0x33, 0xaa, 0x97, 0x91, 0x0f, 0xc1, 0xa2, 0xaa, 0xcc, 0x78,
-	0x98, 0x71, 0xc0, 0x91, 0x1b, 0x09, 0xb6, 0x10, 0x56, 0x9b, 0x01, 0x64, 0x9c, 0xc6, 0x00, 0x98,
-	0x6d, 0x35, 0xf0, 0xfa, 0x7d, 0x8f, 0xe3, 0x72, 0x9b, 0x3e, 0xaf, 0x8a, 0xf5, 0xd9, 0xf5, 0x22,
-	0xf7, 0x28, 0x39, 0xfd, 0x51, 0x65, 0x0c, 0xcf, 0xb8, 0xcf, 0xb4, 0xf0, 0xcc, 0x24, 0xca, 0x15,
-	0x13, 0x68, 0xff, 0x59, 0x01, 0x6a, 0xd2, 0x44, 0xe8, 0xf6, 0xa8, 0x38, 0xd0, 0x34, 0x05, 0xa3,
-	0x06, 0x91, 0xf5, 0x86, 0x37, 0xa6, 0x41, 0xd2, 0x84, 0x51, 0xcc, 0x12, 0xc6, 0x15, 0xa8, 0x32,
-	0x02, 0x7d, 0x13, 0xdd, 0x3e, 0x91, 0xdf, 0xad, 0x00, 0xb2, 0xf6, 0x36, 0xd6, 0x96, 0x93, 0x5a,
-	0x04, 0xbc, 0xf0, 0xf8, 0xf3, 0x0e, 0xd4, 0x45, 0x33, 0xb8, 0x73, 0x28, 0x79, 0x12, 0x16, 0x31,
-	0x76, 0xd5, 0x31, 0x30, 0xe5, 0x97, 0xb7, 0xe5, 0x97, 0x95, 0x8b, 0xbe, 0x94, 0x98, 0xf6, 0x7d,
-	0x75, 0xaa, 0x7c, 0x3f, 0x74, 0x87, 0x27, 0x92, 0x97, 0x6f, 0xc1, 0xbc, 0xe7, 0x77, 0xfa, 0xa3,
-	0x2e, 0x6d, 0x8f, 0x7c, 0xd7, 0xf7, 0x83, 0x91, 0xdf, 0xa1, 0x32, 0x41, 0x27, 0xaf, 0xca, 0xee,
-	0xaa, 0x74, 0x4e, 0x6c, 0x88, 0xac, 0x41, 0x99, 0xab, 0x4a, 0xae, 0x3b, 0xf2, 0x19, 0x9d, 0xa3,
-	0x90, 0x55, 0x28, 0x73, 0x8d, 0x59, 0x30, 0xb8, 0x46, 0xdb, 0x55, 0x87, 0x23, 0x30, 0xb1, 0x83,
-	0x19, 0xbd, 0xa6, 0xd8, 0x31, 0xf5, 0xce, 0x64, 0x07, 0x73, 0x7e, 0xed, 0x05, 0x20, 0xfb, 0x9c,
-	0x53, 0xf4, 0xb3, 0xa1, 0xef, 0x17, 0xa1, 0xa6, 0x81, 0x99, 0x04, 0xe9, 0xb1, 0x01, 0xb7, 0xbb,
-	0x9e, 0x3b, 0xa0, 0x31, 0x0d, 0x05, 0x77, 0xa4, 0xa0, 0x0c, 0xcf, 0x3d, 0xed, 0xb5, 0x83, 0x51,
-	0xdc, 0xee, 0xd2, 0x5e, 0x48, 0xb9, 0x36, 0x65, 0xaa, 0xc9, 0x80, 0x32, 0x3c, 0x46, 0x9f, 0x1a,
-	0x1e, 0xa7, 0xa0, 0x14, 0x54, 0x9e, 0xf4, 0xf0, 0x35, 0x2a, 0x25, 0x27, 0x3d, 0x7c, 0x45, 0xd2,
-	0xb2, 0xaf, 0x9c, 0x23, 0xfb, 0xde, 0x82, 0x25, 0x2e, 0xe5, 0x84, 0x3c, 0x68, 0xa7, 0x08, 0x6b,
-	0x4c, 0x2d, 0x59, 0x83, 0x06, 0x1b, 0xb3, 0x64, 0x89, 0xc8, 0xfb, 0x06, 0x8f, 0x9a, 0x5a, 0x4e,
-	0x06, 0xce, 0x70, 0x31, 0x7c, 0xa9, 0xe3, 0xf2, 0xe3, 0xf6, 0x0c, 0x1c, 0x71, 0xdd, 0x67, 0x26,
-	0x6e, 0x55, 0xe0, 0xa6, 0xe0, 0xe4, 0x0e, 0x2c, 0x0f, 0x68, 0xd7, 0x73, 0xcd, 0x26, 0x30, 0x02,
-	0xcc, 0x73, 0x6a, 0xc6, 0x55, 0xdb, 0xd3, 0x50, 0x3b, 0x8c, 0x83, 0xa1, 0xdc, 0xce, 0x19, 0xa8,
-	0xf3, 0xa2, 0x48, 0xb1, 0xba, 0x0c, 0x97, 0x90, 0xfe, 0x1e, 0x05, 0xc3, 0xa0, 0x1f, 0xf4, 0xce,
-	0x0d, 0xa7, 0xeb, 0xaf, 0x2c, 0x98, 0x37, 0x6a, 0x13, 0xaf, 0x0b, 0xe3, 0x35, 0x32, 0x37, 0x86,
-	0x93, 0xec, 0x9c, 0x26, 0xbc, 0x39, 0x22, 0x0f, 0x8d, 0x3f, 0x16, 0xe9, 0x32, 0x1b, 0xc9, 0xb5,
-	0x19, 0xf9, 0x21, 0xa7, 0xdf, 0x66, 0x96, 0x7e, 0xc5, 0xf7, 0xf2, 0xd6, 0x8c, 0x6c, 0xe2, 0x0b,
-	0x22, 0xe1, 0x81, 0x3b, 0x61, 0x32, 0x3c, 0xa7, 0xdc, 0x36, 0xdd, 0x49, 0x97, 0x23, 0xe8, 0x28,
-	0x60, 0x64, 0xff, 0xb2, 0x05, 0x90, 0x8c, 0x0e, 0x8f, 0xc9, 0x95, 0x02, 0xe2, 0x57, 0xb4, 0x34,
-	0x65, 0xf3, 0x1a, 0xd4, 0xd5, 0x49, 0x67, 0xa2, 0xd3, 0x6a, 0x12, 0xc6, 0x6c, 0xee, 0x1b, 0x30,
-	0xdb, 0xeb, 0x07, 0x47, 0x68, 0x10, 0x60, 0xce, 0x5e, 0x24, 0x12, 0xcd, 0x66, 0x38, 0xf8, 0x9e,
-	0x80, 0x26, 0x0a, 0xb0, 0xa4, 0x29, 0x40, 0xfb, 0x57, 0x0a, 0xea, 0x60, 0x2a, 0x99, 0xf3, 0x58,
-	0xfe, 0x24, 0xb7, 0x33, 0x82, 0x78, 0xcc, 0x39, 0x10, 0x9a, 0xb5, 0x07, 0x17, 0xc6, 0xc9, 0xee,
-	0xc2, 0x4c, 0xc8, 0x25, 0x9d, 0x14, 0x83, 0xa5, 0x17, 0x88, 0xc1, 0xe9, 0xd0, 0xd0, 0x92, 0x9f,
-	0x82, 0x86, 0xdb, 0x3d, 0xa5, 0x61, 0xec, 0x61, 0xa4, 0x02, 0x4d, 0x14, 0x2e, 0xbc, 0x67, 0x35,
-	0x38, 0x5a, 0x0e, 0x37, 0x60, 0x56, 0x24, 0xf7, 0x29, 0x4c, 0x71, 0xed, 0x21, 0x01, 0x33, 0x44,
-	0xfb, 0x3b, 0xf2, 0x0c, 0xcc, 0xdc, 0xc3, 0xf1, 0x2b, 0xa2, 0xcf, 0xae, 0x90, 0x9a, 0xdd, 0x27,
-	0xc4, 0x79, 0x54, 0x57, 0x86, 0x43, 0x8a, 0x5a, 0x72, 0x4c, 0x57, 0x9c, 0x1f, 0x9a, 0x4b, 0x5a,
-	0x7a, 0x99, 0x25, 0xb5, 0x7f, 0x68, 0xc1, 0xd4, 0x6e, 0x30, 0xdc, 0x15, 0x69, 0x42, 0xc8, 0x08,
-	0x2a, 0xab, 0x56, 0x16, 0x5f, 0x90, 0x40, 0x94, 0x6b, 0x19, 0x4c, 0xa7, 0x2d, 0x83, 0xff, 0x0b,
-	0x97, 0x31, 0x18, 0x17, 0x06, 0xc3, 0x20, 0x64, 0xcc, 0xe8, 0xf6, 0xb9, 0x19, 0x10, 0xf8, 0xf1,
-	0x89, 0x14, 0x80, 0x2f, 0x42, 0x41, 0x0f, 0x99, 0x79, 0x75, 0xdc, 0xa8, 0x17, 0x96, 0x0c, 0x97,
-	0x8b, 0xd9, 0x0a, 0xfb, 0xf3, 0x50, 0x45, 0x53, 0x1c, 0xa7, 0xf5, 0x06, 0x54, 0x4f, 0x82, 0x61,
-	0xfb, 0xc4, 0xf3, 0x63, 0xc9, 0xdc, 0x33, 0x89, 0x8d, 0xbc, 0x8b, 0x0b, 0xa2, 0x10, 0xec, 0xdf,
-	0x98, 0x84, 0xa9, 0x07, 0xfe, 0x69, 0xe0, 0x75, 0xf0, 0xbc, 0x6d, 0x40, 0x07, 0x81, 0xcc, 0x31,
-	0x66, 0xff, 0xc9, 0x15, 0x98, 0xc2, 0xa4, 0xba, 0x21, 0x27, 0xda, 0x3a, 0x3f, 0x17, 0x17, 0x20,
-	0x66, 0x5e, 0x84, 0xc9, 0x6d, 0x10, 0xce, 0x3e, 0x1a, 0x84, 0x39, 0x29, 0xa1, 0x7e, 0x9b, 0x43,
-	0x94, 0x92, 0x1c, 0xee, 0xb2, 0x96, 0xc3, 0xcd, 0xfa, 0x12, 0x69, 0x4d, 0x3c, 0xef, 0x85, 0xf7,
-	0x25, 0x40, 0xe8, 0x58, 0x85, 0x94, 0x07, 0x53, 0xd1, 0x58, 0x99, 0x12, 0x8e, 0x95, 0x0e, 0x64,
-	0x06, 0x0d, 0xff, 0x80, 0xe3, 0x70, 0xf1, 0xad, 0x83, 0x98, 0x89, 0x98, 0xbe, 0xc8, 0x53, 0xe5,
-	0xb4, 0x9f, 0x02, 0x33, 0x19, 0xdf, 0xa5, 0x4a, 0xa0, 0xf2, 0x79, 0x00, 0xbf, 0xf1, 0x92, 0x86,
-	0x6b, 0xee, 0x18, 0xcf, 0x7f, 0x94, 0xee, 0x18, 0x23, 0x18, 0xb7, 0xdf, 0x3f, 0x72, 0x3b, 0x4f,
-	0xf1, 0x9e, 0x16, 0x9e, 0x80, 0x55, 0x1d, 0x13, 0x88, 0xc9, 0x49, 0xc9, 0xae, 0x62, 0x06, 0x41,
-	0xc9, 0xd1, 0x41, 0xe4, 0x36, 0xd4, 0xd0, 0x05, 0x15, 0xfb, 0x3a, 0x83, 0xfb, 0xda, 0xd0, 0x7d,
-	0x54, 0xdc, 0x59, 0x1d, 0x49, 0x3f, 0x0b, 0x9c, 0xcd, 0x64, 0x24, 0xba, 0xdd, 0xae, 0x38, 0x42,
-	0x6d, 0x70, 0x77, 0x5a, 0x01, 0x98, 0x3e, 0x16, 0x0b, 0xc6, 0x11, 0xe6, 0x10, 0xc1, 0x80, 0x91,
-	0xab, 0x50, 0x61, 0xee, 0xd1, 0xd0, 0xf5, 0xba, 0x98, 0xd2, 0xc8, 0xbd, 0x34, 0x05, 0x63, 0x6d,
-	0xc8, 0xff, 0xa8, 0xe8, 0xe6, 0x71, 0x55, 0x0c, 0x18, 0x5b, 0x1b, 0x55, 0x46, 0x66, 0x5a, 0xe0,
-	0x3b, 0x6a, 0x00, 0xc9, 0x9b, 0x78, 0x90, 0x15, 0xd3, 0xe6, 0x22, 0x06, 0xca, 0x2e, 0x8b, 0x39,
-	0x0b, 0xa2, 0x95, 0xbf, 0x87, 0x0c, 0xc5, 0xe1, 0x98, 0xf6, 0x06, 0xd4, 0x75, 0x30, 0xa9, 0x40,
-	0xe9, 0xe1, 0xc1, 0xce, 0x7e, 0x63, 0x82, 0xd4, 0x60, 0xea, 0x70, 0xe7, 0xd1, 0xa3, 0xbd, 0x9d,
-	0xed, 0x86, 0x45, 0xea, 0x50, 0x51, 0x99, 0x64, 0x05, 0x56, 0xda, 0xd8, 0xda, 0xda, 0x39, 0x78,
-	0xb4, 0xb3, 0xdd, 0x28, 0xda, 0x31, 0x90, 0x8d, 0x6e, 0x57, 0xb4, 0xa2, 0x82, 0x04, 0x09, 0x3d,
-	0x5b, 0x06, 0x3d, 0xe7, 0xd0, 0x54, 0x21, 0x9f, 0xa6, 0x5e, 0xb8, 0xf2, 0xf6, 0x0e, 0xd4, 0x0e,
-	0xb4, 0x4b, 0x4b, 0xc8, 0x5e, 0xf2, 0xba, 0x92, 0x60, 0x4b, 0x0d, 0xa2, 0x0d, 0xa7, 0xa0, 0x0f,
-	0xc7, 0xfe, 0x7d, 0x8b, 0xdf, 0x0c, 0x50, 0xc3, 0xe7, 0x7d, 0xdb, 0x50, 0x57, 0xd1, 0xaa, 0x24,
-	0x49, 0xd4, 0x80, 0x31, 0x1c, 0x1c, 0x4a, 0x3b, 0x38, 0x3e, 0x8e, 0xa8, 0x4c, 0xe9, 0x32, 0x60,
-	0x8c, 0x2f, 0x98, 0x6d, 0xc6, 0xec, 0x1c, 0x8f, 0xf7, 0x10, 0x89, 0xd4, 0xae, 0x0c, 0x9c, 0x49,
-	0x79, 0x11, 0x90, 0x91, 0xc9, 0x6c, 0xaa, 0xac, 0x72, 0x59, 0xd3, 0xab, 0xbc, 0x06, 0x15, 0xd5,
-	0xae, 0x29, 0xc0, 0x24, 0xa6, 0xaa, 0x67, 0x82, 0x12, 0xbd, 0x15, 0x63, 0xd0, 0x5c, 0x68, 0x67,
-	0x2b, 0xc8, 0x4d, 0x20, 0xc7, 0x5e, 0x98, 0x46, 0x2f, 0x22, 0x7a, 0x4e, 0x8d, 0xfd, 0x04, 0xe6,
-	0x25, 0x21, 0x69, 0xa6, 0x95, 0xb9, 0x89, 0xd6, 0x45, 0xec, 0x53, 0xc8, 0xb2, 0x8f, 0xfd, 0x9f,
-	0x16, 0x4c, 0x89, 0x9d, 0xce, 0x5c, 0x7c, 0xe3, 0xfb, 0x6c, 0xc0, 0x48, 0xd3, 0xb8, 0xf4, 0x82,
-	0xbc, 0x26, 0x84, 0x66, 0x46, 0x2c, 0x16, 0xf3, 0xc4, 0x22, 0x81, 0xd2, 0xd0, 0x8d, 0x4f, 0xd0,
-	0x53, 0xaf, 0x3a, 0xf8, 0x9f, 0x34, 0x78, 0x5c, 0x89, 0x8b, 0x60, 0x8c, 0x29, 0xe5, 0x5d, 0xf1,
-	0xe3, 0xda, 0x3e, 0x7b, 0xc5, 0xef, 0x0a, 0x54, 0x71, 0x00, 0xed, 0x24, 0x6c, 0x94, 0x00, 0x18,
-	0xe5, 0xf2, 0x02, 0xf2, 0xb5, 0xc8, 0x3f, 0x4f, 0x20, 0xf6, 0x22, 0xdf, 0x79, 0xb1, 0x04, 0xea,
-	0x10, 0x5a, 0xe4, 0x0e, 0x27, 0xe0, 0x84, 0x22, 0xc4, 0x00, 0xd2, 0x14, 0x21, 0x50, 0x1d, 0x55,
-	0x6f, 0xb7, 0xa0, 0xb9, 0x4d, 0xfb, 0x34, 0xa6, 0x1b, 0xfd, 0x7e, 0xba, 0xfd, 0xcb, 0x70, 0x29,
-	0xa7, 0x4e, 0x58, 0xd3, 0x5f, 0x86, 0xc5, 0x0d, 0x9e, 0x67, 0xf9, 0x93, 0x4a, 0xe3, 0xb1, 0x9b,
-	0xb0, 0x94, 0x6e, 0x52, 0x74, 0x76, 0x0f, 0xe6, 0xb6, 0xe9, 0xd1, 0xa8, 0xb7, 0x47, 0x4f, 0x93,
-	0x8e, 0x08, 0x94, 0xa2, 0x93, 0xe0, 0x4c, 0x30, 0x26, 0xfe, 0x27, 0xaf, 0x00, 0xf4, 0x19, 0x4e,
-	0x3b, 0x1a, 0xd2, 0x8e, 0xbc, 0x67, 0x82, 0x90, 0xc3, 0x21, 0xed, 0xd8, 0x6f, 0x01, 0xd1, 0xdb,
-	0x11, 0xeb, 0xc5, 0xb4, 0xe0, 0xe8, 0xa8, 0x1d, 0x9d, 0x47, 0x31, 0x1d, 0xc8, 0x0b, 0x34, 0x3a,
-	0xc8, 0xbe, 0x01, 0xf5, 0x03, 0xf7, 0xdc, 0xa1, 0x1f, 0x8a, 0xfb, 0x8e, 0xcb, 0x30, 0x35, 0x74,
-	0xcf, 0x99, 0x98, 0x52, 0xf1, 0x2c, 0xac, 0xb6, 0xff, 0xbd, 0x00, 0x93, 0x1c, 0x93, 0xb5, 0xda,
-	0xa5, 0x51, 0xec, 0xf9, 0x48, 0x58, 0xb2, 0x55, 0x0d, 0x94, 0x21, 0xe5, 0x42, 0x0e, 0x29, 0x0b,
-	0x6f, 0x4f, 0xe6, 0xec, 0x0b, 0x7a, 0x35, 0x60, 0x8c, 0xb8, 0x92, 0x7c, 0x3a, 0x1e, 0x50, 0x49,
-	0x00, 0xa9, 0xd0, 0x67, 0xa2, 0x6b, 0xf9, 0xf8, 0x24, 0x97, 0x0a, 0xca, 0xd5, 0x41, 0xb9, 0x1a,
-	0x7d, 0x8a, 0x13, 0x78, 0x46, 0xa3, 0x67, 0x34, 0x77, 0xe5, 0x25, 0x34, 0x37, 0x77, 0x01, 0x5f,
-	0xa4, 0xb9, 0xe1, 0x25, 0x34, 0xb7, 0x4d, 0xa0, 0x81, 0x97, 0x01, 0x99, 0x6d, 0x28, 0x69, 0xf7,
-	0x5b, 0x16, 0x34, 0x04, 0x15, 0xa9, 0x3a, 0xf2, 0x9a, 0x61, 0x03, 0xe7, 0x66, 0xc3, 0x5f, 0x83,
-	0x69, 0xb4, 0x4c, 0x55, 0x8c, 0x57, 0x04, 0xa4, 0x0d, 0x20, 0x9b, 0x87, 0x3c, 0x3f, 0x1e, 0x78,
-	0x7d, 0xb1, 0x29, 0x3a, 0x48, 0x86, 0x89, 0x43, 0x57, 0xe4, 0x95, 0x59, 0x8e, 0x2a, 0xdb, 0x7f,
-	0x6e, 0xc1, 0x9c, 0x36, 0x60, 0x41, 0x85, 0x77, 0x41, 0x72, 0x03, 0x0f, 0xf8, 0x72, 0xce, 0x5d,
-	0x36, 0xd9, 0x26, 0xf9, 0xcc, 0x40, 0xc6, 0xcd, 0x74, 0xcf, 0x71, 0x80, 0xd1, 0x68, 0x20, 0x84,
-	0xa8, 0x0e, 0x62, 0x84, 0x74, 0x46, 0xe9, 0x53, 0x85, 0xc2, 0xc5, 0xb8, 0x01, 0xc3, 0xa8, 0x1a,
-	0xb3, 0xa8, 0x15, 0x52, 0x49, 0x44, 0xd5, 0x74, 0xa0, 0xfd, 0xb7, 0x16, 0xcc, 0x73, 0xd7, 0x48,
-	0x38, 0x9e, 0xea, 0xda, 0xd3, 0x24, 0xf7, 0x05, 0x39, 0x47, 0xee, 0x4e, 0x38, 0xa2, 0x4c, 0x3e,
-	0xfb, 0x92, 0xee, 0x9c, 0x4a, 0x76, 0x1b, 0xb3, 0x17, 0xc5, 0xbc, 0xbd, 0x78, 0xc1, 0x4a, 0xe7,
-	0x05, 0x38, 0xcb, 0xb9, 0x01, 0xce, 0xcd, 0x29, 0x28, 0x47, 0x9d, 0x60, 0x48, 0xed, 0x25, 0x58,
-	0x30, 0x27, 0x27, 0x44, 0xd0, 0xb7, 0x2d, 0x68, 0xde, 0xe3, 0x07, 0x01, 0x9e, 0xdf, 0xdb, 0xf5,
-	0xa2, 0x38, 0x08, 0xd5, 0xed, 0xd0, 0xab, 0x00, 0x51, 0xec, 0x86, 0x31, 0xcf, 0xa3, 0x16, 0x81,
-	0xc5, 0x04, 0xc2, 0xc6, 0x48, 0xfd, 0x2e, 0xaf, 0xe5, 0x7b, 0xa3, 0xca, 0x19, 0x1b, 0x42, 0x38,
-	0x6f, 0x86, 0x26, 0xbe, 0xce, 0x93, 0x3f, 0x99, 0xad, 0x40, 0x4f, 0x51, 0xae, 0x73, 0xaf, 0x28,
-	0x05, 0xb5, 0xff, 0xc6, 0x82, 0xd9, 0x64, 0x90, 0x78, 0x2c, 0x6a, 0x4a, 0x07, 0xa1, 0x7e, 0x13,
-	0xe9, 0x20, 0x43, 0x9e, 0x1e, 0xd3, 0xc7, 0x62, 0x6c, 0x1a, 0x04, 0x39, 0x56, 0x94, 0x82, 0x91,
-	0x34, 0x70, 0x74, 0x10, 0x4f, 0xe5, 0x62, 0x96, 0x80, 0xb0, 0x6a, 0x44, 0x09, 0xd3, 0xe0, 0x07,
-	0x31, 0x7e, 0xc5, 0x83, 0xb3, 0xb2, 0x28, 0x55, 0xe9, 0x14, 0x42, 0x51, 0x95, 0xea, 0x87, 0x2a,
-	0x15, 0xbe, 0x3e, 0xb2, 0x6c, 0xff, 0xaa, 0x05, 0x97, 0x72, 0x16, 0x5e, 0x70, 0xcd, 0x36, 0xcc,
-	0x1d, 0xab, 0x4a, 0xb9, 0x38, 0x9c, 0x75, 0x96, 0xe4, 0xa1, 0x9d, 0xb9, 0x20, 0x4e, 0xf6, 0x03,
-	0x65, 0x17, 0xf1, 0xe5, 0x36, 0x92, 0x25, 0xb3, 0x15, 0xf6, 0x01, 0xb4, 0x76, 0x9e, 0x31, 0x26,
-	0xdc, 0xd2, 0x1f, 0x3a, 0x91, 0xb4, 0x70, 0x3b, 0x23, 0x64, 0x2e, 0x76, 0xb4, 0x8f, 0x61, 0xda,
-	0x68, 0x8b, 0x7c, 0xfa, 0x65, 0x1b, 0x49, 0x85, 0xa7, 0xb1, 0xc4, 0x5f, 0x6a, 0x91, 0x29, 0x9b,
-	0x1a, 0xc8, 0x3e, 0x85, 0xd9, 0x77, 0x47, 0xfd, 0xd8, 0x4b, 0x5e, 0x6d, 0x21, 0x9f, 0x15, 0x1f,
-	0x61, 0x13, 0x72, 0xe9, 0x72, 0xbb, 0xd2, 0xf1, 0xd8, 0x8a, 0x0d, 0x58, 0x4b, 0xed, 0x6c, 0x8f,
-	0xd9, 0x0a, 0xfb, 0x12, 0x2c, 0x27, 0x5d, 0xf2, 0xb5, 0x93, 0x82, 0xfa, 0x3b, 0x16, 0xcf, 0x76,
-	0x30, 0x1f, 0x91, 0x21, 0xf7, 0x61, 0x3e, 0xf2, 0xfc, 0x5e, 0x9f, 0xea, 0xed, 0x44, 0x62, 0x25,
-	0x16, 0xcd, 0xe1, 0x89, 0x87, 0x66, 0x9c, 0xbc, 0x2f, 0x18, 0x81, 0xe4, 0x0f, 0x34, 0x21, 0x90,
-	0xd4, 0x92, 0xe4, 0x4d, 0xe0, 0x4b, 0x30, 0x63, 0x76, 0x46, 0xee, 0x88, 0x6c, 0xcb, 0x64, 0x64,
-	0x7a, 0x2c, 0xdb, 0xa4, 0x0c, 0x03, 0xd3, 0xfe, 0xa6, 0x05, 0x4d, 0x87, 0x32, 0x32, 0xa6, 0x5a,
-	0xa7, 0x82, 0x7a, 0xee, 0x66, 0x9a, 0x1d, 0x3f, 0x61, 0x95, 0xc5, 0x29, 0xe7, 0x7a, 0x73, 0xec,
-	0xa6, 0xec, 0x4e, 0xe4, 0xcc, 0x6a, 0xb3, 0x02, 0x93, 0x62, 0x7e, 0xcb, 0xb0, 0x28, 0x86, 0x24,
-	0x87, 0x93, 0x04, 0x4d, 0x8d, 0x4e, 0x8d, 0xa0, 0x69, 0x0b, 0x9a, 0xfc, 0xda, 0xae, 0x3e, 0x0f,
-	0xfe, 0xe1, 0xda, 0x73, 0xa8, 0x69, 0x97, 0x97, 0xc9, 0x32, 0xcc, 0x3f, 0x79, 0xf0, 0x68, 0x7f,
-	0xe7, 0xf0, 0xb0, 0x7d, 0xf0, 0x78, 0xf3, 0x9d, 0x9d, 0xf7, 0xda, 0xbb, 0x1b, 0x87, 0xbb, 0x8d,
-	0x09, 0xb2, 0x04, 0x64, 0x7f, 0xe7, 0xf0, 0xd1, 0xce, 0xb6, 0x01, 0xb7, 0xc8, 0x55, 0x68, 0x3d,
-	0xde, 0x7f, 0x7c, 0xb8, 0xb3, 0xdd, 0xce, 0xfb, 0xae, 0x40, 0x5e, 0x81, 0x4b, 0xa2, 0x3e, 0xe7,
-	0xf3, 0xe2, 0xed, 0x6f, 0x16, 0x61, 0x86, 0x27, 0x5d, 0xf0, 0xb7, 0x87, 0x68, 0x48, 0xde, 0x85,
-	0x29, 0xf1, 0x88, 0x15, 0x91, 0xeb, 0x69, 0x3e, 0x9b, 0xd5, 0x5a, 0x4a, 0x83, 0xc5, 0x22, 0xcc,
-	0xff, 0xec, 0x0f, 0xff, 0xe9, 0xd7, 0x0b, 0xd3, 0xa4, 0xb6, 0x7e, 0xfa, 0xe6, 0x7a, 0x8f, 0xfa,
-	0x11, 0x6b, 0xe3, 0x6b, 0x00, 0xc9, 0xd3, 0x4c, 0xa4, 0xa9, 0x7c, 0xae, 0xd4, 0xbb, 0x55, 0xad,
-	0x4b, 0x39, 0x35, 0xa2, 0xdd, 0x4b, 0xd8, 0xee, 0xbc, 0x3d, 0xc3, 0xda, 0xf5, 0x7c, 0x2f, 0xe6,
-	0xcf, 0x34, 0xbd, 0x6d, 0xad, 0x91, 0x2e, 0xd4, 0xf5, 0x47, 0x93, 0x88, 0x0c, 0xfc, 0xe6, 0x3c,
-	0xfb, 0xd4, 0xba, 0x9c, 0x5b, 0x27, 0x37, 0x10, 0xfb, 0x58, 0xb4, 0x1b, 0xac, 0x8f, 0x11, 0x62,
-	0x24, 0xbd, 0xf4, 0x39, 0x59, 0x27, 0x6f, 0x23, 0x91, 0x2b, 0x1a, 0xa5, 0x65, 0x5e, 0x66, 0x6a,
-	0xbd, 0x32, 0xa6, 0x56, 0xf4, 0xf5, 0x0a, 0xf6, 0xb5, 0x6c, 0x13, 0xd6, 0x57, 0x07, 0x71, 0xe4,
-	0xcb, 0x4c, 0x6f, 0x5b, 0x6b, 0xb7, 0x7f, 0xf3, 0x3a, 0x54, 0xd5, 0x21, 0x0f, 0xf9, 0x00, 0xa6,
-	0x8d, 0xac, 0x18, 0x22, 0xa7, 0x91, 0x97, 0x44, 0xd3, 0xba, 0x92, 0x5f, 0x29, 0x3a, 0xbe, 0x8a,
-	0x1d, 0x37, 0xc9, 0x12, 0xeb, 0x58, 0xa4, 0x95, 0xac, 0x63, 0x7e, 0x17, 0xbf, 0xac, 0xf1, 0x54,
-	0x63, 0x5f, 0xde, 0xd9, 0x95, 0x34, 0x47, 0x19, 0xbd, 0xbd, 0x32, 0xa6, 0x56, 0x74, 0x77, 0x05,
-	0xbb, 0x5b, 0x22, 0x0b, 0x7a, 0x77, 0xea, 0xf0, 0x85, 0xe2, 0x0d, 0x23, 0xfd, 0x59, 0x21, 0xf2,
-	0x8a, 0x22, 0xac, 0xbc, 0xe7, 0x86, 0x14, 0x89, 0x64, 0xdf, 0x1c, 0xb2, 0x9b, 0xd8, 0x15, 0x21,
-	0xb8, 0x7d, 0xfa, 0xab, 0x42, 0xe4, 0x08, 0x6a, 0xda, 0x53, 0x18, 0xe4, 0xd2, 0xd8, 0x67, 0x3b,
-	0x5a, 0xad, 0xbc, 0xaa, 0xbc, 0xa9, 0xe8, 0xed, 0xaf, 0x33, 0xbd, 0xfc, 0x55, 0xa8, 0xaa, 0xc7,
-	0x15, 0xc8, 0xb2, 0xf6, 0xd8, 0x85, 0xfe, 0x18, 0x44, 0xab, 0x99, 0xad, 0xc8, 0x23, 0x3e, 0xbd,
-	0x75, 0x46, 0x7c, 0x4f, 0xa0, 0xa6, 0x3d, 0xa0, 0xa0, 0x26, 0x90, 0x7d, 0xa4, 0x41, 0x4d, 0x20,
-	0xe7, 0xbd, 0x05, 0x7b, 0x0e, 0xbb, 0xa8, 0x91, 0x2a, 0xd2, 0x77, 0xfc, 0x2c, 0x88, 0xc8, 0x1e,
-	0x2c, 0x0a, 0x31, 0x75, 0x44, 0x3f, 0xce, 0x36, 0xe4, 0xbc, 0xe4, 0x74, 0xcb, 0x22, 0x77, 0xa1,
-	0x22, 0xdf, 0xc9, 0x20, 0x4b, 0xf9, 0xef, 0x7d, 0xb4, 0x96, 0x33, 0x70, 0x61, 0x9e, 0xbc, 0x07,
-	0x90, 0xbc, 0xd6, 0xa0, 0x84, 0x44, 0xe6, 0xf5, 0x07, 0x45, 0x01, 0xd9, 0xa7, 0x1d, 0xec, 0x25,
-	0x9c, 0x60, 0x83, 0xa0, 0x90, 0xf0, 0xe9, 0x99, 0xbc, 0x4c, 0xf8, 0x75, 0xa8, 0x69, 0x0f, 0x36,
-	0xa8, 0xe5, 0xcb, 0x3e, 0xf6, 0xa0, 0x96, 0x2f, 0xe7, 0x7d, 0x07, 0xbb, 0x85, 0xad, 0x2f, 0xd8,
-	0xb3, 0xac, 0xf5, 0xc8, 0xeb, 0xf9, 0x03, 0x8e, 0xc0, 0x36, 0xe8, 0x04, 0xa6, 0x8d, 0x57, 0x19,
-	0x14, 0x87, 0xe6, 0xbd, 0xf9, 0xa0, 0x38, 0x34, 0xf7, 0x21, 0x07, 0x49, 0x67, 0xf6, 0x1c, 0xeb,
-	0xe7, 0x14, 0x51, 0xb4, 0x9e, 0xde, 0x87, 0x9a, 0xf6, 0xc2, 0x82, 0x9a, 0x4b, 0xf6, 0x31, 0x07,
-	0x35, 0x97, 0xbc, 0x07, 0x19, 0x16, 0xb0, 0x8f, 0x19, 0x1b, 0x49, 0x01, 0xaf, 0xc5, 0xb1, 0xb6,
-	0x3f, 0x80, 0x19, 0xf3, 0xcd, 0x05, 0xc5, 0xfb, 0xb9, 0xaf, 0x37, 0x28, 0xde, 0x1f, 0xf3, 0x50,
-	0x83, 0x20, 0xe9, 0xb5, 0x79, 0xd5, 0xc9, 0xfa, 0x47, 0x22, 0xf9, 0xe3, 0x39, 0xf9, 0x32, 0x13,
-	0x70, 0xe2, 0x9e, 0x22, 0x59, 0xd6, 0xa8, 0x56, 0xbf, 0xcd, 0xa8, 0xf8, 0x25, 0x73, 0xa5, 0xd1,
-	0x24, 0x66, 0x7e, 0xb1, 0x0f, 0xb5, 0x16, 0xde, 0x57, 0xd4, 0xb4, 0x96, 0x7e, 0xa5, 0x51, 0xd3,
-	0x5a, 0xc6, 0xb5, 0xc6, 0xb4, 0xd6, 0x8a, 0x3d, 0xd6, 0x86, 0x0f, 0xb3, 0xa9, 0xcc, 0x5d, 0xc5,
-	0x15, 0xf9, 0x57, 0x1d, 0x5a, 0x57, 0x5f, 0x9c, 0xf0, 0x6b, 0x4a, 0x10, 0x29, 0x04, 0xd7, 0xe5,
-	0xc5, 0x92, 0xff, 0x0f, 0x75, 0xfd, 0x7e, 0x3b, 0xd1, 0x59, 0x39, 0xdd, 0xd3, 0xe5, 0xdc, 0x3a,
-	0x73, 0x73, 0x49, 0x5d, 0xef, 0x86, 0x7c, 0x05, 0x96, 0x14, 0xab, 0xeb, 0xc9, 0xa0, 0x11, 0x79,
-	0x35, 0x27, 0x45, 0x54, 0x37, 0x5e, 0x5a, 0x97, 0xc6, 0xe6, 0x90, 0xde, 0xb2, 0x18, 0xd1, 0x98,
-	0x17, 0x87, 0x13, 0x85, 0x91, 0x77, 0x5f, 0x3a, 0x51, 0x18, 0xb9, 0xb7, 0x8d, 0x25, 0xd1, 0x90,
-	0x79, 0x63, 0x8d, 0xf8, 0xf9, 0x1c, 0x79, 0x1f, 0x66, 0xb5, 0x74, 0xfb, 0xc3, 0x73, 0xbf, 0xa3,
-	0x18, 0x20, 0x7b, 0x2f, 0xab, 0x95, 0x67, 0x9a, 0xdb, 0xcb, 0xd8, 0xfe, 0x9c, 0x6d, 0x2c, 0x0e,
-	0x23, 0xfe, 0x2d, 0xa8, 0xe9, 0xa9, 0xfc, 0x2f, 0x68, 0x77, 0x59, 0xab, 0xd2, 0xaf, 0x15, 0xdd,
-	0xb2, 0xc8, 0x6f, 0x5b, 0x50, 0x37, 0x12, 0xe3, 0x8d, 0x53, 0xe8, 0x54, 0x3b, 0x4d, 0xbd, 0x4e,
-	0x6f, 0xc8, 0x76, 0x70, 0x90, 0x7b, 0x6b, 0x5f, 0x32, 0x16, 0xe1, 0x23, 0x23, 0xfe, 0x72, 0x33,
-	0xfd, 0x36, 0xd7, 0xf3, 0x34, 0x82, 0x7e, 0x77, 0xed, 0xf9, 0x2d, 0x8b, 0x7c, 0xd7, 0x82, 0x19,
-	0x33, 0x6a, 0xa8, 0xb6, 0x2a, 0x37, 0x3e, 0xa9, 0xb6, 0x6a, 0x4c, 0xa8, 0xf1, 0x7d, 0x1c, 0xe5,
-	0xa3, 0x35, 0xc7, 0x18, 0xa5, 0xb8, 0x52, 0xfe, 0xe3, 0x8d, 0x96, 0xbc, 0xcd, 0x9f, 0xef, 0x93,
-	0xa1, 0x6c, 0xa2, 0x69, 0x8d, 0xf4, 0xf6, 0xea, 0x4f, 0xce, 0xad, 0x5a, 0xb7, 0x2c, 0xf2, 0x75,
-	0xfe, 0x26, 0x95, 0xf8, 0x16, 0xa9, 0xe4, 0x65, 0xbf, 0xb7, 0xaf, 0xe1, 0x9c, 0xae, 0xda, 0x97,
-	0x8c, 0x39, 0xa5, 0xf5, 0xf1, 0x06, 0x1f, 0x9d, 0x78, 0x2d, 0x2e, 0x51, 0x28, 0x99, 0x17, 0xe4,
-	0xc6, 0x0f, 0x72, 0xc0, 0x07, 0x29, 0xd0, 0x0d, 0x52, 0x7e, 0xc9, 0x66, 0xec, 0x35, 0x1c, 0xeb,
-	0x35, 0xfb, 0xd5, 0xb1, 0x63, 0x5d, 0xc7, 0xd8, 0x1f, 0x1b, 0xf1, 0x01, 0x40, 0x72, 0xec, 0x44,
-	0x52, 0xc7, 0x1e, 0x8a, 0xc1, 0xb3, 0x27, 0x53, 0x26, 0xbf, 0xc8, 0xd3, 0x11, 0xd6, 0xe2, 0x57,
-	0xb9, 0xb8, 0x7a, 0x20, 0x0f, 0x4c, 0x74, 0xa3, 0xc4, 0x3c, 0x1f, 0x32, 0x8c, 0x92, 0x74, 0xfb,
-	0x86, 0xb0, 0x52, 0xa7, 0x2f, 0x8f, 0x61, 0x7a, 0x2f, 0x08, 0x9e, 0x8e, 0x86, 0xea, 0x08, 0xd9,
-	0x0c, 0xcb, 0xef, 0xba, 0xd1, 0x49, 0x2b, 0x35, 0x0b, 0x7b, 0x05, 0x9b, 0x6a, 0x91, 0xa6, 0xd6,
-	0xd4, 0xfa, 0x47, 0xc9, 0xb1, 0xd6, 0x73, 0xe2, 0xc2, 0x9c, 0x92, 0x81, 0x6a, 0xe0, 0x2d, 0xb3,
-	0x19, 0x43, 0xf2, 0xa5, 0xbb, 0x30, 0xac, 0x67, 0x39, 0xda, 0xf5, 0x48, 0xb6, 0x79, 0xcb, 0x22,
-	0x07, 0x50, 0xdf, 0xa6, 0x9d, 0xa0, 0x4b, 0x45, 0x6c, 0x7b, 0x3e, 0x19, 0xb8, 0x0a, 0x8a, 0xb7,
-	0xa6, 0x0d, 0xa0, 0xa9, 0x17, 0x86, 0xee, 0x79, 0x48, 0x3f, 0x5c, 0xff, 0x48, 0x44, 0xcd, 0x9f,
-	0x4b, 0xbd, 0x20, 0x8f, 0x15, 0x0c, 0xbd, 0x90, 0x3a, 0x87, 0x30, 0xf4, 0x42, 0xe6, 0x1c, 0xc2,
-	0x58, 0x6a, 0x79, 0xac, 0x41, 0xfa, 0x30, 0x97, 0x39, 0xba, 0x50, 0x2a, 0x61, 0xdc, 0x81, 0x47,
-	0x6b, 0x65, 0x3c, 0x82, 0xd9, 0xdb, 0x9a, 0xd9, 0xdb, 0x21, 0x4c, 0x6f, 0x53, 0xbe, 0x58, 0x3c,
-	0xc3, 0x2d, 0x75, 0xbb, 0x42, 0xcf, 0x9f, 0x4b, 0x0b, 0x70, 0xac, 0x33, 0x15, 0x3f, 0xa6, 0x97,
-	0x91, 0xaf, 0x42, 0xed, 0x3e, 0x8d, 0x65, 0x4a, 0x9b, 0x32, 0x3d, 0x53, 0x39, 0x6e, 0xad, 0x9c,
-	0x8c, 0x38, 0x93, 0x66, 0xb0, 0xb5, 0x75, 0xda, 0xed, 0x51, 0x2e, 0x9c, 0xda, 0x5e, 0xf7, 0x39,
-	0xf9, 0x7f, 0xd8, 0xb8, 0xca, 0xbc, 0x5d, 0xd2, 0xf2, 0x99, 0xf4, 0xc6, 0x67, 0x53, 0xf0, 0xbc,
-	0x96, 0xfd, 0xa0, 0x4b, 0x35, 0x13, 0xc8, 0x87, 0x9a, 0x96, 0x30, 0xae, 0x18, 0x28, 0x9b, 0xdf,
-	0xaf, 0x18, 0x28, 0x27, 0xbf, 0xdc, 0x5e, 0xc5, 0x7e, 0x6c, 0xb2, 0x92, 0xf4, 0xc3, 0x73, 0xca,
-	0x93, 0x9e, 0xd6, 0x3f, 0x72, 0x07, 0xf1, 0x73, 0xf2, 0x04, 0x9f, 0x78, 0xd0, 0xd3, 0xf6, 0x12,
-	0x5b, 0x3a, 0x9d, 0xe1, 0xa7, 0x16, 0x4b, 0xab, 0x32, 0xed, 0x6b, 0xde, 0x15, 0x5a, 0x4a, 0x9f,
-	0x05, 0x38, 0x8c, 0x83, 0xe1, 0xb6, 0x4b, 0x07, 0x81, 0x9f, 0xc8, 0xda, 0x24, 0xc1, 0x2c, 0x91,
-	0x5f, 0x5a, 0x96, 0x19, 0x79, 0xa2, 0x39, 0x1f, 0x46, 0xd6, 0xa3, 0x24, 0xae, 0xb1, 0x39, 0x68,
-	0x6a, 0x41, 0x72, 0xf2, 0xd0, 0x6e, 0x59, 0x64, 0x03, 0x20, 0x39, 0xbb, 0x52, 0xae, 0x44, 0xe6,
-	0x58, 0x4c, 0x89, 0xbd, 0x9c, 0x83, 0xae, 0x03, 0xa8, 0x26, 0x87, 0x21, 0xcb, 0xc9, 0x9d, 0x06,
-	0xe3, 0xe8, 0x44, 0x69, 0xf0, 0xcc, 0x11, 0x85, 0xdd, 0xc0, 0xa5, 0x02, 0x52, 0x61, 0x4b, 0x85,
-	0xe7, 0x0e, 0x1e, 0xcc, 0xf3, 0x01, 0x2a, 0x73, 0x04, 0x53, 0xa6, 0xe4, 0x4c, 0x72, 0x8e, 0x09,
-	0x14, 0x37, 0xe7, 0x46, 0xd9, 0x8d, 0x88, 0x08, 0xa3, 0x56, 0x9e, 0xae, 0xc5, 0x44, 0xf3, 0x00,
-	0xe6, 0x32, 0x61, 0x60, 0xc5, 0xd2, 0xe3, 0x22, 0xf3, 0x8a, 0xa5, 0xc7, 0x46, 0x90, 0xed, 0x45,
-	0xec, 0x72, 0xd6, 0x06, 0xf4, 0x80, 0xce, 0xbc, 0xb8, 0x73, 0xc2, 0xba, 0xfb, 0x8e, 0x05, 0xf3,
-	0x39, 0x51, 0x5e, 0xf2, 0x9a, 0x74, 0xa6, 0xc7, 0x46, 0x80, 0x5b, 0xb9, 0x41, 0x40, 0xfb, 0x10,
-	0xfb, 0x79, 0x97, 0xbc, 0x63, 0x28, 0x36, 0x1e, 0x7f, 0x13, 0x9c, 0xf9, 0x42, 0xa3, 0x22, 0xd7,
-	0xa2, 0xf8, 0x10, 0x96, 0xf9, 0x40, 0x36, 0xfa, 0xfd, 0x54, 0x80, 0xf2, 0x6a, 0xe6, 0x85, 0x6e,
-	0x23, 0xf0, 0xda, 0x1a, 0xff, 0x82, 0xf7, 0x18, 0x73, 0x95, 0x0f, 0x95, 0x8c, 0xa0, 0x91, 0x0e,
-	0xfa, 0x91, 0xf1, 0x6d, 0xb5, 0x5e, 0x35, 0xdc, 0xc2, 0x6c, 0xa0, 0xd0, 0xfe, 0x24, 0x76, 0xf6,
-	0xaa, 0xdd, 0xca, 0x5b, 0x17, 0xee, 0x29, 0xb2, 0xfd, 0xf8, 0x69, 0x15, 0xa1, 0x4c, 0xcd, 0x53,
-	0x76, 0x30, 0x2e, 0xa4, 0xaa, 0x1c, 0xd3, 0xfc, 0x00, 0xe7, 0x75, 0xec, 0x7e, 0xc5, 0xbe, 0x9c,
-	0xd7, 0x7d, 0xc8, 0x3f, 0xe1, 0x2e, 0xea, 0x72, 0x9a, 0xaf, 0xe5, 0x08, 0x56, 0xf2, 0xf6, 0x7b,
-	0xac, 0xaf, 0x91, 0x5a, 0xeb, 0x89, 0x5b, 0xd6, 0xe6, 0x8d, 0xf7, 0x3f, 0xd9, 0xf3, 0xe2, 0x93,
-	0xd1, 0xd1, 0xcd, 0x4e, 0x30, 0x58, 0xef, 0xcb, 0x10, 0x99, 0x48, 0xcf, 0x5d, 0xef, 0xfb, 0xdd,
-	0x75, 0xfc, 0xfe, 0x68, 0x12, 0x1f, 0xfc, 0xff, 0xf4, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xb7,
-	0x24, 0x9f, 0x62, 0x22, 0x60, 0x00, 0x00,
+func init() { proto.RegisterFile("rpc.proto", fileDescriptor_rpc_468d8071bcb69afa) }
+
+var fileDescriptor_rpc_468d8071bcb69afa = []byte{
+	// 9191 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7d, 0x6f, 0x6c, 0x24, 0x49,
+	0x96, 0x57, 0x67, 0x55, 0xd9, 0xae, 0x7a, 0x55, 0xb6, 0xcb, 0xe1, 0x6e, 0xbb, 0x3a, 0xfb, 0xcf,
+	0x78, 0xf3, 0x7a, 0x66, 0x4c, 0xef, 0xd0, 0xee, 0xe9, 0xdd, 0x9d, 0x9d, 0x9d, 0x66, 0xef, 0xce,
+	0x6d, 0xbb, 0xdb, 0xbd, 0xd3, 0xe3, 0xf6, 0xa6, 0xbb, 0x67, 0x6e, 0xff, 0xa0, 0xba, 0x74, 0x55,
+	0xd8, 0xce, 0xe9, 0xac, 0xcc, 0x9a, 0xcc, 0x2c, 0xbb, 0xbd, 0xc3, 0x20, 0xb4, 0x42, 0x20, 0x21,
+	0x10, 0x3a, 0x10, 0x12, 0x87, 0x40, 0x48, 0x77, 0x20, 0x38, 0x21, 0x3e, 0x80, 0xb4, 0x08, 0x09,
+	0x4e, 0xe2, 0x13, 0x48, 0x27, 0x71, 0x08, 0xdd, 0x47, 0x24, 0x24, 0x04, 0x5f, 0xd0, 0x7d, 0x40,
+	0x42, 0xe2, 0x0b, 0xd2, 0x49, 0xe8, 0xbd, 0x88, 0xc8, 0x8c, 0xc8, 0xcc, 0x6a, 0x77, 0xef, 0x2e,
+	0x88, 0x4f, 0xae, 0xf8, 0xbd, 0xc8, 0xf8, 0xfb, 0xe2, 0xc5, 0x8b, 0xf7, 0x5e, 0x84, 0xa1, 0x15,
+	0x8f, 0x07, 0x77, 0xc6, 0x71, 0x94, 0x46, 0x6c, 0x26, 0x08, 0xe3, 0xf1, 0xc0, 0xbe, 0x7e, 0x1c,
+	0x45, 0xc7, 0x01, 0xdf, 0xf0, 0xc6, 0xfe, 0x86, 0x17, 0x86, 0x51, 0xea, 0xa5, 0x7e, 0x14, 0x26,
+	0x22, 0x93, 0xf3, 0x8f, 0x2d, 0x58, 0x78, 0xc4, 0xc3, 0x03, 0xce, 0x87, 0x2e, 0xff, 0x62, 0xc2,
+	0x93, 0x94, 0x7d, 0x1d, 0x96, 0x3c, 0xfe, 0x13, 0xce, 0x87, 0xfd, 0xb1, 0x97, 0x24, 0xe3, 0x93,
+	0xd8, 0x4b, 0x78, 0xcf, 0x5a, 0xb3, 0xd6, 0x3b, 0x6e, 0x57, 0x10, 0xf6, 0x33, 0x9c, 0x7d, 0x0d,
+	0x3a, 0x09, 0x66, 0xe5, 0x61, 0x1a, 0x47, 0xe3, 0xf3, 0x5e, 0x8d, 0xf2, 0xb5, 0x11, 0xdb, 0x11,
+	0x10, 0x7b, 0x17, 0x16, 0x93, 0x13, 0x2f, 0xe6, 0xfd, 0xf4, 0x24, 0xe6, 0xc9, 0x49, 0x14, 0x0c,
+	0x7b, 0xf5, 0x35, 0x6b, 0x7d, 0xde, 0x5d, 0x20, 0xf8, 0x99, 0x42, 0xd9, 0x0d, 0x80, 0x70, 0x32,
+	0xea, 0x13, 0x9a, 0xf4, 0x1a, 0x94, 0xa7, 0x15, 0x4e, 0x46, 0x07, 0x04, 0x38, 0x1f, 0xc2, 0xe2,
+	0x96, 0x3f, 0x3e, 0xe1, 0x31, 0x36, 0x96, 0x30, 0xf6, 0x36, 0x88, 0x32, 0xfa, 0xa3, 0x90, 0x8f,
+	0xa2, 0xd0, 0x1f, 0xf4, 0xac, 0xb5, 0xfa, 0x7a, 0xcb, 0x9d, 0x27, 0xf4, 0x13, 0x09, 0x3a, 0xff,
+	0xdc, 0x82, 0xc5, 0xac, 0x93, 0xc9, 0x38, 0x0a, 0x13, 0xce, 0xee, 0xc2, 0xe5, 0x01, 0x95, 0xd6,
+	0xa7, 0xf6, 0x17, 0x0a, 0x60, 0x83, 0xac, 0x26, 0x55, 0x0a, 0xf6, 0x83, 0x87, 0x02, 0xe7, 0x43,
+	0xfa, 0x4a, 0xf6, 0x76, 0x21, 0x87, 0xf1, 0x03, 0xb6, 0x0d, 0x4c, 0x2f, 0x5a, 0xf6, 0xa7, 0xbe,
+	0x56, 0x5f, 0x6f, 0xdf, 0x5b, 0xb9, 0x43, 0xb3, 0x72, 0xa7, 0xd0, 0x13, 0xb7, 0x3b, 0x30, 0x81,
+	0xc4, 0xf9, 0xc3, 0x1a, 0x2c, 0x3d, 0x0e, 0xfd, 0xf4, 0x33, 0x2f, 0x08, 0x78, 0xaa, 0x26, 0xe7,
+	0x5d, 0x58, 0x3c, 0x23, 0x80, 0x26, 0xe7, 0x2c, 0x8a, 0x87, 0x72, 0x6a, 0x16, 0x04, 0xbc, 0x2f,
+	0xd1, 0xa9, 0xfd, 0xab, 0x4d, 0xed, 0x5f, 0xe5, 0xbc, 0xd7, 0xa7, 0xcc, 0xfb, 0xbb, 0xb0, 0x18,
+	0xf3, 0x41, 0x74, 0xca, 0xe3, 0xf3, 0xfe, 0x99, 0x1f, 0x0e, 0xa3, 0x33, 0x9a, 0xb0, 0x19, 0x77,
+	0x41, 0xc1, 0x9f, 0x11, 0xca, 0x1e, 0xc0, 0xe2, 0xe0, 0xc4, 0x0b, 0x43, 0x1e, 0xf4, 0x0f, 0xbd,
+	0xc1, 0x8b, 0xc9, 0x38, 0xe9, 0xcd, 0xac, 0x59, 0xeb, 0xed, 0x7b, 0x57, 0xd5, 0x48, 0x9c, 0x78,
+	0xe1, 0x03, 0xa2, 0x1c, 0x84, 0xde, 0x38, 0x39, 0x89, 0x52, 0x77, 0x41, 0x7e, 0x21, 0xe0, 0x64,
+	0xca, 0x80, 0xce, 0xbe, 0xe1, 0x80, 0x5e, 0x06, 0xa6, 0x8f, 0xa7, 0xe0, 0x03, 0xe7, 0x9f, 0x58,
+	0xb0, 0xfc, 0x3c, 0x0c, 0xa2, 0xc1, 0x8b, 0x9f, 0x73, 0xa0, 0x2b, 0x46, 0xa2, 0xf6, 0xba, 0x23,
+	0x51, 0x7f, 0xc3, 0x91, 0x70, 0x56, 0xe0, 0xb2, 0xd9, 0x58, 0xd9, 0x0b, 0x0e, 0x57, 0xf0, 0xeb,
+	0x63, 0xae, 0x9a, 0xa5, 0xba, 0xf1, 0xa7, 0xa0, 0x3b, 0x98, 0xc4, 0x31, 0x0f, 0x4b, 0xfd, 0x58,
+	0x94, 0x78, 0xd6, 0x91, 0xaf, 0x41, 0x27, 0xe4, 0x67, 0x79, 0x36, 0xb9, 0x94, 0x43, 0x7e, 0xa6,
+	0xb2, 0x38, 0x3d, 0x58, 0x29, 0x56, 0x23, 0x1b, 0xf0, 0x5f, 0x2c, 0x68, 0x3c, 0x4f, 0x5f, 0x46,
+	0xec, 0x0e, 0x34, 0xd2, 0xf3, 0xb1, 0x10, 0x18, 0x0b, 0xf7, 0x98, 0xec, 0xda, 0xe6, 0x70, 0x18,
+	0xf3, 0x24, 0x79, 0x76, 0x3e, 0xe6, 0x6e, 0xc7, 0x13, 0x89, 0x3e, 0xe6, 0x63, 0x3d, 0x98, 0x93,
+	0x69, 0xaa, 0xb0, 0xe5, 0xaa, 0x24, 0xbb, 0x09, 0xe0, 0x8d, 0xa2, 0x49, 0x98, 0xf6, 0x13, 0x2f,
+	0xa5, 0xa1, 0xaa, 0xbb, 0x1a, 0xc2, 0xae, 0x43, 0x6b, 0xfc, 0xa2, 0x9f, 0x0c, 0x62, 0x7f, 0x9c,
+	0x12, 0xf3, 0xb5, 0xdc, 0x1c, 0x60, 0x5f, 0x87, 0x66, 0x34, 0x49, 0xc7, 0x91, 0x1f, 0xa6, 0x92,
+	0xe1, 0x16, 0x65, 0x5b, 0x9e, 0x4e, 0xd2, 0x7d, 0x84, 0xdd, 0x2c, 0x03, 0xbb, 0x05, 0xf3, 0x83,
+	0x28, 0x3c, 0xf2, 0xe3, 0x91, 0x10, 0x8e, 0xbd, 0x59, 0xaa, 0xcd, 0x04, 0x9d, 0xdf, 0xae, 0x41,
+	0xfb, 0x59, 0xec, 0x85, 0x89, 0x37, 0x40, 0x00, 0x9b, 0x9e, 0xbe, 0xec, 0x9f, 0x78, 0xc9, 0x09,
+	0xf5, 0xb6, 0xe5, 0xaa, 0x24, 0x5b, 0x81, 0x59, 0xd1, 0x50, 0xea, 0x53, 0xdd, 0x95, 0x29, 0xf6,
+	0x1e, 0x2c, 0xa1, 0x84, 0x33, 0xeb, 0xaa, 0x13, 0xb7, 0x94, 0x09, 0x38, 0x00, 0x87, 0x38, 0xd7,
+	0xa2, 0x0a, 0xd1, 0x43, 0x0d, 0x61, 0x0e, 0x74, 0x64, 0x8a, 0xfb, 0xc7, 0x27, 0xa2, 0x9b, 0x33,
+	0xae, 0x81, 0x61, 0x19, 0xa9, 0x3f, 0xe2, 0xfd, 0x24, 0xf5, 0x46, 0x63, 0xd9, 0x2d, 0x0d, 0x21,
+	0x7a, 0x94, 0x7a, 0x41, 0xff, 0x88, 0xf3, 0xa4, 0x37, 0x27, 0xe9, 0x19, 0xc2, 0xde, 0x81, 0x85,
+	0x21, 0x4f, 0xd2, 0xbe, 0x9c, 0x14, 0x9e, 0xf4, 0x9a, 0x24, 0x40, 0x0a, 0x28, 0x72, 0xc6, 0x23,
+	0x9e, 0x6a, 0xa3, 0x93, 0x48, 0x0e, 0x74, 0x9e, 0x00, 0xd3, 0xe0, 0x6d, 0x9e, 0x7a, 0x7e, 0x90,
+	0xb0, 0x0f, 0xa0, 0x93, 0x6a, 0x99, 0x49, 0xec, 0xb6, 0x33, 0x76, 0xd1, 0x3e, 0x70, 0x8d, 0x7c,
+	0xce, 0x23, 0x68, 0x3e, 0xe4, 0xfc, 0x89, 0x3f, 0xf2, 0x53, 0xb6, 0x02, 0x33, 0x47, 0xfe, 0x4b,
+	0x2e, 0x18, 0xba, 0xbe, 0x7b, 0xc9, 0x15, 0x49, 0x66, 0xc3, 0xdc, 0x98, 0xc7, 0x03, 0xae, 0x86,
+	0x7f, 0xf7, 0x92, 0xab, 0x80, 0x07, 0x73, 0x30, 0x13, 0xe0, 0xc7, 0xce, 0x3f, 0x6d, 0x40, 0xfb,
+	0x80, 0x87, 0xd9, 0x42, 0x61, 0xd0, 0xc0, 0x2e, 0xc9, 0xc5, 0x41, 0xbf, 0xd9, 0x5b, 0xd0, 0xa6,
+	0x6e, 0x26, 0x69, 0xec, 0x87, 0xc7, 0x92, 0x3f, 0x01, 0xa1, 0x03, 0x42, 0x58, 0x17, 0xea, 0xde,
+	0x48, 0xf1, 0x26, 0xfe, 0xc4, 0x45, 0x34, 0xf6, 0xce, 0x47, 0xb8, 0xde, 0xb2, 0x59, 0xeb, 0xb8,
+	0x6d, 0x89, 0xed, 0xe2, 0xb4, 0xdd, 0x81, 0x65, 0x3d, 0x8b, 0x2a, 0x7d, 0x86, 0x4a, 0x5f, 0xd2,
+	0x72, 0xca, 0x4a, 0xde, 0x85, 0x45, 0x95, 0x3f, 0x16, 0x8d, 0xa5, 0x79, 0x6c, 0xb9, 0x0b, 0x12,
+	0x56, 0x5d, 0x58, 0x87, 0xee, 0x91, 0x1f, 0x7a, 0x41, 0x7f, 0x10, 0xa4, 0xa7, 0xfd, 0x21, 0x0f,
+	0x52, 0x8f, 0x66, 0x74, 0xc6, 0x5d, 0x20, 0x7c, 0x2b, 0x48, 0x4f, 0xb7, 0x11, 0x65, 0xef, 0x41,
+	0xeb, 0x88, 0xf3, 0x3e, 0x8d, 0x44, 0xaf, 0x69, 0xac, 0x0e, 0x35, 0xba, 0x6e, 0xf3, 0x48, 0xfe,
+	0xc2, 0x72, 0xa3, 0x49, 0x7a, 0x1c, 0xf9, 0xe1, 0x71, 0x1f, 0xe5, 0x51, 0xdf, 0x1f, 0xf6, 0x5a,
+	0x6b, 0xd6, 0x7a, 0xc3, 0x5d, 0x50, 0x38, 0x4a, 0x85, 0xc7, 0xb4, 0x83, 0x53, 0xdd, 0xa2, 0x60,
+	0x10, 0x3b, 0x38, 0x22, 0xa2, 0xa0, 0x77, 0x60, 0x31, 0xf0, 0x92, 0xb4, 0x7f, 0x12, 0x8d, 0xfb,
+	0xe3, 0xc9, 0xe1, 0x0b, 0x7e, 0xde, 0x6b, 0xd3, 0xf8, 0xcc, 0x23, 0xbc, 0x1b, 0x8d, 0xf7, 0x09,
+	0x64, 0xbf, 0x02, 0xf3, 0xfe, 0x71, 0x18, 0xe1, 0x36, 0x1b, 0x46, 0x43, 0x9e, 0xf4, 0x3a, 0x6b,
+	0xf5, 0xf5, 0x8e, 0xdb, 0x91, 0xe0, 0x1e, 0x62, 0xec, 0xdb, 0x79, 0x26, 0x3e, 0x3c, 0xe6, 0x49,
+	0x6f, 0xde, 0x60, 0xa1, 0x9d, 0xe1, 0x31, 0x7f, 0x12, 0x0d, 0xbc, 0x34, 0x8a, 0xb3, 0x0f, 0x11,
+	0x4b, 0xd8, 0x37, 0xf3, 0x0f, 0xc7, 0x9e, 0x1f, 0x27, 0xbd, 0x85, 0xb5, 0xba, 0x36, 0x00, 0x58,
+	0xfa, 0xbe, 0xe7, 0xe7, 0x5f, 0x61, 0x22, 0x71, 0xfe, 0xa5, 0x05, 0x1d, 0xc1, 0x2f, 0x52, 0x81,
+	0xb8, 0x05, 0xf3, 0x6a, 0x5a, 0x78, 0x1c, 0x47, 0xb1, 0x94, 0x01, 0x26, 0xc8, 0x6e, 0x43, 0x57,
+	0x01, 0xe3, 0x98, 0xfb, 0x23, 0xef, 0x98, 0x4b, 0xc1, 0x5a, 0xc2, 0xd9, 0xbd, 0xbc, 0xc4, 0x38,
+	0x9a, 0xa4, 0x5c, 0x6e, 0x0f, 0x1d, 0xd9, 0x30, 0x17, 0x31, 0xd7, 0xcc, 0x82, 0x32, 0xa0, 0x82,
+	0xdf, 0x0c, 0xcc, 0xf9, 0x99, 0x05, 0x0c, 0x9b, 0xfe, 0x2c, 0x12, 0x45, 0x48, 0x76, 0x29, 0xb2,
+	0xaa, 0xf5, 0xda, 0xac, 0x5a, 0x9b, 0xc6, 0xaa, 0xeb, 0x30, 0x4b, 0xcd, 0x52, 0xda, 0x8e, 0xd1,
+	0xf4, 0x07, 0xb5, 0x9e, 0xe5, 0x4a, 0x3a, 0x73, 0x60, 0x46, 0xf4, 0xb1, 0x51, 0xd1, 0x47, 0x41,
+	0x72, 0x7e, 0xc7, 0x82, 0xce, 0x96, 0xd8, 0xff, 0x48, 0x60, 0xb3, 0xbb, 0xc0, 0x8e, 0x26, 0xe1,
+	0x10, 0xf9, 0x30, 0x7d, 0xe9, 0x0f, 0xfb, 0x87, 0xe7, 0x58, 0x15, 0xb5, 0x7b, 0xf7, 0x92, 0x5b,
+	0x41, 0x63, 0xef, 0x41, 0xd7, 0x40, 0x93, 0x34, 0x16, 0xad, 0xdf, 0xbd, 0xe4, 0x96, 0x28, 0x38,
+	0x98, 0xb8, 0x25, 0x4c, 0xd2, 0xbe, 0x1f, 0x0e, 0xf9, 0x4b, 0xa9, 0xa6, 0x1a, 0xd8, 0x83, 0x05,
+	0xe8, 0xe8, 0xdf, 0x39, 0x9f, 0x43, 0x53, 0x6d, 0x28, 0x24, 0x4c, 0x0b, 0xed, 0x72, 0x35, 0x84,
+	0xd9, 0xd0, 0x34, 0x5b, 0xe1, 0x36, 0xdf, 0xa4, 0x6e, 0xe7, 0x57, 0xa1, 0xfb, 0x04, 0xa5, 0x7a,
+	0xe8, 0x87, 0xc7, 0x72, 0x47, 0xc5, 0xad, 0x46, 0x2e, 0x25, 0xc1, 0x7f, 0x32, 0x85, 0xf2, 0xec,
+	0x24, 0x4a, 0x52, 0x59, 0x0f, 0xfd, 0x76, 0xfe, 0x9d, 0x05, 0x6c, 0x27, 0x49, 0xfd, 0x91, 0x97,
+	0xf2, 0x87, 0x3c, 0x63, 0x84, 0xa7, 0xd0, 0xc1, 0xd2, 0x9e, 0x45, 0x9b, 0x62, 0xcf, 0x12, 0xb2,
+	0xf8, 0xeb, 0x6a, 0x21, 0x95, 0x3e, 0xb8, 0xa3, 0xe7, 0x46, 0x2d, 0xff, 0xdc, 0x35, 0x0a, 0x40,
+	0xb9, 0x99, 0x7a, 0xf1, 0x31, 0x4f, 0x69, 0x43, 0x93, 0xea, 0x10, 0x08, 0x68, 0x2b, 0x0a, 0x8f,
+	0xec, 0x5f, 0x83, 0xa5, 0x52, 0x19, 0x28, 0x4c, 0xf3, 0x6e, 0xe0, 0x4f, 0x76, 0x19, 0x66, 0x4e,
+	0xbd, 0x60, 0xc2, 0xe5, 0x2e, 0x2a, 0x12, 0x1f, 0xd5, 0x3e, 0xb4, 0x9c, 0x01, 0x2c, 0x1b, 0xed,
+	0x92, 0x6b, 0xb2, 0x07, 0x73, 0x28, 0xd7, 0x50, 0x5f, 0xa0, 0x3d, 0xc1, 0x55, 0x49, 0x76, 0x0f,
+	0x2e, 0x1f, 0x71, 0x1e, 0x7b, 0x29, 0x25, 0xfb, 0x63, 0x1e, 0xd3, 0x9c, 0xc8, 0x92, 0x2b, 0x69,
+	0xce, 0x7f, 0xb5, 0x60, 0x11, 0xd7, 0xcd, 0x27, 0x5e, 0x78, 0xae, 0xc6, 0xea, 0x49, 0xe5, 0x58,
+	0xad, 0xcb, 0xb1, 0x2a, 0xe4, 0x7e, 0xd3, 0x81, 0xaa, 0x17, 0x07, 0x8a, 0xad, 0x41, 0xc7, 0x68,
+	0xee, 0x8c, 0xd8, 0xa0, 0x13, 0x2f, 0xdd, 0xe7, 0xf1, 0x83, 0xf3, 0x94, 0xff, 0xe2, 0x43, 0xf9,
+	0x0e, 0x74, 0xf3, 0x66, 0xcb, 0x71, 0x64, 0xd0, 0x40, 0xc6, 0x94, 0x05, 0xd0, 0x6f, 0xe7, 0xef,
+	0x5a, 0x22, 0xe3, 0x56, 0xe4, 0x67, 0x9b, 0x3b, 0x66, 0x44, 0x1d, 0x40, 0x65, 0xc4, 0xdf, 0x53,
+	0x95, 0x9f, 0x5f, 0xbc, 0xb3, 0xec, 0x2a, 0x34, 0x13, 0x1e, 0x0e, 0xfb, 0x5e, 0x10, 0xd0, 0x1e,
+	0xd8, 0x74, 0xe7, 0x30, 0xbd, 0x19, 0x04, 0xce, 0xbb, 0xb0, 0xa4, 0xb5, 0xee, 0x15, 0xfd, 0xd8,
+	0x03, 0xf6, 0xc4, 0x4f, 0xd2, 0xe7, 0x61, 0x32, 0xd6, 0xf6, 0xce, 0x6b, 0xd0, 0x1a, 0xf9, 0x21,
+	0xb5, 0x4c, 0xac, 0xdc, 0x19, 0xb7, 0x39, 0xf2, 0x43, 0x6c, 0x57, 0x42, 0x44, 0xef, 0xa5, 0x24,
+	0xd6, 0x24, 0xd1, 0x7b, 0x49, 0x44, 0xe7, 0x43, 0x58, 0x36, 0xca, 0x93, 0x55, 0x7f, 0x0d, 0x66,
+	0x26, 0xe9, 0xcb, 0x48, 0x69, 0x36, 0x6d, 0xc9, 0x21, 0xa8, 0x23, 0xbb, 0x82, 0xe2, 0xdc, 0x87,
+	0xa5, 0x3d, 0x7e, 0x26, 0x17, 0xb2, 0x6a, 0xc8, 0x3b, 0x17, 0xea, 0xcf, 0x44, 0x77, 0xee, 0x00,
+	0xd3, 0x3f, 0xce, 0x17, 0x80, 0xd2, 0xa6, 0x2d, 0x43, 0x9b, 0x76, 0xde, 0x01, 0x76, 0xe0, 0x1f,
+	0x87, 0x9f, 0xf0, 0x24, 0xf1, 0x8e, 0xb3, 0xa5, 0xdf, 0x85, 0xfa, 0x28, 0x39, 0x96, 0xa2, 0x0a,
+	0x7f, 0x3a, 0xdf, 0x80, 0x65, 0x23, 0x9f, 0x2c, 0xf8, 0x3a, 0xb4, 0x12, 0xff, 0x38, 0xf4, 0xd2,
+	0x49, 0xcc, 0x65, 0xd1, 0x39, 0xe0, 0x3c, 0x84, 0xcb, 0x9f, 0xf2, 0xd8, 0x3f, 0x3a, 0xbf, 0xa8,
+	0x78, 0xb3, 0x9c, 0x5a, 0xb1, 0x9c, 0x1d, 0xb8, 0x52, 0x28, 0x47, 0x56, 0x2f, 0xd8, 0x57, 0xce,
+	0x64, 0xd3, 0x15, 0x09, 0x4d, 0xf6, 0xd5, 0x74, 0xd9, 0xe7, 0x3c, 0x07, 0xb6, 0x15, 0x85, 0x21,
+	0x1f, 0xa4, 0xfb, 0x9c, 0xc7, 0xb9, 0x5d, 0x23, 0xe7, 0xd5, 0xf6, 0xbd, 0x55, 0x39, 0xb2, 0x45,
+	0x81, 0x2a, 0x99, 0x98, 0x41, 0x63, 0xcc, 0xe3, 0x11, 0x15, 0xdc, 0x74, 0xe9, 0xb7, 0x73, 0x05,
+	0x96, 0x8d, 0x62, 0xe5, 0xd1, 0xe7, 0x7d, 0xb8, 0xb2, 0xed, 0x27, 0x83, 0x72, 0x85, 0x3d, 0x98,
+	0x1b, 0x4f, 0x0e, 0xfb, 0xf9, 0x4a, 0x54, 0x49, 0xd4, 0x96, 0x8b, 0x9f, 0xc8, 0xc2, 0xfe, 0x92,
+	0x05, 0x8d, 0xdd, 0x67, 0x4f, 0xb6, 0x70, 0xaf, 0xf0, 0xc3, 0x41, 0x34, 0xc2, 0xfd, 0x56, 0x74,
+	0x3a, 0x4b, 0x4f, 0x5d, 0x61, 0xd7, 0xa1, 0x45, 0xdb, 0x34, 0x1e, 0x00, 0xe4, 0xc9, 0x3d, 0x07,
+	0xf0, 0xf0, 0xc1, 0x5f, 0x8e, 0xfd, 0x98, 0x4e, 0x17, 0xea, 0xcc, 0x20, 0xac, 0x2c, 0x65, 0x82,
+	0xf3, 0x07, 0x33, 0x30, 0x27, 0x37, 0x5f, 0xaa, 0x6f, 0x90, 0xfa, 0xa7, 0x5c, 0xb6, 0x44, 0xa6,
+	0x50, 0x05, 0x8a, 0xf9, 0x28, 0x4a, 0x79, 0xdf, 0x98, 0x06, 0x13, 0xc4, 0x5c, 0xea, 0xdc, 0x2b,
+	0x8e, 0x63, 0x75, 0x91, 0xcb, 0x00, 0x71, 0xb0, 0x94, 0x6e, 0xd9, 0x20, 0xdd, 0x52, 0x25, 0x71,
+	0x24, 0x06, 0xde, 0xd8, 0x1b, 0xf8, 0xe9, 0xb9, 0x14, 0x09, 0x59, 0x1a, 0xcb, 0x0e, 0xa2, 0x81,
+	0x87, 0x27, 0xea, 0xc0, 0x0b, 0x07, 0x5c, 0x1d, 0xdc, 0x0c, 0x10, 0x0f, 0x31, 0xb2, 0x49, 0x2a,
+	0x9b, 0x38, 0xe8, 0x14, 0x50, 0xdc, 0xbf, 0x07, 0xd1, 0x68, 0xe4, 0xa7, 0x78, 0xf6, 0x21, 0xbd,
+	0xb8, 0xee, 0x6a, 0x88, 0x38, 0x26, 0x52, 0xea, 0x4c, 0x8c, 0x5e, 0x4b, 0x1d, 0x13, 0x35, 0x10,
+	0x4b, 0xc1, 0x5d, 0x07, 0xc5, 0xd8, 0x8b, 0x33, 0x52, 0x82, 0xeb, 0xae, 0x86, 0xe0, 0x3c, 0x4c,
+	0xc2, 0x84, 0xa7, 0x69, 0xc0, 0x87, 0x59, 0x83, 0xda, 0x94, 0xad, 0x4c, 0x60, 0x77, 0x61, 0x59,
+	0x1c, 0xc7, 0x12, 0x2f, 0x8d, 0x92, 0x13, 0x3f, 0xe9, 0x27, 0x78, 0xb0, 0xe9, 0x50, 0xfe, 0x2a,
+	0x12, 0xfb, 0x10, 0x56, 0x0b, 0x70, 0xcc, 0x07, 0xdc, 0x3f, 0xe5, 0xc3, 0xde, 0x3c, 0x7d, 0x35,
+	0x8d, 0xcc, 0xd6, 0xa0, 0x8d, 0xa7, 0xd0, 0xc9, 0x78, 0xe8, 0xa1, 0x02, 0xb3, 0x40, 0xf3, 0xa0,
+	0x43, 0xec, 0x7d, 0x98, 0x1f, 0x73, 0xa1, 0xfd, 0x9c, 0xa4, 0xc1, 0x20, 0xe9, 0x2d, 0x1a, 0xd2,
+	0x0d, 0x39, 0xd7, 0x35, 0x73, 0x20, 0x53, 0x0e, 0x12, 0x3a, 0x8e, 0x78, 0xe7, 0xbd, 0xae, 0x3c,
+	0x12, 0x28, 0x80, 0xd6, 0x48, 0xec, 0x9f, 0x7a, 0x29, 0xef, 0x2d, 0x09, 0x81, 0x2e, 0x93, 0xf8,
+	0x9d, 0x1f, 0xfa, 0xa9, 0x8f, 0x1a, 0x7c, 0x8f, 0x11, 0x2d, 0x07, 0x70, 0x10, 0x89, 0x3f, 0x92,
+	0xd4, 0x4b, 0x27, 0x49, 0xff, 0x28, 0xf0, 0x8e, 0x93, 0xde, 0xb2, 0xd0, 0x4b, 0x4b, 0x04, 0xe7,
+	0xef, 0x5b, 0x42, 0x48, 0x4b, 0x86, 0xce, 0x84, 0xed, 0x5b, 0xd0, 0x16, 0xac, 0xdc, 0x8f, 0xc2,
+	0xe0, 0x5c, 0x72, 0x37, 0x08, 0xe8, 0x69, 0x18, 0x88, 0x93, 0x48, 0xa8, 0x67, 0x11, 0xf2, 0xa0,
+	0xe3, 0x87, 0x5a, 0xa6, 0xb7, 0xa0, 0x3d, 0x9e, 0x1c, 0x06, 0xfe, 0x40, 0x64, 0xa9, 0x8b, 0x52,
+	0x04, 0x44, 0x19, 0x50, 0xd3, 0x16, 0xbd, 0x12, 0x39, 0x1a, 0x94, 0xa3, 0x2d, 0x31, 0xcc, 0xe2,
+	0x3c, 0x80, 0xcb, 0x66, 0x03, 0xa5, 0xe0, 0xbb, 0x0d, 0x4d, 0xb9, 0x4e, 0x92, 0x5e, 0x9b, 0xc6,
+	0x7a, 0x41, 0xb3, 0x16, 0x85, 0x3c, 0x70, 0x33, 0xba, 0xf3, 0x2f, 0x1a, 0xb0, 0x2c, 0xd1, 0xad,
+	0x20, 0x4a, 0xf8, 0xc1, 0x64, 0x34, 0xf2, 0xe2, 0x8a, 0x05, 0x68, 0x5d, 0xb0, 0x00, 0x6b, 0xe6,
+	0x02, 0xc4, 0x65, 0x71, 0xe2, 0xf9, 0xa1, 0x38, 0x26, 0x88, 0xd5, 0xab, 0x21, 0x6c, 0x1d, 0x16,
+	0x07, 0x41, 0x94, 0x08, 0x95, 0x58, 0x37, 0x56, 0x14, 0xe1, 0xb2, 0xc0, 0x98, 0xa9, 0x12, 0x18,
+	0xfa, 0x82, 0x9f, 0x2d, 0x2c, 0x78, 0x07, 0x3a, 0x58, 0x28, 0x57, 0xf2, 0x6b, 0x4e, 0xa8, 0xc9,
+	0x3a, 0x86, 0xed, 0x29, 0x2e, 0x2f, 0xb1, 0x96, 0x17, 0xab, 0x16, 0x17, 0xda, 0x42, 0x50, 0x3e,
+	0x6a, 0xb9, 0x5b, 0x72, 0x71, 0x95, 0x49, 0xec, 0x21, 0x80, 0xa8, 0x8b, 0x36, 0x69, 0xa0, 0x4d,
+	0xfa, 0x1d, 0x73, 0x46, 0xf4, 0xb1, 0xbf, 0x83, 0x89, 0x49, 0xcc, 0x69, 0xe3, 0xd6, 0xbe, 0x74,
+	0xfe, 0x8a, 0x05, 0x6d, 0x8d, 0xc6, 0xae, 0xc0, 0xd2, 0xd6, 0xd3, 0xa7, 0xfb, 0x3b, 0xee, 0xe6,
+	0xb3, 0xc7, 0x9f, 0xee, 0xf4, 0xb7, 0x9e, 0x3c, 0x3d, 0xd8, 0xe9, 0x5e, 0x42, 0xf8, 0xc9, 0xd3,
+	0xad, 0xcd, 0x27, 0xfd, 0x87, 0x4f, 0xdd, 0x2d, 0x05, 0x5b, 0x6c, 0x05, 0x98, 0xbb, 0xf3, 0xc9,
+	0xd3, 0x67, 0x3b, 0x06, 0x5e, 0x63, 0x5d, 0xe8, 0x3c, 0x70, 0x77, 0x36, 0xb7, 0x76, 0x25, 0x52,
+	0x67, 0x97, 0xa1, 0xfb, 0xf0, 0xf9, 0xde, 0xf6, 0xe3, 0xbd, 0x47, 0xfd, 0xad, 0xcd, 0xbd, 0xad,
+	0x9d, 0x27, 0x3b, 0xdb, 0xdd, 0x06, 0x9b, 0x87, 0xd6, 0xe6, 0x83, 0xcd, 0xbd, 0xed, 0xa7, 0x7b,
+	0x3b, 0xdb, 0xdd, 0x19, 0xe7, 0x3f, 0x5b, 0x70, 0x85, 0x5a, 0x3d, 0x2c, 0x2e, 0x90, 0x35, 0x68,
+	0x0f, 0xa2, 0x68, 0xcc, 0x63, 0x4f, 0x13, 0xff, 0x3a, 0x84, 0xcc, 0x2f, 0x84, 0xed, 0x51, 0x14,
+	0x0f, 0xb8, 0x5c, 0x1f, 0x40, 0xd0, 0x43, 0x44, 0x90, 0xf9, 0xe5, 0xf4, 0x8a, 0x1c, 0x62, 0x79,
+	0xb4, 0x05, 0x26, 0xb2, 0xac, 0xc0, 0xec, 0x61, 0xcc, 0xbd, 0xc1, 0x89, 0x5c, 0x19, 0x32, 0x85,
+	0xc6, 0x4b, 0x75, 0xd6, 0x1a, 0xe0, 0xe8, 0x07, 0x7c, 0x48, 0x1c, 0xd3, 0x74, 0x17, 0x25, 0xbe,
+	0x25, 0x61, 0x94, 0x16, 0xde, 0xa1, 0x17, 0x0e, 0xa3, 0x90, 0x0f, 0xa5, 0x6a, 0x98, 0x03, 0xce,
+	0x3e, 0xac, 0x14, 0xfb, 0x27, 0xd7, 0xd7, 0x07, 0xda, 0xfa, 0x12, 0x9a, 0x9a, 0x3d, 0x7d, 0x36,
+	0xb5, 0xb5, 0xf6, 0x6f, 0x66, 0xa0, 0x81, 0x1b, 0xf7, 0xf4, 0x4d, 0x5e, 0xd7, 0xc5, 0xea, 0x25,
+	0xcb, 0x26, 0x1d, 0x08, 0x85, 0x28, 0x17, 0xdb, 0x9d, 0x86, 0xe4, 0xf4, 0x98, 0x0f, 0x4e, 0x7b,
+	0x33, 0x3a, 0x1d, 0x11, 0x5c, 0x20, 0xa8, 0x28, 0xd3, 0xd7, 0x72, 0x81, 0xa8, 0xb4, 0xa2, 0xd1,
+	0x97, 0x73, 0x39, 0x8d, 0xbe, 0xeb, 0xc1, 0x9c, 0x1f, 0x1e, 0x46, 0x93, 0x70, 0x48, 0x0b, 0xa2,
+	0xe9, 0xaa, 0x24, 0xd9, 0x52, 0x69, 0xa1, 0xfa, 0x23, 0xc5, 0xfe, 0x39, 0xc0, 0xee, 0x41, 0x2b,
+	0x39, 0x0f, 0x07, 0x3a, 0xcf, 0x5f, 0x96, 0xa3, 0x84, 0x63, 0x70, 0xe7, 0xe0, 0x3c, 0x1c, 0x10,
+	0x87, 0xe7, 0xd9, 0xd8, 0xb7, 0xa1, 0x35, 0x4a, 0x8e, 0x65, 0x17, 0x85, 0xe4, 0xba, 0xaa, 0x7f,
+	0xf3, 0x49, 0x72, 0x9c, 0x1c, 0x70, 0x75, 0x2c, 0xca, 0xf3, 0x66, 0x1f, 0x52, 0x0f, 0x3a, 0xd5,
+	0x1f, 0xba, 0x7c, 0x70, 0xaa, 0x7f, 0x48, 0xbd, 0xdb, 0x80, 0x59, 0xb2, 0xb9, 0x28, 0x4b, 0x90,
+	0xd2, 0xf0, 0x9e, 0xf9, 0x23, 0x4e, 0xc6, 0x4e, 0x3e, 0xdc, 0x41, 0xba, 0x2b, 0xb3, 0xd1, 0x46,
+	0x1d, 0x78, 0xe3, 0xfe, 0x80, 0x54, 0xa9, 0x05, 0x71, 0x1e, 0xc9, 0x11, 0x94, 0x35, 0x64, 0xae,
+	0x22, 0x28, 0xc4, 0xbd, 0x0e, 0xc7, 0xc5, 0xc0, 0xec, 0xfb, 0x30, 0x6f, 0xf4, 0xe4, 0xa2, 0xa3,
+	0x57, 0x43, 0x3b, 0x7a, 0xa9, 0x8f, 0xb3, 0xde, 0xbc, 0xc9, 0xc7, 0xce, 0xaf, 0x41, 0x53, 0x8d,
+	0x3b, 0xae, 0xfb, 0xe7, 0x7b, 0x1f, 0xef, 0x3d, 0xfd, 0x6c, 0xaf, 0x7f, 0xf0, 0x83, 0xbd, 0xad,
+	0xee, 0x25, 0xb6, 0x08, 0xed, 0xcd, 0x2d, 0x12, 0x25, 0x04, 0x58, 0x98, 0x65, 0x7f, 0xf3, 0xe0,
+	0x20, 0x43, 0x6a, 0xce, 0x4f, 0x2d, 0xe8, 0x16, 0xc7, 0x06, 0x19, 0x21, 0x55, 0x18, 0xb5, 0xa3,
+	0xe1, 0xe6, 0x00, 0xb6, 0x46, 0xd8, 0xba, 0x84, 0xa2, 0x27, 0x12, 0x72, 0x7f, 0xa0, 0xad, 0xc4,
+	0x1f, 0x6a, 0xfb, 0x83, 0x44, 0x90, 0x25, 0x95, 0x9d, 0x50, 0x2e, 0xf0, 0x2c, 0xed, 0x7c, 0x07,
+	0x4d, 0x1a, 0x09, 0xe9, 0xc0, 0x99, 0xd0, 0x79, 0x1b, 0x16, 0xfc, 0x70, 0x10, 0x4c, 0x86, 0xbc,
+	0x2f, 0x27, 0x54, 0xc8, 0x9d, 0x79, 0x89, 0x52, 0x4b, 0x13, 0xe7, 0x03, 0x58, 0xd2, 0x3e, 0xcd,
+	0x8f, 0x5d, 0x63, 0x04, 0x0a, 0xc7, 0x2e, 0xcc, 0xe4, 0x0a, 0x8a, 0xf3, 0x29, 0xf4, 0xe8, 0xa4,
+	0x38, 0x49, 0xd2, 0x68, 0x54, 0x38, 0xb0, 0x90, 0xda, 0xcf, 0x63, 0x65, 0x05, 0xc6, 0xdf, 0x88,
+	0x11, 0xe3, 0xd7, 0x68, 0xab, 0xa1, 0xdf, 0x88, 0x0d, 0xbd, 0xd4, 0x93, 0x4a, 0x36, 0xfd, 0x76,
+	0xae, 0xc1, 0xd5, 0x8a, 0x72, 0xa5, 0x5e, 0xbf, 0x06, 0x37, 0x0f, 0x26, 0x87, 0xe8, 0x9b, 0x38,
+	0xe4, 0x46, 0x8e, 0xcc, 0x4e, 0xfe, 0x31, 0xcc, 0x1b, 0x84, 0x5f, 0xa8, 0x2d, 0x5d, 0xf4, 0xea,
+	0xa6, 0x8f, 0xc3, 0xa3, 0x48, 0x15, 0xff, 0xb3, 0x19, 0x58, 0xcc, 0x20, 0x39, 0x58, 0xeb, 0xb0,
+	0xe8, 0x0f, 0x79, 0x98, 0xfa, 0xe9, 0x79, 0xdf, 0x30, 0x22, 0x15, 0x61, 0x9c, 0x78, 0x2f, 0xf0,
+	0x3d, 0xe5, 0xa3, 0x11, 0x09, 0x34, 0xaa, 0xa0, 0x72, 0xa8, 0xf4, 0xbd, 0x4c, 0x90, 0x0a, 0xdb,
+	0x55, 0x25, 0x0d, 0xb7, 0x5c, 0xc4, 0xa5, 0x4e, 0x95, 0x7d, 0x22, 0xce, 0x21, 0x55, 0x24, 0x64,
+	0x49, 0x51, 0x12, 0x4e, 0xeb, 0x4c, 0xe6, 0x15, 0x16, 0x40, 0xc9, 0x09, 0x32, 0x2b, 0x14, 0x82,
+	0xa2, 0x13, 0x44, 0x73, 0xa4, 0x34, 0x4b, 0x8e, 0x14, 0x54, 0x18, 0xce, 0xc3, 0x01, 0x1f, 0xf6,
+	0xd3, 0xa8, 0x4f, 0x8a, 0x0d, 0xc9, 0xc0, 0xa6, 0x5b, 0x84, 0xd9, 0x75, 0x98, 0x4b, 0x79, 0x92,
+	0x86, 0x5c, 0x58, 0xb7, 0x9b, 0x64, 0xd3, 0x54, 0x10, 0xce, 0xc4, 0x24, 0xf6, 0x85, 0xb9, 0xba,
+	0xe5, 0xd2, 0x6f, 0xf6, 0x4d, 0xb8, 0x72, 0xc8, 0xd1, 0xe6, 0xcd, 0xbd, 0x21, 0x8f, 0xfb, 0xf9,
+	0xe2, 0x12, 0xba, 0x78, 0x35, 0x11, 0x25, 0xf5, 0x29, 0x8f, 0x13, 0x3f, 0x0a, 0x49, 0x2e, 0xb5,
+	0x5c, 0x95, 0xc4, 0xf2, 0xb0, 0xf3, 0x7e, 0x58, 0x18, 0x26, 0x92, 0x4e, 0xf3, 0x6e, 0x35, 0x91,
+	0xdd, 0x82, 0x59, 0xea, 0x40, 0xd2, 0xeb, 0x1a, 0x86, 0xd9, 0x2d, 0x04, 0x5d, 0x49, 0xa3, 0x73,
+	0xd2, 0x18, 0xb5, 0x58, 0x3c, 0x07, 0x52, 0x4f, 0x96, 0x84, 0xb3, 0xc7, 0x44, 0xd9, 0x07, 0xb0,
+	0x82, 0xd5, 0x90, 0xc5, 0x8c, 0x4c, 0xfd, 0x7c, 0x88, 0xc6, 0x91, 0x30, 0x21, 0x3d, 0xbd, 0xe1,
+	0x4e, 0xa1, 0x2a, 0xf7, 0x17, 0x3f, 0xf5, 0x07, 0x08, 0x8a, 0x19, 0x5d, 0xa6, 0x4f, 0xca, 0x84,
+	0xef, 0x35, 0x9a, 0xed, 0x6e, 0xc7, 0xf9, 0x36, 0xcc, 0x50, 0x23, 0x91, 0x05, 0xc5, 0xd4, 0x08,
+	0x16, 0x15, 0x09, 0x1c, 0xa8, 0x90, 0xa7, 0x67, 0x51, 0xfc, 0x42, 0xb9, 0x0f, 0x65, 0xd2, 0xf9,
+	0x09, 0x19, 0x01, 0x32, 0x77, 0xda, 0x73, 0x3a, 0xc1, 0xa0, 0x29, 0x47, 0x4c, 0x7c, 0x72, 0xe2,
+	0xc9, 0x95, 0xd5, 0x24, 0xe0, 0xe0, 0xc4, 0x43, 0x55, 0xc5, 0xe0, 0x25, 0x61, 0xea, 0x69, 0x13,
+	0xb6, 0x4b, 0x10, 0xbb, 0x05, 0x0b, 0xca, 0x51, 0x97, 0xf4, 0x03, 0x7e, 0x94, 0x2a, 0x43, 0x6d,
+	0x38, 0x19, 0x61, 0x75, 0xc9, 0x13, 0x7e, 0x94, 0x3a, 0x7b, 0xb0, 0x24, 0xd5, 0x87, 0xa7, 0x63,
+	0xae, 0xaa, 0xfe, 0x4e, 0x95, 0x1a, 0xde, 0xbe, 0xb7, 0x6c, 0xea, 0x1b, 0xc2, 0x35, 0x69, 0xe6,
+	0x74, 0x5c, 0x60, 0xba, 0x3a, 0x22, 0x0b, 0x94, 0xba, 0xb0, 0x32, 0x45, 0xcb, 0xee, 0x18, 0x18,
+	0x8e, 0x4f, 0x32, 0x19, 0x0c, 0x94, 0x7b, 0xb5, 0xe9, 0xaa, 0x24, 0x46, 0x7e, 0x2c, 0x53, 0x69,
+	0xb2, 0x64, 0x25, 0x02, 0x3f, 0x7c, 0x83, 0x66, 0x76, 0x06, 0x5a, 0x0a, 0x67, 0x48, 0x57, 0x02,
+	0x45, 0xe2, 0xcd, 0xcd, 0x7e, 0x8d, 0xa2, 0xd9, 0xcf, 0xf9, 0xdb, 0x16, 0x2c, 0x09, 0x3d, 0x8c,
+	0x0e, 0x75, 0xb2, 0xfb, 0x7f, 0x06, 0xe6, 0x85, 0x42, 0x2d, 0x65, 0x8c, 0x6c, 0x68, 0xae, 0x99,
+	0x10, 0x2a, 0x32, 0xef, 0x5e, 0x72, 0xcd, 0xcc, 0xec, 0xbe, 0xd8, 0xb4, 0xfa, 0x84, 0x56, 0x38,
+	0xe2, 0xcd, 0xb1, 0xde, 0xbd, 0xe4, 0x6a, 0xd9, 0x1f, 0x34, 0x61, 0x56, 0x9c, 0x88, 0x9d, 0x47,
+	0x30, 0x6f, 0x54, 0x64, 0x98, 0x1c, 0x3b, 0xc2, 0xe4, 0x58, 0xb2, 0xed, 0xd7, 0x2a, 0x6c, 0xfb,
+	0xff, 0xac, 0x0e, 0x0c, 0x99, 0xa5, 0x30, 0x1b, 0x78, 0x24, 0x8f, 0x86, 0x86, 0x81, 0xa5, 0xe3,
+	0xea, 0x10, 0xbb, 0x03, 0x4c, 0x4b, 0x2a, 0x17, 0x8d, 0xd8, 0x85, 0x2b, 0x28, 0x28, 0xb4, 0xa5,
+	0xc2, 0x2e, 0x55, 0x6b, 0x69, 0x4a, 0x12, 0xc3, 0x5e, 0x49, 0xc3, 0x1d, 0x7c, 0x3c, 0x41, 0xff,
+	0x8f, 0x97, 0x2a, 0x13, 0x8c, 0x4a, 0x17, 0xe7, 0x77, 0xf6, 0xc2, 0xf9, 0x9d, 0x2b, 0x99, 0x75,
+	0x35, 0x23, 0x40, 0xd3, 0x34, 0x02, 0xdc, 0x82, 0x79, 0x34, 0xcb, 0xa2, 0x25, 0xa1, 0x3f, 0xc2,
+	0xda, 0xa5, 0xc5, 0xc5, 0x00, 0xd1, 0xc9, 0x26, 0x8f, 0x18, 0xb9, 0xa5, 0x41, 0x38, 0x1f, 0x4b,
+	0x38, 0xee, 0x26, 0xb9, 0xa1, 0xb7, 0x4d, 0x8d, 0xcd, 0x01, 0x94, 0x50, 0x09, 0x72, 0x48, 0x7f,
+	0x12, 0x4a, 0x5f, 0x3c, 0x1f, 0x92, 0xad, 0xa5, 0xe9, 0x96, 0x09, 0xce, 0xdf, 0xb0, 0xa0, 0x8b,
+	0x73, 0x66, 0xb0, 0xe5, 0x47, 0x40, 0xab, 0xe2, 0x35, 0xb9, 0xd2, 0xc8, 0xcb, 0x3e, 0x84, 0x16,
+	0xa5, 0xa3, 0x31, 0x0f, 0x25, 0x4f, 0xf6, 0x4c, 0x9e, 0xcc, 0xe5, 0xc9, 0xee, 0x25, 0x37, 0xcf,
+	0xac, 0x71, 0xe4, 0x7f, 0xb0, 0xa0, 0x2d, 0x6b, 0xf9, 0xb9, 0x0d, 0x89, 0xb6, 0x16, 0x3c, 0x21,
+	0x38, 0x29, 0x4b, 0xe3, 0x66, 0x39, 0x42, 0x6b, 0x2d, 0x6a, 0x07, 0x86, 0x11, 0xb1, 0x08, 0xe3,
+	0x56, 0x4f, 0xa2, 0x33, 0xe9, 0xa7, 0x7e, 0xd0, 0x57, 0x54, 0x19, 0xa6, 0x50, 0x45, 0x42, 0x09,
+	0x92, 0xa4, 0xe8, 0x22, 0x15, 0xbb, 0xb8, 0x48, 0xa0, 0xb5, 0x54, 0x76, 0xa8, 0x70, 0x3c, 0x75,
+	0x7e, 0xbf, 0x03, 0xab, 0x25, 0x52, 0x16, 0xe0, 0x25, 0xad, 0x63, 0x81, 0x3f, 0x3a, 0x8c, 0xb2,
+	0xb3, 0xbd, 0xa5, 0x1b, 0xce, 0x0c, 0x12, 0x3b, 0x86, 0x2b, 0x4a, 0x5d, 0xc1, 0x31, 0xcd, 0xb7,
+	0xd6, 0x1a, 0xed, 0x99, 0xef, 0x9b, 0x53, 0x58, 0xac, 0x50, 0xe1, 0xfa, 0x22, 0xae, 0x2e, 0x8f,
+	0x9d, 0x40, 0x4f, 0x11, 0x94, 0xb0, 0xd6, 0x74, 0x27, 0xac, 0xeb, 0xbd, 0x0b, 0xea, 0x32, 0x4e,
+	0xb3, 0xee, 0xd4, 0xd2, 0xd8, 0x39, 0xdc, 0x54, 0x34, 0x92, 0xc6, 0xe5, 0xfa, 0x1a, 0xaf, 0xd5,
+	0x37, 0x3a, 0xa7, 0x9b, 0x95, 0x5e, 0x50, 0x30, 0xfb, 0x1c, 0x56, 0xce, 0x3c, 0x3f, 0x55, 0xcd,
+	0xd2, 0x34, 0x95, 0x19, 0xaa, 0xf2, 0xde, 0x05, 0x55, 0x7e, 0x26, 0x3e, 0x36, 0xb6, 0xa8, 0x29,
+	0x25, 0xda, 0x7f, 0x60, 0xc1, 0x82, 0x59, 0x0e, 0xb2, 0xa9, 0x5c, 0xfb, 0x4a, 0x06, 0x2a, 0xdd,
+	0xb6, 0x00, 0x97, 0xcd, 0x63, 0xb5, 0x2a, 0xf3, 0x98, 0x6e, 0x94, 0xaa, 0x5f, 0x64, 0x85, 0x6e,
+	0xbc, 0x9e, 0x15, 0x7a, 0xa6, 0xca, 0x0a, 0x6d, 0xff, 0x2f, 0x0b, 0x58, 0x99, 0x97, 0xd8, 0x23,
+	0x61, 0x9f, 0x0b, 0x79, 0x20, 0x45, 0xca, 0x9f, 0x7e, 0x3d, 0x7e, 0x54, 0x63, 0xa7, 0xbe, 0xc6,
+	0x85, 0xa1, 0xc7, 0x19, 0xe9, 0xca, 0xce, 0xbc, 0x5b, 0x45, 0x2a, 0xd8, 0xc5, 0x1b, 0x17, 0xdb,
+	0xc5, 0x67, 0x2e, 0xb6, 0x8b, 0xcf, 0x16, 0xed, 0xe2, 0xf6, 0x5f, 0xb4, 0x60, 0xb9, 0x62, 0xd2,
+	0x7f, 0x79, 0x1d, 0xc7, 0x69, 0x32, 0x64, 0x41, 0x4d, 0x4e, 0x93, 0x0e, 0xda, 0x7f, 0x0e, 0xe6,
+	0x0d, 0x46, 0xff, 0xe5, 0xd5, 0x5f, 0xd4, 0xd7, 0x04, 0x9f, 0x19, 0x98, 0xfd, 0xc7, 0x35, 0x60,
+	0xe5, 0xc5, 0xf6, 0xff, 0xb4, 0x0d, 0xe5, 0x71, 0xaa, 0x57, 0x8c, 0xd3, 0xff, 0xd5, 0x7d, 0xe0,
+	0x3d, 0x58, 0x92, 0xc1, 0x93, 0x9a, 0x55, 0x56, 0x70, 0x4c, 0x99, 0x80, 0x1a, 0xab, 0xe9, 0x94,
+	0x68, 0x1a, 0x91, 0x40, 0xda, 0x66, 0x58, 0xf0, 0x4d, 0x38, 0x36, 0xf4, 0xe4, 0x08, 0xed, 0x9c,
+	0xf2, 0x30, 0x95, 0x27, 0xf4, 0x31, 0xf2, 0xbe, 0xf3, 0xb3, 0x3a, 0x30, 0x9d, 0x28, 0xb7, 0xf7,
+	0x6f, 0x42, 0x47, 0x17, 0xe6, 0x72, 0x3a, 0x0a, 0x46, 0x79, 0xdc, 0xd8, 0xf5, 0x5c, 0x6c, 0x1b,
+	0x16, 0x48, 0x64, 0x0d, 0xb3, 0xef, 0x6a, 0x6b, 0xd6, 0xab, 0x8d, 0x8d, 0xbb, 0x97, 0xdc, 0xc2,
+	0x37, 0xec, 0xbb, 0xb0, 0x60, 0x1e, 0xec, 0x7a, 0xf5, 0xa9, 0xba, 0x39, 0x7e, 0x6e, 0x66, 0x66,
+	0x9b, 0xd0, 0x2d, 0x9e, 0x0c, 0x7b, 0x8d, 0x57, 0x15, 0x50, 0xca, 0xce, 0x3e, 0x94, 0xf6, 0x87,
+	0x19, 0x32, 0x02, 0xde, 0x32, 0x3f, 0xd3, 0x86, 0xe9, 0x8e, 0xf8, 0xa3, 0xf9, 0xab, 0x7f, 0x0c,
+	0x90, 0x63, 0x68, 0x8d, 0x7a, 0xba, 0xbf, 0xb3, 0xd7, 0xdf, 0xda, 0xdd, 0xdc, 0xdb, 0xdb, 0x79,
+	0xd2, 0xbd, 0xc4, 0x18, 0x2c, 0x90, 0xcd, 0x7a, 0x3b, 0xc3, 0x2c, 0xc4, 0xa4, 0x11, 0x4b, 0x61,
+	0x35, 0x34, 0x68, 0x3f, 0xde, 0x2b, 0xa0, 0xf5, 0x07, 0xad, 0x6c, 0x7d, 0x60, 0x88, 0xac, 0x08,
+	0x8e, 0x7d, 0x20, 0xd8, 0x43, 0xe9, 0x0a, 0x7f, 0xcf, 0x82, 0x2b, 0x05, 0x42, 0x1e, 0xc9, 0x25,
+	0xd4, 0x01, 0x53, 0x47, 0x30, 0x41, 0xf2, 0x38, 0x29, 0xcd, 0xaf, 0x20, 0x41, 0xca, 0x04, 0xe4,
+	0xf9, 0x49, 0x58, 0x82, 0xe5, 0x4a, 0xaa, 0x22, 0x39, 0xab, 0x22, 0x84, 0x97, 0x82, 0x7d, 0x8d,
+	0x86, 0x1f, 0xc1, 0x4a, 0x91, 0x90, 0x7b, 0xfb, 0xcd, 0x26, 0xab, 0x24, 0x2a, 0xf9, 0x86, 0xea,
+	0x61, 0xb6, 0xb7, 0x92, 0xe6, 0xfc, 0xdb, 0x3a, 0xb0, 0xef, 0x4f, 0x78, 0x7c, 0x4e, 0x41, 0x58,
+	0x99, 0x35, 0x6e, 0xb5, 0x68, 0xe0, 0x46, 0x2f, 0xfb, 0xc7, 0xfc, 0x5c, 0x05, 0x3f, 0xd6, 0xf4,
+	0xe0, 0x47, 0x0a, 0xe0, 0xcf, 0x42, 0xc0, 0xac, 0xf5, 0x19, 0x32, 0x90, 0xa0, 0xb9, 0x46, 0x14,
+	0x5a, 0x19, 0xa3, 0xd8, 0xb8, 0x38, 0x46, 0x71, 0xe6, 0xa2, 0x18, 0xc5, 0x52, 0xc8, 0xe0, 0xec,
+	0xeb, 0x84, 0x0c, 0xce, 0xbd, 0x66, 0xc8, 0xe0, 0x2d, 0x58, 0x48, 0xa2, 0x09, 0xaa, 0x39, 0x6a,
+	0x28, 0x84, 0x11, 0xa9, 0x23, 0xd0, 0x7d, 0x31, 0x20, 0xa5, 0xc0, 0xc2, 0xd6, 0x6b, 0x04, 0x16,
+	0x56, 0x46, 0x57, 0x42, 0x65, 0x74, 0xe5, 0x6b, 0x86, 0x4f, 0x3a, 0x77, 0xa0, 0xa9, 0xea, 0xc2,
+	0xe3, 0xe8, 0x51, 0x1c, 0x8d, 0xd4, 0x71, 0x14, 0x7f, 0xb3, 0x05, 0xa8, 0xa5, 0x91, 0x3c, 0x4a,
+	0xd6, 0xd2, 0xc8, 0xf9, 0x01, 0xb4, 0xb5, 0xae, 0x53, 0x10, 0x67, 0x6e, 0xce, 0x95, 0x36, 0x60,
+	0x89, 0x3c, 0x1e, 0xe2, 0x35, 0x81, 0xa1, 0x1f, 0x73, 0x8a, 0xc7, 0xed, 0xc7, 0x1c, 0xed, 0x52,
+	0xea, 0xc4, 0xdf, 0xcd, 0x08, 0xae, 0xc0, 0x9d, 0xfb, 0xb0, 0x6c, 0xb0, 0x54, 0xb6, 0xe2, 0x54,
+	0x9c, 0xa0, 0x55, 0x8e, 0x13, 0x54, 0x31, 0x82, 0xce, 0x5f, 0xae, 0x41, 0x7d, 0x37, 0x1a, 0xeb,
+	0x9e, 0x49, 0xcb, 0xf4, 0x4c, 0x4a, 0xd5, 0xad, 0x9f, 0x69, 0x66, 0x72, 0x47, 0x37, 0x40, 0x76,
+	0x1b, 0x16, 0xbc, 0x51, 0x8a, 0x46, 0xbc, 0xa3, 0x28, 0x3e, 0xf3, 0x62, 0x61, 0xa3, 0xae, 0x13,
+	0x6b, 0x16, 0x28, 0xec, 0x32, 0xd4, 0x33, 0x1d, 0x87, 0x32, 0x60, 0x12, 0xcf, 0x49, 0x14, 0x21,
+	0x71, 0x2e, 0xed, 0x8f, 0x32, 0x85, 0xab, 0xdc, 0xfc, 0x5e, 0x1c, 0x52, 0xc5, 0x4e, 0x55, 0x45,
+	0x42, 0x35, 0x12, 0xb9, 0x9a, 0xb2, 0x49, 0xf7, 0x8c, 0x4a, 0xeb, 0xae, 0xa4, 0xa6, 0x19, 0x2f,
+	0xf2, 0xdf, 0x2d, 0x98, 0xa1, 0xb1, 0xc1, 0x5d, 0x57, 0x88, 0xa5, 0xcc, 0x39, 0x49, 0x63, 0x32,
+	0xef, 0x16, 0x61, 0xe6, 0x18, 0x91, 0xdd, 0xb5, 0xac, 0x43, 0x1a, 0xca, 0xd6, 0xa0, 0x25, 0x52,
+	0x59, 0x14, 0xb3, 0x58, 0xaf, 0x19, 0xc8, 0x6e, 0x62, 0x18, 0xe1, 0x58, 0x1d, 0x13, 0x40, 0xf9,
+	0xf9, 0xa3, 0xb1, 0x4b, 0x78, 0xde, 0x1e, 0x2c, 0x4f, 0x74, 0x4b, 0x28, 0x7f, 0x45, 0x18, 0xd5,
+	0xdf, 0xac, 0x58, 0x7d, 0x98, 0x0a, 0xa8, 0x73, 0x1b, 0x16, 0x91, 0x7b, 0x35, 0xdb, 0xf5, 0x54,
+	0x11, 0xe4, 0xfc, 0x05, 0x0b, 0x9a, 0x2a, 0x33, 0x5b, 0x87, 0x06, 0x2e, 0xfd, 0xc2, 0x81, 0x3b,
+	0x8b, 0xef, 0xc1, 0x7c, 0x2e, 0xe5, 0x40, 0x25, 0x88, 0x8c, 0x78, 0xf9, 0xf9, 0x4e, 0x99, 0xf0,
+	0x32, 0x2c, 0x6f, 0x6e, 0x41, 0xeb, 0x2f, 0xa0, 0xce, 0xef, 0x59, 0x30, 0x6f, 0xd4, 0x81, 0x26,
+	0x1b, 0x5a, 0xa6, 0xe2, 0x3c, 0x2e, 0xa7, 0x47, 0x87, 0xf4, 0x89, 0xae, 0x99, 0x3e, 0xc3, 0xcc,
+	0xce, 0x5e, 0xd7, 0xed, 0xec, 0x77, 0xa1, 0x95, 0xc7, 0xdf, 0x37, 0x0c, 0x99, 0x85, 0x35, 0xaa,
+	0xc8, 0xa5, 0x3c, 0x13, 0x96, 0x33, 0x88, 0x82, 0x28, 0x96, 0x0e, 0x76, 0x91, 0x70, 0xee, 0x43,
+	0x5b, 0xcb, 0xaf, 0xdb, 0x4e, 0x2d, 0xc3, 0x76, 0x9a, 0x85, 0xf5, 0xd5, 0xf2, 0xb0, 0x3e, 0xe7,
+	0x7f, 0x58, 0x30, 0x8f, 0x3c, 0xe8, 0x87, 0xc7, 0xfb, 0x51, 0xe0, 0x0f, 0xce, 0x69, 0xee, 0x15,
+	0xbb, 0x49, 0x51, 0xae, 0x78, 0xd1, 0x84, 0x91, 0xeb, 0x95, 0xc5, 0x46, 0x2e, 0xd1, 0x2c, 0x8d,
+	0x6b, 0x18, 0x57, 0xc0, 0xa1, 0x97, 0xc8, 0x65, 0x21, 0xb5, 0x4d, 0x03, 0xc4, 0x95, 0x86, 0x00,
+	0x19, 0x95, 0x47, 0x7e, 0x10, 0xf8, 0x22, 0xaf, 0x38, 0x8b, 0x54, 0x91, 0xb0, 0xce, 0xa1, 0x9f,
+	0x78, 0x87, 0xb9, 0xd3, 0x38, 0x4b, 0x63, 0x9d, 0x18, 0xd0, 0x97, 0x9b, 0x95, 0x66, 0x49, 0xae,
+	0x98, 0xa0, 0xf3, 0xaf, 0x6a, 0xd0, 0x56, 0xaa, 0xcd, 0xf0, 0x98, 0x17, 0xfc, 0x5c, 0x42, 0x14,
+	0x69, 0x88, 0xa2, 0x1b, 0xa7, 0x48, 0x0d, 0x29, 0x32, 0x46, 0xbd, 0xcc, 0x18, 0xe8, 0xea, 0x88,
+	0x86, 0xfc, 0x7d, 0x3a, 0xae, 0xca, 0x2b, 0x2d, 0x19, 0xa0, 0xa8, 0xf7, 0x88, 0x3a, 0x93, 0x53,
+	0x09, 0x78, 0x65, 0xd4, 0xc4, 0x87, 0xd0, 0x91, 0xc5, 0xd0, 0xcc, 0xf5, 0xe6, 0x8c, 0x25, 0x62,
+	0xcc, 0xaa, 0x6b, 0xe4, 0x54, 0x5f, 0xde, 0x53, 0x5f, 0x36, 0x2f, 0xfa, 0x52, 0xe5, 0x74, 0x1e,
+	0x65, 0xc1, 0x28, 0x8f, 0x62, 0x6f, 0x7c, 0xa2, 0xd6, 0xf2, 0x5d, 0x58, 0x56, 0xce, 0xbd, 0x49,
+	0xe8, 0x85, 0x61, 0x34, 0x09, 0x07, 0x5c, 0xc5, 0xf5, 0x55, 0x91, 0x9c, 0x21, 0x74, 0xf4, 0x82,
+	0xd8, 0x6d, 0x98, 0x11, 0x5b, 0xbc, 0xd8, 0x3b, 0xaa, 0x17, 0xba, 0xc8, 0xc2, 0xd6, 0x61, 0x46,
+	0xec, 0xf4, 0x35, 0x63, 0xd5, 0x68, 0xb3, 0xea, 0x8a, 0x0c, 0x28, 0x76, 0x68, 0x9b, 0x35, 0xc5,
+	0x8e, 0xb9, 0xef, 0xa0, 0x9f, 0x24, 0x7c, 0x3c, 0xc4, 0x9b, 0x64, 0x7b, 0x62, 0xa5, 0x68, 0xd9,
+	0x9d, 0xdf, 0x6f, 0x40, 0x5b, 0x83, 0x51, 0x82, 0x1c, 0x63, 0x83, 0xfb, 0x43, 0xdf, 0x1b, 0xf1,
+	0x54, 0x7a, 0xf2, 0xe6, 0xdd, 0x02, 0x8a, 0xf9, 0xbc, 0xd3, 0xe3, 0x7e, 0x34, 0x49, 0xfb, 0x43,
+	0x7e, 0x1c, 0x73, 0xb1, 0x9b, 0x5a, 0x6e, 0x01, 0xc5, 0x7c, 0xc8, 0x9f, 0x5a, 0x3e, 0x79, 0x8d,
+	0xd2, 0x44, 0x95, 0xbf, 0x4c, 0x8c, 0x51, 0x7e, 0x8b, 0x52, 0x00, 0x25, 0xd9, 0x37, 0x53, 0x21,
+	0xfb, 0x3e, 0x80, 0x15, 0x21, 0xe5, 0xa4, 0x3c, 0xe8, 0x17, 0x18, 0x6b, 0x0a, 0x15, 0xed, 0xb0,
+	0xd8, 0x66, 0xb5, 0x24, 0x12, 0xff, 0x27, 0xc2, 0xda, 0x6b, 0xb9, 0x25, 0x1c, 0xf3, 0x92, 0xd9,
+	0x55, 0xcf, 0x2b, 0xa2, 0x74, 0x4a, 0x38, 0xe5, 0xf5, 0x5e, 0x1a, 0x98, 0x34, 0x04, 0x97, 0x70,
+	0x8c, 0x7e, 0x1b, 0xf1, 0xa1, 0xef, 0x99, 0x45, 0x90, 0xe5, 0x5a, 0x84, 0xe2, 0x4d, 0x23, 0xb3,
+	0x5f, 0x05, 0x1b, 0x47, 0x21, 0x3d, 0x89, 0x23, 0x11, 0x25, 0x44, 0x93, 0xaf, 0x82, 0xe1, 0xda,
+	0xc4, 0x01, 0xaf, 0xc8, 0x51, 0xfe, 0x7e, 0xcc, 0x79, 0x9c, 0x7d, 0xdf, 0xa9, 0xfa, 0x5e, 0xcf,
+	0xe1, 0xfc, 0x23, 0x0b, 0xae, 0x8a, 0x23, 0x12, 0xf1, 0xf9, 0x03, 0x2f, 0x44, 0xf7, 0xb6, 0x62,
+	0xc6, 0xeb, 0xd0, 0x3a, 0xf4, 0xc2, 0x7e, 0xce, 0xf9, 0x2d, 0x37, 0x07, 0x50, 0xb4, 0x4c, 0xc2,
+	0x9c, 0x2e, 0x2e, 0x79, 0xea, 0x10, 0xf9, 0x49, 0x65, 0xaf, 0xfb, 0xfe, 0x50, 0xd8, 0x19, 0x1b,
+	0xae, 0x81, 0x21, 0x87, 0x4d, 0x42, 0x1d, 0xa1, 0xcd, 0xa6, 0xe1, 0x16, 0x50, 0xe7, 0x2a, 0xac,
+	0x62, 0xd3, 0x2a, 0x9a, 0xe9, 0xfc, 0x3a, 0x74, 0x74, 0x18, 0x37, 0x22, 0xbd, 0xc9, 0x22, 0x41,
+	0xb2, 0x4a, 0x55, 0x51, 0xa3, 0x2a, 0xb2, 0xb4, 0x73, 0x1d, 0xec, 0x9d, 0x97, 0xe3, 0x28, 0x16,
+	0xc5, 0x67, 0x37, 0x21, 0x65, 0xf9, 0xeb, 0xc0, 0x0c, 0x7c, 0xeb, 0x64, 0x12, 0xbe, 0xc8, 0x5c,
+	0xe0, 0x96, 0xe6, 0x02, 0xff, 0x87, 0x16, 0x5c, 0x7b, 0x3c, 0xaa, 0x28, 0x28, 0x8f, 0x68, 0xce,
+	0x97, 0x89, 0x75, 0xd1, 0x32, 0xa9, 0x52, 0x11, 0x64, 0x1e, 0x92, 0x77, 0x3e, 0x4f, 0x34, 0x4f,
+	0x60, 0x86, 0xa9, 0x90, 0xca, 0xe4, 0x85, 0x3f, 0x1e, 0xf3, 0xa1, 0x5c, 0x8e, 0x3a, 0xe4, 0xcc,
+	0x43, 0xfb, 0x20, 0x8d, 0xc6, 0xaa, 0x83, 0x0b, 0xd0, 0x11, 0x49, 0x19, 0x38, 0x70, 0x0d, 0xae,
+	0x52, 0xfb, 0x9f, 0x45, 0xe3, 0x28, 0x88, 0x8e, 0xcf, 0x0d, 0x1b, 0xc5, 0xbf, 0xb7, 0x60, 0xd9,
+	0xa0, 0xe6, 0x46, 0x0a, 0xec, 0x46, 0xc6, 0x7c, 0x42, 0x52, 0x2e, 0x69, 0x3a, 0x83, 0xc8, 0x28,
+	0x3c, 0x49, 0xcf, 0x25, 0x03, 0x6f, 0xe6, 0x17, 0x54, 0xd5, 0x87, 0x42, 0x6c, 0xf6, 0xca, 0x62,
+	0x53, 0x7e, 0xaf, 0xee, 0xa7, 0xaa, 0x22, 0xbe, 0x2b, 0xc3, 0xf3, 0xc4, 0xd2, 0x50, 0xd6, 0xec,
+	0xcc, 0xca, 0xa1, 0xdb, 0xb4, 0x54, 0x0b, 0x06, 0x19, 0x98, 0x38, 0x7f, 0xd5, 0x02, 0xc8, 0x5b,
+	0x87, 0x53, 0x94, 0xeb, 0x3d, 0x92, 0xe7, 0x33, 0x00, 0xbd, 0xb5, 0x59, 0x98, 0x42, 0xae, 0x4a,
+	0xb5, 0x15, 0x86, 0x27, 0xb2, 0x77, 0x61, 0xf1, 0x38, 0x88, 0x0e, 0x49, 0x0f, 0xa5, 0x08, 0xf3,
+	0x44, 0x46, 0x49, 0x2c, 0x08, 0xf8, 0xa1, 0x44, 0x73, 0xbd, 0xab, 0xa1, 0xe9, 0x5d, 0xce, 0x5f,
+	0xab, 0xc1, 0x52, 0xa9, 0xcf, 0x53, 0xb7, 0x05, 0x76, 0xaf, 0xb4, 0xff, 0x4f, 0x71, 0x9b, 0xd2,
+	0x69, 0x6a, 0xff, 0x42, 0xb3, 0xf2, 0x7d, 0x58, 0x88, 0xc5, 0x06, 0xab, 0x76, 0xdf, 0xc6, 0x2b,
+	0x76, 0xdf, 0xf9, 0x58, 0x4f, 0x62, 0xec, 0x9c, 0x37, 0x3c, 0xe5, 0x71, 0xea, 0x93, 0x61, 0x8f,
+	0x34, 0x63, 0xa1, 0x33, 0x2c, 0x6a, 0x38, 0x29, 0xac, 0xef, 0xc2, 0xa2, 0x0c, 0x45, 0xcf, 0x72,
+	0xca, 0x0b, 0x86, 0x39, 0x8c, 0x19, 0x9d, 0xdf, 0x55, 0x2e, 0x63, 0x73, 0x0e, 0xa7, 0x8f, 0x88,
+	0xde, 0xbb, 0x5a, 0xa1, 0x77, 0xbf, 0x22, 0xdd, 0xb7, 0x43, 0x65, 0x3d, 0xac, 0x6b, 0xa1, 0x9c,
+	0x43, 0xe9, 0x6e, 0x37, 0x87, 0xb4, 0xf1, 0x3a, 0x43, 0xea, 0xfc, 0x91, 0x05, 0x73, 0xbb, 0xd1,
+	0x78, 0x57, 0x06, 0xb5, 0xd2, 0x42, 0xc8, 0xee, 0x80, 0xa8, 0xe4, 0x2b, 0xc2, 0x5d, 0x2b, 0x15,
+	0xd2, 0xf9, 0xa2, 0x42, 0xfa, 0xeb, 0x70, 0x0d, 0x81, 0x71, 0x1c, 0xa1, 0x88, 0xf1, 0xa3, 0xd0,
+	0x0b, 0x84, 0xf6, 0x19, 0x85, 0xe9, 0x89, 0xda, 0x77, 0x5f, 0x95, 0x85, 0x0c, 0x4a, 0x68, 0x04,
+	0x11, 0x67, 0x49, 0xa9, 0x40, 0x8b, 0xed, 0xb8, 0x4c, 0x70, 0xbe, 0x03, 0x2d, 0x3a, 0x01, 0x52,
+	0xb7, 0xde, 0x83, 0x16, 0x1a, 0x01, 0x4e, 0xfc, 0x30, 0x55, 0x8b, 0x7b, 0x21, 0x3f, 0x9a, 0xed,
+	0xd2, 0x80, 0x64, 0x19, 0x9c, 0xbf, 0x35, 0x0b, 0x73, 0x8f, 0xc3, 0xd3, 0xc8, 0x1f, 0x90, 0x7b,
+	0x7a, 0xc4, 0x47, 0x91, 0xba, 0x11, 0x83, 0xbf, 0x31, 0xa8, 0x85, 0x42, 0xc0, 0xc7, 0x82, 0x69,
+	0x3b, 0x22, 0xa8, 0x45, 0x42, 0xa8, 0xd5, 0xc6, 0xf9, 0xdd, 0x45, 0xb1, 0x7c, 0x34, 0x04, 0xcf,
+	0xc6, 0xb1, 0x7e, 0xf7, 0x50, 0xa6, 0xf2, 0xc8, 0xb5, 0x19, 0xed, 0xc6, 0x11, 0xd6, 0x25, 0x83,
+	0x70, 0x45, 0x94, 0xa6, 0xa8, 0x4b, 0x42, 0x74, 0x9e, 0x8f, 0xb9, 0xf0, 0x3d, 0x90, 0x8e, 0x3c,
+	0x27, 0xcf, 0xf3, 0x3a, 0x88, 0x32, 0x55, 0x7c, 0x20, 0xf2, 0x08, 0xad, 0x41, 0x87, 0xf0, 0x64,
+	0x52, 0xbc, 0x32, 0xdb, 0x12, 0xbc, 0x5f, 0x80, 0x51, 0xb5, 0x18, 0xf2, 0x4c, 0xa0, 0x8a, 0x7e,
+	0x80, 0xb8, 0x9f, 0x59, 0xc4, 0x35, 0x2b, 0x80, 0x88, 0xd6, 0x97, 0x29, 0x62, 0x18, 0x2f, 0x08,
+	0xf0, 0x52, 0x3f, 0xdd, 0x88, 0xa6, 0xbd, 0xbe, 0xe5, 0x9a, 0x20, 0xb6, 0x5a, 0x9b, 0x55, 0x0a,
+	0xff, 0x69, 0xb8, 0x3a, 0xc4, 0xee, 0x41, 0x9b, 0x2c, 0x1f, 0x72, 0x5e, 0xc5, 0xb5, 0xd4, 0xae,
+	0x6e, 0x1a, 0xa1, 0x99, 0xd5, 0x33, 0xe9, 0xae, 0xf3, 0xc5, 0x52, 0xfc, 0xbc, 0x37, 0x1c, 0xca,
+	0x88, 0x83, 0xae, 0xb0, 0xe2, 0x64, 0x00, 0xee, 0x5d, 0x72, 0xc0, 0x44, 0x86, 0x25, 0xca, 0x60,
+	0x60, 0xec, 0x26, 0x34, 0xf1, 0x54, 0x3e, 0xf6, 0xfc, 0x61, 0x8f, 0x65, 0xc6, 0x81, 0x0c, 0xc3,
+	0x32, 0xd4, 0x6f, 0xd2, 0xaf, 0x96, 0x45, 0x7c, 0xa4, 0x8e, 0xe1, 0xd8, 0x64, 0x69, 0x5a, 0x4c,
+	0x97, 0xc5, 0x8c, 0x1a, 0x20, 0x7b, 0x9f, 0xfc, 0xbe, 0x29, 0xef, 0x5d, 0x21, 0xbb, 0xf2, 0x35,
+	0xd9, 0x67, 0xc9, 0xb4, 0xea, 0x2f, 0xba, 0xd9, 0xb9, 0x2b, 0x72, 0x3a, 0x9b, 0xd0, 0xd1, 0x61,
+	0xd6, 0x84, 0x06, 0x5a, 0x94, 0xbb, 0x97, 0x58, 0x1b, 0xe6, 0x0e, 0x76, 0x9e, 0x3d, 0xc3, 0x48,
+	0x67, 0x8b, 0x75, 0xa0, 0x99, 0xc5, 0x3d, 0xd7, 0x30, 0xb5, 0xb9, 0xb5, 0xb5, 0xb3, 0xff, 0x6c,
+	0x67, 0xbb, 0x5b, 0x77, 0x52, 0x60, 0x9b, 0xc3, 0xa1, 0x2c, 0x25, 0xd3, 0x0b, 0x72, 0x7e, 0xb6,
+	0x0c, 0x7e, 0xae, 0xe0, 0xa9, 0x5a, 0x35, 0x4f, 0xbd, 0x72, 0xe4, 0x9d, 0x1d, 0x68, 0xef, 0x6b,
+	0x57, 0x6c, 0x69, 0x79, 0xa9, 0xcb, 0xb5, 0x72, 0x59, 0x6a, 0x88, 0xd6, 0x9c, 0x9a, 0xde, 0x1c,
+	0xe7, 0x1f, 0x58, 0xe2, 0x1e, 0x5b, 0xd6, 0x7c, 0x51, 0x37, 0xde, 0x07, 0x56, 0xc6, 0xdd, 0xfc,
+	0x4a, 0x83, 0x81, 0x61, 0x1e, 0x6a, 0x4a, 0x3f, 0x3a, 0x3a, 0x4a, 0xb8, 0x0a, 0x40, 0x36, 0x30,
+	0x5c, 0x17, 0xa8, 0xa4, 0xa0, 0x7a, 0xed, 0x8b, 0x1a, 0x12, 0x19, 0x88, 0x5c, 0xc2, 0x51, 0xca,
+	0x4b, 0x3b, 0xa0, 0x0a, 0xbd, 0xce, 0xd2, 0xd9, 0xcd, 0x8b, 0xe2, 0x28, 0xdf, 0xc6, 0xa8, 0x04,
+	0x59, 0xae, 0x29, 0xc0, 0x54, 0xce, 0x8c, 0x8e, 0x82, 0x92, 0x0e, 0xc9, 0x46, 0xa3, 0x85, 0xd0,
+	0x2e, 0x13, 0x30, 0x1e, 0xe6, 0xc8, 0x8f, 0x8b, 0xd9, 0xeb, 0x94, 0xbd, 0x82, 0xe2, 0x7c, 0x06,
+	0xcb, 0x8a, 0x91, 0x34, 0xd5, 0xca, 0x9c, 0x44, 0xeb, 0xa2, 0xe5, 0x53, 0x2b, 0x2f, 0x1f, 0xe7,
+	0x4f, 0x2c, 0x98, 0x93, 0x33, 0x5d, 0xba, 0xa6, 0x2d, 0xe6, 0xd9, 0xc0, 0x58, 0xcf, 0xb8, 0xa2,
+	0x49, 0x6b, 0x4d, 0x00, 0x65, 0xb1, 0x58, 0xaf, 0x12, 0x8b, 0x18, 0x2f, 0xea, 0xa5, 0x27, 0xa4,
+	0xb3, 0xb7, 0x5c, 0xfa, 0xcd, 0xba, 0xc2, 0x9c, 0x29, 0x44, 0x30, 0xfe, 0xac, 0xbc, 0x90, 0x2e,
+	0x76, 0xfb, 0x12, 0x8e, 0x63, 0x40, 0x0d, 0xe8, 0xe7, 0xd6, 0xca, 0x1c, 0x40, 0xce, 0x15, 0x09,
+	0x5a, 0xd7, 0xf2, 0xb6, 0x54, 0x8e, 0x38, 0x57, 0xc4, 0xcc, 0xcb, 0x21, 0xc8, 0x62, 0x36, 0xe4,
+	0x4d, 0x97, 0x1c, 0xce, 0x39, 0x42, 0x36, 0xa0, 0xc8, 0x11, 0x32, 0xab, 0x9b, 0xd1, 0xd1, 0x6f,
+	0xb7, 0xcd, 0x03, 0x9e, 0xf2, 0xcd, 0x20, 0x28, 0x96, 0x7f, 0x0d, 0xae, 0x56, 0xd0, 0xa4, 0x36,
+	0xfd, 0x7d, 0xb8, 0xb2, 0x29, 0x6e, 0x05, 0xfc, 0xb2, 0xa2, 0xde, 0x30, 0x3a, 0xa5, 0x58, 0xa4,
+	0xac, 0xec, 0x21, 0x2c, 0x6d, 0xf3, 0xc3, 0xc9, 0xf1, 0x13, 0x7e, 0x9a, 0x57, 0xc4, 0xa0, 0x91,
+	0x9c, 0x44, 0x67, 0x72, 0x61, 0xd2, 0x6f, 0xb4, 0xb8, 0x07, 0x98, 0xa7, 0x9f, 0x8c, 0xf9, 0x40,
+	0xdd, 0x8a, 0x24, 0xe4, 0x60, 0xcc, 0x07, 0xce, 0x07, 0xc0, 0xf4, 0x72, 0xe4, 0x78, 0xe1, 0x2e,
+	0x38, 0x39, 0xec, 0x27, 0xe7, 0x49, 0xca, 0x47, 0xea, 0xba, 0xa7, 0x0e, 0x39, 0xef, 0x42, 0x67,
+	0xdf, 0xc3, 0xbb, 0xc8, 0xf2, 0x76, 0x3e, 0x9a, 0x51, 0xbd, 0x73, 0x14, 0x53, 0x99, 0x19, 0x95,
+	0xc8, 0xce, 0xff, 0xac, 0xc1, 0xac, 0xc8, 0x89, 0xa5, 0x0e, 0x79, 0x92, 0xfa, 0x21, 0x31, 0x96,
+	0x2a, 0x55, 0x83, 0x4a, 0xac, 0x5c, 0xab, 0x60, 0x65, 0x79, 0x32, 0x52, 0x37, 0xcc, 0x24, 0xbf,
+	0x1a, 0x98, 0x19, 0x69, 0x2e, 0xec, 0x78, 0x39, 0x50, 0xb0, 0xb8, 0xe7, 0x7b, 0xad, 0x68, 0x9f,
+	0x5a, 0xa5, 0x92, 0x73, 0x75, 0xa8, 0x72, 0x47, 0x9f, 0x13, 0x0c, 0x5e, 0xc4, 0xcb, 0x3b, 0x77,
+	0xf3, 0x35, 0x76, 0x6e, 0x61, 0x79, 0x78, 0xd5, 0xce, 0x0d, 0xaf, 0xb1, 0x73, 0x3b, 0x0c, 0xba,
+	0x74, 0x75, 0x1d, 0x75, 0x43, 0xc5, 0xbb, 0xbf, 0x6d, 0x41, 0x57, 0x72, 0x51, 0x46, 0x43, 0xaf,
+	0x9a, 0xa6, 0x03, 0x57, 0xde, 0xdd, 0xba, 0x05, 0xf3, 0xa4, 0x99, 0x66, 0xae, 0x05, 0xe9, 0x07,
+	0x31, 0x40, 0xec, 0x87, 0x0a, 0xb7, 0x18, 0xf9, 0x81, 0x9c, 0x14, 0x1d, 0x52, 0xde, 0x89, 0xd8,
+	0x93, 0x61, 0x98, 0x96, 0x9b, 0xa5, 0x9d, 0x7f, 0x6d, 0xc1, 0x92, 0xd6, 0x60, 0xc9, 0x85, 0xf7,
+	0x41, 0xad, 0x06, 0xe1, 0x67, 0xb0, 0x8c, 0xab, 0x17, 0xc5, 0xbe, 0xb8, 0x46, 0x66, 0x9a, 0x4c,
+	0xef, 0x9c, 0x1a, 0x98, 0x4c, 0x46, 0x52, 0x88, 0xea, 0x10, 0x32, 0xd2, 0x19, 0xe7, 0x2f, 0xb2,
+	0x2c, 0x42, 0x8c, 0x1b, 0x18, 0x19, 0x73, 0x51, 0xa3, 0xce, 0x32, 0x35, 0xa4, 0x31, 0x57, 0x07,
+	0x9d, 0xb7, 0xe0, 0xc6, 0x43, 0xce, 0xb7, 0xa2, 0x30, 0x8d, 0xa3, 0x20, 0xe0, 0xf1, 0x36, 0x1f,
+	0xf8, 0x89, 0xfe, 0x4e, 0xcd, 0x1f, 0xd7, 0xe1, 0x4a, 0x65, 0x8e, 0x8b, 0xfd, 0x4f, 0x17, 0x84,
+	0x0e, 0x19, 0x9c, 0x5e, 0x2f, 0x72, 0xfa, 0x5d, 0x58, 0x36, 0xe2, 0x84, 0xfa, 0x74, 0x0f, 0x57,
+	0x0e, 0x7f, 0x15, 0x09, 0xbf, 0x50, 0x3e, 0xa5, 0xd3, 0x28, 0x98, 0x8c, 0x78, 0xee, 0x77, 0x69,
+	0xb8, 0x55, 0x24, 0xdc, 0x13, 0x03, 0xff, 0x8b, 0x89, 0x3f, 0xc4, 0xb3, 0xf2, 0x91, 0xe7, 0x07,
+	0x13, 0xf1, 0x80, 0x16, 0x9e, 0x35, 0x2a, 0x28, 0xb8, 0xe3, 0x46, 0xc1, 0xb0, 0x6f, 0xf2, 0x94,
+	0xd8, 0x00, 0xca, 0x04, 0xd4, 0x8c, 0x10, 0xd4, 0x79, 0x4b, 0xde, 0xb7, 0x2b, 0xc0, 0x58, 0x2e,
+	0x3e, 0x31, 0x65, 0x96, 0x2b, 0xd6, 0x53, 0x99, 0x80, 0xe5, 0x22, 0xa8, 0x97, 0x2b, 0x4c, 0x78,
+	0x45, 0x18, 0x67, 0xc8, 0x1b, 0x8f, 0x03, 0x9f, 0x0f, 0x49, 0x35, 0x6f, 0xba, 0x2a, 0x49, 0xea,
+	0x13, 0xf7, 0x92, 0x28, 0x94, 0x4a, 0xb9, 0x4c, 0x39, 0x3f, 0x86, 0x9b, 0xd3, 0xd8, 0x41, 0x72,
+	0xf6, 0x47, 0xd0, 0x1a, 0x2a, 0x50, 0xb2, 0xf5, 0xf5, 0xdc, 0xff, 0x5c, 0xfe, 0xd2, 0xcd, 0xb3,
+	0x3b, 0xff, 0xc9, 0x82, 0x65, 0x71, 0x0e, 0x97, 0x56, 0x8e, 0xec, 0x46, 0xf8, 0xac, 0x30, 0x3c,
+	0x08, 0xf1, 0xbf, 0x7b, 0xc9, 0x95, 0x69, 0xf6, 0xad, 0xd7, 0xb4, 0x1d, 0x64, 0x81, 0xc8, 0x53,
+	0x16, 0x7e, 0xbd, 0x6a, 0xe1, 0xbf, 0x62, 0x59, 0x57, 0x39, 0x71, 0x66, 0x2a, 0x9d, 0x38, 0xf8,
+	0x74, 0x52, 0x32, 0x88, 0xc6, 0x1c, 0x23, 0x2c, 0xcc, 0xce, 0xc9, 0xfd, 0xee, 0x77, 0x2c, 0xe8,
+	0x3d, 0x14, 0xdc, 0x87, 0xf1, 0x36, 0x7e, 0x92, 0x46, 0x71, 0xf6, 0x70, 0xc6, 0x4d, 0x80, 0x24,
+	0xf5, 0xe2, 0x54, 0x5c, 0x31, 0x93, 0xce, 0x93, 0x1c, 0xc1, 0x36, 0xf2, 0x70, 0x28, 0xa8, 0x42,
+	0x10, 0x64, 0xe9, 0x92, 0xc2, 0x2a, 0x2d, 0x05, 0x3a, 0x86, 0xb6, 0x4b, 0xa5, 0x98, 0xf2, 0x53,
+	0x52, 0x22, 0xc4, 0x11, 0xbc, 0x80, 0x3a, 0xff, 0xd1, 0x82, 0xc5, 0xbc, 0x91, 0x14, 0xb2, 0x72,
+	0xc1, 0xa5, 0x27, 0xe5, 0xd6, 0xf1, 0x51, 0xf9, 0x93, 0x6d, 0xd3, 0x10, 0xda, 0x1e, 0x64, 0x2a,
+	0x9a, 0x28, 0x6d, 0x5a, 0x87, 0x44, 0x98, 0x2d, 0xaa, 0x9d, 0x72, 0x8d, 0xca, 0x14, 0xb1, 0xed,
+	0x28, 0xa5, 0xaf, 0x84, 0x03, 0x4a, 0x25, 0x95, 0xde, 0x36, 0x47, 0x28, 0xfe, 0x34, 0x1c, 0xc7,
+	0x4d, 0x31, 0x3e, 0x2a, 0xed, 0xfc, 0x75, 0x0b, 0xae, 0x56, 0x0c, 0xbc, 0x64, 0xe4, 0x6d, 0x58,
+	0x3a, 0xca, 0x88, 0x6a, 0x70, 0x2c, 0xe3, 0xf1, 0xbc, 0xc2, 0x80, 0xb8, 0xe5, 0x0f, 0x32, 0x25,
+	0x5c, 0x0c, 0xb7, 0x11, 0xc8, 0x5e, 0x26, 0x38, 0xcb, 0xb0, 0xb4, 0x9b, 0x06, 0x83, 0xef, 0x4f,
+	0xa2, 0xd4, 0xcb, 0xa3, 0x75, 0x6b, 0xd0, 0xca, 0xd0, 0x57, 0x4b, 0x55, 0x71, 0x8b, 0xa5, 0x7f,
+	0x14, 0x68, 0xa1, 0x89, 0x26, 0x88, 0x21, 0x2e, 0x42, 0xd9, 0xcc, 0xa0, 0x7c, 0x05, 0x34, 0xdc,
+	0x4a, 0x9a, 0x3a, 0xd5, 0x24, 0x41, 0x84, 0xa7, 0xb5, 0xcf, 0xf9, 0x40, 0xb2, 0x49, 0xc3, 0x2d,
+	0xe1, 0xea, 0xb2, 0x8a, 0x28, 0x47, 0x65, 0x9e, 0xc9, 0x2f, 0xab, 0x18, 0x04, 0x75, 0x2d, 0x07,
+	0xdf, 0xb1, 0x14, 0x3e, 0x78, 0xf5, 0x85, 0x98, 0xd8, 0x6a, 0xa2, 0xaa, 0x23, 0x09, 0xa2, 0xb3,
+	0x7e, 0xcc, 0x93, 0x28, 0xc0, 0x4b, 0xfa, 0x73, 0x79, 0x1d, 0x06, 0xc1, 0xf9, 0x43, 0x0b, 0x98,
+	0x3e, 0xaa, 0x99, 0xe2, 0x4c, 0x5e, 0x10, 0x6a, 0xbc, 0x7a, 0xf7, 0x4c, 0xd8, 0xb3, 0x4b, 0x38,
+	0x56, 0x88, 0x98, 0x68, 0xbb, 0xfe, 0x48, 0xda, 0xbc, 0x5b, 0x26, 0x60, 0xa7, 0x10, 0xcc, 0xdb,
+	0x9d, 0xf0, 0x41, 0x14, 0x0e, 0x13, 0x39, 0xc6, 0xd5, 0x44, 0x7c, 0x04, 0xea, 0x0b, 0x6a, 0x61,
+	0xaf, 0x61, 0xe8, 0x41, 0x59, 0xd3, 0x5d, 0x49, 0x77, 0xf6, 0x95, 0xa9, 0x7f, 0x4b, 0x7f, 0xe5,
+	0x50, 0x49, 0x8c, 0x7b, 0x25, 0xbd, 0xe7, 0x62, 0xdb, 0xdf, 0x11, 0xcc, 0x1b, 0x65, 0xb1, 0x6f,
+	0xbc, 0x6e, 0x21, 0x05, 0x47, 0x2d, 0xa5, 0xc4, 0x33, 0x8d, 0xea, 0xd2, 0x85, 0x06, 0x39, 0xa7,
+	0xb0, 0xf8, 0xc9, 0x24, 0x48, 0xfd, 0xfc, 0xc9, 0x46, 0xf6, 0x2d, 0x68, 0xe7, 0x45, 0xa8, 0x05,
+	0x56, 0x59, 0x95, 0x9e, 0x8f, 0x66, 0x04, 0x4b, 0xea, 0x97, 0x6b, 0x2c, 0x13, 0xd0, 0xf3, 0x92,
+	0x57, 0x29, 0xc6, 0x4e, 0xad, 0xae, 0xdf, 0xb5, 0x80, 0xe5, 0x34, 0xe5, 0xee, 0x60, 0x8f, 0x60,
+	0x19, 0x0d, 0xbd, 0x01, 0xd7, 0xcb, 0x49, 0xe4, 0x48, 0x5c, 0x31, 0x9b, 0x27, 0x3e, 0x4d, 0xdc,
+	0xaa, 0x2f, 0x50, 0x8c, 0x54, 0x37, 0x34, 0x17, 0x23, 0x85, 0x21, 0xa9, 0xea, 0xc0, 0xf7, 0x60,
+	0xc1, 0xac, 0x0c, 0x3d, 0xcc, 0x85, 0x96, 0xe9, 0x5e, 0x5d, 0x93, 0x33, 0x8c, 0x9c, 0xce, 0x6f,
+	0x59, 0xd0, 0x73, 0x39, 0x0a, 0x3b, 0xae, 0x55, 0x2a, 0xb9, 0xe7, 0x7e, 0xa9, 0xd8, 0xe9, 0x1d,
+	0xce, 0xee, 0x61, 0xa8, 0xbe, 0xde, 0x99, 0x3a, 0x29, 0xbb, 0x97, 0x2a, 0x7a, 0x85, 0xb7, 0x2f,
+	0x64, 0xff, 0x56, 0xe1, 0x8a, 0x6c, 0x92, 0x6a, 0x4e, 0xee, 0xc7, 0x31, 0x2a, 0x35, 0xfc, 0x38,
+	0x36, 0xf4, 0xc4, 0xbb, 0x37, 0x7a, 0x3f, 0xe4, 0x87, 0xdb, 0xc0, 0x3e, 0xf1, 0x06, 0x5e, 0x1c,
+	0x45, 0xe1, 0x3e, 0x8f, 0x47, 0x7e, 0x42, 0x3a, 0x29, 0x9e, 0x8f, 0xc8, 0xcd, 0xa1, 0x8e, 0x72,
+	0x22, 0xa5, 0x9e, 0x6a, 0x89, 0x42, 0xf5, 0x24, 0x8e, 0x48, 0x39, 0x29, 0x2c, 0x3f, 0xf0, 0x5e,
+	0x70, 0x55, 0x52, 0x3e, 0x4a, 0xed, 0x71, 0x56, 0xa8, 0x1a, 0x7b, 0x75, 0x0d, 0xaa, 0x5c, 0xad,
+	0xab, 0xe7, 0xc6, 0x65, 0x12, 0x47, 0x51, 0x8a, 0xce, 0x97, 0xdc, 0x8c, 0xae, 0x43, 0xce, 0x3d,
+	0xb8, 0x6c, 0xd6, 0x2a, 0x45, 0x16, 0xc6, 0x83, 0x48, 0x4c, 0xb6, 0x3f, 0x4b, 0xe3, 0x79, 0x1a,
+	0xed, 0x03, 0xea, 0x9b, 0xc7, 0xdb, 0xd9, 0xfe, 0xf1, 0x5d, 0x58, 0x2d, 0x51, 0x64, 0x81, 0x0e,
+	0x74, 0xb4, 0x7a, 0x45, 0x47, 0x1a, 0xae, 0x81, 0x39, 0xf7, 0x61, 0x55, 0x18, 0x06, 0xf2, 0x02,
+	0xb4, 0x5b, 0x56, 0x7a, 0x4f, 0xac, 0x72, 0x4f, 0xbe, 0x09, 0xbd, 0xf2, 0xc7, 0x79, 0x18, 0xe6,
+	0x90, 0x68, 0x2a, 0x8c, 0x41, 0x25, 0xf1, 0x3c, 0xb7, 0xb8, 0x39, 0x19, 0xfa, 0xe9, 0x93, 0xe8,
+	0xf8, 0xff, 0x37, 0x45, 0xe8, 0x7f, 0x5b, 0x00, 0xd4, 0x36, 0xa1, 0x03, 0x39, 0xd0, 0xc9, 0x54,
+	0x9e, 0x7e, 0x98, 0xc8, 0x86, 0x19, 0x18, 0x32, 0xd7, 0x88, 0xa7, 0x27, 0x91, 0x0a, 0x1b, 0x97,
+	0x29, 0x1c, 0x3e, 0x35, 0x7d, 0xf9, 0x0d, 0x70, 0x1d, 0xa2, 0x01, 0x16, 0xb7, 0x1c, 0xe8, 0x98,
+	0x2d, 0xbc, 0x6c, 0x3a, 0xa4, 0x5f, 0x54, 0x9c, 0x31, 0x2e, 0x2a, 0xe6, 0x97, 0xce, 0x67, 0xf5,
+	0x4b, 0xe7, 0xb6, 0x30, 0x4e, 0x67, 0x27, 0x93, 0x86, 0x9b, 0xa5, 0x8b, 0x46, 0x8c, 0x66, 0xc9,
+	0x88, 0xe1, 0x9c, 0x41, 0x37, 0x9f, 0x17, 0x39, 0x8d, 0xdf, 0x82, 0x8e, 0x87, 0x98, 0xa9, 0x22,
+	0x29, 0xa7, 0x69, 0x3e, 0x54, 0xae, 0x91, 0xed, 0xcd, 0x14, 0xa3, 0xdb, 0x5f, 0x41, 0x5b, 0x7b,
+	0xcb, 0x8b, 0xad, 0xc2, 0xf2, 0x67, 0x8f, 0x9f, 0xed, 0xed, 0x1c, 0x1c, 0xf4, 0xf7, 0x9f, 0x3f,
+	0xf8, 0x78, 0xe7, 0x07, 0xfd, 0xdd, 0xcd, 0x83, 0xdd, 0xee, 0x25, 0x7c, 0xe1, 0x63, 0x6f, 0xe7,
+	0xe0, 0xd9, 0xce, 0xb6, 0x81, 0x5b, 0xec, 0x26, 0xd8, 0xcf, 0xf7, 0x9e, 0x63, 0x98, 0x74, 0xd5,
+	0x77, 0x35, 0x76, 0x03, 0xae, 0x4a, 0x7a, 0xc5, 0xe7, 0xf5, 0x7b, 0xbf, 0x55, 0x87, 0x05, 0x11,
+	0x04, 0x2d, 0x9e, 0x11, 0xe6, 0x31, 0xfb, 0x04, 0xe6, 0xe4, 0xdb, 0xd8, 0x4c, 0x49, 0x47, 0xf3,
+	0x41, 0x70, 0x7b, 0xa5, 0x08, 0x4b, 0xc9, 0xb4, 0xfc, 0xd3, 0x3f, 0xfa, 0x6f, 0x7f, 0xb3, 0x36,
+	0xcf, 0xda, 0x1b, 0xa7, 0xef, 0x6f, 0x1c, 0xf3, 0x30, 0xc1, 0x32, 0x7e, 0x0c, 0x90, 0xbf, 0xb2,
+	0xcc, 0x7a, 0x99, 0x51, 0xb7, 0xf0, 0x90, 0xb5, 0x7d, 0xb5, 0x82, 0x22, 0xcb, 0xbd, 0x4a, 0xe5,
+	0x2e, 0x3b, 0x0b, 0x58, 0xae, 0x1f, 0xfa, 0xa9, 0x78, 0x71, 0xf9, 0x23, 0xeb, 0x36, 0x1b, 0x42,
+	0x47, 0x7f, 0xff, 0x98, 0x29, 0xcf, 0x72, 0xc5, 0x0b, 0xce, 0xf6, 0xb5, 0x4a, 0x9a, 0x12, 0xc7,
+	0x54, 0xc7, 0x15, 0xa7, 0x8b, 0x75, 0x4c, 0x28, 0x47, 0x5e, 0x4b, 0x00, 0x0b, 0xe6, 0x33, 0xc7,
+	0xec, 0xba, 0xb6, 0x6f, 0x94, 0x1e, 0x59, 0xb6, 0x6f, 0x4c, 0xa1, 0xca, 0xba, 0x6e, 0x50, 0x5d,
+	0xab, 0x0e, 0xc3, 0xba, 0x06, 0x94, 0x47, 0x3d, 0xb2, 0xfc, 0x91, 0x75, 0xfb, 0xde, 0x9f, 0x6c,
+	0x40, 0x2b, 0x0b, 0x5e, 0x62, 0x9f, 0xc3, 0xbc, 0x11, 0xa5, 0xce, 0x54, 0x37, 0xaa, 0x82, 0xda,
+	0xed, 0xeb, 0xd5, 0x44, 0x59, 0xf1, 0x4d, 0xaa, 0xb8, 0xc7, 0x56, 0xb0, 0x62, 0x69, 0x43, 0xd8,
+	0xa0, 0xfb, 0x16, 0xe2, 0xf2, 0xf4, 0x0b, 0x6d, 0x33, 0x16, 0x95, 0x5d, 0x2f, 0xee, 0x8f, 0x46,
+	0x6d, 0x37, 0xa6, 0x50, 0x65, 0x75, 0xd7, 0xa9, 0xba, 0x15, 0x76, 0x59, 0xaf, 0x2e, 0x8b, 0x96,
+	0xe0, 0xf4, 0xfe, 0x80, 0xfe, 0x42, 0x30, 0xbb, 0x91, 0x31, 0x56, 0xd5, 0xcb, 0xc1, 0x19, 0x8b,
+	0x94, 0x9f, 0x0f, 0x76, 0x7a, 0x54, 0x15, 0x63, 0x34, 0x7d, 0xfa, 0x03, 0xc1, 0xec, 0x10, 0xda,
+	0xda, 0xcb, 0x90, 0xec, 0xea, 0xd4, 0x57, 0x2c, 0x6d, 0xbb, 0x8a, 0x54, 0xd5, 0x15, 0xbd, 0xfc,
+	0x0d, 0x3c, 0x8b, 0xfd, 0x08, 0x5a, 0xd9, 0x5b, 0x83, 0x6c, 0x55, 0x7b, 0xfb, 0x51, 0x7f, 0x1b,
+	0xd1, 0xee, 0x95, 0x09, 0x55, 0xcc, 0xa7, 0x97, 0x8e, 0xcc, 0xf7, 0x19, 0xb4, 0xb5, 0xf7, 0x04,
+	0xb3, 0x0e, 0x94, 0xdf, 0x2c, 0xb4, 0xed, 0x2a, 0x92, 0xac, 0x62, 0x89, 0xaa, 0x68, 0xb3, 0x16,
+	0xf1, 0x37, 0x3e, 0x37, 0xc8, 0x9e, 0xc0, 0x95, 0xec, 0x09, 0x8a, 0x37, 0x99, 0x86, 0x8a, 0x47,
+	0x99, 0xef, 0x5a, 0xec, 0x3e, 0x34, 0xd5, 0xb3, 0x91, 0x6c, 0xa5, 0xfa, 0xf9, 0x4b, 0x7b, 0xb5,
+	0x84, 0x4b, 0x51, 0xfb, 0x03, 0x80, 0xfc, 0xf1, 0xc2, 0x4c, 0x48, 0x94, 0x1e, 0x43, 0xb4, 0xaf,
+	0x56, 0x50, 0x64, 0x07, 0x57, 0xa8, 0x83, 0x5d, 0x46, 0x42, 0x22, 0xe4, 0x67, 0xea, 0x6d, 0x9d,
+	0xdf, 0x84, 0xb6, 0xf6, 0x7e, 0x61, 0x36, 0x7c, 0xe5, 0xb7, 0x0f, 0x6d, 0xbb, 0x8a, 0x24, 0x4b,
+	0xb7, 0xa9, 0xf4, 0xcb, 0xce, 0x22, 0x96, 0x8e, 0xef, 0x13, 0x8e, 0x44, 0x06, 0x9c, 0xa0, 0x13,
+	0x98, 0x37, 0x1e, 0x29, 0xcc, 0x56, 0x68, 0xd5, 0x13, 0x88, 0xf6, 0xf5, 0x6a, 0xa2, 0xc9, 0x67,
+	0xce, 0x12, 0xd6, 0x73, 0x4a, 0x59, 0xb4, 0x9a, 0x7e, 0x08, 0x6d, 0xed, 0xc1, 0xc1, 0xac, 0x2f,
+	0xe5, 0xb7, 0x0d, 0x6d, 0xbb, 0x8a, 0x24, 0xeb, 0xb8, 0x4c, 0x75, 0x2c, 0x38, 0xc4, 0x0a, 0xf4,
+	0xb4, 0x02, 0x96, 0xfd, 0x39, 0x2c, 0x98, 0x4f, 0x10, 0x66, 0x6b, 0xbf, 0xf2, 0x31, 0x43, 0xfb,
+	0xc6, 0x14, 0xaa, 0xc9, 0xd2, 0xb7, 0x97, 0xb3, 0x4a, 0x36, 0xbe, 0x94, 0x41, 0xcd, 0x5f, 0xb1,
+	0xef, 0x43, 0x2b, 0x7b, 0xa9, 0x85, 0xad, 0x6a, 0x5c, 0xab, 0x3f, 0xfb, 0x62, 0xf7, 0xca, 0x84,
+	0x2a, 0x66, 0xa6, 0xc2, 0x59, 0x2a, 0x9f, 0xfb, 0x34, 0x5e, 0x4c, 0x79, 0x4b, 0x5f, 0x71, 0x15,
+	0xcf, 0xbb, 0xd8, 0x6b, 0xd3, 0x33, 0x54, 0x4d, 0xc8, 0x80, 0xb2, 0x68, 0x13, 0xf2, 0x1b, 0xb0,
+	0x3a, 0xe5, 0x15, 0x17, 0xf6, 0xb6, 0x2a, 0xfa, 0x95, 0xaf, 0xbc, 0xd8, 0xd9, 0xb9, 0x46, 0xa7,
+	0xde, 0xb5, 0xc4, 0x2e, 0x4c, 0xaf, 0xb3, 0x68, 0xbb, 0xb0, 0xfe, 0x80, 0x8b, 0xbd, 0x52, 0x84,
+	0xab, 0x77, 0xe1, 0xd4, 0xc7, 0x32, 0x42, 0x58, 0x2c, 0xdc, 0x0c, 0xcc, 0x56, 0x79, 0xf5, 0x55,
+	0x6a, 0xfb, 0xe6, 0xab, 0x2f, 0x14, 0x9a, 0x12, 0x51, 0x09, 0xf5, 0x0d, 0x75, 0x71, 0xfd, 0xcf,
+	0x42, 0x47, 0x7f, 0xbe, 0x8e, 0xe9, 0xa2, 0xa9, 0x58, 0xd3, 0xb5, 0x4a, 0x9a, 0xc9, 0xac, 0xac,
+	0xa3, 0x57, 0xc3, 0x3e, 0x85, 0x95, 0x7c, 0x5c, 0xb5, 0xcb, 0x66, 0x49, 0x36, 0xe5, 0xd3, 0xae,
+	0xf1, 0xd9, 0x57, 0xa7, 0xde, 0x51, 0xbb, 0x6b, 0xe1, 0x22, 0x30, 0xdf, 0x05, 0xcb, 0x37, 0xc0,
+	0xaa, 0xe7, 0xd0, 0xec, 0x1b, 0x53, 0xa8, 0xe6, 0x22, 0x60, 0xcb, 0xc6, 0x18, 0x89, 0x80, 0x26,
+	0xf6, 0x43, 0x58, 0xd4, 0xae, 0xf3, 0xe2, 0xd3, 0x4d, 0xd9, 0x82, 0x2e, 0xbf, 0xfb, 0x60, 0x57,
+	0x19, 0x0e, 0x9c, 0x55, 0x2a, 0x7f, 0xc9, 0x31, 0x06, 0x07, 0xf9, 0x72, 0x0b, 0xda, 0x5a, 0x19,
+	0xaf, 0x2a, 0x77, 0x55, 0x23, 0xe9, 0xcf, 0x16, 0xdc, 0xb5, 0xd8, 0xdf, 0xc1, 0xe7, 0xb6, 0xf5,
+	0x8b, 0xb7, 0x46, 0xd8, 0x5e, 0xa1, 0x9c, 0x9e, 0x4e, 0xd3, 0x0b, 0x72, 0x5c, 0x6a, 0xe4, 0x93,
+	0xdb, 0xdf, 0x33, 0x06, 0xe1, 0x4b, 0xc3, 0x25, 0x72, 0xa7, 0xf8, 0xf4, 0xf6, 0x57, 0xc5, 0x0c,
+	0xfa, 0xdb, 0x18, 0x5f, 0xdd, 0xb5, 0xd8, 0xef, 0x59, 0xb0, 0x60, 0xba, 0x59, 0xb3, 0xa9, 0xaa,
+	0x74, 0xe8, 0xda, 0x37, 0xa6, 0x50, 0xe5, 0x54, 0xfd, 0x90, 0x5a, 0xf9, 0xec, 0xb6, 0x6b, 0xb4,
+	0x52, 0xbe, 0x18, 0xf7, 0x8b, 0xb5, 0x96, 0x7d, 0x24, 0xfe, 0xb3, 0x80, 0xf2, 0xfd, 0x33, 0x4d,
+	0xe8, 0x14, 0xa7, 0x57, 0x7f, 0x51, 0x7e, 0xdd, 0xba, 0x6b, 0xb1, 0xdf, 0x84, 0x45, 0xed, 0x5b,
+	0xe2, 0x92, 0xd7, 0xfd, 0xde, 0xb9, 0x45, 0x7d, 0xba, 0xe9, 0x5c, 0x35, 0xfa, 0x54, 0xd4, 0x2f,
+	0x36, 0xa1, 0xad, 0x3d, 0x06, 0x9f, 0x6f, 0x90, 0xa5, 0x07, 0xe2, 0xa7, 0x37, 0x72, 0x04, 0x8b,
+	0x5a, 0x76, 0x83, 0x95, 0x5f, 0xb3, 0x18, 0xe7, 0x36, 0xb5, 0xf5, 0x96, 0xf3, 0xd6, 0xd4, 0xb6,
+	0x6e, 0x90, 0xb3, 0x14, 0x5b, 0xbc, 0x0f, 0x90, 0xc7, 0xe9, 0xb0, 0x42, 0x9c, 0x48, 0xb6, 0xc0,
+	0xcb, 0xa1, 0x3c, 0xe6, 0x7a, 0x51, 0xe1, 0x24, 0x58, 0xe2, 0x8f, 0x84, 0xb8, 0x92, 0xf9, 0x13,
+	0x43, 0xc9, 0x32, 0x03, 0x6a, 0x6c, 0xbb, 0x8a, 0x54, 0x25, 0xac, 0x54, 0xf9, 0xec, 0x39, 0xcc,
+	0x3f, 0x89, 0xa2, 0x17, 0x93, 0xb1, 0x6a, 0x31, 0x33, 0xe3, 0x18, 0x30, 0xec, 0xc7, 0x2e, 0xf4,
+	0xc2, 0x59, 0xa3, 0xa2, 0x6c, 0xd6, 0xd3, 0x8a, 0xda, 0xf8, 0x32, 0x8f, 0x03, 0xfa, 0x8a, 0x79,
+	0xb0, 0x94, 0xc9, 0xc0, 0xac, 0xe1, 0xb6, 0x59, 0x8c, 0x21, 0xf9, 0x8a, 0x55, 0x18, 0xa7, 0x01,
+	0xd5, 0xda, 0x8d, 0x44, 0x95, 0x79, 0xd7, 0x62, 0xfb, 0xd0, 0xd9, 0xe6, 0x03, 0xba, 0x3a, 0x48,
+	0xc1, 0x00, 0xcb, 0x79, 0xc3, 0xb3, 0x28, 0x02, 0x7b, 0xde, 0x00, 0xcd, 0x7d, 0x61, 0xec, 0x9d,
+	0xc7, 0xfc, 0x8b, 0x8d, 0x2f, 0x65, 0x98, 0xc1, 0x57, 0x6a, 0x5f, 0x90, 0x3d, 0x37, 0xf7, 0x85,
+	0x42, 0xe0, 0x86, 0x7d, 0xad, 0x92, 0x56, 0x35, 0xd4, 0x2a, 0x0e, 0x84, 0x05, 0x18, 0x61, 0x51,
+	0x88, 0xf5, 0xc8, 0xb6, 0x84, 0x69, 0x11, 0x22, 0xf6, 0xda, 0xf4, 0x0c, 0x66, 0x6d, 0xb7, 0xcd,
+	0xda, 0x0e, 0x60, 0x7e, 0x9b, 0x8b, 0xc1, 0x12, 0x37, 0x51, 0x0a, 0xb7, 0xb7, 0xf5, 0x7b, 0x2e,
+	0xf6, 0x72, 0x05, 0xcd, 0x54, 0x64, 0xe8, 0x1a, 0x08, 0xfb, 0x11, 0xb4, 0x1f, 0xf1, 0x54, 0x5d,
+	0x3d, 0xc9, 0x54, 0xe9, 0xc2, 0x5d, 0x14, 0xbb, 0xe2, 0xe6, 0x8a, 0xc9, 0x33, 0x54, 0xda, 0x06,
+	0xde, 0x65, 0x11, 0xc2, 0xa9, 0xef, 0x0f, 0xbf, 0x62, 0xbf, 0x41, 0x85, 0x67, 0x37, 0xe4, 0x56,
+	0xb4, 0x00, 0x70, 0xbd, 0xf0, 0xc5, 0x02, 0x5e, 0x55, 0x72, 0x18, 0x0d, 0xb9, 0xa6, 0xd2, 0x85,
+	0xd0, 0xd6, 0x2e, 0x76, 0x66, 0x0b, 0xa8, 0x7c, 0x7f, 0xd8, 0xb6, 0xab, 0x48, 0x72, 0x9c, 0xd7,
+	0xa9, 0x1e, 0x87, 0xad, 0xe5, 0xf5, 0x88, 0xbb, 0x9f, 0x79, 0x4d, 0x1b, 0x5f, 0x7a, 0xa3, 0xf4,
+	0x2b, 0xf6, 0x19, 0x3d, 0x68, 0xa7, 0x5f, 0xaf, 0xc9, 0xcf, 0x06, 0xc5, 0x9b, 0x38, 0x36, 0x2b,
+	0x93, 0xcc, 0xf3, 0x82, 0xa8, 0x8a, 0x34, 0x25, 0x1f, 0x58, 0xf9, 0xd2, 0x05, 0x53, 0x2c, 0x32,
+	0xf5, 0x3e, 0x46, 0x36, 0xbf, 0x3a, 0xcd, 0xd4, 0x1e, 0x45, 0x25, 0x87, 0x5e, 0x18, 0xf8, 0x09,
+	0x99, 0x15, 0x0e, 0xc5, 0x5b, 0x87, 0x46, 0x45, 0x37, 0x35, 0xa6, 0x7f, 0xed, 0x6a, 0xa4, 0x81,
+	0x84, 0x95, 0xab, 0x61, 0x5f, 0xc0, 0x72, 0xc5, 0xed, 0x09, 0xf6, 0x35, 0x75, 0xd6, 0x9d, 0x7a,
+	0xb3, 0x22, 0x93, 0xa3, 0xe5, 0xeb, 0x15, 0xea, 0x34, 0xc4, 0x58, 0x5e, 0x5f, 0x22, 0x33, 0xdc,
+	0xb5, 0xd8, 0xa7, 0xb0, 0x5c, 0x71, 0xcf, 0x82, 0x4d, 0x2f, 0xcf, 0x76, 0x94, 0x64, 0x9a, 0x7e,
+	0x3d, 0x63, 0xdd, 0x42, 0x97, 0x38, 0xde, 0x84, 0xd8, 0xf6, 0xf8, 0x28, 0x0a, 0xf3, 0x5d, 0x30,
+	0xbf, 0x2b, 0x61, 0x2f, 0x1b, 0x98, 0xf8, 0x90, 0x7d, 0xa6, 0x1d, 0x73, 0x8d, 0x7b, 0x63, 0x6b,
+	0x7a, 0x83, 0xaa, 0xae, 0x53, 0xd8, 0x76, 0x55, 0x8e, 0x4c, 0x3f, 0xda, 0x04, 0xc8, 0xc3, 0xb0,
+	0xb2, 0x43, 0x6b, 0x29, 0xc2, 0xcb, 0xbe, 0x5a, 0x41, 0x91, 0x6d, 0xdb, 0x87, 0x56, 0x1e, 0xd7,
+	0xb3, 0x9a, 0x47, 0x13, 0x18, 0x51, 0x40, 0x76, 0xaf, 0x4c, 0x90, 0xeb, 0xa5, 0x4b, 0x13, 0x01,
+	0xac, 0x89, 0x13, 0x41, 0x21, 0x34, 0x3f, 0xb5, 0x60, 0xa5, 0x3a, 0x90, 0x81, 0xdd, 0x7a, 0x55,
+	0xb4, 0x42, 0xb6, 0x3c, 0xdf, 0xbe, 0x20, 0x57, 0x95, 0x6a, 0x8b, 0x35, 0x6f, 0x0c, 0xb2, 0xdc,
+	0xcc, 0x87, 0x65, 0x31, 0x4a, 0x99, 0xb6, 0x4a, 0x57, 0x10, 0xd4, 0x70, 0x56, 0x44, 0x42, 0xd8,
+	0xd7, 0x2a, 0x69, 0x55, 0x06, 0x40, 0x14, 0x66, 0xe2, 0xfa, 0x03, 0xae, 0xa1, 0x11, 0x2c, 0x95,
+	0x3c, 0xdd, 0x99, 0xc4, 0x9f, 0x16, 0x7c, 0x60, 0xaf, 0x4d, 0xcf, 0x20, 0xab, 0xbc, 0x42, 0x55,
+	0x2e, 0x3a, 0x80, 0x55, 0x26, 0x67, 0x7e, 0x3a, 0x38, 0xc1, 0xea, 0x7e, 0x0c, 0x90, 0x7b, 0x5c,
+	0xb3, 0x39, 0x2f, 0xb9, 0xb6, 0xed, 0xab, 0x15, 0x14, 0xb3, 0x33, 0x6c, 0x29, 0x2f, 0x79, 0x43,
+	0xf8, 0x3f, 0x19, 0xde, 0xa7, 0xa8, 0x70, 0x80, 0x16, 0x56, 0x6b, 0x95, 0x73, 0xd4, 0xae, 0xf4,
+	0x8f, 0x39, 0x07, 0x54, 0xd7, 0x27, 0xec, 0x63, 0x43, 0xab, 0x12, 0xae, 0x29, 0xb9, 0x2d, 0xbc,
+	0x52, 0xa3, 0xad, 0x54, 0x67, 0xbf, 0x80, 0x55, 0xd1, 0x90, 0xcd, 0x20, 0x28, 0xf8, 0xee, 0x6e,
+	0x96, 0xfe, 0x73, 0x9d, 0xe1, 0x93, 0xb4, 0xa7, 0xff, 0x67, 0xbb, 0x29, 0x67, 0x25, 0xd1, 0x54,
+	0x36, 0x81, 0x6e, 0xd1, 0x1f, 0xc6, 0xa6, 0x97, 0x65, 0xbf, 0x65, 0xd8, 0x58, 0x2a, 0x7c, 0x68,
+	0x6f, 0x53, 0x65, 0x6f, 0x39, 0x76, 0xd5, 0xb8, 0x08, 0xb3, 0x0b, 0xce, 0xf6, 0x9f, 0xcf, 0x9c,
+	0x77, 0x85, 0x7e, 0xaa, 0x0a, 0xa6, 0x79, 0x1b, 0xed, 0xeb, 0x66, 0x86, 0x42, 0xf5, 0xef, 0x50,
+	0xf5, 0x6b, 0xce, 0xb5, 0xaa, 0xea, 0x63, 0xf1, 0x89, 0xb0, 0xf7, 0xac, 0x16, 0x45, 0x97, 0x6a,
+	0xc1, 0x5a, 0xd5, 0x7c, 0x4f, 0x3d, 0xe8, 0x16, 0xc6, 0xfa, 0x12, 0x1d, 0x2c, 0x3a, 0xba, 0x2b,
+	0x2e, 0x5b, 0x9c, 0x15, 0x5e, 0x41, 0xfb, 0x5a, 0x25, 0xad, 0x4a, 0xa9, 0x56, 0x3e, 0x1e, 0x61,
+	0x51, 0x5a, 0x2c, 0xb8, 0xe7, 0x32, 0x9b, 0x43, 0xb5, 0x43, 0xcf, 0xbe, 0x39, 0x8d, 0x2c, 0xab,
+	0x32, 0xac, 0xbc, 0xaa, 0xaa, 0x0d, 0xbc, 0xa9, 0x78, 0x06, 0xdd, 0xa2, 0x3b, 0x2e, 0x63, 0xc6,
+	0x29, 0x4e, 0x3e, 0xfb, 0xad, 0xa9, 0x74, 0x59, 0x9d, 0x43, 0xd5, 0x5d, 0xbf, 0x6d, 0x1b, 0xd5,
+	0x7d, 0xa9, 0xb9, 0x01, 0xd1, 0x94, 0xd5, 0x54, 0x8e, 0xa3, 0x4c, 0x9d, 0x2a, 0x78, 0xf8, 0xec,
+	0xd5, 0x12, 0x5e, 0xa5, 0xc4, 0x92, 0x13, 0x29, 0x88, 0x8e, 0x1f, 0xbc, 0xfb, 0xc3, 0xb7, 0x8f,
+	0xfd, 0xf4, 0x64, 0x72, 0x78, 0x67, 0x10, 0x8d, 0x36, 0x02, 0xe5, 0x09, 0x90, 0xb7, 0x6b, 0x37,
+	0x82, 0x70, 0xb8, 0x41, 0xe5, 0x1d, 0xce, 0xd2, 0xbf, 0x6c, 0xfd, 0xc6, 0xff, 0x19, 0x00, 0x86,
+	0xc4, 0x3f, 0x94, 0xe4, 0x75, 0x00, 0x00,
 }
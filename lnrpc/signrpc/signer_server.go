@@ -166,6 +166,16 @@ func (s *Server) RegisterWithRootServer(grpcServer *grpc.Server) error {
 // NOTE: The resulting signature should be void of a sighash byte.
 func (s *Server) SignOutputRaw(ctx context.Context, in *SignReq) (*SignResp, error) {
 
+	// Signatures over arbitrary transactions can spend wallet funds
+	// without accounting for them against the spending limits of a
+	// macaroon, so macaroons that carry them are rejected.
+	if s.cfg.MacService != nil {
+		err := s.cfg.MacService.RejectUntrackedSpend(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	switch {
 	// If the client doesn't specify a transaction, then there's nothing to
 	// sign, so we'll exit early.
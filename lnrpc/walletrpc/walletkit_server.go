@@ -268,6 +268,15 @@ func (w *WalletKit) PublishTransaction(ctx context.Context,
 func (w *WalletKit) SendOutputs(ctx context.Context,
 	req *SendOutputsRequest) (*SendOutputsResponse, error) {
 
+	// On-chain sends can't be accounted for against the spending limits
+	// of a macaroon, so macaroons that carry them are rejected.
+	if w.cfg.MacService != nil {
+		err := w.cfg.MacService.RejectUntrackedSpend(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	switch {
 	// If the client didn't specify any outputs to create, then  we can't
 	// proceed .
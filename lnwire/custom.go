@@ -0,0 +1,85 @@
+package lnwire
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// CustomTypeStart is the start of the message type range reserved for custom
+// messages. Messages of a type within this range aren't interpreted by lnd,
+// and are instead passed through as is to external applications.
+const CustomTypeStart MessageType = 32768
+
+// Custom represents an application-defined wire message whose payload is
+// opaque to lnd.
+type Custom struct {
+	// Type is the message type of the custom message, which must be within
+	// the custom range.
+	Type MessageType
+
+	// Data is the raw payload of the custom message.
+	Data []byte
+}
+
+// A compile time check to ensure Custom implements the lnwire.Message
+// interface.
+var _ Message = (*Custom)(nil)
+
+// NewCustom instantiates a new custom message of the given type, returning an
+// error if the type falls outside of the custom range.
+func NewCustom(msgType MessageType, data []byte) (*Custom, error) {
+	if msgType < CustomTypeStart {
+		return nil, fmt.Errorf("msg type %v is not within the custom "+
+			"range, must be at least %v", uint16(msgType),
+			uint16(CustomTypeStart))
+	}
+
+	return &Custom{
+		Type: msgType,
+		Data: data,
+	}, nil
+}
+
+// Decode deserializes a serialized Custom message stored in the passed
+// io.Reader observing the specified protocol version. The entire remaining
+// payload is read as the raw data of the message.
+//
+// This is part of the lnwire.Message interface.
+func (c *Custom) Decode(r io.Reader, pver uint32) error {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	c.Data = data
+
+	return nil
+}
+
+// Encode serializes the target Custom message into the passed io.Writer
+// observing the protocol version specified.
+//
+// This is part of the lnwire.Message interface.
+func (c *Custom) Encode(w io.Writer, pver uint32) error {
+	_, err := w.Write(c.Data)
+	return err
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the lnwire.Message interface.
+func (c *Custom) MsgType() MessageType {
+	return c.Type
+}
+
+// MaxPayloadLength returns the maximum allowed payload size for a Custom
+// complete message observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (c *Custom) MaxPayloadLength(uint32) uint32 {
+	// The type is already accounted for by the message header, leaving
+	// the full payload to the custom data.
+	return MaxMessagePayload
+}
@@ -0,0 +1,63 @@
+package lnwire
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+// TestCustomMessage ensures that messages within the custom range survive a
+// round trip over the wire with their type and raw payload intact, and that
+// custom messages can't be created outside of the custom range.
+func TestCustomMessage(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewCustom(MsgPing, nil); err == nil {
+		t.Fatalf("expected custom message with type %v to be rejected",
+			MsgPing)
+	}
+
+	testCases := []struct {
+		msgType MessageType
+		data    []byte
+	}{
+		{
+			msgType: CustomTypeStart,
+			data:    []byte{},
+		},
+		{
+			msgType: CustomTypeStart + 1,
+			data:    []byte("custom payload"),
+		},
+		{
+			msgType: 65535,
+			data:    bytes.Repeat([]byte{0xaa}, MaxMessagePayload-2),
+		},
+	}
+
+	for _, test := range testCases {
+		msg, err := NewCustom(test.msgType, test.data)
+		if err != nil {
+			t.Fatalf("unable to create custom message: %v", err)
+		}
+
+		var b bytes.Buffer
+		if _, err := WriteMessage(&b, msg, 0); err != nil {
+			t.Fatalf("unable to write custom message: %v", err)
+		}
+
+		newMsg, err := ReadMessage(&b, 0)
+		if err != nil {
+			t.Fatalf("unable to read custom message: %v", err)
+		}
+
+		custom, ok := newMsg.(*Custom)
+		if !ok {
+			t.Fatalf("expected *Custom, got %T", newMsg)
+		}
+		if !reflect.DeepEqual(custom, msg) {
+			t.Fatalf("custom message mismatch: expected %v, got %v",
+				msg, custom)
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"math/rand"
 	"net"
 	"reflect"
+	"sort"
 	"testing"
 	"testing/quick"
 	"time"
@@ -238,7 +239,9 @@ func TestMaxOutPointIndex(t *testing.T) {
 func TestEmptyMessageUnknownType(t *testing.T) {
 	t.Parallel()
 
-	fakeType := MessageType(math.MaxUint16)
+	// Types within the custom range are always passed through, so we'll
+	// use the largest type below it.
+	fakeType := CustomTypeStart - 1
 	if _, err := makeEmptyMessage(fakeType); err == nil {
 		t.Fatalf("should not be able to make an empty message of an " +
 			"unknown type")
@@ -804,12 +807,44 @@ func TestLightningWireProtocol(t *testing.T) {
 				req.EncodingType = EncodingSortedPlain
 			}
 
+			// With a 50/50 chance, we'll also include the update
+			// timestamps and checksums, which require the short
+			// channel IDs to be sorted and take up more space.
+			withExtensions := r.Int31()%2 == 0
+
 			numChanIDs := rand.Int31n(5000)
+			if withExtensions {
+				numChanIDs = rand.Int31n(2000)
+			}
 			for i := int32(0); i < numChanIDs; i++ {
 				req.ShortChanIDs = append(req.ShortChanIDs,
 					NewShortChanIDFromInt(uint64(r.Int63())))
 			}
 
+			if withExtensions && numChanIDs > 0 {
+				sort.Slice(req.ShortChanIDs, func(i, j int) bool {
+					return req.ShortChanIDs[i].ToUint64() <
+						req.ShortChanIDs[j].ToUint64()
+				})
+
+				req.Timestamps = make(
+					[]ChanUpdateTimestamps, numChanIDs,
+				)
+				req.Checksums = make(
+					[]ChanUpdateChecksums, numChanIDs,
+				)
+				for i := int32(0); i < numChanIDs; i++ {
+					req.Timestamps[i] = ChanUpdateTimestamps{
+						Timestamp1: r.Uint32(),
+						Timestamp2: r.Uint32(),
+					}
+					req.Checksums[i] = ChanUpdateChecksums{
+						Checksum1: r.Uint32(),
+						Checksum2: r.Uint32(),
+					}
+				}
+			}
+
 			v[0] = reflect.ValueOf(req)
 		},
 	}
@@ -1,6 +1,7 @@
 package lnwire
 
 import (
+	"bytes"
 	"io"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -24,6 +25,11 @@ type QueryChannelRange struct {
 	// NumBlocks is the number of blocks beyond the first block that short
 	// channel ID's should be sent for.
 	NumBlocks uint32
+
+	// QueryOptions is the set of optional extensions the receiver should
+	// include within its replies. These are encoded as a trailing TLV
+	// record, which receivers unaware of it will ignore.
+	QueryOptions QueryOptions
 }
 
 // NewQueryChannelRange creates a new empty QueryChannelRange message.
@@ -40,11 +46,30 @@ var _ Message = (*QueryChannelRange)(nil)
 //
 // This is part of the lnwire.Message interface.
 func (q *QueryChannelRange) Decode(r io.Reader, pver uint32) error {
-	return ReadElements(r,
+	err := ReadElements(r,
 		q.ChainHash[:],
 		&q.FirstBlockHeight,
 		&q.NumBlocks,
 	)
+	if err != nil {
+		return err
+	}
+
+	records, err := decodeTLVStream(r, queryOptionsType)
+	if err != nil {
+		return err
+	}
+
+	q.QueryOptions = 0
+	if value, ok := records[queryOptionsType]; ok {
+		options, err := readBigSize(bytes.NewReader(value))
+		if err != nil {
+			return err
+		}
+		q.QueryOptions = QueryOptions(options)
+	}
+
+	return nil
 }
 
 // Encode serializes the target QueryChannelRange into the passed io.Writer
@@ -52,11 +77,30 @@ func (q *QueryChannelRange) Decode(r io.Reader, pver uint32) error {
 //
 // This is part of the lnwire.Message interface.
 func (q *QueryChannelRange) Encode(w io.Writer, pver uint32) error {
-	return WriteElements(w,
+	err := WriteElements(w,
 		q.ChainHash[:],
 		q.FirstBlockHeight,
 		q.NumBlocks,
 	)
+	if err != nil {
+		return err
+	}
+
+	// We'll only append the query options if any are set, to remain
+	// identical to the original message otherwise.
+	if q.QueryOptions == 0 {
+		return nil
+	}
+
+	var options bytes.Buffer
+	if err := writeBigSize(&options, uint64(q.QueryOptions)); err != nil {
+		return err
+	}
+
+	return encodeTLVStream(w, tlvRecord{
+		typ:   queryOptionsType,
+		value: options.Bytes(),
+	})
 }
 
 // MsgType returns the integer uniquely identifying this message type on the
@@ -72,6 +116,7 @@ func (q *QueryChannelRange) MsgType() MessageType {
 //
 // This is part of the lnwire.Message interface.
 func (q *QueryChannelRange) MaxPayloadLength(uint32) uint32 {
-	// 32 + 4 + 4
-	return 40
+	// The message may be extended by any number of TLV records, so we
+	// can't bound it any further than the max payload size.
+	return MaxMessagePayload
 }
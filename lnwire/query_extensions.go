@@ -0,0 +1,395 @@
+package lnwire
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+)
+
+// QueryOptions is a bit field of the optional extensions the sender of a
+// QueryChannelRange would like the receiver to include within each
+// ReplyChannelRange.
+type QueryOptions uint64
+
+const (
+	// QueryOptionTimestamps requests the receiver to include the
+	// timestamps of the latest ChannelUpdates of each channel within its
+	// replies.
+	QueryOptionTimestamps QueryOptions = 1 << 0
+
+	// QueryOptionChecksums requests the receiver to include the checksums
+	// of the latest ChannelUpdates of each channel within its replies.
+	QueryOptionChecksums QueryOptions = 1 << 1
+)
+
+// WantTimestamps returns true if the timestamps extension is requested.
+func (q QueryOptions) WantTimestamps() bool {
+	return q&QueryOptionTimestamps != 0
+}
+
+// WantChecksums returns true if the checksums extension is requested.
+func (q QueryOptions) WantChecksums() bool {
+	return q&QueryOptionChecksums != 0
+}
+
+const (
+	// queryOptionsType is the TLV type of the query options within a
+	// QueryChannelRange.
+	queryOptionsType uint64 = 1
+
+	// timestampsType is the TLV type of the update timestamps within a
+	// ReplyChannelRange.
+	timestampsType uint64 = 1
+
+	// checksumsType is the TLV type of the update checksums within a
+	// ReplyChannelRange.
+	checksumsType uint64 = 3
+)
+
+// ChanUpdateTimestamps houses the timestamps of the latest ChannelUpdate of
+// both directions of a channel. A zero timestamp signals that no update is
+// known for that direction.
+type ChanUpdateTimestamps struct {
+	// Timestamp1 is the timestamp of the update of the first node.
+	Timestamp1 uint32
+
+	// Timestamp2 is the timestamp of the update of the second node.
+	Timestamp2 uint32
+}
+
+// ChanUpdateChecksums houses the checksums of the latest ChannelUpdate of
+// both directions of a channel, as computed by ChanUpdateChecksum. A zero
+// checksum signals that no update is known for that direction.
+type ChanUpdateChecksums struct {
+	// Checksum1 is the checksum of the update of the first node.
+	Checksum1 uint32
+
+	// Checksum2 is the checksum of the update of the second node.
+	Checksum2 uint32
+}
+
+// crc32cTable is the table of the Castagnoli polynomial used to compute the
+// checksums of ChannelUpdates.
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
+// ChanUpdateChecksum computes the CRC32C checksum of the given ChannelUpdate,
+// excluding its signature and timestamp. Two updates with an equal checksum
+// therefore carry the same policy, which allows a node to skip an update that
+// merely refreshes the timestamp of one it already has.
+func ChanUpdateChecksum(upd *ChannelUpdate) (uint32, error) {
+	data, err := upd.DataToSign()
+	if err != nil {
+		return 0, err
+	}
+
+	// The timestamp directly follows the chain hash and the short channel
+	// ID, so we'll snip it out before computing the checksum.
+	const timestampOffset = 32 + 8
+	var b bytes.Buffer
+	b.Write(data[:timestampOffset])
+	b.Write(data[timestampOffset+4:])
+
+	return crc32.Checksum(b.Bytes(), crc32cTable), nil
+}
+
+// errNonCanonicalBigSize is returned when a BigSize integer isn't encoded
+// using the minimal number of bytes.
+var errNonCanonicalBigSize = errors.New("non-canonical bigsize encoding")
+
+// writeBigSize writes the given integer to the passed io.Writer using the
+// variable length BigSize encoding of TLV records.
+func writeBigSize(w io.Writer, v uint64) error {
+	var b [9]byte
+	switch {
+	case v < 0xfd:
+		b[0] = uint8(v)
+		_, err := w.Write(b[:1])
+		return err
+
+	case v <= 0xffff:
+		b[0] = 0xfd
+		binary.BigEndian.PutUint16(b[1:3], uint16(v))
+		_, err := w.Write(b[:3])
+		return err
+
+	case v <= 0xffffffff:
+		b[0] = 0xfe
+		binary.BigEndian.PutUint32(b[1:5], uint32(v))
+		_, err := w.Write(b[:5])
+		return err
+
+	default:
+		b[0] = 0xff
+		binary.BigEndian.PutUint64(b[1:9], v)
+		_, err := w.Write(b[:9])
+		return err
+	}
+}
+
+// readBigSize reads a BigSize encoded integer from the passed io.Reader.
+func readBigSize(r io.Reader) (uint64, error) {
+	var b [8]byte
+	if _, err := io.ReadFull(r, b[:1]); err != nil {
+		return 0, err
+	}
+
+	switch b[0] {
+	case 0xff:
+		if _, err := io.ReadFull(r, b[:8]); err != nil {
+			return 0, err
+		}
+		v := binary.BigEndian.Uint64(b[:8])
+		if v <= 0xffffffff {
+			return 0, errNonCanonicalBigSize
+		}
+		return v, nil
+
+	case 0xfe:
+		if _, err := io.ReadFull(r, b[:4]); err != nil {
+			return 0, err
+		}
+		v := uint64(binary.BigEndian.Uint32(b[:4]))
+		if v <= 0xffff {
+			return 0, errNonCanonicalBigSize
+		}
+		return v, nil
+
+	case 0xfd:
+		if _, err := io.ReadFull(r, b[:2]); err != nil {
+			return 0, err
+		}
+		v := uint64(binary.BigEndian.Uint16(b[:2]))
+		if v < 0xfd {
+			return 0, errNonCanonicalBigSize
+		}
+		return v, nil
+
+	default:
+		return uint64(b[0]), nil
+	}
+}
+
+// tlvRecord is a single type-length-value record of the optional extensions
+// appended to a message.
+type tlvRecord struct {
+	typ   uint64
+	value []byte
+}
+
+// encodeTLVStream writes the given records to the passed io.Writer. The
+// records MUST be sorted by their type in strictly ascending order.
+func encodeTLVStream(w io.Writer, records ...tlvRecord) error {
+	for _, record := range records {
+		if err := writeBigSize(w, record.typ); err != nil {
+			return err
+		}
+		err := writeBigSize(w, uint64(len(record.value)))
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(record.value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// decodeTLVStream reads all records remaining within the passed io.Reader,
+// returning the values of those with a known type. Unknown records of an odd
+// type are skipped, while unknown records of an even type result in an error,
+// as the sender requires us to understand them.
+func decodeTLVStream(r io.Reader,
+	knownTypes ...uint64) (map[uint64][]byte, error) {
+
+	stream, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[uint64]struct{}, len(knownTypes))
+	for _, typ := range knownTypes {
+		known[typ] = struct{}{}
+	}
+
+	var (
+		records  = make(map[uint64][]byte)
+		reader   = bytes.NewReader(stream)
+		lastType uint64
+		first    = true
+	)
+	for reader.Len() > 0 {
+		typ, err := readBigSize(reader)
+		if err != nil {
+			return nil, err
+		}
+		if !first && typ <= lastType {
+			return nil, fmt.Errorf("tlv type %d doesn't follow "+
+				"type %d in ascending order", typ, lastType)
+		}
+		first = false
+		lastType = typ
+
+		length, err := readBigSize(reader)
+		if err != nil {
+			return nil, err
+		}
+		if length > uint64(reader.Len()) {
+			return nil, fmt.Errorf("tlv record of type %d with "+
+				"length %d exceeds message", typ, length)
+		}
+
+		value := make([]byte, length)
+		if _, err := io.ReadFull(reader, value); err != nil {
+			return nil, err
+		}
+
+		if _, ok := known[typ]; ok {
+			records[typ] = value
+			continue
+		}
+
+		if typ%2 == 0 {
+			return nil, fmt.Errorf("unknown required tlv type %d",
+				typ)
+		}
+	}
+
+	return records, nil
+}
+
+// encodeUpdateTimestamps serializes the given timestamps as the value of the
+// timestamps record of a ReplyChannelRange, using the specified encoding.
+func encodeUpdateTimestamps(encodingType ShortChanIDEncoding,
+	timestamps []ChanUpdateTimestamps) ([]byte, error) {
+
+	var raw bytes.Buffer
+	for _, ts := range timestamps {
+		err := WriteElements(&raw, ts.Timestamp1, ts.Timestamp2)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var b bytes.Buffer
+	if err := WriteElements(&b, encodingType); err != nil {
+		return nil, err
+	}
+
+	switch encodingType {
+	case EncodingSortedPlain:
+		b.Write(raw.Bytes())
+
+	case EncodingSortedZlib:
+		zlibWriter := zlib.NewWriter(&b)
+		if _, err := zlibWriter.Write(raw.Bytes()); err != nil {
+			return nil, err
+		}
+		if err := zlibWriter.Close(); err != nil {
+			return nil, fmt.Errorf("unable to finalize "+
+				"compression: %v", err)
+		}
+
+	default:
+		return nil, ErrUnknownShortChanIDEncoding(encodingType)
+	}
+
+	return b.Bytes(), nil
+}
+
+// decodeUpdateTimestamps parses the value of the timestamps record of a
+// ReplyChannelRange.
+func decodeUpdateTimestamps(value []byte) ([]ChanUpdateTimestamps, error) {
+	if len(value) == 0 {
+		return nil, fmt.Errorf("no encoding type specified")
+	}
+
+	encodingType := ShortChanIDEncoding(value[0])
+	body := value[1:]
+
+	var r io.Reader
+	switch encodingType {
+	case EncodingSortedPlain:
+		if len(body)%8 != 0 {
+			return nil, fmt.Errorf("whole number of timestamps "+
+				"cannot be encoded in len=%v", len(body))
+		}
+		r = bytes.NewReader(body)
+
+	// Just as with the short channel IDs, we'll bound the memory we're
+	// willing to allocate to decompress the payload.
+	case EncodingSortedZlib:
+		zlibDecodeMtx.Lock()
+		defer zlibDecodeMtx.Unlock()
+
+		decompressor, err := zlib.NewReader(&io.LimitedReader{
+			R: bytes.NewReader(body),
+			N: maxZlibBufSize,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to create zlib "+
+				"reader: %v", err)
+		}
+		r = decompressor
+
+	default:
+		return nil, ErrUnknownShortChanIDEncoding(encodingType)
+	}
+
+	var timestamps []ChanUpdateTimestamps
+	for {
+		var ts ChanUpdateTimestamps
+		err := ReadElements(r, &ts.Timestamp1, &ts.Timestamp2)
+		switch {
+		case err == io.EOF:
+			return timestamps, nil
+
+		case err != nil:
+			return nil, fmt.Errorf("unable to read update "+
+				"timestamps: %v", err)
+		}
+
+		timestamps = append(timestamps, ts)
+	}
+}
+
+// encodeUpdateChecksums serializes the given checksums as the value of the
+// checksums record of a ReplyChannelRange.
+func encodeUpdateChecksums(checksums []ChanUpdateChecksums) ([]byte, error) {
+	var b bytes.Buffer
+	for _, cs := range checksums {
+		err := WriteElements(&b, cs.Checksum1, cs.Checksum2)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return b.Bytes(), nil
+}
+
+// decodeUpdateChecksums parses the value of the checksums record of a
+// ReplyChannelRange.
+func decodeUpdateChecksums(value []byte) ([]ChanUpdateChecksums, error) {
+	if len(value)%8 != 0 {
+		return nil, fmt.Errorf("whole number of checksums cannot be "+
+			"encoded in len=%v", len(value))
+	}
+
+	r := bytes.NewReader(value)
+	checksums := make([]ChanUpdateChecksums, len(value)/8)
+	for i := range checksums {
+		err := ReadElements(
+			r, &checksums[i].Checksum1, &checksums[i].Checksum2,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return checksums, nil
+}
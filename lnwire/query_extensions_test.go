@@ -0,0 +1,141 @@
+package lnwire
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestBigSizeEncoding ensures that BigSize integers round trip using the
+// minimal encoding, and that non-canonical encodings are rejected.
+func TestBigSizeEncoding(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value uint64
+		size  int
+	}{
+		{value: 0, size: 1},
+		{value: 0xfc, size: 1},
+		{value: 0xfd, size: 3},
+		{value: 0xffff, size: 3},
+		{value: 0x10000, size: 5},
+		{value: 0xffffffff, size: 5},
+		{value: 0x100000000, size: 9},
+		{value: 0xffffffffffffffff, size: 9},
+	}
+	for _, test := range tests {
+		var b bytes.Buffer
+		if err := writeBigSize(&b, test.value); err != nil {
+			t.Fatalf("unable to write %d: %v", test.value, err)
+		}
+		if b.Len() != test.size {
+			t.Fatalf("expected %d to be encoded in %d bytes, got %d",
+				test.value, test.size, b.Len())
+		}
+
+		value, err := readBigSize(&b)
+		if err != nil {
+			t.Fatalf("unable to read %d: %v", test.value, err)
+		}
+		if value != test.value {
+			t.Fatalf("expected %d, got %d", test.value, value)
+		}
+	}
+
+	nonCanonical := [][]byte{
+		{0xfd, 0x00, 0xfc},
+		{0xfe, 0x00, 0x00, 0xff, 0xff},
+		{0xff, 0x00, 0x00, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff},
+	}
+	for _, encoded := range nonCanonical {
+		_, err := readBigSize(bytes.NewReader(encoded))
+		if err != errNonCanonicalBigSize {
+			t.Fatalf("expected non-canonical error for %x, got %v",
+				encoded, err)
+		}
+	}
+}
+
+// TestDecodeTLVStream ensures that unknown odd records are skipped, while
+// unknown even records and records out of order are rejected.
+func TestDecodeTLVStream(t *testing.T) {
+	t.Parallel()
+
+	encode := func(records ...tlvRecord) []byte {
+		var b bytes.Buffer
+		if err := encodeTLVStream(&b, records...); err != nil {
+			t.Fatalf("unable to encode records: %v", err)
+		}
+		return b.Bytes()
+	}
+
+	stream := encode(
+		tlvRecord{typ: 1, value: []byte{0x01}},
+		tlvRecord{typ: 5, value: []byte{0x05, 0x05}},
+	)
+	records, err := decodeTLVStream(bytes.NewReader(stream), 1)
+	if err != nil {
+		t.Fatalf("unable to decode records: %v", err)
+	}
+	if len(records) != 1 || !bytes.Equal(records[1], []byte{0x01}) {
+		t.Fatalf("unexpected records: %v", records)
+	}
+
+	stream = encode(tlvRecord{typ: 4, value: []byte{0x04}})
+	if _, err := decodeTLVStream(bytes.NewReader(stream), 1); err == nil {
+		t.Fatalf("expected unknown even record to be rejected")
+	}
+
+	stream = encode(
+		tlvRecord{typ: 3, value: nil},
+		tlvRecord{typ: 1, value: nil},
+	)
+	if _, err := decodeTLVStream(bytes.NewReader(stream), 1, 3); err == nil {
+		t.Fatalf("expected records out of order to be rejected")
+	}
+
+	stream = []byte{0x01, 0x05, 0x00}
+	if _, err := decodeTLVStream(bytes.NewReader(stream), 1); err == nil {
+		t.Fatalf("expected truncated record to be rejected")
+	}
+}
+
+// TestChanUpdateChecksum ensures that the checksum of a ChannelUpdate covers
+// its policy, but not its signature and timestamp.
+func TestChanUpdateChecksum(t *testing.T) {
+	t.Parallel()
+
+	upd := &ChannelUpdate{
+		ShortChannelID:  NewShortChanIDFromInt(1337),
+		Timestamp:       1000,
+		TimeLockDelta:   144,
+		HtlcMinimumMsat: 1000,
+		BaseFee:         1000,
+		FeeRate:         1,
+	}
+	checksum, err := ChanUpdateChecksum(upd)
+	if err != nil {
+		t.Fatalf("unable to compute checksum: %v", err)
+	}
+
+	refreshed := *upd
+	refreshed.Timestamp = 2000
+	refreshed.Signature[0] = 0x01
+	refreshedChecksum, err := ChanUpdateChecksum(&refreshed)
+	if err != nil {
+		t.Fatalf("unable to compute checksum: %v", err)
+	}
+	if refreshedChecksum != checksum {
+		t.Fatalf("checksum changed by timestamp or signature")
+	}
+
+	changed := *upd
+	changed.FeeRate = 2
+	changedChecksum, err := ChanUpdateChecksum(&changed)
+	if err != nil {
+		t.Fatalf("unable to compute checksum: %v", err)
+	}
+	if changedChecksum == checksum {
+		t.Fatalf("checksum unchanged by policy change")
+	}
+}
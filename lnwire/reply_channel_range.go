@@ -1,6 +1,10 @@
 package lnwire
 
-import "io"
+import (
+	"fmt"
+	"io"
+	"sort"
+)
 
 // ReplyChannelRange is the response to the QueryChannelRange message. It
 // includes the original query, and the next streaming chunk of encoded short
@@ -21,6 +25,17 @@ type ReplyChannelRange struct {
 
 	// ShortChanIDs is a slice of decoded short channel ID's.
 	ShortChanIDs []ShortChannelID
+
+	// Timestamps is the optional set of timestamps of the latest
+	// ChannelUpdates of each channel, in the same order as ShortChanIDs.
+	// These are only included if requested by the query, and are encoded
+	// using the same EncodingType as the short channel IDs.
+	Timestamps []ChanUpdateTimestamps
+
+	// Checksums is the optional set of checksums of the latest
+	// ChannelUpdates of each channel, in the same order as ShortChanIDs.
+	// These are only included if requested by the query.
+	Checksums []ChanUpdateChecksums
 }
 
 // NewReplyChannelRange creates a new empty ReplyChannelRange message.
@@ -37,18 +52,55 @@ var _ Message = (*ReplyChannelRange)(nil)
 //
 // This is part of the lnwire.Message interface.
 func (c *ReplyChannelRange) Decode(r io.Reader, pver uint32) error {
-	err := c.QueryChannelRange.Decode(r, pver)
+	// The query options of the embedded query aren't part of the reply,
+	// so we'll only read out its fixed fields.
+	err := ReadElements(r,
+		c.ChainHash[:],
+		&c.FirstBlockHeight,
+		&c.NumBlocks,
+		&c.Complete,
+	)
 	if err != nil {
 		return err
 	}
 
-	if err := ReadElements(r, &c.Complete); err != nil {
+	c.EncodingType, c.ShortChanIDs, err = decodeShortChanIDs(r)
+	if err != nil {
 		return err
 	}
 
-	c.EncodingType, c.ShortChanIDs, err = decodeShortChanIDs(r)
+	records, err := decodeTLVStream(r, timestampsType, checksumsType)
+	if err != nil {
+		return err
+	}
+
+	c.Timestamps = nil
+	if value, ok := records[timestampsType]; ok {
+		c.Timestamps, err = decodeUpdateTimestamps(value)
+		if err != nil {
+			return err
+		}
+		if len(c.Timestamps) != len(c.ShortChanIDs) {
+			return fmt.Errorf("got %d update timestamps for %d "+
+				"short chan IDs", len(c.Timestamps),
+				len(c.ShortChanIDs))
+		}
+	}
 
-	return err
+	c.Checksums = nil
+	if value, ok := records[checksumsType]; ok {
+		c.Checksums, err = decodeUpdateChecksums(value)
+		if err != nil {
+			return err
+		}
+		if len(c.Checksums) != len(c.ShortChanIDs) {
+			return fmt.Errorf("got %d update checksums for %d "+
+				"short chan IDs", len(c.Checksums),
+				len(c.ShortChanIDs))
+		}
+	}
+
+	return nil
 }
 
 // Encode serializes the target ReplyChannelRange into the passed io.Writer
@@ -56,15 +108,70 @@ func (c *ReplyChannelRange) Decode(r io.Reader, pver uint32) error {
 //
 // This is part of the lnwire.Message interface.
 func (c *ReplyChannelRange) Encode(w io.Writer, pver uint32) error {
-	if err := c.QueryChannelRange.Encode(w, pver); err != nil {
+	// As the short channel IDs are sorted during encoding, the optional
+	// extensions can only be matched to them if they're sorted already.
+	hasExtensions := c.Timestamps != nil || c.Checksums != nil
+	isSorted := sort.SliceIsSorted(c.ShortChanIDs, func(i, j int) bool {
+		return c.ShortChanIDs[i].ToUint64() <
+			c.ShortChanIDs[j].ToUint64()
+	})
+	if hasExtensions && !isSorted {
+		return fmt.Errorf("short chan IDs must be sorted to include " +
+			"update timestamps or checksums")
+	}
+
+	err := WriteElements(w,
+		c.ChainHash[:],
+		c.FirstBlockHeight,
+		c.NumBlocks,
+		c.Complete,
+	)
+	if err != nil {
 		return err
 	}
 
-	if err := WriteElements(w, c.Complete); err != nil {
+	err = encodeShortChanIDs(w, c.EncodingType, c.ShortChanIDs)
+	if err != nil {
 		return err
 	}
 
-	return encodeShortChanIDs(w, c.EncodingType, c.ShortChanIDs)
+	var records []tlvRecord
+	if c.Timestamps != nil {
+		if len(c.Timestamps) != len(c.ShortChanIDs) {
+			return fmt.Errorf("got %d update timestamps for %d "+
+				"short chan IDs", len(c.Timestamps),
+				len(c.ShortChanIDs))
+		}
+
+		value, err := encodeUpdateTimestamps(
+			c.EncodingType, c.Timestamps,
+		)
+		if err != nil {
+			return err
+		}
+		records = append(records, tlvRecord{
+			typ:   timestampsType,
+			value: value,
+		})
+	}
+	if c.Checksums != nil {
+		if len(c.Checksums) != len(c.ShortChanIDs) {
+			return fmt.Errorf("got %d update checksums for %d "+
+				"short chan IDs", len(c.Checksums),
+				len(c.ShortChanIDs))
+		}
+
+		value, err := encodeUpdateChecksums(c.Checksums)
+		if err != nil {
+			return err
+		}
+		records = append(records, tlvRecord{
+			typ:   checksumsType,
+			value: value,
+		})
+	}
+
+	return encodeTLVStream(w, records...)
 }
 
 // MsgType returns the integer uniquely identifying this message type on the
@@ -3,6 +3,8 @@ package macaroons
 import (
 	"fmt"
 	"net"
+	"strconv"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc/peer"
@@ -13,6 +15,24 @@ import (
 	"golang.org/x/net/context"
 )
 
+const (
+	// CondMethods is the caveat condition that restricts a macaroon to a
+	// list of RPC methods.
+	CondMethods = "methods"
+
+	// CondMaxPayment is the caveat condition that limits the amount of
+	// every single payment made with a macaroon.
+	CondMaxPayment = "maxpayment"
+
+	// CondSpendLimit is the caveat condition that limits the total amount
+	// spent with a macaroon within a time window.
+	CondSpendLimit = "spendlimit"
+
+	// CondMaxInvoice is the caveat condition that limits the amount of
+	// invoices created with a macaroon.
+	CondMaxInvoice = "maxinvoice"
+)
+
 // Constraint type adds a layer of indirection over macaroon caveats.
 type Constraint func(*macaroon.Macaroon) error
 
@@ -88,3 +108,194 @@ func IPLockChecker() (string, checkers.Func) {
 		return nil
 	}
 }
+
+// methodContextKey is the type of the context key under which the full name
+// of the RPC method being called is stored.
+type methodContextKey struct{}
+
+// ContextWithMethod returns a copy of the passed context that carries the full
+// name of the RPC method being called, such that the MethodChecker can verify
+// it.
+func ContextWithMethod(ctx context.Context, method string) context.Context {
+	return context.WithValue(ctx, methodContextKey{}, method)
+}
+
+// MethodFromContext returns the full name of the RPC method stored in the
+// passed context, or an empty string if there is none.
+func MethodFromContext(ctx context.Context) string {
+	method, _ := ctx.Value(methodContextKey{}).(string)
+	return method
+}
+
+// MethodsConstraint restricts the macaroon to the given RPC methods. Methods
+// can be given by their full name, such as /lnrpc.Lightning/GetInfo, or just by
+// their name, such as GetInfo. If no methods are given, this constraint does
+// nothing.
+func MethodsConstraint(methods ...string) func(*macaroon.Macaroon) error {
+	return func(mac *macaroon.Macaroon) error {
+		if len(methods) == 0 {
+			return nil
+		}
+
+		for _, method := range methods {
+			if method == "" || strings.ContainsAny(method, " \t") {
+				return fmt.Errorf("invalid method name %q",
+					method)
+			}
+		}
+
+		caveat := checkers.Condition(
+			CondMethods, strings.Join(methods, " "),
+		)
+		return mac.AddFirstPartyCaveat([]byte(caveat))
+	}
+}
+
+// MethodChecker accepts the RPC method being called from the validation
+// context and checks that it's one of the methods the macaroon is restricted
+// to. It is of the `Checker` type.
+func MethodChecker() (string, checkers.Func) {
+	return CondMethods, func(ctx context.Context, cond, arg string) error {
+		method := MethodFromContext(ctx)
+		if method == "" {
+			return fmt.Errorf("unable to get method from context")
+		}
+
+		// The short name of a method is the part after the service
+		// name.
+		shortName := method[strings.LastIndex(method, "/")+1:]
+		for _, allowed := range strings.Fields(arg) {
+			if allowed == method || allowed == shortName {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("method %v not allowed by macaroon", method)
+	}
+}
+
+// PaymentLimitConstraint limits the cost in milli-satoshis of every single
+// payment made with the macaroon, which is the amount paid plus the maximum
+// fee the payment may incur. A zero amount means no limit, in which case this
+// constraint does nothing.
+func PaymentLimitConstraint(maxMsat uint64) func(*macaroon.Macaroon) error {
+	return func(mac *macaroon.Macaroon) error {
+		if maxMsat == 0 {
+			return nil
+		}
+
+		caveat := checkers.Condition(
+			CondMaxPayment, strconv.FormatUint(maxMsat, 10),
+		)
+		return mac.AddFirstPartyCaveat([]byte(caveat))
+	}
+}
+
+// SpendLimitConstraint limits the total amount in milli-satoshis, including
+// fees, that can be spent with the macaroon within any time window of the given
+// duration. A zero limit means no limit, in which case this constraint does
+// nothing.
+func SpendLimitConstraint(limitMsat uint64,
+	window time.Duration) func(*macaroon.Macaroon) error {
+
+	return func(mac *macaroon.Macaroon) error {
+		if limitMsat == 0 {
+			return nil
+		}
+
+		seconds := int64(window / time.Second)
+		if seconds <= 0 {
+			return fmt.Errorf("spend limit window must be at " +
+				"least one second")
+		}
+
+		arg := fmt.Sprintf("%d %d", limitMsat, seconds)
+		caveat := checkers.Condition(CondSpendLimit, arg)
+		return mac.AddFirstPartyCaveat([]byte(caveat))
+	}
+}
+
+// InvoiceLimitConstraint limits the amount in milli-satoshis of invoices
+// created with the macaroon. Invoices without an amount can't be created with
+// such a macaroon. A zero amount means no limit, in which case this constraint
+// does nothing.
+func InvoiceLimitConstraint(maxMsat uint64) func(*macaroon.Macaroon) error {
+	return func(mac *macaroon.Macaroon) error {
+		if maxMsat == 0 {
+			return nil
+		}
+
+		caveat := checkers.Condition(
+			CondMaxInvoice, strconv.FormatUint(maxMsat, 10),
+		)
+		return mac.AddFirstPartyCaveat([]byte(caveat))
+	}
+}
+
+// parseAmountArg parses the argument of the maxpayment and maxinvoice caveats.
+func parseAmountArg(arg string) (uint64, error) {
+	amt, err := strconv.ParseUint(arg, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q: %v", arg, err)
+	}
+
+	return amt, nil
+}
+
+// parseSpendLimitArg parses the argument of the spendlimit caveat into the
+// limit in milli-satoshis and the duration of the time window.
+func parseSpendLimitArg(arg string) (uint64, time.Duration, error) {
+	fields := strings.Fields(arg)
+	if len(fields) != 2 {
+		return 0, 0, fmt.Errorf("invalid spend limit %q", arg)
+	}
+
+	limit, err := strconv.ParseUint(fields[0], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid spend limit %q: %v", arg, err)
+	}
+
+	seconds, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil || seconds <= 0 {
+		return 0, 0, fmt.Errorf("invalid spend limit window %q", arg)
+	}
+
+	return limit, time.Duration(seconds) * time.Second, nil
+}
+
+// PaymentLimitChecker only verifies that the maxpayment caveat is well formed
+// when the macaroon is validated. The limit itself is enforced by the payment
+// path through Service.ReserveSpend, as the amount isn't known at this point.
+// It is of the `Checker` type.
+func PaymentLimitChecker() (string, checkers.Func) {
+	return CondMaxPayment, func(ctx context.Context, cond,
+		arg string) error {
+
+		_, err := parseAmountArg(arg)
+		return err
+	}
+}
+
+// SpendLimitChecker only verifies that the spendlimit caveat is well formed
+// when the macaroon is validated. The limit itself is enforced by the payment
+// path through Service.ReserveSpend. It is of the `Checker` type.
+func SpendLimitChecker() (string, checkers.Func) {
+	return CondSpendLimit, func(ctx context.Context, cond,
+		arg string) error {
+
+		_, _, err := parseSpendLimitArg(arg)
+		return err
+	}
+}
+
+// InvoiceLimitChecker only verifies that the maxinvoice caveat is well formed
+// when the macaroon is validated. The limit itself is enforced when invoices
+// are created through Service.CheckInvoiceAmount. It is of the `Checker` type.
+func InvoiceLimitChecker() (string, checkers.Func) {
+	return CondMaxInvoice, func(ctx context.Context, cond,
+		arg string) error {
+
+		_, err := parseAmountArg(arg)
+		return err
+	}
+}
@@ -61,6 +61,12 @@ func NewService(dir string, checks ...Checker) (*Service, error) {
 		return nil, err
 	}
 
+	// The amounts spent with macaroons that carry spending caveats are
+	// tracked in the same database.
+	if err := initSpendBucket(macaroonDB); err != nil {
+		return nil, err
+	}
+
 	macaroonParams := bakery.BakeryParams{
 		Location:     "lnd",
 		RootKeyStore: rootKeyStore,
@@ -73,7 +79,6 @@ func NewService(dir string, checks ...Checker) (*Service, error) {
 	svc := bakery.New(macaroonParams)
 
 	// Register all custom caveat checkers with the bakery's checker.
-	// TODO(aakselrod): Add more checks as required.
 	checker := svc.Checker.FirstPartyCaveatChecker.(*checkers.Checker)
 	for _, check := range checks {
 		cond, fun := check()
@@ -117,7 +122,12 @@ func (svc *Service) UnaryServerInterceptor(
 				"required for method", info.FullMethod)
 		}
 
-		err := svc.ValidateMacaroon(ctx, permissionMap[info.FullMethod])
+		// The method is passed along to the checkers, such that
+		// macaroons can be restricted to a list of methods.
+		err := svc.ValidateMacaroon(
+			ContextWithMethod(ctx, info.FullMethod),
+			permissionMap[info.FullMethod],
+		)
 		if err != nil {
 			return nil, err
 		}
@@ -140,7 +150,8 @@ func (svc *Service) StreamServerInterceptor(
 		}
 
 		err := svc.ValidateMacaroon(
-			ss.Context(), permissionMap[info.FullMethod],
+			ContextWithMethod(ss.Context(), info.FullMethod),
+			permissionMap[info.FullMethod],
 		)
 		if err != nil {
 			return err
@@ -150,20 +161,17 @@ func (svc *Service) StreamServerInterceptor(
 	}
 }
 
-// ValidateMacaroon validates the capabilities of a given request given a
-// bakery service, context, and uri. Within the passed context.Context, we
-// expect a macaroon to be encoded as request metadata using the key
-// "macaroon".
-func (svc *Service) ValidateMacaroon(ctx context.Context,
-	requiredPermissions []bakery.Op) error {
-
+// MacaroonFromContext extracts the macaroon of a request from the passed
+// context. Within the passed context.Context, we expect a macaroon to be
+// encoded as request metadata using the key "macaroon".
+func MacaroonFromContext(ctx context.Context) (*macaroon.Macaroon, error) {
 	// Get macaroon bytes from context and unmarshal into macaroon.
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return fmt.Errorf("unable to get metadata from context")
+		return nil, fmt.Errorf("unable to get metadata from context")
 	}
 	if len(md["macaroon"]) != 1 {
-		return fmt.Errorf("expected 1 macaroon, got %d",
+		return nil, fmt.Errorf("expected 1 macaroon, got %d",
 			len(md["macaroon"]))
 	}
 
@@ -172,10 +180,25 @@ func (svc *Service) ValidateMacaroon(ctx context.Context,
 	// representation.
 	macBytes, err := hex.DecodeString(md["macaroon"][0])
 	if err != nil {
-		return err
+		return nil, err
 	}
 	mac := &macaroon.Macaroon{}
 	err = mac.UnmarshalBinary(macBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return mac, nil
+}
+
+// ValidateMacaroon validates the capabilities of a given request given a
+// bakery service, context, and uri. Within the passed context.Context, we
+// expect a macaroon to be encoded as request metadata using the key
+// "macaroon".
+func (svc *Service) ValidateMacaroon(ctx context.Context,
+	requiredPermissions []bakery.Op) error {
+
+	mac, err := MacaroonFromContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -198,3 +221,34 @@ func (svc *Service) Close() error {
 func (svc *Service) CreateUnlock(password *[]byte) error {
 	return svc.rks.CreateUnlock(password)
 }
+
+// NewMacaroon returns a new macaroon that allows the given operations, minted
+// with the root key of the given ID. The root key is created if it doesn't
+// exist yet.
+func (svc *Service) NewMacaroon(ctx context.Context, rootKeyID []byte,
+	ops ...bakery.Op) (*bakery.Macaroon, error) {
+
+	if len(rootKeyID) == 0 {
+		return nil, fmt.Errorf("root key id must be specified")
+	}
+
+	// The root key store picks up the root key ID from the context when
+	// the oven asks it for a root key.
+	ctx = ContextWithRootKeyID(ctx, rootKeyID)
+
+	return svc.Oven.NewMacaroon(ctx, bakery.LatestVersion, nil, ops...)
+}
+
+// ListMacaroonIDs returns the IDs of all root keys in the underlying root key
+// store.
+func (svc *Service) ListMacaroonIDs(ctx context.Context) ([][]byte, error) {
+	return svc.rks.ListMacaroonIDs(ctx)
+}
+
+// DeleteMacaroonID removes the root key with the given ID from the underlying
+// root key store, which revokes all macaroons minted with it.
+func (svc *Service) DeleteMacaroonID(ctx context.Context,
+	rootKeyID []byte) ([]byte, error) {
+
+	return svc.rks.DeleteMacaroonID(ctx, rootKeyID)
+}
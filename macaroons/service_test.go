@@ -134,3 +134,99 @@ func TestValidateMacaroon(t *testing.T) {
 		t.Fatalf("Error validating the macaroon: %v", err)
 	}
 }
+
+// TestRevokeMacaroon tests that macaroons minted with a custom root key ID are
+// valid until their root key is deleted, and that deleting it doesn't affect
+// macaroons minted with other root keys.
+func TestRevokeMacaroon(t *testing.T) {
+	// First, initialize the service and unlock it.
+	tempDir := setupTestRootKeyStorage(t)
+	defer os.RemoveAll(tempDir)
+	service, err := macaroons.NewService(tempDir, macaroons.IPLockChecker)
+	if err != nil {
+		t.Fatalf("Error creating new service: %v", err)
+	}
+	defer service.Close()
+	err = service.CreateUnlock(&defaultPw)
+	if err != nil {
+		t.Fatalf("Error unlocking root key storage: %v", err)
+	}
+
+	// validate returns the result of validating the given macaroon
+	// against the test operation.
+	validate := func(mac *bakery.Macaroon) error {
+		macaroonBinary, err := mac.M().MarshalBinary()
+		if err != nil {
+			t.Fatalf("Error serializing macaroon: %v", err)
+		}
+
+		md := metadata.New(map[string]string{
+			"macaroon": hex.EncodeToString(macaroonBinary),
+		})
+		ctx := metadata.NewIncomingContext(context.Background(), md)
+
+		return service.ValidateMacaroon(ctx, []bakery.Op{testOperation})
+	}
+
+	// Mint one macaroon with the default root key, and one with a custom
+	// root key.
+	ctx := context.Background()
+	defaultMac, err := service.NewMacaroon(
+		ctx, macaroons.DefaultRootKeyID, testOperation,
+	)
+	if err != nil {
+		t.Fatalf("Error creating macaroon: %v", err)
+	}
+	customID := macaroons.EncodeRootKeyID(1)
+	customMac, err := service.NewMacaroon(ctx, customID, testOperation)
+	if err != nil {
+		t.Fatalf("Error creating macaroon: %v", err)
+	}
+
+	if err := validate(defaultMac); err != nil {
+		t.Fatalf("Error validating default macaroon: %v", err)
+	}
+	if err := validate(customMac); err != nil {
+		t.Fatalf("Error validating custom macaroon: %v", err)
+	}
+
+	// Both root keys should now be listed.
+	ids, err := service.ListMacaroonIDs(ctx)
+	if err != nil {
+		t.Fatalf("Error listing root key ids: %v", err)
+	}
+	if len(ids) != 2 {
+		t.Fatalf("Expected 2 root key ids, got %d", len(ids))
+	}
+
+	// The default root key can't be deleted.
+	_, err = service.DeleteMacaroonID(ctx, macaroons.DefaultRootKeyID)
+	if err != macaroons.ErrDeletionForbidden {
+		t.Fatalf("Received %v instead of ErrDeletionForbidden", err)
+	}
+
+	// Deleting the custom root key revokes the macaroon minted with it,
+	// while the default macaroon remains valid.
+	deleted, err := service.DeleteMacaroonID(ctx, customID)
+	if err != nil {
+		t.Fatalf("Error deleting root key id: %v", err)
+	}
+	if string(deleted) != string(customID) {
+		t.Fatalf("Expected deleted id %s, got %s", customID, deleted)
+	}
+	if err := validate(customMac); err == nil {
+		t.Fatalf("Expected revoked macaroon to be invalid")
+	}
+	if err := validate(defaultMac); err != nil {
+		t.Fatalf("Error validating default macaroon: %v", err)
+	}
+
+	// Deleting the root key a second time is a no-op.
+	deleted, err = service.DeleteMacaroonID(ctx, customID)
+	if err != nil {
+		t.Fatalf("Error deleting root key id: %v", err)
+	}
+	if deleted != nil {
+		t.Fatalf("Expected no root key to be deleted, got %s", deleted)
+	}
+}
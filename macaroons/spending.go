@@ -0,0 +1,329 @@
+package macaroons
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/coreos/bbolt"
+	"gopkg.in/macaroon-bakery.v2/bakery/checkers"
+	macaroon "gopkg.in/macaroon.v2"
+
+	"golang.org/x/net/context"
+)
+
+var (
+	// spendBucketName is the name of the bucket that tracks the amounts
+	// spent with macaroons that carry a spend limit caveat. It holds a
+	// sub-bucket for every such caveat, keyed by the hash of the macaroon
+	// ID and the caveat, which in turn holds the spends within the time
+	// window of the caveat.
+	spendBucketName = []byte("macspending")
+)
+
+// spendLimit is a spendlimit caveat of a macaroon, along with the key of the
+// sub-bucket its spends are tracked in.
+type spendLimit struct {
+	key    [sha256.Size]byte
+	limit  uint64
+	window time.Duration
+}
+
+// spendCaveats holds the spending related caveats of a macaroon.
+type spendCaveats struct {
+	maxPayments []uint64
+	maxInvoices []uint64
+	spendLimits []spendLimit
+}
+
+// parseSpendCaveats extracts the spending related caveats from the given
+// macaroon. Derived macaroons share the ID of the macaroon they were derived
+// from, so all of them draw from the same spend limit if they carry the same
+// caveat.
+func parseSpendCaveats(mac *macaroon.Macaroon) (*spendCaveats, error) {
+	caveats := &spendCaveats{}
+	for _, caveat := range mac.Caveats() {
+		// Third party caveats are never about spending.
+		if len(caveat.VerificationId) > 0 {
+			continue
+		}
+
+		cond, arg, err := checkers.ParseCaveat(string(caveat.Id))
+		if err != nil {
+			return nil, err
+		}
+
+		switch cond {
+		case CondMaxPayment:
+			amt, err := parseAmountArg(arg)
+			if err != nil {
+				return nil, err
+			}
+			caveats.maxPayments = append(caveats.maxPayments, amt)
+
+		case CondMaxInvoice:
+			amt, err := parseAmountArg(arg)
+			if err != nil {
+				return nil, err
+			}
+			caveats.maxInvoices = append(caveats.maxInvoices, amt)
+
+		case CondSpendLimit:
+			limit, window, err := parseSpendLimitArg(arg)
+			if err != nil {
+				return nil, err
+			}
+
+			h := sha256.New()
+			h.Write(mac.Id())
+			h.Write(caveat.Id)
+
+			spend := spendLimit{
+				limit:  limit,
+				window: window,
+			}
+			copy(spend.key[:], h.Sum(nil))
+			caveats.spendLimits = append(caveats.spendLimits, spend)
+		}
+	}
+
+	return caveats, nil
+}
+
+// SpendReservation is an amount that has been reserved against the spend
+// limits of a macaroon. Once the payment it was reserved for has completed,
+// the reservation must be settled with the amount that was actually spent.
+type SpendReservation struct {
+	db *bbolt.DB
+
+	// records maps the sub-buckets of the spend limits the amount was
+	// reserved against to the key of the spend record in each of them.
+	records map[[sha256.Size]byte][]byte
+}
+
+// Settle replaces the reserved amount with the amount that was actually spent.
+// If nothing was spent because the payment failed, the reservation is
+// released. Settling a nil reservation has no effect.
+func (r *SpendReservation) Settle(spentMsat uint64) error {
+	if r == nil || len(r.records) == 0 {
+		return nil
+	}
+
+	return r.db.Update(func(tx *bbolt.Tx) error {
+		spendBucket := tx.Bucket(spendBucketName)
+		for limitKey, recordKey := range r.records {
+			bucket := spendBucket.Bucket(limitKey[:])
+			if bucket == nil {
+				continue
+			}
+
+			if spentMsat == 0 {
+				if err := bucket.Delete(recordKey); err != nil {
+					return err
+				}
+				continue
+			}
+
+			var amt [8]byte
+			binary.BigEndian.PutUint64(amt[:], spentMsat)
+			if err := bucket.Put(recordKey, amt[:]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// initSpendBucket creates the bucket that tracks the amounts spent with
+// macaroons if it doesn't exist yet.
+func initSpendBucket(db *bbolt.DB) error {
+	return db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(spendBucketName)
+		return err
+	})
+}
+
+// ReserveSpend checks that the macaroon of the request in the passed context
+// allows a payment costing at most maxCostMsat to be made. Both the maxpayment
+// and the spendlimit caveats apply to the cost of a payment, which is the
+// amount paid plus fees. As the fees aren't known in advance, the maximum cost
+// is checked against the limits and reserved against every spend limit of the
+// macaroon, such that concurrent payments can't exceed it. The returned
+// reservation must be settled with the actual cost once the payment has
+// completed.
+func (svc *Service) ReserveSpend(ctx context.Context,
+	maxCostMsat uint64) (*SpendReservation, error) {
+
+	mac, err := MacaroonFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	caveats, err := parseSpendCaveats(mac)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, maxPayment := range caveats.maxPayments {
+		if maxCostMsat > maxPayment {
+			return nil, fmt.Errorf("payment costing up to %d "+
+				"msat exceeds the macaroon limit of %d msat "+
+				"per payment", maxCostMsat, maxPayment)
+		}
+	}
+
+	reservation := &SpendReservation{
+		db:      svc.rks.DB,
+		records: make(map[[sha256.Size]byte][]byte),
+	}
+	if len(caveats.spendLimits) == 0 {
+		return reservation, nil
+	}
+
+	now := time.Now()
+	err = svc.rks.Update(func(tx *bbolt.Tx) error {
+		spendBucket := tx.Bucket(spendBucketName)
+		for _, limit := range caveats.spendLimits {
+			bucket, err := spendBucket.CreateBucketIfNotExists(
+				limit.key[:],
+			)
+			if err != nil {
+				return err
+			}
+
+			spent, err := pruneAndSumSpends(
+				bucket, now.Add(-limit.window),
+			)
+			if err != nil {
+				return err
+			}
+
+			if spent+maxCostMsat > limit.limit {
+				return fmt.Errorf("payment costing up to %d "+
+					"msat exceeds the macaroon spend "+
+					"limit of %d msat per %v, %d msat "+
+					"already spent", maxCostMsat,
+					limit.limit, limit.window, spent)
+			}
+
+			// The record key starts with the time of the spend,
+			// such that the records are sorted by time. The
+			// sequence number makes it unique.
+			seq, err := bucket.NextSequence()
+			if err != nil {
+				return err
+			}
+			var recordKey [16]byte
+			binary.BigEndian.PutUint64(
+				recordKey[:8], uint64(now.UnixNano()),
+			)
+			binary.BigEndian.PutUint64(recordKey[8:], seq)
+
+			var amt [8]byte
+			binary.BigEndian.PutUint64(amt[:], maxCostMsat)
+			if err := bucket.Put(recordKey[:], amt[:]); err != nil {
+				return err
+			}
+
+			reservation.records[limit.key] = recordKey[:]
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reservation, nil
+}
+
+// RejectUntrackedSpend returns an error if the macaroon of the request in the
+// passed context carries a maxpayment or spendlimit caveat. It must be called
+// by every call that moves funds without reserving them through ReserveSpend,
+// such as on-chain sends and channel opens and closes, whose cost can't be
+// accounted for against the limits of the macaroon.
+func (svc *Service) RejectUntrackedSpend(ctx context.Context) error {
+	mac, err := MacaroonFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	caveats, err := parseSpendCaveats(mac)
+	if err != nil {
+		return err
+	}
+
+	if len(caveats.maxPayments) > 0 || len(caveats.spendLimits) > 0 {
+		return fmt.Errorf("macaroons with spending limits can only " +
+			"be used for off-chain payments")
+	}
+
+	return nil
+}
+
+// pruneAndSumSpends deletes all spend records from the bucket that are older
+// than the given time, and returns the sum of the remaining ones.
+func pruneAndSumSpends(bucket *bbolt.Bucket, since time.Time) (uint64, error) {
+	var (
+		expired [][]byte
+		sum     uint64
+	)
+
+	cursor := bucket.Cursor()
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		if len(k) != 16 || len(v) != 8 {
+			continue
+		}
+
+		spendTime := time.Unix(0, int64(binary.BigEndian.Uint64(k[:8])))
+		if spendTime.Before(since) {
+			expired = append(expired, k)
+			continue
+		}
+
+		sum += binary.BigEndian.Uint64(v)
+	}
+
+	for _, k := range expired {
+		if err := bucket.Delete(k); err != nil {
+			return 0, err
+		}
+	}
+
+	return sum, nil
+}
+
+// CheckInvoiceAmount checks that the macaroon of the request in the passed
+// context allows an invoice of the given amount to be created. An amount of
+// zero denotes an invoice that can be paid with any amount, which isn't
+// allowed if the macaroon limits the invoice amount.
+func (svc *Service) CheckInvoiceAmount(ctx context.Context,
+	amtMsat uint64) error {
+
+	mac, err := MacaroonFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	caveats, err := parseSpendCaveats(mac)
+	if err != nil {
+		return err
+	}
+
+	for _, maxInvoice := range caveats.maxInvoices {
+		if amtMsat == 0 {
+			return fmt.Errorf("invoices without an amount are " +
+				"not allowed by macaroon")
+		}
+
+		if amtMsat > maxInvoice {
+			return fmt.Errorf("invoice of %d msat exceeds the "+
+				"macaroon limit of %d msat", amtMsat,
+				maxInvoice)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,263 @@
+package macaroons_test
+
+import (
+	"context"
+	"encoding/hex"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/macaroons"
+	"google.golang.org/grpc/metadata"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
+
+// setupSpendingService creates an unlocked macaroon service that knows about
+// the spending caveats. The returned function cleans up the service.
+func setupSpendingService(t *testing.T) (*macaroons.Service, func()) {
+	tempDir := setupTestRootKeyStorage(t)
+	service, err := macaroons.NewService(
+		tempDir, macaroons.MethodChecker,
+		macaroons.PaymentLimitChecker, macaroons.SpendLimitChecker,
+		macaroons.InvoiceLimitChecker,
+	)
+	if err != nil {
+		os.RemoveAll(tempDir)
+		t.Fatalf("Error creating new service: %v", err)
+	}
+	err = service.CreateUnlock(&defaultPw)
+	if err != nil {
+		service.Close()
+		os.RemoveAll(tempDir)
+		t.Fatalf("Error unlocking root key storage: %v", err)
+	}
+
+	return service, func() {
+		service.Close()
+		os.RemoveAll(tempDir)
+	}
+}
+
+// constrainedContext bakes a macaroon with the given constraints and returns
+// an incoming context that carries it for the given method.
+func constrainedContext(t *testing.T, service *macaroons.Service,
+	method string, cs ...macaroons.Constraint) context.Context {
+
+	mac, err := service.Oven.NewMacaroon(
+		nil, bakery.LatestVersion, nil, testOperation,
+	)
+	if err != nil {
+		t.Fatalf("Error creating macaroon from service: %v", err)
+	}
+	constrainedMac, err := macaroons.AddConstraints(mac.M(), cs...)
+	if err != nil {
+		t.Fatalf("Error adding constraints: %v", err)
+	}
+	macBytes, err := constrainedMac.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Error serializing macaroon: %v", err)
+	}
+
+	md := metadata.New(map[string]string{
+		"macaroon": hex.EncodeToString(macBytes),
+	})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	return macaroons.ContextWithMethod(ctx, method)
+}
+
+// TestMethodsConstraint tests that a macaroon restricted to a set of methods
+// is only valid for those methods.
+func TestMethodsConstraint(t *testing.T) {
+	service, cleanUp := setupSpendingService(t)
+	defer cleanUp()
+
+	restriction := macaroons.MethodsConstraint(
+		"GetInfo", "/lnrpc.Lightning/ListChannels",
+	)
+	tests := []struct {
+		method string
+		valid  bool
+	}{
+		{"/lnrpc.Lightning/GetInfo", true},
+		{"/lnrpc.Lightning/ListChannels", true},
+		{"/lnrpc.Lightning/SendPayment", false},
+		{"/routerrpc.Router/ListChannels", false},
+	}
+	for _, test := range tests {
+		ctx := constrainedContext(t, service, test.method, restriction)
+		err := service.ValidateMacaroon(
+			ctx, []bakery.Op{testOperation},
+		)
+		if test.valid && err != nil {
+			t.Fatalf("method %v: expected macaroon to be valid, "+
+				"got: %v", test.method, err)
+		}
+		if !test.valid && err == nil {
+			t.Fatalf("method %v: expected macaroon to be invalid",
+				test.method)
+		}
+	}
+}
+
+// TestReserveSpend tests that payments are checked against the payment and
+// spend limits of a macaroon, and that reservations are settled correctly.
+func TestReserveSpend(t *testing.T) {
+	service, cleanUp := setupSpendingService(t)
+	defer cleanUp()
+
+	ctx := constrainedContext(
+		t, service, "/lnrpc.Lightning/SendPaymentSync",
+		macaroons.PaymentLimitConstraint(5000),
+		macaroons.SpendLimitConstraint(10000, time.Hour),
+	)
+
+	// A payment that may cost more than the per payment limit, including
+	// fees, is refused outright.
+	if _, err := service.ReserveSpend(ctx, 5001); err == nil {
+		t.Fatalf("expected payment above limit to be refused")
+	}
+
+	// Reserve the maximum cost of two payments, which leaves no room for
+	// a third one while they are in flight.
+	first, err := service.ReserveSpend(ctx, 5000)
+	if err != nil {
+		t.Fatalf("unable to reserve spend: %v", err)
+	}
+	second, err := service.ReserveSpend(ctx, 5000)
+	if err != nil {
+		t.Fatalf("unable to reserve spend: %v", err)
+	}
+	if _, err := service.ReserveSpend(ctx, 1); err == nil {
+		t.Fatalf("expected payment above spend limit to be refused")
+	}
+
+	// The first payment fails and the second one costs less than the
+	// reserved amount, which frees up room for another payment.
+	if err := first.Settle(0); err != nil {
+		t.Fatalf("unable to settle spend: %v", err)
+	}
+	if err := second.Settle(4100); err != nil {
+		t.Fatalf("unable to settle spend: %v", err)
+	}
+	if _, err := service.ReserveSpend(ctx, 4900); err != nil {
+		t.Fatalf("unable to reserve spend: %v", err)
+	}
+	if _, err := service.ReserveSpend(ctx, 1001); err == nil {
+		t.Fatalf("expected payment above spend limit to be refused")
+	}
+
+	// A macaroon without spending caveats isn't limited.
+	ctx = constrainedContext(t, service, "/lnrpc.Lightning/SendPayment")
+	reservation, err := service.ReserveSpend(ctx, 1000000)
+	if err != nil {
+		t.Fatalf("unable to reserve spend: %v", err)
+	}
+	if err := reservation.Settle(1000000); err != nil {
+		t.Fatalf("unable to settle spend: %v", err)
+	}
+}
+
+// TestSpendLimitWindow tests that spends older than the window of a spend
+// limit no longer count against it.
+func TestSpendLimitWindow(t *testing.T) {
+	service, cleanUp := setupSpendingService(t)
+	defer cleanUp()
+
+	ctx := constrainedContext(
+		t, service, "/lnrpc.Lightning/SendPaymentSync",
+		macaroons.SpendLimitConstraint(1000, time.Second),
+	)
+
+	if _, err := service.ReserveSpend(ctx, 1000); err != nil {
+		t.Fatalf("unable to reserve spend: %v", err)
+	}
+	if _, err := service.ReserveSpend(ctx, 1); err == nil {
+		t.Fatalf("expected payment above spend limit to be refused")
+	}
+
+	time.Sleep(1100 * time.Millisecond)
+
+	if _, err := service.ReserveSpend(ctx, 1000); err != nil {
+		t.Fatalf("unable to reserve spend after window: %v", err)
+	}
+}
+
+// TestCheckInvoiceAmount tests that invoice amounts are checked against the
+// invoice limit of a macaroon.
+func TestCheckInvoiceAmount(t *testing.T) {
+	service, cleanUp := setupSpendingService(t)
+	defer cleanUp()
+
+	ctx := constrainedContext(
+		t, service, "/lnrpc.Lightning/AddInvoice",
+		macaroons.InvoiceLimitConstraint(1000),
+	)
+
+	if err := service.CheckInvoiceAmount(ctx, 1000); err != nil {
+		t.Fatalf("expected invoice to be allowed: %v", err)
+	}
+	if err := service.CheckInvoiceAmount(ctx, 1001); err == nil {
+		t.Fatalf("expected invoice above limit to be refused")
+	}
+	if err := service.CheckInvoiceAmount(ctx, 0); err == nil {
+		t.Fatalf("expected invoice without amount to be refused")
+	}
+
+	ctx = constrainedContext(t, service, "/lnrpc.Lightning/AddInvoice")
+	if err := service.CheckInvoiceAmount(ctx, 0); err != nil {
+		t.Fatalf("expected invoice to be allowed: %v", err)
+	}
+}
+
+// TestRejectUntrackedSpend tests that macaroons with spending limits are
+// rejected for calls that move funds without tracking their cost.
+func TestRejectUntrackedSpend(t *testing.T) {
+	service, cleanUp := setupSpendingService(t)
+	defer cleanUp()
+
+	tests := []struct {
+		name        string
+		constraints []macaroons.Constraint
+		allowed     bool
+	}{
+		{
+			name:    "no caveats",
+			allowed: true,
+		},
+		{
+			name: "invoice limit",
+			constraints: []macaroons.Constraint{
+				macaroons.InvoiceLimitConstraint(1000),
+			},
+			allowed: true,
+		},
+		{
+			name: "payment limit",
+			constraints: []macaroons.Constraint{
+				macaroons.PaymentLimitConstraint(1000),
+			},
+		},
+		{
+			name: "spend limit",
+			constraints: []macaroons.Constraint{
+				macaroons.SpendLimitConstraint(
+					1000, time.Hour,
+				),
+			},
+		},
+	}
+	for _, test := range tests {
+		ctx := constrainedContext(
+			t, service, "/lnrpc.Lightning/SendCoins",
+			test.constraints...,
+		)
+		err := service.RejectUntrackedSpend(ctx)
+		if test.allowed && err != nil {
+			t.Fatalf("%v: expected call to be allowed: %v",
+				test.name, err)
+		}
+		if !test.allowed && err == nil {
+			t.Fatalf("%v: expected call to be rejected", test.name)
+		}
+	}
+}
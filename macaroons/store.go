@@ -1,9 +1,11 @@
 package macaroons
 
 import (
+	"bytes"
 	"crypto/rand"
 	"fmt"
 	"io"
+	"strconv"
 
 	"golang.org/x/net/context"
 
@@ -21,11 +23,11 @@ var (
 	// rootKeyBucketName is the name of the root key store bucket.
 	rootKeyBucketName = []byte("macrootkeys")
 
-	// defaultRootKeyID is the ID of the default root key. The first is
-	// just 0, to emulate the memory storage that comes with bakery.
-	//
-	// TODO(aakselrod): Add support for key rotation.
-	defaultRootKeyID = []byte("0")
+	// DefaultRootKeyID is the ID of the default root key. The first is
+	// just 0, to emulate the memory storage that comes with bakery. It's
+	// used to mint the default macaroons, and whenever no other root key
+	// ID is specified through the context.
+	DefaultRootKeyID = []byte("0")
 
 	// encryptedKeyID is the name of the database key that stores the
 	// encryption key, encrypted with a salted + hashed password. The
@@ -42,8 +44,49 @@ var (
 
 	// ErrPasswordRequired specifies that a nil password has been passed.
 	ErrPasswordRequired = fmt.Errorf("a non-nil password is required")
+
+	// ErrDeletionForbidden is used when attempting to delete the default
+	// root key ID or the encryption key.
+	ErrDeletionForbidden = fmt.Errorf("the specified ID cannot be deleted")
 )
 
+// rootKeyIDContextKey is the type of the context key under which the root key
+// ID to mint new macaroons with is stored.
+type rootKeyIDContextKey struct{}
+
+// ContextWithRootKeyID returns a copy of the passed context that instructs the
+// root key storage to mint new macaroons with the root key of the given ID.
+func ContextWithRootKeyID(ctx context.Context, id []byte) context.Context {
+	return context.WithValue(ctx, rootKeyIDContextKey{}, id)
+}
+
+// RootKeyIDFromContext returns the root key ID stored in the passed context,
+// or the default root key ID if there is none.
+func RootKeyIDFromContext(ctx context.Context) []byte {
+	if ctx == nil {
+		return DefaultRootKeyID
+	}
+
+	id, ok := ctx.Value(rootKeyIDContextKey{}).([]byte)
+	if !ok || len(id) == 0 {
+		return DefaultRootKeyID
+	}
+
+	return id
+}
+
+// EncodeRootKeyID returns the root key ID that corresponds to the given
+// numeric ID. Numeric IDs are what root keys are identified by through RPC,
+// where zero is the default root key ID.
+func EncodeRootKeyID(id uint64) []byte {
+	return []byte(strconv.FormatUint(id, 10))
+}
+
+// DecodeRootKeyID returns the numeric ID of the given root key ID.
+func DecodeRootKeyID(id []byte) (uint64, error) {
+	return strconv.ParseUint(string(id), 10, 64)
+}
+
 // RootKeyStorage implements the bakery.RootKeyStorage interface.
 type RootKeyStorage struct {
 	*bbolt.DB
@@ -148,14 +191,19 @@ func (r *RootKeyStorage) Get(_ context.Context, id []byte) ([]byte, error) {
 }
 
 // RootKey implements the RootKey method for the bakery.RootKeyStorage
-// interface.
-// TODO(aakselrod): Add support for key rotation.
-func (r *RootKeyStorage) RootKey(_ context.Context) ([]byte, []byte, error) {
+// interface. The root key with the ID found in the context is returned, which
+// is created if it doesn't exist yet. If the context holds no root key ID, the
+// default root key is used.
+func (r *RootKeyStorage) RootKey(ctx context.Context) ([]byte, []byte, error) {
 	if r.encKey == nil {
 		return nil, nil, ErrStoreLocked
 	}
 	var rootKey []byte
-	id := defaultRootKeyID
+	id := RootKeyIDFromContext(ctx)
+	if bytes.Equal(id, encryptedKeyID) {
+		return nil, nil, fmt.Errorf("invalid root key id %s",
+			string(id))
+	}
 	err := r.Update(func(tx *bbolt.Tx) error {
 		ns := tx.Bucket(rootKeyBucketName)
 		dbKey := ns.Get(id)
@@ -193,6 +241,76 @@ func (r *RootKeyStorage) RootKey(_ context.Context) ([]byte, []byte, error) {
 	return rootKey, id, nil
 }
 
+// ListMacaroonIDs returns the IDs of all root keys in the store.
+func (r *RootKeyStorage) ListMacaroonIDs(_ context.Context) ([][]byte,
+	error) {
+
+	if r.encKey == nil {
+		return nil, ErrStoreLocked
+	}
+
+	var rootKeyIDs [][]byte
+	err := r.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(rootKeyBucketName)
+		return bucket.ForEach(func(k, _ []byte) error {
+			// The encryption key is stored in the same bucket, but
+			// isn't a root key.
+			if bytes.Equal(k, encryptedKeyID) {
+				return nil
+			}
+
+			id := make([]byte, len(k))
+			copy(id, k)
+			rootKeyIDs = append(rootKeyIDs, id)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rootKeyIDs, nil
+}
+
+// DeleteMacaroonID removes the root key with the given ID from the store. All
+// macaroons minted with this root key will no longer be valid. The default root
+// key can't be deleted. It returns the ID of the deleted root key, or nil if
+// no root key with the given ID exists.
+func (r *RootKeyStorage) DeleteMacaroonID(_ context.Context,
+	rootKeyID []byte) ([]byte, error) {
+
+	if r.encKey == nil {
+		return nil, ErrStoreLocked
+	}
+
+	if len(rootKeyID) == 0 {
+		return nil, fmt.Errorf("root key id must be specified")
+	}
+
+	if bytes.Equal(rootKeyID, DefaultRootKeyID) ||
+		bytes.Equal(rootKeyID, encryptedKeyID) {
+
+		return nil, ErrDeletionForbidden
+	}
+
+	var deleted []byte
+	err := r.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(rootKeyBucketName)
+		if bucket.Get(rootKeyID) == nil {
+			return nil
+		}
+
+		deleted = rootKeyID
+		return bucket.Delete(rootKeyID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deleted, nil
+}
+
 // Close closes the underlying database and zeroes the encryption key stored
 // in memory.
 func (r *RootKeyStorage) Close() error {
@@ -10,6 +10,10 @@ import (
 	natpmp "github.com/jackpal/go-nat-pmp"
 )
 
+// pmpPortMappingLifetime is the lifetime we'll request for port mappings, as
+// recommended by RFC 6886. The NAT-PMP device may grant a shorter lifetime.
+const pmpPortMappingLifetime = 2 * time.Hour
+
 // Compile-time check to ensure PMP implements the Traversal interface.
 var _ Traversal = (*PMP)(nil)
 
@@ -20,6 +24,10 @@ type PMP struct {
 
 	forwardedPortsMtx sync.Mutex
 	forwardedPorts    map[uint16]struct{}
+
+	// lease is the shortest lifetime granted by the NAT-PMP device for
+	// any of our port mappings.
+	lease time.Duration
 }
 
 // DiscoverPMP attempts to scan the local network for a NAT-PMP enabled device
@@ -65,11 +73,22 @@ func (p *PMP) AddPortMapping(port uint16) error {
 	p.forwardedPortsMtx.Lock()
 	defer p.forwardedPortsMtx.Unlock()
 
-	_, err := p.client.AddPortMapping("tcp", int(port), int(port), 0)
+	// A lifetime of zero would request the mapping to be deleted, so
+	// we'll request our default lifetime and keep track of the lifetime
+	// actually granted in order to renew it in time.
+	res, err := p.client.AddPortMapping(
+		"tcp", int(port), int(port),
+		int(pmpPortMappingLifetime/time.Second),
+	)
 	if err != nil {
 		return err
 	}
 
+	lease := time.Duration(res.PortMappingLifetimeInSeconds) * time.Second
+	if p.lease == 0 || lease < p.lease {
+		p.lease = lease
+	}
+
 	p.forwardedPorts[port] = struct{}{}
 
 	return nil
@@ -107,6 +126,19 @@ func (p *PMP) ForwardedPorts() []uint16 {
 	return ports
 }
 
+// PortMappingLease returns the shortest lifetime granted by the NAT-PMP device
+// for any of our port mappings.
+func (p *PMP) PortMappingLease() time.Duration {
+	p.forwardedPortsMtx.Lock()
+	defer p.forwardedPortsMtx.Unlock()
+
+	if p.lease == 0 {
+		return pmpPortMappingLifetime
+	}
+
+	return p.lease
+}
+
 // Name returns the name of the specific NAT traversal technique used.
 func (p *PMP) Name() string {
 	return "NAT-PMP"
@@ -3,6 +3,7 @@ package nat
 import (
 	"errors"
 	"net"
+	"time"
 )
 
 var (
@@ -47,6 +48,12 @@ type Traversal interface {
 	// traversal.
 	ForwardedPorts() []uint16
 
+	// PortMappingLease returns the duration a port mapping remains active
+	// for once added. The mapping must be renewed by adding it again
+	// before the lease runs out. A zero duration indicates that port
+	// mappings remain active indefinitely.
+	PortMappingLease() time.Duration
+
 	// Name returns the name of the specific NAT traversal technique used.
 	Name() string
 }
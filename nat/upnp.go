@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"sync"
+	"time"
 
 	upnp "github.com/NebulousLabs/go-upnp"
 )
@@ -102,6 +103,13 @@ func (u *UPnP) ForwardedPorts() []uint16 {
 	return ports
 }
 
+// PortMappingLease returns the duration port mappings remain active for. The
+// UPnP device is requested to keep port mappings active indefinitely, though
+// they may still be dropped, e.g. when the device restarts.
+func (u *UPnP) PortMappingLease() time.Duration {
+	return 0
+}
+
 // Name returns the name of the specific NAT traversal technique used.
 func (u *UPnP) Name() string {
 	return "UPnP"
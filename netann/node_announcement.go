@@ -0,0 +1,63 @@
+package netann
+
+import (
+	"net"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// NodeAnnModifier is a closure that makes in-place modifications to an
+// lnwire.NodeAnnouncement.
+type NodeAnnModifier func(*lnwire.NodeAnnouncement)
+
+// NodeAnnSetAddrs is a functional option that allows updating the addresses of
+// the given node announcement.
+func NodeAnnSetAddrs(addrs []net.Addr) NodeAnnModifier {
+	return func(nodeAnn *lnwire.NodeAnnouncement) {
+		nodeAnn.Addresses = addrs
+	}
+}
+
+// SignNodeAnnouncement applies the given modifiers to the passed
+// lnwire.NodeAnnouncement, then signs the resulting announcement. The provided
+// announcement should be the most recent one, otherwise the timestamp may not
+// monotonically increase from the prior.
+//
+// NOTE: This method modifies the given announcement.
+func SignNodeAnnouncement(signer lnwallet.MessageSigner,
+	pubKey *btcec.PublicKey, nodeAnn *lnwire.NodeAnnouncement,
+	mods ...NodeAnnModifier) error {
+
+	// Apply the requested changes to the node announcement.
+	for _, modifier := range mods {
+		modifier(nodeAnn)
+	}
+
+	// Update the message's timestamp to the current time. If the
+	// announcement's current time is already in the future, we increment
+	// the prior value to ensure the timestamps monotonically increase,
+	// otherwise the announcement won't propagate.
+	newTimestamp := uint32(time.Now().Unix())
+	if newTimestamp <= nodeAnn.Timestamp {
+		newTimestamp = nodeAnn.Timestamp + 1
+	}
+	nodeAnn.Timestamp = newTimestamp
+
+	nodeAnnMsg, err := nodeAnn.DataToSign()
+	if err != nil {
+		return err
+	}
+
+	// Create the DER-encoded ECDSA signature over the message digest.
+	sig, err := signer.SignMessage(pubKey, nodeAnnMsg)
+	if err != nil {
+		return err
+	}
+
+	// Parse the DER-encoded signature into a fixed-size 64-byte array.
+	nodeAnn.Signature, err = lnwire.NewSigFromSignature(sig)
+	return err
+}
@@ -0,0 +1,95 @@
+package netann_test
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/netann"
+	"github.com/lightningnetwork/lnd/routing"
+)
+
+// TestSignNodeAnnouncement checks that SignNodeAnnouncement applies the passed
+// modifiers, monotonically increases the timestamp, and produces a signature
+// that validates, or returns the signer's error.
+func TestSignNodeAnnouncement(t *testing.T) {
+	t.Parallel()
+
+	newAddrs := []net.Addr{
+		&net.TCPAddr{IP: net.ParseIP("1.2.3.4"), Port: 9735},
+	}
+
+	tests := []struct {
+		name      string
+		startTime time.Time
+		signer    *mockSigner
+		expErr    error
+	}{
+		{
+			name:      "working signer",
+			startTime: time.Now(),
+		},
+		{
+			name:      "working signer future monotonicity",
+			startTime: time.Now().Add(time.Hour),
+		},
+		{
+			name:      "failing signer",
+			startTime: time.Now(),
+			signer:    &mockSigner{err: errFailedToSign},
+			expErr:    errFailedToSign,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ogTimestamp := uint32(tc.startTime.Unix())
+			nodeAnn := &lnwire.NodeAnnouncement{
+				Timestamp: ogTimestamp,
+				Features:  lnwire.NewRawFeatureVector(),
+			}
+			copy(nodeAnn.NodeID[:], pubKey.SerializeCompressed())
+
+			var err error
+			if tc.signer != nil {
+				err = netann.SignNodeAnnouncement(
+					tc.signer, pubKey, nodeAnn,
+					netann.NodeAnnSetAddrs(newAddrs),
+				)
+			} else {
+				err = netann.SignNodeAnnouncement(
+					netann.NewNodeSigner(privKey), pubKey,
+					nodeAnn, netann.NodeAnnSetAddrs(newAddrs),
+				)
+			}
+			if err != tc.expErr {
+				t.Fatalf("expected error: %v, got %v",
+					tc.expErr, err)
+			}
+			if tc.expErr != nil {
+				return
+			}
+
+			if nodeAnn.Timestamp <= ogTimestamp {
+				t.Fatalf("announcement timestamp should be "+
+					"monotonically increasing, "+
+					"original: %d, new %d", ogTimestamp,
+					nodeAnn.Timestamp)
+			}
+
+			if len(nodeAnn.Addresses) != 1 ||
+				nodeAnn.Addresses[0] != newAddrs[0] {
+
+				t.Fatalf("expected addresses %v, got %v",
+					newAddrs, nodeAnn.Addresses)
+			}
+
+			if err := routing.ValidateNodeAnn(nodeAnn); err != nil {
+				t.Fatalf("node announcement failed to "+
+					"validate: %v", err)
+			}
+		})
+	}
+}
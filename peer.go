@@ -38,6 +38,11 @@ const (
 	// pingInterval is the interval at which ping messages are sent.
 	pingInterval = 1 * time.Minute
 
+	// pongTimeout is the duration we'll wait for a pong in response to one
+	// of our pings before considering the peer unresponsive and
+	// disconnecting it.
+	pongTimeout = 30 * time.Second
+
 	// idleTimeout is the duration of inactivity before we time out a peer.
 	idleTimeout = 5 * time.Minute
 
@@ -125,6 +130,18 @@ type peer struct {
 	// our last ping message.  To be used atomically.
 	pingLastSend int64
 
+	// pongReceived is signaled by the readHandler each time a pong is
+	// received, allowing the pingHandler to detect unresponsive peers.
+	pongReceived chan struct{}
+
+	// msgStatsMtx protects access to the msgsSent and msgsReceived maps.
+	msgStatsMtx sync.Mutex
+
+	// msgsSent and msgsReceived count the number of messages sent to and
+	// received from the peer over this connection, indexed by their type.
+	msgsSent     map[lnwire.MessageType]uint64
+	msgsReceived map[lnwire.MessageType]uint64
+
 	connReq *connmgr.ConnReq
 	conn    net.Conn
 
@@ -138,6 +155,10 @@ type peer struct {
 
 	inbound bool
 
+	// connectedAt is the time at which the connection to the peer was
+	// made.
+	connectedAt time.Time
+
 	// sendQueue is the channel which is used to queue outgoing to be
 	// written onto the wire. Note that this channel is unbuffered.
 	sendQueue chan outgoingMsg
@@ -251,8 +272,9 @@ func newPeer(conn net.Conn, connReq *connmgr.ConnReq, server *server,
 		conn: conn,
 		addr: addr,
 
-		inbound: inbound,
-		connReq: connReq,
+		inbound:     inbound,
+		connReq:     connReq,
+		connectedAt: time.Now(),
 
 		server: server,
 
@@ -264,6 +286,10 @@ func newPeer(conn net.Conn, connReq *connmgr.ConnReq, server *server,
 		sendQueue:     make(chan outgoingMsg),
 		outgoingQueue: make(chan outgoingMsg),
 
+		pongReceived: make(chan struct{}, 1),
+		msgsSent:     make(map[lnwire.MessageType]uint64),
+		msgsReceived: make(map[lnwire.MessageType]uint64),
+
 		addedChannels:  make(map[lnwire.ChannelID]struct{}),
 		activeChannels: make(map[lnwire.ChannelID]*lnwallet.LightningChannel),
 		newChannels:    make(chan *newChannelMsg, 1),
@@ -601,6 +627,7 @@ func (p *peer) addLink(chanPoint *wire.OutPoint,
 		MaxFeeUpdateTimeout:     htlcswitch.DefaultMaxLinkFeeUpdateTimeout,
 		FinalCltvRejectDelta:    p.finalCltvRejectDelta,
 		OutgoingCltvRejectDelta: p.outgoingCltvRejectDelta,
+		HtlcNotifier:            p.server.htlcNotifier,
 	}
 
 	link := htlcswitch.NewChannelLink(linkCfg, lnChan)
@@ -709,6 +736,10 @@ func (p *peer) readNextMessage() (lnwire.Message, error) {
 
 	p.logWireMessage(nextMsg, true)
 
+	p.msgStatsMtx.Lock()
+	p.msgsReceived[nextMsg.MsgType()]++
+	p.msgStatsMtx.Unlock()
+
 	return nextMsg, nil
 }
 
@@ -1076,6 +1107,13 @@ out:
 			delay := (time.Now().UnixNano() - pingSendTime) / 1000
 			atomic.StoreInt64(&p.pingTime, delay)
 
+			// Let the pingHandler know the peer is still
+			// responsive.
+			select {
+			case p.pongReceived <- struct{}{}:
+			default:
+			}
+
 		case *lnwire.Ping:
 			pongBytes := make([]byte, msg.NumPongBytes)
 			p.queueMsg(lnwire.NewPong(pongBytes), nil)
@@ -1107,6 +1145,10 @@ out:
 		case *lnwire.Error:
 			key := p.addr.IdentityKey
 
+			// Keep track of the error, such that it can be
+			// inspected after the fact.
+			p.server.recordPeerError(p, msg, false)
+
 			switch {
 			// In the case of an all-zero channel ID we want to
 			// forward the error to all channels with this peer.
@@ -1176,6 +1218,20 @@ out:
 
 			discStream.AddMsg(msg)
 
+		case *lnwire.Custom:
+			// Custom messages aren't interpreted by lnd, so we'll
+			// hand them off to any subscribed clients as is.
+			err := p.server.customMessageServer.SendUpdate(
+				&CustomMessage{
+					Peer: p.pubKeyBytes,
+					Msg:  msg,
+				},
+			)
+			if err != nil {
+				peerLog.Debugf("Unable to dispatch custom "+
+					"message from peer %v: %v", p, err)
+			}
+
 		default:
 			peerLog.Errorf("unknown message %v received from peer "+
 				"%v", uint16(msg.MsgType()), p)
@@ -1333,6 +1389,9 @@ func messageSummary(msg lnwire.Message) string {
 			time.Unix(int64(msg.FirstTimestamp), 0),
 			msg.TimestampRange)
 
+	case *lnwire.Custom:
+		return fmt.Sprintf("type=%d, len=%v", uint16(msg.Type),
+			len(msg.Data))
 	}
 
 	return ""
@@ -1506,8 +1565,10 @@ out:
 			}
 
 			// Record the time at which we first attempt to send the
-			// message.
+			// message, and hold on to the message itself, as it's
+			// dropped from outMsg on retries.
 			startTime := time.Now()
+			msg := outMsg.msg
 
 		retry:
 			// Write out the message to the socket. If a timeout
@@ -1555,6 +1616,16 @@ out:
 				break out
 			}
 
+			p.msgStatsMtx.Lock()
+			p.msgsSent[msg.MsgType()]++
+			p.msgStatsMtx.Unlock()
+
+			// Keep track of any errors we send, such that they can
+			// be inspected after the fact.
+			if errMsg, ok := msg.(*lnwire.Error); ok {
+				p.server.recordPeerError(p, errMsg, true)
+			}
+
 		case <-p.quit:
 			exitErr = lnpeer.ErrPeerExiting
 			break out
@@ -1637,7 +1708,8 @@ func (p *peer) queueHandler() {
 
 // pingHandler is responsible for periodically sending ping messages to the
 // remote peer in order to keep the connection alive and/or determine if the
-// connection is still active.
+// connection is still active. If the peer doesn't respond to a ping with a
+// pong within pongTimeout, it is disconnected.
 //
 // NOTE: This method MUST be run as a goroutine.
 func (p *peer) pingHandler() {
@@ -1649,11 +1721,43 @@ func (p *peer) pingHandler() {
 	// TODO(roasbeef): make dynamic in order to create fake cover traffic
 	const numPingBytes = 16
 
+	// pongTimer is non-nil while we're awaiting a pong in response to our
+	// last ping.
+	var (
+		pongTimer     *time.Timer
+		pongTimeoutCh <-chan time.Time
+	)
+	defer func() {
+		if pongTimer != nil {
+			pongTimer.Stop()
+		}
+	}()
+
 out:
 	for {
 		select {
 		case <-pingTicker.C:
 			p.queueMsg(lnwire.NewPing(numPingBytes), nil)
+
+			// Start awaiting the pong, unless we're still awaiting
+			// the one for a prior ping.
+			if pongTimer == nil {
+				pongTimer = time.NewTimer(pongTimeout)
+				pongTimeoutCh = pongTimer.C
+			}
+
+		case <-p.pongReceived:
+			if pongTimer != nil {
+				pongTimer.Stop()
+				pongTimer = nil
+				pongTimeoutCh = nil
+			}
+
+		case <-pongTimeoutCh:
+			p.Disconnect(fmt.Errorf("pong not received within %v",
+				pongTimeout))
+			break out
+
 		case <-p.quit:
 			break out
 		}
@@ -1665,6 +1769,27 @@ func (p *peer) PingTime() int64 {
 	return atomic.LoadInt64(&p.pingTime)
 }
 
+// MsgStats returns the number of messages sent to and received from the peer
+// over the current connection, indexed by their type.
+func (p *peer) MsgStats() (map[lnwire.MessageType]uint64,
+	map[lnwire.MessageType]uint64) {
+
+	p.msgStatsMtx.Lock()
+	defer p.msgStatsMtx.Unlock()
+
+	sent := make(map[lnwire.MessageType]uint64, len(p.msgsSent))
+	for msgType, count := range p.msgsSent {
+		sent[msgType] = count
+	}
+
+	received := make(map[lnwire.MessageType]uint64, len(p.msgsReceived))
+	for msgType, count := range p.msgsReceived {
+		received[msgType] = count
+	}
+
+	return sent, received
+}
+
 // queueMsg adds the lnwire.Message to the back of the high priority send queue.
 // If the errChan is non-nil, an error is sent back if the msg failed to queue
 // or failed to write, and nil otherwise.
@@ -0,0 +1,75 @@
+package queue
+
+import (
+	"errors"
+)
+
+// errInvalidSize is returned when an invalid size for a buffer is provided.
+var errInvalidSize = errors.New("buffer size must be > 0")
+
+// CircularBuffer is a buffer which retains a set of values in memory, and
+// overwrites the oldest item in the buffer when a new item needs to be
+// written.
+//
+// NOTE: CircularBuffer is not safe for concurrent access, callers must
+// provide their own synchronization.
+type CircularBuffer struct {
+	// total is the total number of items that have been added to the
+	// buffer.
+	total int
+
+	// items is the set of buffered items.
+	items []interface{}
+}
+
+// NewCircularBuffer returns a new circular buffer with the size provided. It
+// will fail if a zero or negative size parameter is provided.
+func NewCircularBuffer(size int) (*CircularBuffer, error) {
+	if size <= 0 {
+		return nil, errInvalidSize
+	}
+
+	return &CircularBuffer{
+		items: make([]interface{}, size),
+	}, nil
+}
+
+// index returns the index that should be written to next.
+func (c *CircularBuffer) index() int {
+	return c.total % len(c.items)
+}
+
+// Add adds an item to the buffer, overwriting the oldest item if the buffer
+// is full.
+func (c *CircularBuffer) Add(item interface{}) {
+	c.items[c.index()] = item
+	c.total++
+}
+
+// List returns a copy of the items in the buffer, ordered from oldest to
+// newest.
+func (c *CircularBuffer) List() []interface{} {
+	size := len(c.items)
+
+	// If the buffer hasn't wrapped around yet, the items are already in
+	// order.
+	if c.total <= size {
+		items := make([]interface{}, c.total)
+		copy(items, c.items[:c.total])
+		return items
+	}
+
+	// Otherwise, the oldest item is the one that will be overwritten
+	// next.
+	items := make([]interface{}, 0, size)
+	items = append(items, c.items[c.index():]...)
+	items = append(items, c.items[:c.index()]...)
+
+	return items
+}
+
+// Total returns the total number of items that have been added to the
+// buffer, including those that have since been overwritten.
+func (c *CircularBuffer) Total() int {
+	return c.total
+}
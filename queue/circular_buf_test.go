@@ -0,0 +1,87 @@
+package queue
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestNewCircularBuffer tests that creating a buffer fails for invalid sizes.
+func TestNewCircularBuffer(t *testing.T) {
+	t.Parallel()
+
+	for _, size := range []int{-1, 0} {
+		if _, err := NewCircularBuffer(size); err != errInvalidSize {
+			t.Fatalf("expected errInvalidSize for size %v, got %v",
+				size, err)
+		}
+	}
+}
+
+// TestCircularBuffer tests that the buffer retains the most recently added
+// items, listed from oldest to newest.
+func TestCircularBuffer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		size     int
+		numItems int
+		expected []interface{}
+	}{
+		{
+			name:     "empty",
+			size:     3,
+			numItems: 0,
+			expected: []interface{}{},
+		},
+		{
+			name:     "partially filled",
+			size:     3,
+			numItems: 2,
+			expected: []interface{}{0, 1},
+		},
+		{
+			name:     "exactly full",
+			size:     3,
+			numItems: 3,
+			expected: []interface{}{0, 1, 2},
+		},
+		{
+			name:     "wrapped around",
+			size:     3,
+			numItems: 5,
+			expected: []interface{}{2, 3, 4},
+		},
+		{
+			name:     "wrapped around multiple times",
+			size:     2,
+			numItems: 7,
+			expected: []interface{}{5, 6},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			buffer, err := NewCircularBuffer(test.size)
+			if err != nil {
+				t.Fatalf("unable to create buffer: %v", err)
+			}
+
+			for i := 0; i < test.numItems; i++ {
+				buffer.Add(i)
+			}
+
+			if buffer.Total() != test.numItems {
+				t.Fatalf("expected total %v, got %v",
+					test.numItems, buffer.Total())
+			}
+
+			items := buffer.List()
+			if !reflect.DeepEqual(items, test.expected) {
+				t.Fatalf("expected items %v, got %v",
+					test.expected, items)
+			}
+		})
+	}
+}
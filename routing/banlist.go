@@ -0,0 +1,320 @@
+package routing
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sync"
+
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+var (
+	// banListBucket is the top level bucket of the ban list, which houses
+	// the nodes and channels banned at runtime.
+	banListBucket = []byte("graph-ban-list")
+
+	// bannedNodesKey is the key of the sub-bucket of the ban list that
+	// stores the public keys of banned nodes.
+	bannedNodesKey = []byte("banned-nodes")
+
+	// bannedChansKey is the key of the sub-bucket of the ban list that
+	// stores the channel IDs of banned channels.
+	bannedChansKey = []byte("banned-chans")
+
+	// purgedChansKey is the key of the sub-bucket of the ban list that
+	// stores the channel IDs of the channels that were purged from the
+	// graph because of a ban. Only these are marked as live again once the
+	// ban is lifted, leaving any other zombies untouched.
+	purgedChansKey = []byte("purged-chans")
+)
+
+// BanList is the set of nodes and channels whose graph data we ignore. Any
+// announcements for them are dropped, they're purged from our graph, and
+// they're never used during path finding. Nodes and channels banned at
+// runtime are persisted, while those banned through the config are only kept
+// in memory, as they'll be banned again upon restart.
+type BanList struct {
+	db *channeldb.DB
+
+	mu    sync.RWMutex
+	nodes map[route.Vertex]struct{}
+	chans map[uint64]struct{}
+}
+
+// NewBanList creates a new BanList backed by the given database, populated
+// with the persisted entries as well as the given static entries.
+func NewBanList(db *channeldb.DB, staticNodes []route.Vertex,
+	staticChans []uint64) (*BanList, error) {
+
+	b := &BanList{
+		db:    db,
+		nodes: make(map[route.Vertex]struct{}),
+		chans: make(map[uint64]struct{}),
+	}
+
+	for _, node := range staticNodes {
+		b.nodes[node] = struct{}{}
+	}
+	for _, chanID := range staticChans {
+		b.chans[chanID] = struct{}{}
+	}
+
+	err := db.View(func(tx *bbolt.Tx) error {
+		banList := tx.Bucket(banListBucket)
+		if banList == nil {
+			return nil
+		}
+
+		if nodes := banList.Bucket(bannedNodesKey); nodes != nil {
+			err := nodes.ForEach(func(k, _ []byte) error {
+				var node route.Vertex
+				copy(node[:], k)
+				b.nodes[node] = struct{}{}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if chans := banList.Bucket(bannedChansKey); chans != nil {
+			return chans.ForEach(func(k, _ []byte) error {
+				b.chans[binary.BigEndian.Uint64(k)] = struct{}{}
+				return nil
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// IsNodeBanned returns true if the given node is banned.
+func (b *BanList) IsNodeBanned(node route.Vertex) bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	_, ok := b.nodes[node]
+	return ok
+}
+
+// IsChannelBanned returns true if the given channel is banned.
+func (b *BanList) IsChannelBanned(chanID uint64) bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	_, ok := b.chans[chanID]
+	return ok
+}
+
+// Nodes returns the set of banned nodes.
+func (b *BanList) Nodes() []route.Vertex {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	nodes := make([]route.Vertex, 0, len(b.nodes))
+	for node := range b.nodes {
+		nodes = append(nodes, node)
+	}
+
+	return nodes
+}
+
+// Channels returns the set of banned channels.
+func (b *BanList) Channels() []uint64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	chans := make([]uint64, 0, len(b.chans))
+	for chanID := range b.chans {
+		chans = append(chans, chanID)
+	}
+
+	return chans
+}
+
+// banNodes adds the given nodes to the ban list, persisting them.
+func (b *BanList) banNodes(nodes ...route.Vertex) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	err := b.update(bannedNodesKey, func(bucket *bbolt.Bucket) error {
+		for _, node := range nodes {
+			if err := bucket.Put(node[:], nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, node := range nodes {
+		b.nodes[node] = struct{}{}
+	}
+
+	return nil
+}
+
+// unbanNodes removes the given nodes from the ban list.
+func (b *BanList) unbanNodes(nodes ...route.Vertex) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	err := b.update(bannedNodesKey, func(bucket *bbolt.Bucket) error {
+		for _, node := range nodes {
+			if err := bucket.Delete(node[:]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, node := range nodes {
+		delete(b.nodes, node)
+	}
+
+	return nil
+}
+
+// banChannels adds the given channels to the ban list, persisting them.
+func (b *BanList) banChannels(chanIDs ...uint64) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	err := b.update(bannedChansKey, func(bucket *bbolt.Bucket) error {
+		for _, chanID := range chanIDs {
+			var k [8]byte
+			binary.BigEndian.PutUint64(k[:], chanID)
+			if err := bucket.Put(k[:], nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, chanID := range chanIDs {
+		b.chans[chanID] = struct{}{}
+	}
+
+	return nil
+}
+
+// unbanChannels removes the given channels from the ban list.
+func (b *BanList) unbanChannels(chanIDs ...uint64) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	err := b.update(bannedChansKey, func(bucket *bbolt.Bucket) error {
+		for _, chanID := range chanIDs {
+			var k [8]byte
+			binary.BigEndian.PutUint64(k[:], chanID)
+			if err := bucket.Delete(k[:]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, chanID := range chanIDs {
+		delete(b.chans, chanID)
+	}
+
+	return nil
+}
+
+// addPurgedChans records that the given channels were purged from the graph
+// because of a ban.
+func (b *BanList) addPurgedChans(chanIDs ...uint64) error {
+	return b.update(purgedChansKey, func(bucket *bbolt.Bucket) error {
+		for _, chanID := range chanIDs {
+			var k [8]byte
+			binary.BigEndian.PutUint64(k[:], chanID)
+			if err := bucket.Put(k[:], nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// removePurgedChans removes the given channels from the set of channels that
+// were purged from the graph because of a ban.
+func (b *BanList) removePurgedChans(chanIDs ...uint64) error {
+	return b.update(purgedChansKey, func(bucket *bbolt.Bucket) error {
+		for _, chanID := range chanIDs {
+			var k [8]byte
+			binary.BigEndian.PutUint64(k[:], chanID)
+			if err := bucket.Delete(k[:]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// filterPurgedChans returns the subset of the given channels that were purged
+// from the graph because of a ban.
+func (b *BanList) filterPurgedChans(chanIDs []uint64) ([]uint64, error) {
+	var purged []uint64
+	err := b.db.View(func(tx *bbolt.Tx) error {
+		banList := tx.Bucket(banListBucket)
+		if banList == nil {
+			return nil
+		}
+		bucket := banList.Bucket(purgedChansKey)
+		if bucket == nil {
+			return nil
+		}
+
+		// As the entries have no value, we'll seek to their keys
+		// rather than relying on the value returned by a lookup.
+		cursor := bucket.Cursor()
+		for _, chanID := range chanIDs {
+			var k [8]byte
+			binary.BigEndian.PutUint64(k[:], chanID)
+			if key, _ := cursor.Seek(k[:]); bytes.Equal(key, k[:]) {
+				purged = append(purged, chanID)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return purged, nil
+}
+
+// update executes the given closure on the ban list's sub-bucket with the
+// given key, creating it if it doesn't exist yet.
+func (b *BanList) update(key []byte, f func(*bbolt.Bucket) error) error {
+	return b.db.Update(func(tx *bbolt.Tx) error {
+		banList, err := tx.CreateBucketIfNotExists(banListBucket)
+		if err != nil {
+			return err
+		}
+		bucket, err := banList.CreateBucketIfNotExists(key)
+		if err != nil {
+			return err
+		}
+
+		return f(bucket)
+	})
+}
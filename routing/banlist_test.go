@@ -0,0 +1,191 @@
+package routing
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/zpay32"
+)
+
+// TestBanListPersistence ensures that nodes and channels banned at runtime are
+// persisted, while those banned statically are only kept in memory.
+func TestBanListPersistence(t *testing.T) {
+	t.Parallel()
+
+	graph, cleanUp, err := makeTestGraph()
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer cleanUp()
+
+	staticNode := route.Vertex{1}
+	banList, err := NewBanList(
+		graph.Database(), []route.Vertex{staticNode}, []uint64{1},
+	)
+	if err != nil {
+		t.Fatalf("unable to create ban list: %v", err)
+	}
+	if !banList.IsNodeBanned(staticNode) || !banList.IsChannelBanned(1) {
+		t.Fatalf("expected static entries to be banned")
+	}
+
+	node1, node2 := route.Vertex{2}, route.Vertex{3}
+	if err := banList.banNodes(node1, node2); err != nil {
+		t.Fatalf("unable to ban nodes: %v", err)
+	}
+	if err := banList.banChannels(2, 3); err != nil {
+		t.Fatalf("unable to ban channels: %v", err)
+	}
+	if err := banList.unbanNodes(node2); err != nil {
+		t.Fatalf("unable to unban node: %v", err)
+	}
+	if err := banList.unbanChannels(3); err != nil {
+		t.Fatalf("unable to unban channel: %v", err)
+	}
+
+	// Reloading the ban list without any static entries should only yield
+	// those that remain banned at runtime.
+	banList, err = NewBanList(graph.Database(), nil, nil)
+	if err != nil {
+		t.Fatalf("unable to reload ban list: %v", err)
+	}
+
+	nodes := banList.Nodes()
+	if len(nodes) != 1 || nodes[0] != node1 {
+		t.Fatalf("expected only node %x to be banned, got %v",
+			node1[:], nodes)
+	}
+	chans := banList.Channels()
+	if len(chans) != 1 || chans[0] != 2 {
+		t.Fatalf("expected only chan_id=2 to be banned, got %v", chans)
+	}
+}
+
+// TestRouterBanList ensures that banning nodes and channels purges them from
+// the graph, excludes them from path finding and ignores their announcements,
+// while unbanning them allows them to be learned of once again.
+func TestRouterBanList(t *testing.T) {
+	t.Parallel()
+
+	policy := &testChannelPolicy{
+		Expiry:  144,
+		FeeRate: 400,
+		MinHTLC: 1,
+		MaxHTLC: 100000000,
+	}
+	testChannels := []*testChannel{
+		symmetricTestChannel("roasbeef", "a", 100000, policy, 1),
+		symmetricTestChannel("a", "b", 100000, policy, 2),
+		symmetricTestChannel("b", "c", 100000, policy, 3),
+		symmetricTestChannel("roasbeef", "b", 100000, policy, 4),
+		symmetricTestChannel("a", "c", 100000, policy, 5),
+	}
+
+	testGraph, err := createTestGraphFromChannels(testChannels)
+	if err != nil {
+		t.Fatalf("unable to create test graph: %v", err)
+	}
+	defer testGraph.cleanUp()
+
+	const startingHeight = 100
+	ctx, cleanUp, err := createTestCtxFromGraphInstance(
+		startingHeight, testGraph,
+	)
+	if err != nil {
+		t.Fatalf("unable to create test context: %v", err)
+	}
+	defer cleanUp()
+
+	banList, err := NewBanList(ctx.graph.Database(), nil, nil)
+	if err != nil {
+		t.Fatalf("unable to create ban list: %v", err)
+	}
+	ctx.router.cfg.BanList = banList
+
+	// We're unable to ban ourselves or our own channels.
+	if err := ctx.router.BanNodes(ctx.aliases["roasbeef"]); err == nil {
+		t.Fatalf("expected banning our own node to fail")
+	}
+	if err := ctx.router.BanChannels(4); err == nil {
+		t.Fatalf("expected banning our own channel to fail")
+	}
+
+	// Channel 3 was already pruned as a zombie before node b is banned,
+	// so it should remain one once the ban is lifted.
+	if err := ctx.graph.DeleteChannelEdges(3); err != nil {
+		t.Fatalf("unable to delete channel: %v", err)
+	}
+
+	// Banning node b should purge its channels, except for the one it
+	// has with us.
+	nodeB := ctx.aliases["b"]
+	if err := ctx.router.BanNodes(nodeB); err != nil {
+		t.Fatalf("unable to ban node: %v", err)
+	}
+	assertChannelsPruned(t, ctx.graph, testChannels, 2, 3)
+
+	// Any route to c should therefore avoid b, even though our own channel
+	// with b remains within the graph.
+	paymentAmt := lnwire.NewMSatFromSatoshis(100)
+	routes, err := ctx.router.FindRoutes(
+		ctx.router.selfNode.PubKeyBytes, ctx.aliases["c"], paymentAmt,
+		noRestrictions, defaultNumRoutes, zpay32.DefaultFinalCLTVDelta,
+	)
+	if err != nil {
+		t.Fatalf("unable to find routes: %v", err)
+	}
+	for _, rt := range routes {
+		for _, hop := range rt.Hops {
+			if hop.PubKeyBytes == nodeB {
+				t.Fatalf("route uses banned node: %v", rt)
+			}
+		}
+	}
+
+	// A new channel announcement involving b should be ignored.
+	edge := &channeldb.ChannelEdgeInfo{
+		ChannelID:     6,
+		NodeKey1Bytes: ctx.aliases["c"],
+		NodeKey2Bytes: nodeB,
+	}
+	err = ctx.router.AddEdge(edge)
+	if !IsError(err, ErrIgnored) {
+		t.Fatalf("expected edge of banned node to be ignored, got %v",
+			err)
+	}
+
+	// Banning channel 5 leaves us without a route to c.
+	if err := ctx.router.BanChannels(5); err != nil {
+		t.Fatalf("unable to ban channel: %v", err)
+	}
+	assertChannelsPruned(t, ctx.graph, testChannels, 2, 3, 5)
+
+	_, err = ctx.router.FindRoutes(
+		ctx.router.selfNode.PubKeyBytes, ctx.aliases["c"], paymentAmt,
+		noRestrictions, defaultNumRoutes, zpay32.DefaultFinalCLTVDelta,
+	)
+	if err == nil {
+		t.Fatalf("expected no route to be found")
+	}
+
+	// Once unbanned, the purged channels should no longer be zombies, such
+	// that we can learn of them once again.
+	if err := ctx.router.UnbanChannels(5); err != nil {
+		t.Fatalf("unable to unban channel: %v", err)
+	}
+	if err := ctx.router.UnbanNodes(nodeB); err != nil {
+		t.Fatalf("unable to unban node: %v", err)
+	}
+	for _, chanID := range []uint64{2, 5} {
+		isZombie, _, _ := ctx.graph.IsZombieEdge(chanID)
+		if isZombie {
+			t.Fatalf("expected chan_id=%v to no longer be a zombie",
+				chanID)
+		}
+	}
+	if isZombie, _, _ := ctx.graph.IsZombieEdge(3); !isZombie {
+		t.Fatalf("expected chan_id=3 to remain a zombie")
+	}
+}
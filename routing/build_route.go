@@ -0,0 +1,188 @@
+package routing
+
+import (
+	"errors"
+
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// BuildRoute returns a fully specified route that delivers amt to the last of
+// the given hops, passing through the other hops in order. For every pair of
+// consecutive hops, the channel that is best able to forward the payment is
+// selected from the graph. The forwarding amounts and time locks are then
+// computed backwards from the destination, in the same way as is done for
+// routes found by path finding. If outgoingChan is set, the first hop is
+// forced to take that channel.
+func (r *ChannelRouter) BuildRoute(amt lnwire.MilliSatoshi,
+	hops []route.Vertex, outgoingChan *uint64,
+	finalCLTVDelta uint16) (*route.Route, error) {
+
+	if len(hops) == 0 {
+		return nil, errors.New("no hops specified")
+	}
+
+	// We'll fetch the current block height so we can properly calculate
+	// the required HTLC time locks within the route.
+	_, currentHeight, err := r.cfg.Chain.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	// The bandwidth hints tell us which of our local channels are able to
+	// carry the payment to the first hop.
+	bandwidthHints, err := generateBandwidthHints(
+		r.selfNode, r.cfg.QueryBandwidth,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.cfg.Graph.Database().Begin(false)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// We walk the hops backwards, as the amount that needs to be forwarded
+	// over each channel depends on the fees of all channels that follow
+	// it.
+	self := route.Vertex(r.selfNode.PubKeyBytes)
+	pathEdges := make([]*channeldb.ChannelEdgePolicy, len(hops))
+	amtToSend := amt
+	for i := len(hops) - 1; i >= 0; i-- {
+		fromNode := self
+		if i > 0 {
+			fromNode = hops[i-1]
+		}
+		toNode := hops[i]
+
+		// The outgoing channel restriction only applies to the channel
+		// leaving our own node.
+		var chanRestriction *uint64
+		if i == 0 {
+			chanRestriction = outgoingChan
+		}
+
+		edge, err := r.selectChannel(
+			tx, fromNode, toNode, amtToSend, chanRestriction,
+			bandwidthHints,
+		)
+		if err != nil {
+			return nil, err
+		}
+		pathEdges[i] = edge
+
+		// All nodes except ourselves charge a fee for forwarding the
+		// payment over the selected channel.
+		if i > 0 {
+			amtToSend += computeFee(amtToSend, edge)
+		}
+	}
+
+	return newRoute(
+		amt, self, pathEdges, uint32(currentHeight), finalCLTVDelta,
+	)
+}
+
+// selectChannel returns the policy of the channel from fromNode to toNode that
+// is best suited to forward amt. Channels that are disabled, lack the
+// capacity or bandwidth, or whose htlc limits don't allow amt, are skipped.
+//
+// As forwarding nodes are free to use any of their channels to the next hop,
+// the policy with the highest fee and time lock delta is selected among the
+// remaining remote channels, such that the route is acceptable whichever
+// channel ends up being used. For our own channels, the one with the most
+// bandwidth is selected.
+func (r *ChannelRouter) selectChannel(tx *bbolt.Tx, fromNode,
+	toNode route.Vertex, amt lnwire.MilliSatoshi, outgoingChan *uint64,
+	bandwidthHints map[uint64]lnwire.MilliSatoshi) (
+	*channeldb.ChannelEdgePolicy, error) {
+
+	isLocal := fromNode == route.Vertex(r.selfNode.PubKeyBytes)
+
+	var (
+		bestEdge      *channeldb.ChannelEdgePolicy
+		bestBandwidth lnwire.MilliSatoshi
+	)
+
+	node := &channeldb.LightningNode{PubKeyBytes: fromNode}
+	err := node.ForEachChannel(tx, func(tx *bbolt.Tx,
+		edgeInfo *channeldb.ChannelEdgeInfo,
+		outEdge, _ *channeldb.ChannelEdgePolicy) error {
+
+		if outEdge == nil || outEdge.Node.PubKeyBytes != toNode {
+			return nil
+		}
+
+		if outgoingChan != nil && *outgoingChan != outEdge.ChannelID {
+			return nil
+		}
+
+		if r.cfg.BanList != nil &&
+			r.cfg.BanList.IsChannelBanned(outEdge.ChannelID) {
+
+			return nil
+		}
+
+		// Disabled flags are ignored for our own channels, as the
+		// bandwidth hints provide more accurate information.
+		edgeFlags := outEdge.ChannelFlags
+		isDisabled := edgeFlags&lnwire.ChanUpdateDisabled != 0
+		if !isLocal && isDisabled {
+			return nil
+		}
+
+		bandwidth := lnwire.NewMSatFromSatoshis(edgeInfo.Capacity)
+		if isLocal {
+			if hint, ok := bandwidthHints[outEdge.ChannelID]; ok {
+				bandwidth = hint
+			}
+		}
+		if bandwidth < amt {
+			return nil
+		}
+
+		if amt < outEdge.MinHTLC {
+			return nil
+		}
+		if outEdge.MaxHTLC != 0 && outEdge.MaxHTLC < amt {
+			return nil
+		}
+
+		switch {
+		case bestEdge == nil:
+
+		case isLocal:
+			if bandwidth <= bestBandwidth {
+				return nil
+			}
+
+		default:
+			fee := computeFee(amt, outEdge)
+			bestFee := computeFee(amt, bestEdge)
+			delta := outEdge.TimeLockDelta
+			bestDelta := bestEdge.TimeLockDelta
+			if fee < bestFee || (fee == bestFee && delta <= bestDelta) {
+				return nil
+			}
+		}
+
+		bestEdge = outEdge
+		bestBandwidth = bandwidth
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if bestEdge == nil {
+		return nil, newErrf(ErrNoPathFound, "no channel from %v to %v "+
+			"is able to forward %v", fromNode, toNode, amt)
+	}
+
+	return bestEdge, nil
+}
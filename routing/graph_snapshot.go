@@ -0,0 +1,588 @@
+package routing
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/coreos/bbolt"
+	"github.com/go-errors/errors"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/netann"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+const (
+	// graphSnapshotVersion is the current version of the graph snapshot
+	// format.
+	graphSnapshotVersion uint8 = 0
+
+	// maxSnapshotRecordSize is the largest record we'll read from a graph
+	// snapshot. As each record houses a single gossip message along with
+	// some auxiliary data, it's bounded by the maximum message size.
+	maxSnapshotRecordSize = lnwire.MaxMessagePayload + 128
+)
+
+// graphSnapshotMagic prefixes every graph snapshot, allowing us to quickly
+// reject files that aren't graph snapshots.
+var graphSnapshotMagic = [4]byte{'L', 'N', 'G', 'S'}
+
+// snapshotRecordType identifies the contents of a record within a graph
+// snapshot.
+type snapshotRecordType uint8
+
+const (
+	// snapshotNodeRecord is a record that houses a NodeAnnouncement.
+	snapshotNodeRecord snapshotRecordType = 0
+
+	// snapshotChannelRecord is a record that houses the capacity and
+	// funding outpoint of a channel, followed by its ChannelAnnouncement.
+	snapshotChannelRecord snapshotRecordType = 1
+
+	// snapshotPolicyRecord is a record that houses a ChannelUpdate. It
+	// always follows the record of its channel.
+	snapshotPolicyRecord snapshotRecordType = 2
+)
+
+// GraphSnapshotStats houses the number of nodes, channels and policies written
+// to, or read from, a graph snapshot.
+type GraphSnapshotStats struct {
+	// NumNodes is the number of node announcements.
+	NumNodes uint32
+
+	// NumChannels is the number of channel announcements.
+	NumChannels uint32
+
+	// NumPolicies is the number of channel updates.
+	NumPolicies uint32
+
+	// NumSkipped is the number of records that weren't imported, as we
+	// either already knew of a newer version, or they're banned.
+	NumSkipped uint32
+}
+
+// WriteGraphSnapshot writes a snapshot of the public part of the given graph
+// to the passed io.Writer. The snapshot consists of a versioned header
+// followed by a zlib compressed stream of the signed announcements of all
+// nodes and channels, along with the latest updates of the channels. Private
+// channels, and nodes that never announced themselves, are omitted.
+func WriteGraphSnapshot(graph *channeldb.ChannelGraph,
+	chainHash chainhash.Hash, w io.Writer) (*GraphSnapshotStats, error) {
+
+	if _, err := w.Write(graphSnapshotMagic[:]); err != nil {
+		return nil, err
+	}
+	err := lnwire.WriteElements(w, graphSnapshotVersion, chainHash[:])
+	if err != nil {
+		return nil, err
+	}
+
+	zlibWriter := zlib.NewWriter(w)
+	stats := &GraphSnapshotStats{}
+
+	err = graph.ForEachChannel(func(info *channeldb.ChannelEdgeInfo,
+		e1, e2 *channeldb.ChannelEdgePolicy) error {
+
+		// Private channels lack the proof required to announce them.
+		if info.AuthProof == nil {
+			return nil
+		}
+
+		chanAnn, err := chanAnnFromEdgeInfo(info)
+		if err != nil {
+			return err
+		}
+
+		var b bytes.Buffer
+		err = lnwire.WriteElements(
+			&b, uint64(info.Capacity), info.ChannelPoint,
+		)
+		if err != nil {
+			return err
+		}
+		if err := chanAnn.Encode(&b, 0); err != nil {
+			return err
+		}
+		err = writeSnapshotRecord(
+			zlibWriter, snapshotChannelRecord, b.Bytes(),
+		)
+		if err != nil {
+			return err
+		}
+		stats.NumChannels++
+
+		for _, policy := range []*channeldb.ChannelEdgePolicy{e1, e2} {
+			if policy == nil {
+				continue
+			}
+
+			update, err := netann.ChannelUpdateFromEdge(
+				info, policy,
+			)
+			if err != nil {
+				return err
+			}
+
+			var b bytes.Buffer
+			if err := update.Encode(&b, 0); err != nil {
+				return err
+			}
+			err = writeSnapshotRecord(
+				zlibWriter, snapshotPolicyRecord, b.Bytes(),
+			)
+			if err != nil {
+				return err
+			}
+			stats.NumPolicies++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The nodes follow the channels, as we only accept the announcements
+	// of nodes that have at least one channel.
+	err = graph.ForEachNode(nil, func(_ *bbolt.Tx,
+		node *channeldb.LightningNode) error {
+
+		if !node.HaveNodeAnnouncement {
+			return nil
+		}
+
+		nodeAnn, err := node.NodeAnnouncement(true)
+		if err != nil {
+			return err
+		}
+
+		var b bytes.Buffer
+		if err := nodeAnn.Encode(&b, 0); err != nil {
+			return err
+		}
+		err = writeSnapshotRecord(
+			zlibWriter, snapshotNodeRecord, b.Bytes(),
+		)
+		if err != nil {
+			return err
+		}
+
+		stats.NumNodes++
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := zlibWriter.Close(); err != nil {
+		return nil, fmt.Errorf("unable to finalize compression: %v",
+			err)
+	}
+
+	return stats, nil
+}
+
+// ImportGraphSnapshot reads a graph snapshot written by WriteGraphSnapshot
+// from the passed io.Reader, and adds its contents to our graph. The signature
+// of every announcement is validated before it's added, but as the snapshot is
+// trusted, the existence of the channels isn't validated against the chain.
+// Records that we already know a newer version of, or that are banned, are
+// skipped.
+func (r *ChannelRouter) ImportGraphSnapshot(rd io.Reader,
+	chainHash chainhash.Hash) (*GraphSnapshotStats, error) {
+
+	var magic [4]byte
+	if _, err := io.ReadFull(rd, magic[:]); err != nil {
+		return nil, err
+	}
+	if magic != graphSnapshotMagic {
+		return nil, errors.New("not a graph snapshot")
+	}
+
+	var (
+		version       uint8
+		snapshotChain [32]byte
+	)
+	err := lnwire.ReadElements(rd, &version, snapshotChain[:])
+	if err != nil {
+		return nil, err
+	}
+	if version != graphSnapshotVersion {
+		return nil, fmt.Errorf("unknown graph snapshot version %v",
+			version)
+	}
+	if !bytes.Equal(snapshotChain[:], chainHash[:]) {
+		return nil, fmt.Errorf("graph snapshot is for chain %x, "+
+			"expected %v", snapshotChain[:], chainHash)
+	}
+
+	zlibReader, err := zlib.NewReader(rd)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create zlib reader: %v", err)
+	}
+	defer zlibReader.Close()
+
+	var (
+		stats       = &GraphSnapshotStats{}
+		edgePoints  []channeldb.EdgePoint
+		lastChannel *channeldb.ChannelEdgeInfo
+	)
+	for {
+		recordType, record, err := readSnapshotRecord(zlibReader)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var imported bool
+		switch recordType {
+		case snapshotNodeRecord:
+			imported, err = r.importSnapshotNode(record)
+			if err != nil {
+				return nil, err
+			}
+			stats.NumNodes++
+
+		case snapshotChannelRecord:
+			var edgePoint *channeldb.EdgePoint
+			lastChannel, edgePoint, err = r.importSnapshotChannel(
+				record, chainHash,
+			)
+			if err != nil {
+				return nil, err
+			}
+			if edgePoint != nil {
+				edgePoints = append(edgePoints, *edgePoint)
+				imported = true
+			}
+			stats.NumChannels++
+
+		case snapshotPolicyRecord:
+			imported, err = r.importSnapshotPolicy(
+				record, lastChannel,
+			)
+			if err != nil {
+				return nil, err
+			}
+			stats.NumPolicies++
+
+		// Records of an unknown type are skipped, allowing later
+		// versions to include additional data.
+		default:
+			continue
+		}
+
+		if !imported {
+			stats.NumSkipped++
+		}
+	}
+
+	// Now that all channels have been added, we'll update our chain view
+	// with their funding outputs, such that we're notified if they're
+	// closed.
+	if len(edgePoints) > 0 {
+		err := r.cfg.ChainView.UpdateFilter(
+			edgePoints, atomic.LoadUint32(&r.bestHeight),
+		)
+		if err != nil {
+			return nil, errors.Errorf("unable to update chain "+
+				"view: %v", err)
+		}
+	}
+
+	log.Infof("Imported graph snapshot with %v nodes, %v channels and "+
+		"%v policies, skipped %v records", stats.NumNodes,
+		stats.NumChannels, stats.NumPolicies, stats.NumSkipped)
+
+	return stats, nil
+}
+
+// importSnapshotNode validates and adds the node announcement of the given
+// record to our graph. False is returned if the node was skipped.
+func (r *ChannelRouter) importSnapshotNode(record []byte) (bool, error) {
+	var nodeAnn lnwire.NodeAnnouncement
+	if err := nodeAnn.Decode(bytes.NewReader(record), 0); err != nil {
+		return false, err
+	}
+
+	// We'll never let a snapshot overwrite our own node.
+	if nodeAnn.NodeID == r.selfNode.PubKeyBytes {
+		return false, nil
+	}
+
+	if err := ValidateNodeAnn(&nodeAnn); err != nil {
+		return false, fmt.Errorf("invalid announcement of node=%x: "+
+			"%v", nodeAnn.NodeID, err)
+	}
+
+	features := lnwire.NewFeatureVector(
+		nodeAnn.Features, lnwire.GlobalFeatures,
+	)
+	node := &channeldb.LightningNode{
+		HaveNodeAnnouncement: true,
+		LastUpdate:           time.Unix(int64(nodeAnn.Timestamp), 0),
+		Addresses:            nodeAnn.Addresses,
+		PubKeyBytes:          nodeAnn.NodeID,
+		Alias:                nodeAnn.Alias.String(),
+		AuthSigBytes:         nodeAnn.Signature.ToSignatureBytes(),
+		Features:             features,
+		Color:                nodeAnn.RGBColor,
+		ExtraOpaqueData:      nodeAnn.ExtraOpaqueData,
+	}
+
+	err := r.AddNode(node)
+	switch {
+	case IsError(err, ErrIgnored, ErrOutdated):
+		return false, nil
+
+	case err != nil:
+		return false, err
+	}
+
+	return true, nil
+}
+
+// importSnapshotChannel validates and adds the channel of the given record to
+// our graph. The channel is returned, along with its funding output if it was
+// added to our graph, such that the caller can watch for its closure.
+func (r *ChannelRouter) importSnapshotChannel(record []byte,
+	chainHash chainhash.Hash) (*channeldb.ChannelEdgeInfo,
+	*channeldb.EdgePoint, error) {
+
+	reader := bytes.NewReader(record)
+
+	var (
+		capacity     uint64
+		channelPoint wire.OutPoint
+		chanAnn      lnwire.ChannelAnnouncement
+	)
+	err := lnwire.ReadElements(reader, &capacity, &channelPoint)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := chanAnn.Decode(reader, 0); err != nil {
+		return nil, nil, err
+	}
+
+	chanID := chanAnn.ShortChannelID.ToUint64()
+	if chanAnn.ChainHash != chainHash {
+		return nil, nil, fmt.Errorf("chan_id=%v is for chain %v, "+
+			"expected %v", chanID, chanAnn.ChainHash, chainHash)
+	}
+	if err := ValidateChannelAnn(&chanAnn); err != nil {
+		return nil, nil, fmt.Errorf("invalid announcement of "+
+			"chan_id=%v: %v", chanID, err)
+	}
+
+	var featureBuf bytes.Buffer
+	if err := chanAnn.Features.Encode(&featureBuf); err != nil {
+		return nil, nil, err
+	}
+	proof := &channeldb.ChannelAuthProof{
+		NodeSig1Bytes:    chanAnn.NodeSig1.ToSignatureBytes(),
+		NodeSig2Bytes:    chanAnn.NodeSig2.ToSignatureBytes(),
+		BitcoinSig1Bytes: chanAnn.BitcoinSig1.ToSignatureBytes(),
+		BitcoinSig2Bytes: chanAnn.BitcoinSig2.ToSignatureBytes(),
+	}
+	edge := &channeldb.ChannelEdgeInfo{
+		ChannelID:        chanID,
+		ChainHash:        chanAnn.ChainHash,
+		NodeKey1Bytes:    chanAnn.NodeID1,
+		NodeKey2Bytes:    chanAnn.NodeID2,
+		BitcoinKey1Bytes: chanAnn.BitcoinKey1,
+		BitcoinKey2Bytes: chanAnn.BitcoinKey2,
+		AuthProof:        proof,
+		Features:         featureBuf.Bytes(),
+		ChannelPoint:     channelPoint,
+		Capacity:         btcutil.Amount(capacity),
+		ExtraOpaqueData:  chanAnn.ExtraOpaqueData,
+	}
+
+	// The channel is added through the router's regular update path, such
+	// that it's processed in order with the updates for it and any
+	// topology clients are notified. Channels that are banned, known or
+	// zombies are ignored there.
+	err = r.addTrustedEdge(edge)
+	switch {
+	case IsError(err, ErrIgnored, ErrRejected):
+		return edge, nil, nil
+
+	case err != nil:
+		return nil, nil, err
+	}
+
+	witnessScript, err := input.GenMultiSigScript(
+		edge.BitcoinKey1Bytes[:], edge.BitcoinKey2Bytes[:],
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	fundingPkScript, err := input.WitnessScriptHash(witnessScript)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return edge, &channeldb.EdgePoint{
+		FundingPkScript: fundingPkScript,
+		OutPoint:        channelPoint,
+	}, nil
+}
+
+// importSnapshotPolicy validates and adds the channel update of the given
+// record to our graph. The update must be for the channel of the preceding
+// record. False is returned if the update was skipped.
+func (r *ChannelRouter) importSnapshotPolicy(record []byte,
+	info *channeldb.ChannelEdgeInfo) (bool, error) {
+
+	var update lnwire.ChannelUpdate
+	if err := update.Decode(bytes.NewReader(record), 0); err != nil {
+		return false, err
+	}
+
+	chanID := update.ShortChannelID.ToUint64()
+	if info == nil || info.ChannelID != chanID {
+		return false, fmt.Errorf("update for chan_id=%v doesn't "+
+			"follow its channel", chanID)
+	}
+
+	var nodeKey route.Vertex
+	if update.ChannelFlags&lnwire.ChanUpdateDirection == 0 {
+		nodeKey = info.NodeKey1Bytes
+	} else {
+		nodeKey = info.NodeKey2Bytes
+	}
+	pubKey, err := btcec.ParsePubKey(nodeKey[:], btcec.S256())
+	if err != nil {
+		return false, err
+	}
+	err = ValidateChannelUpdateAnn(pubKey, info.Capacity, &update)
+	if err != nil {
+		return false, fmt.Errorf("invalid update of chan_id=%v: %v",
+			chanID, err)
+	}
+
+	timestamp := time.Unix(int64(update.Timestamp), 0)
+	policy := &channeldb.ChannelEdgePolicy{
+		SigBytes:                  update.Signature.ToSignatureBytes(),
+		ChannelID:                 chanID,
+		LastUpdate:                timestamp,
+		MessageFlags:              update.MessageFlags,
+		ChannelFlags:              update.ChannelFlags,
+		TimeLockDelta:             update.TimeLockDelta,
+		MinHTLC:                   update.HtlcMinimumMsat,
+		MaxHTLC:                   update.HtlcMaximumMsat,
+		FeeBaseMSat:               lnwire.MilliSatoshi(update.BaseFee),
+		FeeProportionalMillionths: lnwire.MilliSatoshi(update.FeeRate),
+		ExtraOpaqueData:           update.ExtraOpaqueData,
+	}
+
+	err = r.UpdateEdge(policy)
+	switch {
+	case IsError(err, ErrIgnored, ErrOutdated):
+		return false, nil
+
+	case err != nil:
+		return false, err
+	}
+
+	return true, nil
+}
+
+// chanAnnFromEdgeInfo recreates the signed ChannelAnnouncement of the given
+// public channel.
+func chanAnnFromEdgeInfo(
+	info *channeldb.ChannelEdgeInfo) (*lnwire.ChannelAnnouncement, error) {
+
+	features := lnwire.NewRawFeatureVector()
+	err := features.Decode(bytes.NewReader(info.Features))
+	if err != nil {
+		return nil, err
+	}
+
+	chanAnn := &lnwire.ChannelAnnouncement{
+		ShortChannelID:  lnwire.NewShortChanIDFromInt(info.ChannelID),
+		NodeID1:         info.NodeKey1Bytes,
+		NodeID2:         info.NodeKey2Bytes,
+		ChainHash:       info.ChainHash,
+		BitcoinKey1:     info.BitcoinKey1Bytes,
+		BitcoinKey2:     info.BitcoinKey2Bytes,
+		Features:        features,
+		ExtraOpaqueData: info.ExtraOpaqueData,
+	}
+
+	proof := info.AuthProof
+	sigs := []struct {
+		sig *lnwire.Sig
+		raw []byte
+	}{
+		{&chanAnn.NodeSig1, proof.NodeSig1Bytes},
+		{&chanAnn.NodeSig2, proof.NodeSig2Bytes},
+		{&chanAnn.BitcoinSig1, proof.BitcoinSig1Bytes},
+		{&chanAnn.BitcoinSig2, proof.BitcoinSig2Bytes},
+	}
+	for _, s := range sigs {
+		*s.sig, err = lnwire.NewSigFromRawSignature(s.raw)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return chanAnn, nil
+}
+
+// writeSnapshotRecord writes a single record of the given type to the graph
+// snapshot.
+func writeSnapshotRecord(w io.Writer, recordType snapshotRecordType,
+	record []byte) error {
+
+	var header [5]byte
+	header[0] = byte(recordType)
+	binary.BigEndian.PutUint32(header[1:], uint32(len(record)))
+	if _, err := w.Write(header[:]); err != nil {
+		return err
+	}
+
+	_, err := w.Write(record)
+	return err
+}
+
+// readSnapshotRecord reads the next record from the graph snapshot. io.EOF is
+// returned once all records have been read.
+func readSnapshotRecord(r io.Reader) (snapshotRecordType, []byte, error) {
+	var header [5]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return 0, nil, errors.New("truncated graph snapshot")
+		}
+		return 0, nil, err
+	}
+
+	length := binary.BigEndian.Uint32(header[1:])
+	if length > maxSnapshotRecordSize {
+		return 0, nil, fmt.Errorf("graph snapshot record of %v "+
+			"bytes exceeds maximum of %v", length,
+			maxSnapshotRecordSize)
+	}
+
+	record := make([]byte, length)
+	if _, err := io.ReadFull(r, record); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return 0, nil, errors.New("truncated graph snapshot")
+		}
+		return 0, nil, err
+	}
+
+	return snapshotRecordType(header[0]), record, nil
+}
@@ -0,0 +1,367 @@
+package routing
+
+import (
+	"bytes"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// snapshotChainHash is the chain of the graphs used to test graph snapshots.
+var snapshotChainHash = chainhash.Hash{0x01}
+
+// signGraphMsg signs the given data as an announcement of the given key.
+func signGraphMsg(t *testing.T, key *btcec.PrivateKey,
+	data []byte) lnwire.Sig {
+
+	sig, err := key.Sign(chainhash.DoubleHashB(data))
+	if err != nil {
+		t.Fatalf("unable to sign: %v", err)
+	}
+	wireSig, err := lnwire.NewSigFromSignature(sig)
+	if err != nil {
+		t.Fatalf("unable to convert signature: %v", err)
+	}
+
+	return wireSig
+}
+
+// signedGraph is a graph populated with properly signed announcements.
+type signedGraph struct {
+	t     *testing.T
+	graph *channeldb.ChannelGraph
+	keys  map[string]*btcec.PrivateKey
+}
+
+// addNode adds a signed node announcement with the given alias to the graph.
+func (g *signedGraph) addNode(alias string) {
+	key, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		g.t.Fatalf("unable to create key: %v", err)
+	}
+	g.keys[alias] = key
+
+	nodeAlias, err := lnwire.NewNodeAlias(alias)
+	if err != nil {
+		g.t.Fatalf("unable to create alias: %v", err)
+	}
+	nodeAnn := &lnwire.NodeAnnouncement{
+		Features:  lnwire.NewRawFeatureVector(),
+		Timestamp: uint32(testTime.Unix()),
+		Alias:     nodeAlias,
+		Addresses: []net.Addr{
+			&net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 9735},
+		},
+	}
+	copy(nodeAnn.NodeID[:], key.PubKey().SerializeCompressed())
+
+	data, err := nodeAnn.DataToSign()
+	if err != nil {
+		g.t.Fatalf("unable to get data to sign: %v", err)
+	}
+	nodeAnn.Signature = signGraphMsg(g.t, key, data)
+
+	node := &channeldb.LightningNode{
+		HaveNodeAnnouncement: true,
+		LastUpdate:           testTime,
+		Addresses:            nodeAnn.Addresses,
+		PubKeyBytes:          nodeAnn.NodeID,
+		Alias:                alias,
+		AuthSigBytes:         nodeAnn.Signature.ToSignatureBytes(),
+		Features: lnwire.NewFeatureVector(
+			nodeAnn.Features, lnwire.GlobalFeatures,
+		),
+	}
+	if err := g.graph.AddLightningNode(node); err != nil {
+		g.t.Fatalf("unable to add node: %v", err)
+	}
+}
+
+// addChannel adds a channel between the given nodes to the graph, which is
+// public if signed.
+func (g *signedGraph) addChannel(chanID uint64, alias1, alias2 string,
+	signed bool) {
+
+	key1, key2 := g.keys[alias1], g.keys[alias2]
+
+	chanAnn := &lnwire.ChannelAnnouncement{
+		ChainHash:      snapshotChainHash,
+		ShortChannelID: lnwire.NewShortChanIDFromInt(chanID),
+		Features:       lnwire.NewRawFeatureVector(),
+	}
+	copy(chanAnn.NodeID1[:], key1.PubKey().SerializeCompressed())
+	copy(chanAnn.NodeID2[:], key2.PubKey().SerializeCompressed())
+	copy(chanAnn.BitcoinKey1[:], key1.PubKey().SerializeCompressed())
+	copy(chanAnn.BitcoinKey2[:], key2.PubKey().SerializeCompressed())
+
+	var featureBuf bytes.Buffer
+	if err := chanAnn.Features.Encode(&featureBuf); err != nil {
+		g.t.Fatalf("unable to encode features: %v", err)
+	}
+	edge := &channeldb.ChannelEdgeInfo{
+		ChannelID:        chanID,
+		ChainHash:        snapshotChainHash,
+		NodeKey1Bytes:    chanAnn.NodeID1,
+		NodeKey2Bytes:    chanAnn.NodeID2,
+		BitcoinKey1Bytes: chanAnn.BitcoinKey1,
+		BitcoinKey2Bytes: chanAnn.BitcoinKey2,
+		Features:         featureBuf.Bytes(),
+		Capacity:         btcutil.Amount(chanID * 100000),
+		ChannelPoint: wire.OutPoint{
+			Hash:  chainhash.Hash{byte(chanID)},
+			Index: uint32(chanID),
+		},
+	}
+
+	if signed {
+		data, err := chanAnn.DataToSign()
+		if err != nil {
+			g.t.Fatalf("unable to get data to sign: %v", err)
+		}
+		wireSig1 := signGraphMsg(g.t, key1, data)
+		wireSig2 := signGraphMsg(g.t, key2, data)
+		sig1 := wireSig1.ToSignatureBytes()
+		sig2 := wireSig2.ToSignatureBytes()
+		edge.AuthProof = &channeldb.ChannelAuthProof{
+			NodeSig1Bytes:    sig1,
+			NodeSig2Bytes:    sig2,
+			BitcoinSig1Bytes: sig1,
+			BitcoinSig2Bytes: sig2,
+		}
+	}
+
+	if err := g.graph.AddChannelEdge(edge); err != nil {
+		g.t.Fatalf("unable to add edge: %v", err)
+	}
+}
+
+// addPolicy adds a signed policy of the given node for the given channel to
+// the graph. The signature covers the passed base fee, allowing the caller to
+// create an invalid policy by specifying a different fee for the policy.
+func (g *signedGraph) addPolicy(chanID uint64, alias string,
+	direction lnwire.ChanUpdateChanFlags, signedFee,
+	fee lnwire.MilliSatoshi) {
+
+	update := &lnwire.ChannelUpdate{
+		ChainHash:       snapshotChainHash,
+		ShortChannelID:  lnwire.NewShortChanIDFromInt(chanID),
+		Timestamp:       uint32(testTime.Unix()),
+		ChannelFlags:    direction,
+		TimeLockDelta:   40,
+		HtlcMinimumMsat: 1000,
+		BaseFee:         uint32(signedFee),
+		FeeRate:         10,
+	}
+	data, err := update.DataToSign()
+	if err != nil {
+		g.t.Fatalf("unable to get data to sign: %v", err)
+	}
+	update.Signature = signGraphMsg(g.t, g.keys[alias], data)
+
+	policy := &channeldb.ChannelEdgePolicy{
+		SigBytes:                  update.Signature.ToSignatureBytes(),
+		ChannelID:                 chanID,
+		LastUpdate:                testTime,
+		ChannelFlags:              direction,
+		TimeLockDelta:             update.TimeLockDelta,
+		MinHTLC:                   update.HtlcMinimumMsat,
+		FeeBaseMSat:               fee,
+		FeeProportionalMillionths: 10,
+	}
+	if err := g.graph.UpdateEdgePolicy(policy); err != nil {
+		g.t.Fatalf("unable to add policy: %v", err)
+	}
+}
+
+// TestGraphSnapshot ensures that the public part of a graph can be exported to
+// a snapshot, and imported into the graph of another node, while only known
+// records are skipped.
+func TestGraphSnapshot(t *testing.T) {
+	t.Parallel()
+
+	graph, cleanUp, err := makeTestGraph()
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer cleanUp()
+
+	g := &signedGraph{
+		t:     t,
+		graph: graph,
+		keys:  make(map[string]*btcec.PrivateKey),
+	}
+	g.addNode("a")
+	g.addNode("b")
+	g.addNode("c")
+	g.addChannel(1, "a", "b", true)
+	g.addChannel(2, "b", "c", true)
+	g.addChannel(3, "a", "c", false)
+	g.addPolicy(1, "a", 0, 1000, 1000)
+	g.addPolicy(1, "b", lnwire.ChanUpdateDirection, 2000, 2000)
+	g.addPolicy(2, "b", 0, 3000, 3000)
+
+	var snapshot bytes.Buffer
+	stats, err := WriteGraphSnapshot(graph, snapshotChainHash, &snapshot)
+	if err != nil {
+		t.Fatalf("unable to write snapshot: %v", err)
+	}
+
+	// The private channel should be omitted from the snapshot.
+	expectedStats := GraphSnapshotStats{
+		NumNodes:    3,
+		NumChannels: 2,
+		NumPolicies: 3,
+	}
+	if *stats != expectedStats {
+		t.Fatalf("expected stats %v, got %v", expectedStats, *stats)
+	}
+
+	const startingHeight = 100
+	ctx, cleanUpCtx, err := createTestCtxSingleNode(startingHeight)
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+	defer cleanUpCtx()
+
+	// A snapshot of another chain should be rejected.
+	_, err = ctx.router.ImportGraphSnapshot(
+		bytes.NewReader(snapshot.Bytes()), chainhash.Hash{0x02},
+	)
+	if err == nil {
+		t.Fatalf("expected snapshot of other chain to be rejected")
+	}
+
+	// Topology clients should learn about the imported records, just like
+	// they do for records received over gossip.
+	ntfnClient, err := ctx.router.SubscribeTopology()
+	if err != nil {
+		t.Fatalf("unable to subscribe for topology changes: %v", err)
+	}
+	defer ntfnClient.Cancel()
+
+	stats, err = ctx.router.ImportGraphSnapshot(
+		bytes.NewReader(snapshot.Bytes()), snapshotChainHash,
+	)
+	if err != nil {
+		t.Fatalf("unable to import snapshot: %v", err)
+	}
+	if *stats != expectedStats {
+		t.Fatalf("expected stats %v, got %v", expectedStats, *stats)
+	}
+
+	var numNodeUpdates, numEdgeUpdates int
+	for numNodeUpdates < 3 || numEdgeUpdates < 3 {
+		select {
+		case change := <-ntfnClient.TopologyChanges:
+			numNodeUpdates += len(change.NodeUpdates)
+			numEdgeUpdates += len(change.ChannelEdgeUpdates)
+
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected 3 node and 3 edge updates, got %v "+
+				"and %v", numNodeUpdates, numEdgeUpdates)
+		}
+	}
+
+	// The imported channels should carry their capacity and funding
+	// outpoint, which should be watched for closure.
+	for _, chanID := range []uint64{1, 2} {
+		info, _, _, err := ctx.graph.FetchChannelEdgesByID(chanID)
+		if err != nil {
+			t.Fatalf("unable to fetch chan_id=%v: %v", chanID, err)
+		}
+		if info.Capacity != btcutil.Amount(chanID*100000) {
+			t.Fatalf("unexpected capacity %v of chan_id=%v",
+				info.Capacity, chanID)
+		}
+		if _, ok := ctx.chainView.filter[info.ChannelPoint]; !ok {
+			t.Fatalf("chan_id=%v not watched for closure", chanID)
+		}
+	}
+	_, e1, e2, err := ctx.graph.FetchChannelEdgesByID(1)
+	if err != nil {
+		t.Fatalf("unable to fetch chan_id=1: %v", err)
+	}
+	if e1 == nil || e1.FeeBaseMSat != 1000 || e2 == nil ||
+		e2.FeeBaseMSat != 2000 {
+
+		t.Fatalf("unexpected policies of chan_id=1: %v, %v", e1, e2)
+	}
+	if _, _, _, err := ctx.graph.FetchChannelEdgesByID(3); err == nil {
+		t.Fatalf("private channel should not have been imported")
+	}
+	node, err := ctx.graph.FetchLightningNode(g.keys["c"].PubKey())
+	if err != nil {
+		t.Fatalf("unable to fetch node: %v", err)
+	}
+	if !node.HaveNodeAnnouncement || node.Alias != "c" {
+		t.Fatalf("unexpected imported node: %v", node)
+	}
+
+	// Importing the same snapshot again should skip all of its records.
+	stats, err = ctx.router.ImportGraphSnapshot(
+		bytes.NewReader(snapshot.Bytes()), snapshotChainHash,
+	)
+	if err != nil {
+		t.Fatalf("unable to import snapshot: %v", err)
+	}
+	expectedStats.NumSkipped = 8
+	if *stats != expectedStats {
+		t.Fatalf("expected stats %v, got %v", expectedStats, *stats)
+	}
+}
+
+// TestGraphSnapshotInvalidSignature ensures that importing a snapshot fails if
+// it contains a record with an invalid signature.
+func TestGraphSnapshotInvalidSignature(t *testing.T) {
+	t.Parallel()
+
+	graph, cleanUp, err := makeTestGraph()
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer cleanUp()
+
+	g := &signedGraph{
+		t:     t,
+		graph: graph,
+		keys:  make(map[string]*btcec.PrivateKey),
+	}
+	g.addNode("a")
+	g.addNode("b")
+	g.addChannel(1, "a", "b", true)
+	g.addPolicy(1, "a", 0, 1000, 1)
+
+	var snapshot bytes.Buffer
+	_, err = WriteGraphSnapshot(graph, snapshotChainHash, &snapshot)
+	if err != nil {
+		t.Fatalf("unable to write snapshot: %v", err)
+	}
+
+	const startingHeight = 100
+	ctx, cleanUpCtx, err := createTestCtxSingleNode(startingHeight)
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+	defer cleanUpCtx()
+
+	_, err = ctx.router.ImportGraphSnapshot(&snapshot, snapshotChainHash)
+	if err == nil {
+		t.Fatalf("expected policy with invalid signature to be " +
+			"rejected")
+	}
+
+	_, e1, _, err := ctx.graph.FetchChannelEdgesByID(1)
+	if err != nil {
+		t.Fatalf("unable to fetch chan_id=1: %v", err)
+	}
+	if e1 != nil {
+		t.Fatalf("invalid policy should not have been imported")
+	}
+}
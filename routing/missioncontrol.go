@@ -62,6 +62,10 @@ type missionControl struct {
 
 	queryBandwidth func(*channeldb.ChannelEdgeInfo) lnwire.MilliSatoshi
 
+	// banList is the optional set of nodes and channels that must never
+	// be used during path finding.
+	banList *BanList
+
 	sync.Mutex
 
 	// TODO(roasbeef): further counters, if vertex continually unavailable,
@@ -74,7 +78,8 @@ type missionControl struct {
 //
 // TODO(roasbeef): persist memory
 func newMissionControl(g *channeldb.ChannelGraph, selfNode *channeldb.LightningNode,
-	qb func(*channeldb.ChannelEdgeInfo) lnwire.MilliSatoshi) *missionControl {
+	qb func(*channeldb.ChannelEdgeInfo) lnwire.MilliSatoshi,
+	banList *BanList) *missionControl {
 
 	return &missionControl{
 		failedEdges:    make(map[EdgeLocator]time.Time),
@@ -82,6 +87,7 @@ func newMissionControl(g *channeldb.ChannelGraph, selfNode *channeldb.LightningN
 		selfNode:       selfNode,
 		queryBandwidth: qb,
 		graph:          g,
+		banList:        banList,
 	}
 }
 
@@ -236,6 +236,11 @@ type RestrictParams struct {
 	// encountered during path finding.
 	IgnoredEdges map[EdgeLocator]struct{}
 
+	// IgnoredPairs is an optional set of directed node pairs that should
+	// be ignored if encountered during path finding. All channels between
+	// the two nodes in the given direction are skipped.
+	IgnoredPairs map[DirectedNodePair]struct{}
+
 	// FeeLimit is a maximum fee amount allowed to be used on the path from
 	// the source to the target.
 	FeeLimit lnwire.MilliSatoshi
@@ -244,10 +249,22 @@ type RestrictParams struct {
 	// hop. If nil, any channel may be used.
 	OutgoingChannelID *uint64
 
+	// IncomingChannelID is the channel that needs to be taken from the
+	// last hop to the target. If nil, any channel may be used.
+	IncomingChannelID *uint64
+
+	// LastHop is the pubkey of the last node before the target. If nil,
+	// any node may be used.
+	LastHop *route.Vertex
+
 	// CltvLimit is the maximum time lock of the route excluding the final
 	// ctlv. After path finding is complete, the caller needs to increase
 	// all cltv expiry heights with the required final cltv delta.
 	CltvLimit *uint32
+
+	// BanList is an optional set of nodes and channels that must never be
+	// used during path finding.
+	BanList *BanList
 }
 
 // findPath attempts to find a path from the source node within the
@@ -261,6 +278,10 @@ type RestrictParams struct {
 // destination node back to source. This is to properly accumulate fees
 // that need to be paid along the path and accurately check the amount
 // to forward at every node against the available bandwidth.
+//
+// The source and target may be the same node, in which case a circular path
+// is returned that leaves and re-enters the source node over two of its
+// channels. This is used to rebalance the channels of the source node.
 func findPath(g *graphParams, r *RestrictParams, source, target route.Vertex,
 	amt lnwire.MilliSatoshi) ([]*channeldb.ChannelEdgePolicy, error) {
 
@@ -342,6 +363,17 @@ func findPath(g *graphParams, r *RestrictParams, source, target route.Vertex,
 	// mapped to within `next`.
 	next := make(map[route.Vertex]*channeldb.ChannelEdgePolicy)
 
+	// If we're paying ourselves, the source node is also the starting
+	// point of our backwards search, which already occupies its entry in
+	// the distance map. The best known distance from the source to the
+	// target and the first edge of that path are therefore tracked
+	// separately.
+	selfPayment := source == target
+	sourceDist := nodeWithDist{
+		dist: infinity,
+	}
+	var sourceNext *channeldb.ChannelEdgePolicy
+
 	ignoredEdges := r.IgnoredEdges
 	if ignoredEdges == nil {
 		ignoredEdges = make(map[EdgeLocator]struct{})
@@ -380,6 +412,22 @@ func findPath(g *graphParams, r *RestrictParams, source, target route.Vertex,
 			return
 		}
 
+		// If we have an incoming channel restriction and this is not
+		// the specified channel into the target, skip it.
+		if toNode == target && r.IncomingChannelID != nil &&
+			*r.IncomingChannelID != edge.ChannelID {
+
+			return
+		}
+
+		// A payment to ourselves can't leave over the same channel it
+		// arrives on.
+		if selfPayment && isSourceChan && next[toNode] != nil &&
+			next[toNode].ChannelID == edge.ChannelID {
+
+			return
+		}
+
 		// If this vertex or edge has been black listed, then we'll
 		// skip exploring this edge.
 		if _, ok := ignoredNodes[fromVertex]; ok {
@@ -391,6 +439,28 @@ func findPath(g *graphParams, r *RestrictParams, source, target route.Vertex,
 			return
 		}
 
+		pair := NewDirectedNodePair(fromVertex, toNode)
+		if _, ok := r.IgnoredPairs[pair]; ok {
+			return
+		}
+
+		// If we have a last hop restriction and this edge doesn't
+		// arrive at the target from the specified node, skip it.
+		if toNode == target && r.LastHop != nil &&
+			*r.LastHop != fromVertex {
+
+			return
+		}
+
+		// Banned nodes and channels are never used, even if they
+		// somehow managed to remain within the graph.
+		if r.BanList != nil && (r.BanList.IsNodeBanned(fromVertex) ||
+			r.BanList.IsNodeBanned(toNode) ||
+			r.BanList.IsChannelBanned(edge.ChannelID)) {
+
+			return
+		}
+
 		toNodeDist := distance[toNode]
 
 		amountToSend := toNodeDist.amountToReceive
@@ -466,7 +536,11 @@ func findPath(g *graphParams, r *RestrictParams, source, target route.Vertex,
 
 		// If this new tentative distance is not better than the current
 		// best known distance to this node, return.
-		if tempDist >= distance[fromVertex].dist {
+		fromNodeDist := distance[fromVertex]
+		if selfPayment && fromVertex == source {
+			fromNodeDist = sourceDist
+		}
+		if tempDist >= fromNodeDist.dist {
 			return
 		}
 
@@ -481,7 +555,7 @@ func findPath(g *graphParams, r *RestrictParams, source, target route.Vertex,
 		// better than the current best known distance to this node.
 		// The new better distance is recorded, and also our "next hop"
 		// map is populated with this edge.
-		distance[fromVertex] = nodeWithDist{
+		fromNodeDist = nodeWithDist{
 			dist:            tempDist,
 			node:            fromNode,
 			amountToReceive: amountToReceive,
@@ -489,11 +563,17 @@ func findPath(g *graphParams, r *RestrictParams, source, target route.Vertex,
 			incomingCltv:    incomingCltv,
 		}
 
-		next[fromVertex] = edge
+		if selfPayment && fromVertex == source {
+			sourceDist = fromNodeDist
+			sourceNext = edge
+		} else {
+			distance[fromVertex] = fromNodeDist
+			next[fromVertex] = edge
+		}
 
 		// Add this new node to our heap as we'd like to further
 		// explore backwards through this edge.
-		heap.Push(&nodeHeap, distance[fromVertex])
+		heap.Push(&nodeHeap, fromNodeDist)
 	}
 
 	// TODO(roasbeef): also add path caching
@@ -511,8 +591,12 @@ func findPath(g *graphParams, r *RestrictParams, source, target route.Vertex,
 
 		// If we've reached our source (or we don't have any incoming
 		// edges), then we're done here and can exit the graph
-		// traversal early.
-		if bestNode.PubKeyBytes == source {
+		// traversal early. When paying ourselves, the source is only
+		// reached once a path back to it has been found, as the first
+		// node popped is the source in its role as the target.
+		if bestNode.PubKeyBytes == source &&
+			(!selfPayment || sourceNext != nil) {
+
 			break
 		}
 
@@ -535,8 +619,12 @@ func findPath(g *graphParams, r *RestrictParams, source, target route.Vertex,
 			// We'll query the lower layer to see if we can obtain
 			// any more up to date information concerning the
 			// bandwidth of this edge.
+			//
+			// The hints only describe our sending bandwidth, so
+			// they don't apply to the channels over which a
+			// payment to ourselves arrives.
 			edgeBandwidth, ok := g.bandwidthHints[edgeInfo.ChannelID]
-			if !ok {
+			if !ok || pivot == source {
 				// If we don't have a hint for this edge, then
 				// we'll just use the known Capacity/MaxHTLC as
 				// the available bandwidth. It's possible for
@@ -584,15 +672,20 @@ func findPath(g *graphParams, r *RestrictParams, source, target route.Vertex,
 
 	// If the source node isn't found in the next hop map, then a path
 	// doesn't exist, so we terminate in an error.
-	if _, ok := next[source]; !ok {
+	firstEdge, ok := next[source]
+	if selfPayment {
+		firstEdge, ok = sourceNext, sourceNext != nil
+	}
+	if !ok {
 		return nil, newErrf(ErrNoPathFound, "unable to find a path to "+
 			"destination")
 	}
 
 	// Use the nextHop map to unravel the forward path from source to
 	// target.
-	pathEdges := make([]*channeldb.ChannelEdgePolicy, 0, len(next))
-	currentNode := source
+	pathEdges := make([]*channeldb.ChannelEdgePolicy, 0, len(next)+1)
+	pathEdges = append(pathEdges, firstEdge)
+	currentNode := route.Vertex(firstEdge.Node.PubKeyBytes)
 	for currentNode != target { // TODO(roasbeef): assumes no cycles
 		// Determine the next hop forward using the next map.
 		nextNode := next[currentNode]
@@ -734,13 +827,21 @@ func findPaths(tx *bbolt.Tx, graph *channeldb.ChannelGraph,
 			// TODO: Fee limit passed to spur path finding isn't
 			// correct, because it doesn't take into account the
 			// fees already paid on the root path.
-			//
-			// TODO: Outgoing channel restriction isn't obeyed for
-			// spur paths.
 			spurRestrictions := &RestrictParams{
-				IgnoredEdges: ignoredEdges,
-				IgnoredNodes: ignoredVertexes,
-				FeeLimit:     restrictions.FeeLimit,
+				IgnoredEdges:      ignoredEdges,
+				IgnoredNodes:      ignoredVertexes,
+				IgnoredPairs:      restrictions.IgnoredPairs,
+				FeeLimit:          restrictions.FeeLimit,
+				IncomingChannelID: restrictions.IncomingChannelID,
+				LastHop:           restrictions.LastHop,
+				BanList:           restrictions.BanList,
+			}
+
+			// The outgoing channel restriction only applies to
+			// spur paths that start at our own node.
+			if i == 0 {
+				spurRestrictions.OutgoingChannelID =
+					restrictions.OutgoingChannelID
 			}
 
 			spurPath, err := findPath(
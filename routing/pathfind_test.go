@@ -13,6 +13,7 @@ import (
 	"math/big"
 	"net"
 	"os"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -2041,6 +2042,235 @@ func TestRestrictOutgoingChannel(t *testing.T) {
 	}
 }
 
+// TestRestrictLastHopAndPairs asserts that the last hop and ignored node pair
+// restrictions steer path finding away from the otherwise cheapest path.
+func TestRestrictLastHopAndPairs(t *testing.T) {
+	t.Parallel()
+
+	// Set up a test graph with two possible paths from roasbeef to target.
+	// The path through b is the highest cost path.
+	testChannels := []*testChannel{
+		symmetricTestChannel("roasbeef", "a", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+			MinHTLC: 1,
+		}, 1),
+		symmetricTestChannel("a", "target", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+			MinHTLC: 1,
+		}, 2),
+		symmetricTestChannel("roasbeef", "b", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 800,
+			MinHTLC: 1,
+		}, 3),
+		symmetricTestChannel("b", "target", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 800,
+			MinHTLC: 1,
+		}, 4),
+	}
+
+	testGraphInstance, err := createTestGraphFromChannels(testChannels)
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer testGraphInstance.cleanUp()
+
+	sourceNode, err := testGraphInstance.graph.SourceNode()
+	if err != nil {
+		t.Fatalf("unable to fetch source node: %v", err)
+	}
+	sourceVertex := route.Vertex(sourceNode.PubKeyBytes)
+
+	paymentAmt := lnwire.NewMSatFromSatoshis(100)
+	aliases := testGraphInstance.aliasMap
+	target := aliases["target"]
+	lastHop := aliases["b"]
+
+	testCases := []struct {
+		name         string
+		restrictions *RestrictParams
+		expectedPath []uint64
+	}{
+		{
+			name: "no restrictions",
+			restrictions: &RestrictParams{
+				FeeLimit: noFeeLimit,
+			},
+			expectedPath: []uint64{1, 2},
+		},
+		{
+			name: "last hop",
+			restrictions: &RestrictParams{
+				FeeLimit: noFeeLimit,
+				LastHop:  &lastHop,
+			},
+			expectedPath: []uint64{3, 4},
+		},
+		{
+			name: "ignored pair",
+			restrictions: &RestrictParams{
+				FeeLimit: noFeeLimit,
+				IgnoredPairs: map[DirectedNodePair]struct{}{
+					NewDirectedNodePair(
+						aliases["a"], target,
+					): {},
+				},
+			},
+			expectedPath: []uint64{3, 4},
+		},
+		{
+			name: "ignored pair in other direction",
+			restrictions: &RestrictParams{
+				FeeLimit: noFeeLimit,
+				IgnoredPairs: map[DirectedNodePair]struct{}{
+					NewDirectedNodePair(
+						target, aliases["a"],
+					): {},
+				},
+			},
+			expectedPath: []uint64{1, 2},
+		},
+	}
+
+	for _, testCase := range testCases {
+		path, err := findPath(
+			&graphParams{
+				graph: testGraphInstance.graph,
+			},
+			testCase.restrictions, sourceVertex, target,
+			paymentAmt,
+		)
+		if err != nil {
+			t.Fatalf("%v: unable to find path: %v", testCase.name,
+				err)
+		}
+
+		var chanIDs []uint64
+		for _, edge := range path {
+			chanIDs = append(chanIDs, edge.ChannelID)
+		}
+		if !reflect.DeepEqual(chanIDs, testCase.expectedPath) {
+			t.Fatalf("%v: expected path %v, got %v", testCase.name,
+				testCase.expectedPath, chanIDs)
+		}
+	}
+}
+
+// TestSelfPayment asserts that circular paths are found from the source back
+// to itself, obeying the outgoing and incoming channel restrictions.
+func TestSelfPayment(t *testing.T) {
+	t.Parallel()
+
+	// Set up a test graph in which roasbeef has a channel with a, and two
+	// channels with b, of which channel 3 is the cheaper one. a and b are
+	// connected as well.
+	testChannels := []*testChannel{
+		symmetricTestChannel("roasbeef", "a", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+			MinHTLC: 1,
+		}, 1),
+		symmetricTestChannel("a", "b", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+			MinHTLC: 1,
+		}, 2),
+		symmetricTestChannel("roasbeef", "b", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+			MinHTLC: 1,
+		}, 3),
+		symmetricTestChannel("roasbeef", "b", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 800,
+			MinHTLC: 1,
+		}, 4),
+	}
+
+	testGraphInstance, err := createTestGraphFromChannels(testChannels)
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer testGraphInstance.cleanUp()
+
+	sourceNode, err := testGraphInstance.graph.SourceNode()
+	if err != nil {
+		t.Fatalf("unable to fetch source node: %v", err)
+	}
+	sourceVertex := route.Vertex(sourceNode.PubKeyBytes)
+
+	paymentAmt := lnwire.NewMSatFromSatoshis(100)
+
+	tests := []struct {
+		name         string
+		outgoingChan uint64
+		incomingChan uint64
+		expectedPath []uint64
+	}{
+		{
+			name:         "via a",
+			outgoingChan: 1,
+			incomingChan: 4,
+			expectedPath: []uint64{1, 2, 4},
+		},
+		{
+			name:         "via b",
+			outgoingChan: 4,
+			incomingChan: 3,
+			expectedPath: []uint64{4, 3},
+		},
+		{
+			name:         "same channel",
+			outgoingChan: 3,
+			incomingChan: 3,
+		},
+	}
+
+	for _, test := range tests {
+		outgoingChan := test.outgoingChan
+		incomingChan := test.incomingChan
+
+		path, err := findPath(
+			&graphParams{
+				graph: testGraphInstance.graph,
+			},
+			&RestrictParams{
+				FeeLimit:          noFeeLimit,
+				OutgoingChannelID: &outgoingChan,
+				IncomingChannelID: &incomingChan,
+			},
+			sourceVertex, sourceVertex, paymentAmt,
+		)
+		if test.expectedPath == nil {
+			if !IsError(err, ErrNoPathFound) {
+				t.Fatalf("%v: expected no path, got: %v",
+					test.name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%v: unable to find path: %v", test.name, err)
+		}
+
+		var chanIDs []uint64
+		for _, edge := range path {
+			chanIDs = append(chanIDs, edge.ChannelID)
+		}
+		if !reflect.DeepEqual(chanIDs, test.expectedPath) {
+			t.Fatalf("%v: expected path %v, got %v", test.name,
+				test.expectedPath, chanIDs)
+		}
+
+		if path[len(path)-1].Node.PubKeyBytes != sourceVertex {
+			t.Fatalf("%v: expected path to end at source",
+				test.name)
+		}
+	}
+}
+
 // TestCltvLimit asserts that a cltv limit is obeyed by the path finding
 // algorithm.
 func TestCltvLimit(t *testing.T) {
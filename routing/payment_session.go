@@ -149,6 +149,30 @@ func (p *paymentSession) RequestRoute(payment *LightningPayment,
 		cltvLimit = &limit
 	}
 
+	// The nodes and edges the payment itself excludes are combined with
+	// the ones mission control has pruned. We copy the prune view, as it's
+	// shared with mission control.
+	ignoredNodes := pruneView.vertexes
+	if len(payment.IgnoredNodes) > 0 {
+		ignoredNodes = make(map[route.Vertex]struct{})
+		for n := range pruneView.vertexes {
+			ignoredNodes[n] = struct{}{}
+		}
+		for n := range payment.IgnoredNodes {
+			ignoredNodes[n] = struct{}{}
+		}
+	}
+	ignoredEdges := pruneView.edges
+	if len(payment.IgnoredEdges) > 0 {
+		ignoredEdges = make(map[EdgeLocator]struct{})
+		for e := range pruneView.edges {
+			ignoredEdges[e] = struct{}{}
+		}
+		for e := range payment.IgnoredEdges {
+			ignoredEdges[e] = struct{}{}
+		}
+	}
+
 	// TODO(roasbeef): sync logic amongst dist sys
 
 	// Taking into account this prune view, we'll attempt to locate a path
@@ -161,11 +185,15 @@ func (p *paymentSession) RequestRoute(payment *LightningPayment,
 			bandwidthHints:  p.bandwidthHints,
 		},
 		&RestrictParams{
-			IgnoredNodes:      pruneView.vertexes,
-			IgnoredEdges:      pruneView.edges,
+			IgnoredNodes:      ignoredNodes,
+			IgnoredEdges:      ignoredEdges,
+			IgnoredPairs:      payment.IgnoredPairs,
 			FeeLimit:          payment.FeeLimit,
 			OutgoingChannelID: payment.OutgoingChannelID,
+			IncomingChannelID: payment.IncomingChannelID,
+			LastHop:           payment.LastHop,
 			CltvLimit:         cltvLimit,
+			BanList:           p.mc.banList,
 		},
 		p.mc.selfNode.PubKeyBytes, payment.Target,
 		payment.Amount,
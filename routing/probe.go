@@ -0,0 +1,350 @@
+package routing
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/zpay32"
+)
+
+// probeAmtResolution is the precision with which the largest amount a route
+// is able to carry is searched for.
+const probeAmtResolution = lnwire.MilliSatoshi(1000)
+
+// ProbeResult describes the outcome of a single probe.
+type ProbeResult struct {
+	// Route is the route that was probed.
+	Route *route.Route
+
+	// Success is true if the probe reached the destination, which means
+	// that the route is able to carry its amount.
+	Success bool
+
+	// FailureSource is the node that failed the probe. If the probe was
+	// successful, this is the destination.
+	FailureSource route.Vertex
+
+	// FailureSourceIndex is the position of FailureSource in the route,
+	// where zero is our own node and i is the i-th hop of the route. It's
+	// -1 if the failure source isn't part of the route.
+	FailureSourceIndex int
+
+	// FailureCode is the code of the failure reported by FailureSource.
+	FailureCode lnwire.FailCode
+}
+
+// ProbeRoute sends an htlc with a random payment hash over the given route to
+// test whether it's able to carry the amount of the route. As the destination
+// doesn't know the payment hash, it'll fail the htlc, which marks the probe as
+// successful. Any other failure is reported to mission control, just like
+// failures of regular payments are. Probes are never recorded as payments.
+func (r *ChannelRouter) ProbeRoute(rt *route.Route) (*ProbeResult, error) {
+	paySession := r.missionControl.NewPaymentSessionFromRoutes(
+		[]*route.Route{rt},
+	)
+
+	result, _, err := r.sendProbe(paySession, rt)
+	return result, err
+}
+
+// ProbePayment probes the network for a route that is able to carry the given
+// payment, without actually paying. Routes are found and retried in the same
+// way as for SendPayment, until a probe reaches the destination, or no more
+// routes are found. The payment hash of the payment is ignored, as every probe
+// uses a random payment hash.
+func (r *ChannelRouter) ProbePayment(payment *LightningPayment) (*ProbeResult,
+	error) {
+
+	paySession, err := r.missionControl.NewPaymentSession(
+		payment.RouteHints, payment.Target,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, currentHeight, err := r.cfg.Chain.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	finalCLTVDelta := uint16(zpay32.DefaultFinalCLTVDelta)
+	if payment.FinalCLTVDelta != nil {
+		finalCLTVDelta = *payment.FinalCLTVDelta
+	}
+
+	payAttemptTimeout := defaultPayAttemptTimeout
+	if payment.PayAttemptTimeout != 0 {
+		payAttemptTimeout = payment.PayAttemptTimeout
+	}
+	timeoutChan := time.After(payAttemptTimeout)
+
+	var lastResult *ProbeResult
+	for {
+		select {
+		case <-timeoutChan:
+			errStr := fmt.Sprintf("probe not completed before "+
+				"timeout of %v", payAttemptTimeout)
+
+			return lastResult, newErr(
+				ErrPaymentAttemptTimeout, errStr,
+			)
+
+		case <-r.quit:
+			return nil, ErrRouterShuttingDown
+
+		default:
+		}
+
+		rt, err := paySession.RequestRoute(
+			payment, uint32(currentHeight), finalCLTVDelta,
+		)
+		if err != nil {
+			// If earlier probes failed, we'll return the last
+			// failure, such that the caller learns where the
+			// payment got stuck.
+			if lastResult != nil {
+				return lastResult, nil
+			}
+
+			return nil, err
+		}
+
+		result, final, err := r.sendProbe(paySession, rt)
+		if err != nil || final {
+			return result, err
+		}
+
+		lastResult = result
+	}
+}
+
+// ProbeMaxAmount searches for the largest amount, up to the amount of the
+// given route, that the route is able to carry. It does so by repeatedly
+// probing the channels of the route with amounts chosen by binary search. The
+// result of the successful probe with the largest amount is returned. If no
+// probe succeeded, the result of the last failed probe is returned instead.
+//
+// The search only continues as long as probes fail because of the liquidity
+// of the route. Any other failure means that no amount can be sent over the
+// route, which ends the search. If an error occurs during the search, the
+// best result found so far is returned along with it.
+//
+// As failures for amounts beyond what the route is able to carry are expected,
+// they aren't reported to mission control.
+func (r *ChannelRouter) ProbeMaxAmount(rt *route.Route) (*ProbeResult,
+	error) {
+
+	if len(rt.Hops) == 0 {
+		return nil, route.ErrNoRouteHopsProvided
+	}
+
+	// probe sends a probe of the given amount over the channels of the
+	// route.
+	probe := func(amt lnwire.MilliSatoshi) (*ProbeResult, error) {
+		probeRoute, err := r.routeWithAmount(rt, amt)
+		if err != nil {
+			return nil, err
+		}
+
+		result, _, err := r.sendProbe(nil, probeRoute)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Debugf("Probe of %v succeeded: %v", amt, result.Success)
+
+		return result, nil
+	}
+
+	// If the route is able to carry its full amount, there's nothing to
+	// search for. The same goes for a route that fails for any other
+	// reason than the amount being too large.
+	maxAmt := rt.Hops[len(rt.Hops)-1].AmtToForward
+	result, err := probe(maxAmt)
+	if err != nil {
+		return nil, err
+	}
+	if result.Success ||
+		result.FailureCode != lnwire.CodeTemporaryChannelFailure {
+
+		return result, nil
+	}
+
+	// Otherwise, amounts up to low are known not to need probing, as they
+	// either succeeded or were too small, while high is the smallest
+	// amount known to be too large.
+	var (
+		bestResult *ProbeResult
+		lastResult = result
+	)
+	low, high := lnwire.MilliSatoshi(0), maxAmt
+	for high-low > probeAmtResolution {
+		amt := low + (high-low)/2
+
+		result, err := probe(amt)
+		if err != nil {
+			return bestResult, err
+		}
+
+		switch {
+		case result.Success:
+			bestResult = result
+			low = amt
+
+		// A channel that lacks the balance to forward the amount fails
+		// with a temporary channel failure, so we'll try a smaller
+		// amount.
+		case result.FailureCode == lnwire.CodeTemporaryChannelFailure:
+			lastResult = result
+			high = amt
+
+		// If the amount is below the minimum of one of the channels,
+		// only a larger amount may succeed.
+		case result.FailureCode == lnwire.CodeAmountBelowMinimum:
+			lastResult = result
+			low = amt
+
+		// Any other failure isn't caused by the amount, so probing
+		// other amounts won't help.
+		default:
+			if bestResult != nil {
+				return bestResult, nil
+			}
+			return result, nil
+		}
+	}
+
+	if bestResult != nil {
+		return bestResult, nil
+	}
+
+	return lastResult, nil
+}
+
+// routeWithAmount rebuilds the given route such that it delivers amt to the
+// destination, over the same channels, using their current policies.
+func (r *ChannelRouter) routeWithAmount(rt *route.Route,
+	amt lnwire.MilliSatoshi) (*route.Route, error) {
+
+	_, currentHeight, err := r.cfg.Chain.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	pathEdges := make([]*channeldb.ChannelEdgePolicy, 0, len(rt.Hops))
+	for _, hop := range rt.Hops {
+		_, e1, e2, err := r.cfg.Graph.FetchChannelEdgesByID(
+			hop.ChannelID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch channel %v: %v",
+				hop.ChannelID, err)
+		}
+
+		// The policy we need is the one of the channel direction that
+		// leads towards the hop.
+		var edge *channeldb.ChannelEdgePolicy
+		switch {
+		case e1 != nil && e1.Node.PubKeyBytes == hop.PubKeyBytes:
+			edge = e1
+		case e2 != nil && e2.Node.PubKeyBytes == hop.PubKeyBytes:
+			edge = e2
+		default:
+			return nil, fmt.Errorf("no policy for channel %v "+
+				"towards %v", hop.ChannelID, hop.PubKeyBytes)
+		}
+
+		pathEdges = append(pathEdges, edge)
+	}
+
+	// The final cltv delta of the original route is recovered from the
+	// time lock of the final hop.
+	finalHop := rt.Hops[len(rt.Hops)-1]
+	var finalCLTVDelta uint16
+	if finalHop.OutgoingTimeLock > uint32(currentHeight) {
+		finalCLTVDelta = uint16(
+			finalHop.OutgoingTimeLock - uint32(currentHeight),
+		)
+	}
+
+	return newRoute(
+		amt, rt.SourcePubKey, pathEdges, uint32(currentHeight),
+		finalCLTVDelta,
+	)
+}
+
+// sendProbe sends a single probe over the given route. If a payment session is
+// passed, failures are reported to mission control through it. The returned
+// bool indicates whether the probe result is final, or whether a new probe
+// over an alternative route may succeed.
+func (r *ChannelRouter) sendProbe(paySession *paymentSession,
+	rt *route.Route) (*ProbeResult, bool, error) {
+
+	if len(rt.Hops) == 0 {
+		return nil, true, route.ErrNoRouteHopsProvided
+	}
+
+	var paymentHash lntypes.Hash
+	if _, err := rand.Read(paymentHash[:]); err != nil {
+		return nil, true, err
+	}
+
+	log.Tracef("Probing route with payment hash %v: %v", paymentHash,
+		newLogClosure(func() string {
+			return spew.Sdump(rt)
+		}),
+	)
+
+	_, err := r.sendProbeToSwitch(rt, paymentHash)
+	if err == nil {
+		return nil, true, errors.New("probe unexpectedly settled")
+	}
+
+	fErr, ok := err.(*htlcswitch.ForwardingError)
+	if !ok {
+		return nil, true, err
+	}
+
+	result := &ProbeResult{
+		Route:         rt,
+		FailureSource: route.NewVertex(fErr.ErrorSource),
+	}
+	if fErr.FailureMessage != nil {
+		result.FailureCode = fErr.FailureMessage.Code()
+	}
+
+	result.FailureSourceIndex = -1
+	if result.FailureSource == rt.SourcePubKey {
+		result.FailureSourceIndex = 0
+	}
+	for i, hop := range rt.Hops {
+		if hop.PubKeyBytes == result.FailureSource {
+			result.FailureSourceIndex = i + 1
+		}
+	}
+
+	// If the destination doesn't know the payment hash, the probe made it
+	// all the way.
+	isFinalHop := result.FailureSourceIndex == len(rt.Hops)
+	if isFinalHop && result.FailureCode == lnwire.CodeUnknownPaymentHash {
+		result.Success = true
+		return result, true, nil
+	}
+
+	log.Debugf("Probe with payment hash %v failed at %v: %v",
+		paymentHash, result.FailureSource, err)
+
+	if paySession == nil {
+		return result, true, nil
+	}
+
+	return result, r.processSendError(paySession, rt, err), nil
+}
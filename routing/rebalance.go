@@ -0,0 +1,149 @@
+package routing
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/zpay32"
+)
+
+// RebalanceRequest describes a circular payment that moves funds out of one of
+// our channels and back into another one.
+type RebalanceRequest struct {
+	// OutgoingChannelID is the channel the funds are moved out of.
+	OutgoingChannelID uint64
+
+	// IncomingChannelID is the channel the funds are moved into.
+	IncomingChannelID uint64
+
+	// Amount is the amount to move, excluding fees.
+	Amount lnwire.MilliSatoshi
+
+	// FeeLimit is the maximum total fee we're willing to pay to the nodes
+	// along the route.
+	FeeLimit lnwire.MilliSatoshi
+
+	// PayAttemptTimeout is the time after which we stop trying to find a
+	// route that completes the rebalance. If zero, the default payment
+	// attempt timeout is used.
+	PayAttemptTimeout time.Duration
+}
+
+// Rebalance moves funds from one of our channels into another one by paying
+// ourselves over a circular route that leaves over the outgoing channel and
+// returns over the incoming channel. The payment is completed by an internal
+// invoice that only exists for the duration of the rebalance, and is retried
+// over alternative routes, reporting failures to mission control, just like a
+// regular payment. Completed rebalances are
+// recorded in the database apart from regular payments.
+func (r *ChannelRouter) Rebalance(req *RebalanceRequest) (*channeldb.Rebalance,
+	error) {
+
+	if r.cfg.AddRebalanceInvoice == nil ||
+		r.cfg.RemoveRebalanceInvoice == nil {
+
+		return nil, errors.New("rebalancing is not supported")
+	}
+
+	if req.OutgoingChannelID == req.IncomingChannelID {
+		return nil, errors.New("outgoing and incoming channel must " +
+			"differ")
+	}
+
+	// Both channels must be our own, as we'd otherwise never be able to
+	// find a route over them.
+	self := route.Vertex(r.selfNode.PubKeyBytes)
+	for _, chanID := range []uint64{
+		req.OutgoingChannelID, req.IncomingChannelID,
+	} {
+		info, _, _, err := r.cfg.Graph.FetchChannelEdgesByID(chanID)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch channel %v: %v",
+				chanID, err)
+		}
+
+		if info.NodeKey1Bytes != self && info.NodeKey2Bytes != self {
+			return nil, fmt.Errorf("channel %v is not one of our "+
+				"channels", chanID)
+		}
+	}
+
+	// With the channels validated, we'll create the internal invoice that
+	// settles the payment once it arrives back at our node.
+	var preimage lntypes.Preimage
+	if _, err := rand.Read(preimage[:]); err != nil {
+		return nil, err
+	}
+
+	finalCLTVDelta := uint16(zpay32.DefaultFinalCLTVDelta)
+	err := r.cfg.AddRebalanceInvoice(
+		req.Amount, preimage, uint32(finalCLTVDelta),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to add rebalance invoice: %v",
+			err)
+	}
+
+	// Once the payment has either completed or failed, the invoice is
+	// removed, such that it can't be paid by anyone else.
+	defer r.cfg.RemoveRebalanceInvoice(preimage.Hash())
+
+	log.Infof("Rebalancing %v from chan_id=%v to chan_id=%v, payment "+
+		"hash %v", req.Amount, req.OutgoingChannelID,
+		req.IncomingChannelID, preimage.Hash())
+
+	outgoingChanID := req.OutgoingChannelID
+	incomingChanID := req.IncomingChannelID
+	payment := &LightningPayment{
+		Target:            self,
+		Amount:            req.Amount,
+		FeeLimit:          req.FeeLimit,
+		PaymentHash:       preimage.Hash(),
+		FinalCLTVDelta:    &finalCLTVDelta,
+		PayAttemptTimeout: req.PayAttemptTimeout,
+		OutgoingChannelID: &outgoingChanID,
+		IncomingChannelID: &incomingChanID,
+	}
+
+	paySession, err := r.missionControl.NewPaymentSession(nil, self)
+	if err != nil {
+		return nil, err
+	}
+
+	_, rt, err := r.sendPayment(payment, paySession)
+	if err != nil {
+		return nil, err
+	}
+
+	rebalance := &channeldb.Rebalance{
+		OutgoingChanID:  lnwire.NewShortChanIDFromInt(outgoingChanID),
+		IncomingChanID:  lnwire.NewShortChanIDFromInt(incomingChanID),
+		Amount:          req.Amount,
+		Fee:             rt.TotalFees,
+		PaymentHash:     preimage.Hash(),
+		PaymentPreimage: preimage,
+		Timestamp:       time.Now(),
+	}
+	for _, hop := range rt.Hops {
+		chanID := lnwire.NewShortChanIDFromInt(hop.ChannelID)
+		rebalance.Path = append(rebalance.Path, chanID)
+	}
+
+	if err := r.cfg.Graph.Database().AddRebalance(rebalance); err != nil {
+		return nil, fmt.Errorf("unable to record rebalance: %v", err)
+	}
+
+	return rebalance, nil
+}
+
+// FetchRebalances returns all completed rebalances, in the order in which they
+// completed.
+func (r *ChannelRouter) FetchRebalances() ([]*channeldb.Rebalance, error) {
+	return r.cfg.Graph.Database().FetchAllRebalances()
+}
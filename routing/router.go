@@ -21,6 +21,7 @@ import (
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/multimutex"
@@ -181,6 +182,13 @@ type Config struct {
 		htlcAdd *lnwire.UpdateAddHTLC,
 		circuit *sphinx.Circuit) ([sha256.Size]byte, error)
 
+	// SendProbeToSwitch is identical to SendToSwitch, except that it's
+	// used to send probes, which shouldn't leave a persisted payment
+	// status behind for their random payment hashes.
+	SendProbeToSwitch func(firstHop lnwire.ShortChannelID,
+		htlcAdd *lnwire.UpdateAddHTLC,
+		circuit *sphinx.Circuit) ([sha256.Size]byte, error)
+
 	// ChannelPruneExpiry is the duration used to determine if a channel
 	// should be pruned or not. If the delta between now and when the
 	// channel was last updated is greater than ChannelPruneExpiry, then
@@ -203,6 +211,24 @@ type Config struct {
 	// spentness of channel outpoints. For neutrino, this saves long rescans
 	// from blocking initial usage of the daemon.
 	AssumeChannelValid bool
+
+	// BanList is an optional set of nodes and channels whose graph data
+	// the router ignores. Any announcements for them are rejected, they're
+	// purged from the graph, and they're never used during path finding.
+	BanList *BanList
+
+	// AddRebalanceInvoice adds an internal invoice of the given amount
+	// that pays ourselves, settled by the given preimage. It is used to
+	// complete the circular payments that rebalance our channels. The
+	// invoice is kept apart from regular invoices, and is never stored in
+	// the invoice database. If nil, rebalancing isn't supported.
+	AddRebalanceInvoice func(amt lnwire.MilliSatoshi,
+		preimage lntypes.Preimage, finalCLTVDelta uint32) error
+
+	// RemoveRebalanceInvoice removes the internal invoice with the given
+	// payment hash once the rebalance it belongs to has completed or
+	// failed, after which it can no longer be paid.
+	RemoveRebalanceInvoice func(paymentHash lntypes.Hash)
 }
 
 // routeTuple is an entry within the ChannelRouter's route cache. We cache
@@ -265,6 +291,24 @@ func (e *EdgeLocator) String() string {
 	return fmt.Sprintf("%v:%v", e.ChannelID, e.Direction)
 }
 
+// DirectedNodePair stores a directed pair of nodes.
+type DirectedNodePair struct {
+	From, To route.Vertex
+}
+
+// NewDirectedNodePair instantiates a new DirectedNodePair struct.
+func NewDirectedNodePair(from, to route.Vertex) DirectedNodePair {
+	return DirectedNodePair{
+		From: from,
+		To:   to,
+	}
+}
+
+// String returns a human readable version of the directed node pair.
+func (d DirectedNodePair) String() string {
+	return fmt.Sprintf("%v -> %v", d.From, d.To)
+}
+
 // ChannelRouter is the layer 3 router within the Lightning stack. Below the
 // ChannelRouter is the HtlcSwitch, and below that is the Bitcoin blockchain
 // itself. The primary role of the ChannelRouter is to respond to queries for
@@ -365,7 +409,7 @@ func New(cfg Config) (*ChannelRouter, error) {
 	}
 
 	r.missionControl = newMissionControl(
-		cfg.Graph, selfNode, cfg.QueryBandwidth,
+		cfg.Graph, selfNode, cfg.QueryBandwidth, cfg.BanList,
 	)
 
 	return r, nil
@@ -407,6 +451,20 @@ func (r *ChannelRouter) Start() error {
 		}
 	}
 
+	// Before we start to watch our channels, we'll purge any banned nodes
+	// and channels that may still be within our graph, such as those that
+	// were only added to our config since the last time we ran.
+	if r.cfg.BanList != nil {
+		err := r.purgeBannedNodes(r.cfg.BanList.Nodes()...)
+		if err != nil {
+			return err
+		}
+		err = r.purgeBannedChannels(r.cfg.BanList.Channels()...)
+		if err != nil {
+			return err
+		}
+	}
+
 	// If AssumeChannelValid is present, then we won't rely on pruning
 	// channels from the graph based on their spentness, but whether they
 	// are considered zombies or not.
@@ -811,7 +869,9 @@ func (r *ChannelRouter) networkHandler() {
 				// this is either a new update from our PoV or
 				// an update to a prior vertex/edge we
 				// previously accepted.
-				err = r.processUpdate(update.msg)
+				err = r.processUpdate(
+					update.msg, update.assumeValid,
+				)
 				update.err <- err
 
 				// If this message had any dependencies, then
@@ -978,6 +1038,274 @@ func (r *ChannelRouter) networkHandler() {
 	}
 }
 
+// isNodeBanned returns true if the given node is within our ban list.
+func (r *ChannelRouter) isNodeBanned(node route.Vertex) bool {
+	return r.cfg.BanList != nil && r.cfg.BanList.IsNodeBanned(node)
+}
+
+// isBannedEdge returns true if the given channel, or either of its nodes, is
+// within our ban list. Our own channels are never considered banned, as
+// they're managed by us rather than the gossip network.
+func (r *ChannelRouter) isBannedEdge(info *channeldb.ChannelEdgeInfo) bool {
+	if r.cfg.BanList == nil {
+		return false
+	}
+
+	if info.NodeKey1Bytes == r.selfNode.PubKeyBytes ||
+		info.NodeKey2Bytes == r.selfNode.PubKeyBytes {
+
+		return false
+	}
+
+	return r.cfg.BanList.IsChannelBanned(info.ChannelID) ||
+		r.cfg.BanList.IsNodeBanned(info.NodeKey1Bytes) ||
+		r.cfg.BanList.IsNodeBanned(info.NodeKey2Bytes)
+}
+
+// BanNodes adds the given nodes to the ban list, and purges them along with
+// their channels from the graph. Our own channels with these nodes are kept,
+// but will never be used during path finding.
+func (r *ChannelRouter) BanNodes(nodes ...route.Vertex) error {
+	if r.cfg.BanList == nil {
+		return errors.New("ban list not active")
+	}
+
+	for _, node := range nodes {
+		if node == r.selfNode.PubKeyBytes {
+			return errors.New("unable to ban our own node")
+		}
+	}
+
+	if err := r.cfg.BanList.banNodes(nodes...); err != nil {
+		return err
+	}
+
+	return r.purgeBannedNodes(nodes...)
+}
+
+// UnbanNodes removes the given nodes from the ban list. As their channels were
+// marked as zombies while purged, we'll mark them as live again, such that we
+// can learn of them once again through the gossip network. Channels that were
+// zombies for any other reason remain zombies.
+func (r *ChannelRouter) UnbanNodes(nodes ...route.Vertex) error {
+	if r.cfg.BanList == nil {
+		return errors.New("ban list not active")
+	}
+
+	if err := r.cfg.BanList.unbanNodes(nodes...); err != nil {
+		return err
+	}
+
+	for _, node := range nodes {
+		zombieChans, err := r.cfg.Graph.ZombieChansOfNode(node)
+		if err != nil {
+			return err
+		}
+
+		if err := r.resurrectPurgedChans(zombieChans...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// BanChannels adds the given channels to the ban list, and purges them from
+// the graph. Our own channels can't be banned.
+func (r *ChannelRouter) BanChannels(chanIDs ...uint64) error {
+	if r.cfg.BanList == nil {
+		return errors.New("ban list not active")
+	}
+
+	for _, chanID := range chanIDs {
+		info, _, _, err := r.cfg.Graph.FetchChannelEdgesByID(chanID)
+		switch {
+		case err == channeldb.ErrEdgeNotFound:
+			continue
+
+		case err != nil:
+			return err
+		}
+
+		if info.NodeKey1Bytes == r.selfNode.PubKeyBytes ||
+			info.NodeKey2Bytes == r.selfNode.PubKeyBytes {
+
+			return errors.Errorf("unable to ban our own "+
+				"chan_id=%v", chanID)
+		}
+	}
+
+	if err := r.cfg.BanList.banChannels(chanIDs...); err != nil {
+		return err
+	}
+
+	return r.purgeBannedChannels(chanIDs...)
+}
+
+// UnbanChannels removes the given channels from the ban list. As they were
+// marked as zombies while purged, we'll mark them as live again, such that we
+// can learn of them once again through the gossip network. Channels that were
+// zombies for any other reason remain zombies.
+func (r *ChannelRouter) UnbanChannels(chanIDs ...uint64) error {
+	if r.cfg.BanList == nil {
+		return errors.New("ban list not active")
+	}
+
+	if err := r.cfg.BanList.unbanChannels(chanIDs...); err != nil {
+		return err
+	}
+
+	return r.resurrectPurgedChans(chanIDs...)
+}
+
+// resurrectPurgedChans marks those of the given channels as live again that
+// are zombies because they were purged for a ban, which has since been lifted
+// for both the channel and its nodes.
+func (r *ChannelRouter) resurrectPurgedChans(chanIDs ...uint64) error {
+	purged, err := r.cfg.BanList.filterPurgedChans(chanIDs)
+	if err != nil {
+		return err
+	}
+
+	var resurrected []uint64
+	for _, chanID := range purged {
+		// Channels that are still banned by themselves, or through
+		// either of their nodes, remain zombies.
+		isZombie, pubKey1, pubKey2 := r.cfg.Graph.IsZombieEdge(chanID)
+		if isZombie && (r.cfg.BanList.IsChannelBanned(chanID) ||
+			r.cfg.BanList.IsNodeBanned(pubKey1) ||
+			r.cfg.BanList.IsNodeBanned(pubKey2)) {
+
+			continue
+		}
+
+		if isZombie {
+			err := r.cfg.Graph.MarkEdgeLive(chanID)
+			if err != nil {
+				return err
+			}
+		}
+		resurrected = append(resurrected, chanID)
+	}
+
+	return r.cfg.BanList.removePurgedChans(resurrected...)
+}
+
+// purgeBannedNodes removes the given nodes and their channels from the graph,
+// marking the channels as zombies such that we won't query for them again.
+// Our own channels with these nodes, and therefore the nodes themselves, are
+// kept.
+func (r *ChannelRouter) purgeBannedNodes(nodes ...route.Vertex) error {
+	for _, node := range nodes {
+		pubKey, err := btcec.ParsePubKey(node[:], btcec.S256())
+		if err != nil {
+			return err
+		}
+
+		dbNode, err := r.cfg.Graph.FetchLightningNode(pubKey)
+		switch {
+		case err == channeldb.ErrGraphNodeNotFound:
+			continue
+
+		case err != nil:
+			return err
+		}
+
+		var (
+			chanIDs    []uint64
+			hasOwnChan bool
+		)
+		err = dbNode.ForEachChannel(nil, func(_ *bbolt.Tx,
+			info *channeldb.ChannelEdgeInfo, _,
+			_ *channeldb.ChannelEdgePolicy) error {
+
+			if info.NodeKey1Bytes == r.selfNode.PubKeyBytes ||
+				info.NodeKey2Bytes == r.selfNode.PubKeyBytes {
+
+				hasOwnChan = true
+				return nil
+			}
+
+			chanIDs = append(chanIDs, info.ChannelID)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := r.deleteChannelEdges(chanIDs...); err != nil {
+			return err
+		}
+
+		if hasOwnChan {
+			continue
+		}
+
+		err = r.cfg.Graph.DeleteLightningNode(pubKey)
+		if err != nil && err != channeldb.ErrGraphNodeNotFound {
+			return err
+		}
+
+		log.Infof("Purged banned node=%x along with %v channels from "+
+			"the graph", node[:], len(chanIDs))
+	}
+
+	return nil
+}
+
+// purgeBannedChannels removes the given channels from the graph, marking them
+// as zombies such that we won't query for them again. Our own channels are
+// kept.
+func (r *ChannelRouter) purgeBannedChannels(chanIDs ...uint64) error {
+	var toDelete []uint64
+	for _, chanID := range chanIDs {
+		info, _, _, err := r.cfg.Graph.FetchChannelEdgesByID(chanID)
+		switch {
+		case err == channeldb.ErrEdgeNotFound:
+			continue
+
+		case err != nil:
+			return err
+		}
+
+		if info.NodeKey1Bytes == r.selfNode.PubKeyBytes ||
+			info.NodeKey2Bytes == r.selfNode.PubKeyBytes {
+
+			continue
+		}
+
+		toDelete = append(toDelete, chanID)
+	}
+
+	return r.deleteChannelEdges(toDelete...)
+}
+
+// deleteChannelEdges removes the given channels from the graph one by one, as
+// the graph refuses to delete a batch that contains channels it doesn't know
+// of. The deleted channels are recorded within the ban list, such that they
+// can be marked as live again once their ban is lifted.
+func (r *ChannelRouter) deleteChannelEdges(chanIDs ...uint64) error {
+	var deleted []uint64
+	for _, chanID := range chanIDs {
+		r.channelEdgeMtx.Lock(chanID)
+		err := r.cfg.Graph.DeleteChannelEdges(chanID)
+		r.channelEdgeMtx.Unlock(chanID)
+
+		switch {
+		case err == channeldb.ErrEdgeNotFound:
+			continue
+
+		case err != nil:
+			return err
+		}
+
+		log.Debugf("Purged banned chan_id=%v from the graph", chanID)
+		deleted = append(deleted, chanID)
+	}
+
+	return r.cfg.BanList.addPurgedChans(deleted...)
+}
+
 // assertNodeAnnFreshness returns a non-nil error if we have an announcement in
 // the database for the passed node with a timestamp newer than the passed
 // timestamp. ErrIgnored will be returned if we already have the node, and
@@ -1017,10 +1345,17 @@ func (r *ChannelRouter) assertNodeAnnFreshness(node route.Vertex,
 // processUpdate processes a new relate authenticated channel/edge, node or
 // channel/edge update network update. If the update didn't affect the internal
 // state of the draft due to either being out of date, invalid, or redundant,
-// then error is returned.
-func (r *ChannelRouter) processUpdate(msg interface{}) error {
+// then error is returned. If assumeValid is set, the existence of a new
+// channel isn't validated against the chain.
+func (r *ChannelRouter) processUpdate(msg interface{}, assumeValid bool) error {
 	switch msg := msg.(type) {
 	case *channeldb.LightningNode:
+		// We'll ignore any announcements of banned nodes.
+		if r.isNodeBanned(msg.PubKeyBytes) {
+			return newErrf(ErrIgnored, "ignoring msg for banned "+
+				"node=%x", msg.PubKeyBytes)
+		}
+
 		// Before we add the node to the database, we'll check to see
 		// if the announcement is "fresh" or not. If it isn't, then
 		// we'll return an error.
@@ -1037,6 +1372,13 @@ func (r *ChannelRouter) processUpdate(msg interface{}) error {
 		log.Infof("Updated vertex data for node=%x", msg.PubKeyBytes)
 
 	case *channeldb.ChannelEdgeInfo:
+		// We'll ignore any channels that are banned, or belong to a
+		// banned node.
+		if r.isBannedEdge(msg) {
+			return newErrf(ErrIgnored, "ignoring msg for banned "+
+				"chan_id=%v", msg.ChannelID)
+		}
+
 		// If we recently rejected this channel edge, then we won't
 		// attempt to re-process it.
 		r.rejectMtx.RLock()
@@ -1068,8 +1410,8 @@ func (r *ChannelRouter) processUpdate(msg interface{}) error {
 		// If AssumeChannelValid is present, then we are unable to
 		// perform any of the expensive checks below, so we'll
 		// short-circuit our path straight to adding the edge to our
-		// graph.
-		if r.cfg.AssumeChannelValid {
+		// graph. The same goes for channels from a trusted source.
+		if r.cfg.AssumeChannelValid || assumeValid {
 			if err := r.cfg.Graph.AddChannelEdge(msg); err != nil {
 				return fmt.Errorf("unable to add edge: %v", err)
 			}
@@ -1310,6 +1652,10 @@ func (r *ChannelRouter) fetchChanPoint(
 type routingMsg struct {
 	msg interface{}
 	err chan error
+
+	// assumeValid indicates that the existence of a channel shouldn't be
+	// validated against the chain, as it comes from a trusted source.
+	assumeValid bool
 }
 
 // pathsToFeeSortedRoutes takes a set of paths, and returns a corresponding set
@@ -1425,6 +1771,14 @@ func (r *ChannelRouter) FindRoutes(source, target route.Vertex,
 		return nil, err
 	}
 
+	// Banned nodes and channels are excluded from every route, regardless
+	// of the restrictions of the caller.
+	if r.cfg.BanList != nil {
+		restrictionsCopy := *restrictions
+		restrictionsCopy.BanList = r.cfg.BanList
+		restrictions = &restrictionsCopy
+	}
+
 	// Now that we know the destination is reachable within the graph,
 	// we'll execute our KSP algorithm to find the k-shortest paths from
 	// our source to the destination.
@@ -1581,6 +1935,26 @@ type LightningPayment struct {
 	// hop. If nil, any channel may be used.
 	OutgoingChannelID *uint64
 
+	// IncomingChannelID is the channel that needs to be taken from the
+	// last hop to the target. If nil, any channel may be used.
+	IncomingChannelID *uint64
+
+	// LastHop is the pubkey of the last node before the final destination
+	// is reached. If nil, any node may be used.
+	LastHop *route.Vertex
+
+	// IgnoredNodes is an optional set of nodes that must not be used to
+	// route this payment.
+	IgnoredNodes map[route.Vertex]struct{}
+
+	// IgnoredEdges is an optional set of channel edges that must not be
+	// used to route this payment.
+	IgnoredEdges map[EdgeLocator]struct{}
+
+	// IgnoredPairs is an optional set of directed node pairs between which
+	// this payment must not be routed.
+	IgnoredPairs map[DirectedNodePair]struct{}
+
 	// TODO(roasbeef): add e2e message?
 }
 
@@ -1750,6 +2124,23 @@ func (r *ChannelRouter) sendPaymentAttempt(paySession *paymentSession,
 func (r *ChannelRouter) sendToSwitch(route *route.Route, paymentHash [32]byte) (
 	[32]byte, error) {
 
+	return r.sendRoute(route, paymentHash, r.cfg.SendToSwitch)
+}
+
+// sendProbeToSwitch sends a probe along the specified route. The returned
+// error contains the reason the probe failed.
+func (r *ChannelRouter) sendProbeToSwitch(route *route.Route,
+	paymentHash [32]byte) ([32]byte, error) {
+
+	return r.sendRoute(route, paymentHash, r.cfg.SendProbeToSwitch)
+}
+
+// sendRoute crafts the htlc for the specified route and hands it to the given
+// switch send function.
+func (r *ChannelRouter) sendRoute(route *route.Route, paymentHash [32]byte,
+	send func(lnwire.ShortChannelID, *lnwire.UpdateAddHTLC,
+		*sphinx.Circuit) ([sha256.Size]byte, error)) ([32]byte, error) {
+
 	// Generate the raw encoded sphinx packet to be included along
 	// with the htlcAdd message that we send directly to the
 	// switch.
@@ -1776,9 +2167,7 @@ func (r *ChannelRouter) sendToSwitch(route *route.Route, paymentHash [32]byte) (
 	firstHop := lnwire.NewShortChanIDFromInt(
 		route.Hops[0].ChannelID,
 	)
-	return r.cfg.SendToSwitch(
-		firstHop, htlcAdd, circuit,
-	)
+	return send(firstHop, htlcAdd, circuit)
 }
 
 // processSendError analyzes the error for the payment attempt received from the
@@ -2137,6 +2526,30 @@ func (r *ChannelRouter) AddEdge(edge *channeldb.ChannelEdgeInfo) error {
 	}
 }
 
+// addTrustedEdge adds a new channel edge from a trusted source, such as a
+// graph snapshot, to the graph. The edge is processed just like one added by
+// AddEdge, except that its existence isn't validated against the chain, and
+// the caller is responsible for watching its funding output.
+func (r *ChannelRouter) addTrustedEdge(edge *channeldb.ChannelEdgeInfo) error {
+	rMsg := &routingMsg{
+		msg:         edge,
+		err:         make(chan error, 1),
+		assumeValid: true,
+	}
+
+	select {
+	case r.networkUpdates <- rMsg:
+		select {
+		case err := <-rMsg.err:
+			return err
+		case <-r.quit:
+			return ErrRouterShuttingDown
+		}
+	case <-r.quit:
+		return ErrRouterShuttingDown
+	}
+}
+
 // UpdateEdge is used to update edge information, without this message edge
 // considered as not fully constructed.
 //
@@ -2,9 +2,11 @@ package routing
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"image/color"
 	"math/rand"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -18,6 +20,7 @@ import (
 	sphinx "github.com/lightningnetwork/lightning-onion"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/lightningnetwork/lnd/zpay32"
@@ -359,6 +362,525 @@ func TestSendPaymentRouteFailureFallback(t *testing.T) {
 	}
 }
 
+// TestRebalance asserts that a rebalance pays our own invoice over a circular
+// route through the requested channels, falls back to alternative routes on
+// failure and is recorded in the database apart from regular payments.
+func TestRebalance(t *testing.T) {
+	t.Parallel()
+
+	// Set up a test graph in which roasbeef has a channel with a, and two
+	// channels with b. a can reach b either directly over the cheaper
+	// channel 2, or through c.
+	policy := &testChannelPolicy{
+		Expiry:  144,
+		FeeRate: 400,
+		MinHTLC: 1,
+	}
+	expensivePolicy := &testChannelPolicy{
+		Expiry:  144,
+		FeeRate: 800,
+		MinHTLC: 1,
+	}
+	testChannels := []*testChannel{
+		symmetricTestChannel("roasbeef", "a", 100000, policy, 1),
+		symmetricTestChannel("a", "b", 100000, policy, 2),
+		symmetricTestChannel("roasbeef", "b", 100000, policy, 3),
+		symmetricTestChannel("roasbeef", "b", 100000, policy, 4),
+		symmetricTestChannel("a", "c", 100000, expensivePolicy, 5),
+		symmetricTestChannel("c", "b", 100000, expensivePolicy, 6),
+	}
+
+	testGraph, err := createTestGraphFromChannels(testChannels)
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxFromGraphInstance(
+		startingBlockHeight, testGraph,
+	)
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+	defer cleanUp()
+
+	nodeAVertex := ctx.aliases["a"]
+	nodeAKey, err := btcec.ParsePubKey(nodeAVertex[:], btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to parse pubkey: %v", err)
+	}
+
+	// Our mock invoice registry only remembers the last invoice added, and
+	// whether it has been removed again.
+	var (
+		invoicePreimage lntypes.Preimage
+		invoiceAmt      lnwire.MilliSatoshi
+		invoiceRemoved  bool
+	)
+	ctx.router.cfg.AddRebalanceInvoice = func(amt lnwire.MilliSatoshi,
+		preimage lntypes.Preimage, _ uint32) error {
+
+		invoicePreimage = preimage
+		invoiceAmt = amt
+		invoiceRemoved = false
+		return nil
+	}
+	ctx.router.cfg.RemoveRebalanceInvoice = func(hash lntypes.Hash) {
+		if hash == invoicePreimage.Hash() {
+			invoiceRemoved = true
+		}
+	}
+
+	// The direct channel from a to b has no liquidity, which should make
+	// the router fall back to the route through c.
+	var attempts int
+	ctx.router.cfg.SendToSwitch = func(_ lnwire.ShortChannelID,
+		htlcAdd *lnwire.UpdateAddHTLC,
+		_ *sphinx.Circuit) ([32]byte, error) {
+
+		if htlcAdd.PaymentHash != invoicePreimage.Hash() {
+			return [32]byte{}, errors.New("unknown payment hash")
+		}
+
+		attempts++
+		if attempts == 1 {
+			return [32]byte{}, &htlcswitch.ForwardingError{
+				ErrorSource:    nodeAKey,
+				FailureMessage: &lnwire.FailTemporaryChannelFailure{},
+			}
+		}
+
+		return invoicePreimage, nil
+	}
+
+	// The amount isn't a whole number of satoshis, which the invoice must
+	// request exactly.
+	amt := lnwire.NewMSatFromSatoshis(1000) + 500
+	rebalance, err := ctx.router.Rebalance(&RebalanceRequest{
+		OutgoingChannelID: 1,
+		IncomingChannelID: 3,
+		Amount:            amt,
+		FeeLimit:          noFeeLimit,
+	})
+	if err != nil {
+		t.Fatalf("unable to rebalance: %v", err)
+	}
+	if invoiceAmt != amt {
+		t.Fatalf("expected invoice of %v, got %v", amt, invoiceAmt)
+	}
+	if !invoiceRemoved {
+		t.Fatalf("expected invoice to be removed after rebalance")
+	}
+
+	if attempts != 2 {
+		t.Fatalf("expected 2 payment attempts, got %v", attempts)
+	}
+
+	expectedPath := []lnwire.ShortChannelID{
+		lnwire.NewShortChanIDFromInt(1),
+		lnwire.NewShortChanIDFromInt(5),
+		lnwire.NewShortChanIDFromInt(6),
+		lnwire.NewShortChanIDFromInt(3),
+	}
+	if !reflect.DeepEqual(rebalance.Path, expectedPath) {
+		t.Fatalf("expected path %v, got %v", expectedPath,
+			rebalance.Path)
+	}
+	if rebalance.PaymentPreimage != invoicePreimage {
+		t.Fatalf("rebalance preimage doesn't match invoice")
+	}
+	if rebalance.Fee == 0 {
+		t.Fatalf("expected non-zero rebalance fee")
+	}
+
+	rebalances, err := ctx.graph.Database().FetchAllRebalances()
+	if err != nil {
+		t.Fatalf("unable to fetch rebalances: %v", err)
+	}
+	if len(rebalances) != 1 {
+		t.Fatalf("expected 1 rebalance, got %v", len(rebalances))
+	}
+
+	// A rebalance that fails should remove its invoice as well.
+	ctx.router.cfg.SendToSwitch = func(_ lnwire.ShortChannelID,
+		_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit) ([32]byte, error) {
+
+		return [32]byte{}, errors.New("payment failed")
+	}
+	_, err = ctx.router.Rebalance(&RebalanceRequest{
+		OutgoingChannelID: 1,
+		IncomingChannelID: 3,
+		Amount:            amt,
+		FeeLimit:          noFeeLimit,
+	})
+	if err == nil {
+		t.Fatalf("expected rebalance to fail")
+	}
+	if !invoiceRemoved {
+		t.Fatalf("expected invoice to be removed after failure")
+	}
+
+	// A rebalance over a single channel is rejected.
+	_, err = ctx.router.Rebalance(&RebalanceRequest{
+		OutgoingChannelID: 3,
+		IncomingChannelID: 3,
+		Amount:            lnwire.NewMSatFromSatoshis(1000),
+		FeeLimit:          noFeeLimit,
+	})
+	if err == nil {
+		t.Fatalf("expected rebalance over a single channel to fail")
+	}
+}
+
+// TestBuildRoute asserts that routes built from an explicit list of hops use
+// the expected channels, and carry the correct amounts and time locks.
+func TestBuildRoute(t *testing.T) {
+	t.Parallel()
+
+	// Set up a test graph in which roasbeef has two channels with a, and a
+	// has a cheap and an expensive channel with b. b has a single channel
+	// with c.
+	policy := &testChannelPolicy{
+		Expiry:  144,
+		FeeRate: 400,
+		MinHTLC: 1,
+	}
+	expensivePolicy := &testChannelPolicy{
+		Expiry:  288,
+		FeeRate: 800,
+		MinHTLC: 1,
+	}
+	testChannels := []*testChannel{
+		symmetricTestChannel("roasbeef", "a", 150000, policy, 1),
+		symmetricTestChannel("roasbeef", "a", 200000, policy, 2),
+		symmetricTestChannel("a", "b", 200000, policy, 3),
+		symmetricTestChannel("a", "b", 200000, expensivePolicy, 4),
+		symmetricTestChannel("b", "c", 200000, policy, 5),
+	}
+
+	testGraph, err := createTestGraphFromChannels(testChannels)
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxFromGraphInstance(
+		startingBlockHeight, testGraph,
+	)
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+	defer cleanUp()
+
+	hops := []route.Vertex{
+		ctx.aliases["a"], ctx.aliases["b"], ctx.aliases["c"],
+	}
+	amt := lnwire.NewMSatFromSatoshis(100000)
+	const finalCLTVDelta = 40
+
+	checkRoute := func(rt *route.Route, expectedChans []uint64) {
+		t.Helper()
+
+		var chanIDs []uint64
+		for _, hop := range rt.Hops {
+			chanIDs = append(chanIDs, hop.ChannelID)
+		}
+		if !reflect.DeepEqual(chanIDs, expectedChans) {
+			t.Fatalf("expected channels %v, got %v", expectedChans,
+				chanIDs)
+		}
+
+		// a must charge the fee of the expensive channel, as it may
+		// forward over either of its channels to b.
+		feeB := amt * 400 / 1000000
+		feeA := (amt + feeB) * 800 / 1000000
+		if rt.TotalAmount != amt+feeB+feeA {
+			t.Fatalf("expected total amount %v, got %v",
+				amt+feeB+feeA, rt.TotalAmount)
+		}
+
+		expectedTimeLock := uint32(startingBlockHeight +
+			finalCLTVDelta + 144 + 288)
+		if rt.TotalTimeLock != expectedTimeLock {
+			t.Fatalf("expected total time lock %v, got %v",
+				expectedTimeLock, rt.TotalTimeLock)
+		}
+
+		if rt.Hops[2].AmtToForward != amt {
+			t.Fatalf("expected final amount %v, got %v", amt,
+				rt.Hops[2].AmtToForward)
+		}
+	}
+
+	// Without an outgoing channel restriction, the local channel with the
+	// most bandwidth is used.
+	rt, err := ctx.router.BuildRoute(amt, hops, nil, finalCLTVDelta)
+	if err != nil {
+		t.Fatalf("unable to build route: %v", err)
+	}
+	checkRoute(rt, []uint64{2, 4, 5})
+
+	// With the restriction, the specified channel must be used instead.
+	outgoingChan := uint64(1)
+	rt, err = ctx.router.BuildRoute(
+		amt, hops, &outgoingChan, finalCLTVDelta,
+	)
+	if err != nil {
+		t.Fatalf("unable to build route: %v", err)
+	}
+	checkRoute(rt, []uint64{1, 4, 5})
+
+	// No route can be built if two consecutive hops share no channel.
+	_, err = ctx.router.BuildRoute(
+		amt, []route.Vertex{ctx.aliases["a"], ctx.aliases["c"]}, nil,
+		finalCLTVDelta,
+	)
+	if !IsError(err, ErrNoPathFound) {
+		t.Fatalf("expected no path found error, got %v", err)
+	}
+
+	// Neither can it if the amount exceeds the capacity of a channel.
+	_, err = ctx.router.BuildRoute(
+		lnwire.NewMSatFromSatoshis(150000), hops, &outgoingChan,
+		finalCLTVDelta,
+	)
+	if !IsError(err, ErrNoPathFound) {
+		t.Fatalf("expected no path found error, got %v", err)
+	}
+}
+
+// TestProbe asserts that probes report whether they reached the destination,
+// feed their failures into mission control and are able to find the largest
+// amount a route can carry.
+func TestProbe(t *testing.T) {
+	t.Parallel()
+
+	// Set up a test graph with two paths from roasbeef to c, the cheaper
+	// one through a and b, and a more expensive one through d.
+	policy := &testChannelPolicy{
+		Expiry:  144,
+		FeeRate: 400,
+		MinHTLC: 1,
+	}
+	expensivePolicy := &testChannelPolicy{
+		Expiry:  144,
+		FeeRate: 2000,
+		MinHTLC: 1,
+	}
+	testChannels := []*testChannel{
+		symmetricTestChannel("roasbeef", "a", 100000, policy, 1),
+		symmetricTestChannel("a", "b", 100000, policy, 2),
+		symmetricTestChannel("b", "c", 100000, policy, 3),
+		symmetricTestChannel("roasbeef", "d", 100000, expensivePolicy, 4),
+		symmetricTestChannel("d", "c", 100000, expensivePolicy, 5),
+	}
+
+	testGraph, err := createTestGraphFromChannels(testChannels)
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxFromGraphInstance(
+		startingBlockHeight, testGraph,
+	)
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+	defer cleanUp()
+
+	pubKey := func(alias string) *btcec.PublicKey {
+		vertex := ctx.aliases[alias]
+		key, err := btcec.ParsePubKey(vertex[:], btcec.S256())
+		if err != nil {
+			t.Fatalf("unable to parse pubkey: %v", err)
+		}
+		return key
+	}
+	aKey, bKey, cKey := pubKey("a"), pubKey("b"), pubKey("c")
+
+	// sendToSwitch mocks a network in which probes that leave over
+	// channel 1 fail at a, and all other probes reach c.
+	sendToSwitch := func(firstHop lnwire.ShortChannelID,
+		htlcAdd *lnwire.UpdateAddHTLC,
+		_ *sphinx.Circuit) ([32]byte, error) {
+
+		if firstHop.ToUint64() == 1 {
+			return [32]byte{}, &htlcswitch.ForwardingError{
+				ErrorSource:    aKey,
+				FailureMessage: &lnwire.FailTemporaryChannelFailure{},
+			}
+		}
+
+		return [32]byte{}, &htlcswitch.ForwardingError{
+			ErrorSource: cKey,
+			FailureMessage: lnwire.NewFailUnknownPaymentHash(
+				htlcAdd.Amount,
+			),
+		}
+	}
+	ctx.router.cfg.SendProbeToSwitch = sendToSwitch
+
+	// A payment probe should fall back to the path through d after the
+	// probe over the cheaper path failed.
+	amt := lnwire.NewMSatFromSatoshis(10000)
+	result, err := ctx.router.ProbePayment(&LightningPayment{
+		Target:   ctx.aliases["c"],
+		Amount:   amt,
+		FeeLimit: noFeeLimit,
+	})
+	if err != nil {
+		t.Fatalf("unable to probe payment: %v", err)
+	}
+	if !result.Success || result.Route.Hops[0].ChannelID != 4 {
+		t.Fatalf("expected successful probe through d, got %v",
+			spew.Sdump(result))
+	}
+
+	// The failure of the first probe must have been reported to mission
+	// control.
+	aVertex, bVertex := ctx.aliases["a"], ctx.aliases["b"]
+	failedEdge := newEdgeLocatorByPubkeys(2, &aVertex, &bVertex)
+	if _, ok := ctx.router.missionControl.failedEdges[*failedEdge]; !ok {
+		t.Fatalf("expected edge %v to be reported to mission control",
+			failedEdge)
+	}
+
+	// A route probe over the path through b that fails at b must report b
+	// as the failure source.
+	hops := []route.Vertex{
+		ctx.aliases["a"], ctx.aliases["b"], ctx.aliases["c"],
+	}
+	rt, err := ctx.router.BuildRoute(amt, hops, nil, 40)
+	if err != nil {
+		t.Fatalf("unable to build route: %v", err)
+	}
+
+	ctx.router.cfg.SendProbeToSwitch = func(_ lnwire.ShortChannelID,
+		_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit) ([32]byte, error) {
+
+		return [32]byte{}, &htlcswitch.ForwardingError{
+			ErrorSource:    bKey,
+			FailureMessage: &lnwire.FailTemporaryChannelFailure{},
+		}
+	}
+	result, err = ctx.router.ProbeRoute(rt)
+	if err != nil {
+		t.Fatalf("unable to probe route: %v", err)
+	}
+	if result.Success || result.FailureSourceIndex != 2 ||
+		result.FailureCode != lnwire.CodeTemporaryChannelFailure {
+
+		t.Fatalf("expected probe to fail at b, got %v",
+			spew.Sdump(result))
+	}
+
+	// With a route over which only part of the amount can be sent, the
+	// binary search should find the largest amount within the resolution.
+	// Failures of the search aren't reported to mission control.
+	maxAmt := lnwire.NewMSatFromSatoshis(6000)
+	ctx.router.cfg.SendProbeToSwitch = func(firstHop lnwire.ShortChannelID,
+		htlcAdd *lnwire.UpdateAddHTLC,
+		circuit *sphinx.Circuit) ([32]byte, error) {
+
+		if htlcAdd.Amount > maxAmt {
+			return [32]byte{}, &htlcswitch.ForwardingError{
+				ErrorSource:    aKey,
+				FailureMessage: &lnwire.FailTemporaryChannelFailure{},
+			}
+		}
+
+		return sendToSwitch(
+			lnwire.ShortChannelID{}, htlcAdd, circuit,
+		)
+	}
+
+	numFailedEdges := len(ctx.router.missionControl.failedEdges)
+	result, err = ctx.router.ProbeMaxAmount(rt)
+	if err != nil {
+		t.Fatalf("unable to probe max amount: %v", err)
+	}
+	if !result.Success {
+		t.Fatalf("expected successful probe, got %v",
+			spew.Sdump(result))
+	}
+	if result.Route.TotalAmount > maxAmt ||
+		maxAmt-result.Route.TotalAmount > 2*probeAmtResolution {
+
+		t.Fatalf("expected amount close to %v, got %v", maxAmt,
+			result.Route.TotalAmount)
+	}
+	if len(ctx.router.missionControl.failedEdges) != numFailedEdges {
+		t.Fatalf("expected binary search failures not to be reported")
+	}
+
+	// A failure that isn't caused by the amount should end the search
+	// right away, as no other amount is going to succeed.
+	var numProbes int
+	ctx.router.cfg.SendProbeToSwitch = func(_ lnwire.ShortChannelID,
+		htlcAdd *lnwire.UpdateAddHTLC,
+		_ *sphinx.Circuit) ([32]byte, error) {
+
+		numProbes++
+		if htlcAdd.Amount > maxAmt {
+			return [32]byte{}, &htlcswitch.ForwardingError{
+				ErrorSource:    aKey,
+				FailureMessage: &lnwire.FailTemporaryChannelFailure{},
+			}
+		}
+
+		return [32]byte{}, &htlcswitch.ForwardingError{
+			ErrorSource:    aKey,
+			FailureMessage: &lnwire.FailUnknownNextPeer{},
+		}
+	}
+	result, err = ctx.router.ProbeMaxAmount(rt)
+	if err != nil {
+		t.Fatalf("unable to probe max amount: %v", err)
+	}
+	if result.Success || result.FailureCode != lnwire.CodeUnknownNextPeer {
+		t.Fatalf("expected probe to fail with unknown next peer, "+
+			"got %v", spew.Sdump(result))
+	}
+	if numProbes != 2 {
+		t.Fatalf("expected search to stop after 2 probes, got %v",
+			numProbes)
+	}
+
+	// If an error occurs during the search, the best result found so far
+	// should be returned along with it.
+	numProbes = 0
+	ctx.router.cfg.SendProbeToSwitch = func(firstHop lnwire.ShortChannelID,
+		htlcAdd *lnwire.UpdateAddHTLC,
+		circuit *sphinx.Circuit) ([32]byte, error) {
+
+		numProbes++
+		switch numProbes {
+		case 1:
+			return [32]byte{}, &htlcswitch.ForwardingError{
+				ErrorSource:    aKey,
+				FailureMessage: &lnwire.FailTemporaryChannelFailure{},
+			}
+
+		case 2:
+			return sendToSwitch(
+				lnwire.ShortChannelID{}, htlcAdd, circuit,
+			)
+
+		default:
+			return [32]byte{}, errors.New("switch unavailable")
+		}
+	}
+	result, err = ctx.router.ProbeMaxAmount(rt)
+	if err == nil {
+		t.Fatalf("expected probe to fail")
+	}
+	if result == nil || !result.Success {
+		t.Fatalf("expected best result along with error, got %v",
+			spew.Sdump(result))
+	}
+}
+
 // TestChannelUpdateValidation tests that a failed payment with an associated
 // channel update will only be applied to the graph when the update contains a
 // valid signature.
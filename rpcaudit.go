@@ -0,0 +1,456 @@
+package lnd
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/macaroons"
+	"github.com/lightningnetwork/lnd/zpay32"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	grpcpeer "google.golang.org/grpc/peer"
+)
+
+// rpcAuditor records every RPC call made to the daemon in the audit log of
+// the channel database. For calls that move funds, the amount and destination
+// are recorded as well, such that it can later be shown who moved funds and
+// when.
+type rpcAuditor struct {
+	log *channeldb.AuditLog
+
+	// exclude is the set of methods that aren't recorded, keyed by either
+	// their full or their short name.
+	exclude map[string]struct{}
+}
+
+// newRPCAuditor creates a new auditor that records RPC calls in the given
+// audit log. Calls of the excluded methods, given by either their full name
+// or the part after the service name, aren't recorded.
+func newRPCAuditor(log *channeldb.AuditLog, exclude []string) *rpcAuditor {
+	a := &rpcAuditor{
+		log:     log,
+		exclude: make(map[string]struct{}, len(exclude)),
+	}
+	for _, method := range exclude {
+		a.exclude[method] = struct{}{}
+	}
+
+	return a
+}
+
+// excluded returns true if calls of the given method shouldn't be recorded.
+func (a *rpcAuditor) excluded(method string) bool {
+	if _, ok := a.exclude[method]; ok {
+		return true
+	}
+
+	shortName := method[strings.LastIndex(method, "/")+1:]
+	_, ok := a.exclude[shortName]
+	return ok
+}
+
+// auditValue is the amount and destination of the funds moved by a request.
+type auditValue struct {
+	amt  lnwire.MilliSatoshi
+	dest string
+
+	// hash is the payment hash of a payment request, if known. It isn't
+	// recorded, but used to match the request to its response on a
+	// stream.
+	hash []byte
+}
+
+// record adds an audit event for a call of the given method to the audit log.
+// A failure to write the event is logged, but doesn't fail the call.
+func (a *rpcAuditor) record(ctx context.Context, method string,
+	start time.Time, value *auditValue, callErr error) {
+
+	event := &channeldb.AuditEvent{
+		Timestamp: start,
+		Method:    method,
+	}
+
+	// The macaroon has already been validated by the time the call
+	// completes, unless the call was rejected because of it. Either way,
+	// we'll record which macaroon was presented.
+	if mac, err := macaroons.MacaroonFromContext(ctx); err == nil {
+		event.MacaroonID = mac.Id()
+	}
+
+	if p, ok := grpcpeer.FromContext(ctx); ok && p.Addr != nil {
+		event.RemoteAddr = p.Addr.String()
+	}
+
+	if callErr != nil {
+		event.Error = callErr.Error()
+	}
+
+	if value != nil {
+		event.Amount = value.amt
+		event.Destination = value.dest
+	}
+
+	if err := a.log.AddAuditEvent(event); err != nil {
+		rpcsLog.Errorf("Unable to record audit event for %v: %v",
+			method, err)
+	}
+}
+
+// UnaryServerInterceptor is a GRPC interceptor that records every unary call
+// in the audit log once it has completed.
+func (a *rpcAuditor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		if a.excluded(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		value := extractAuditValue(req)
+		a.record(ctx, info.FullMethod, start, value, err)
+
+		return resp, err
+	}
+}
+
+// errAuditNoResponse is recorded as the outcome of a request received over a
+// stream that was closed before a response to it was sent.
+var errAuditNoResponse = errors.New("stream closed before a response was " +
+	"sent")
+
+// StreamServerInterceptor is a GRPC interceptor that records every request
+// received over a stream in the audit log. As a stream can carry several
+// requests, each of them is recorded as soon as the response to it is sent,
+// with the time it was received and its own outcome. Requests that are still
+// outstanding once the stream is closed are recorded with the error the
+// stream was closed with.
+func (a *rpcAuditor) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream,
+		info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+		if a.excluded(info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		stream := &auditServerStream{
+			ServerStream: ss,
+			auditor:      a,
+			method:       info.FullMethod,
+		}
+		err := handler(srv, stream)
+
+		outcome := err
+		if outcome == nil {
+			outcome = errAuditNoResponse
+		}
+		for _, req := range stream.popAll() {
+			stream.recordRequest(req, outcome)
+		}
+
+		return err
+	}
+}
+
+// auditRequest is a request received over a stream that hasn't been recorded
+// in the audit log yet.
+type auditRequest struct {
+	received time.Time
+	value    *auditValue
+}
+
+// auditServerStream wraps a grpc.ServerStream in order to record every
+// request received over it once the response to it is sent.
+type auditServerStream struct {
+	grpc.ServerStream
+
+	auditor *rpcAuditor
+	method  string
+
+	mu      sync.Mutex
+	pending []*auditRequest
+}
+
+// RecvMsg receives a request from the stream, and keeps track of it until a
+// response to it is sent.
+func (s *auditServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.pending = append(s.pending, &auditRequest{
+		received: time.Now(),
+		value:    extractAuditValue(m),
+	})
+	s.mu.Unlock()
+
+	return nil
+}
+
+// SendMsg sends a response over the stream, and records the request it
+// responds to along with its outcome.
+func (s *auditServerStream) SendMsg(m interface{}) error {
+	err := s.ServerStream.SendMsg(m)
+
+	// Payment responses carry the hash of the payment they belong to, as
+	// payments sent over the same stream may complete in any order. Any
+	// other response is matched to the oldest outstanding request.
+	var hash []byte
+	outcome := err
+	if resp, ok := m.(*lnrpc.SendResponse); ok {
+		hash = resp.PaymentHash
+		if outcome == nil && resp.PaymentError != "" {
+			outcome = errors.New(resp.PaymentError)
+		}
+	}
+
+	if req := s.pop(hash); req != nil {
+		s.recordRequest(req, outcome)
+	}
+
+	return err
+}
+
+// pop removes the outstanding request with the given payment hash, or the
+// oldest one if there is none, and returns it. Nil is returned if there are
+// no outstanding requests.
+func (s *auditServerStream) pop(hash []byte) *auditRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.pending) == 0 {
+		return nil
+	}
+
+	i := 0
+	if len(hash) != 0 {
+		for j, req := range s.pending {
+			if req.value == nil {
+				continue
+			}
+			if bytes.Equal(req.value.hash, hash) {
+				i = j
+				break
+			}
+		}
+	}
+
+	req := s.pending[i]
+	s.pending = append(s.pending[:i], s.pending[i+1:]...)
+
+	return req
+}
+
+// popAll removes all outstanding requests and returns them.
+func (s *auditServerStream) popAll() []*auditRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pending := s.pending
+	s.pending = nil
+
+	return pending
+}
+
+// recordRequest records the given request received over the stream in the
+// audit log.
+func (s *auditServerStream) recordRequest(req *auditRequest, outcome error) {
+	s.auditor.record(
+		s.Context(), s.method, req.received, req.value, outcome,
+	)
+}
+
+// extractAuditValue returns the amount and destination of the funds moved by
+// the given request, or nil if it isn't a request that moves funds. Requests
+// are recorded as they were made, so a payment request that can't be decoded
+// is recorded without an amount or destination.
+func extractAuditValue(req interface{}) *auditValue {
+	switch r := req.(type) {
+	case *lnrpc.SendRequest:
+		value := &auditValue{
+			amt: lnwire.NewMSatFromSatoshis(btcutil.Amount(r.Amt)),
+		}
+		switch {
+		case r.DestString != "":
+			value.dest = r.DestString
+		case len(r.Dest) > 0:
+			value.dest = hex.EncodeToString(r.Dest)
+		}
+
+		value.hash = auditPaymentHash(
+			r.PaymentHash, r.PaymentHashString,
+		)
+
+		if r.PaymentRequest != "" {
+			decodePayReqAuditValue(r.PaymentRequest, value)
+		}
+		return value
+
+	case *lnrpc.SendToRouteRequest:
+		value := &auditValue{
+			hash: auditPaymentHash(
+				r.PaymentHash, r.PaymentHashString,
+			),
+		}
+
+		route := r.Route
+		if route == nil && len(r.Routes) > 0 {
+			route = r.Routes[0]
+		}
+		if route == nil {
+			return value
+		}
+
+		value.amt = lnwire.MilliSatoshi(route.TotalAmtMsat)
+		if len(route.Hops) > 0 {
+			value.dest = route.Hops[len(route.Hops)-1].PubKey
+		}
+		return value
+
+	case *lnrpc.SendCoinsRequest:
+		return &auditValue{
+			amt: lnwire.NewMSatFromSatoshis(
+				btcutil.Amount(r.Amount),
+			),
+			dest: r.Addr,
+		}
+
+	case *lnrpc.SendManyRequest:
+		var (
+			value auditValue
+			addrs []string
+		)
+		for addr, amt := range r.AddrToAmount {
+			value.amt += lnwire.NewMSatFromSatoshis(
+				btcutil.Amount(amt),
+			)
+			addrs = append(addrs, addr)
+		}
+		sort.Strings(addrs)
+		value.dest = strings.Join(addrs, ",")
+		return &value
+
+	case *lnrpc.OpenChannelRequest:
+		value := &auditValue{
+			amt: lnwire.NewMSatFromSatoshis(
+				btcutil.Amount(r.LocalFundingAmount),
+			),
+		}
+		switch {
+		case r.NodePubkeyString != "":
+			value.dest = r.NodePubkeyString
+		case len(r.NodePubkey) > 0:
+			value.dest = hex.EncodeToString(r.NodePubkey)
+		}
+		return value
+
+	case *lnrpc.CloseChannelRequest:
+		value := &auditValue{}
+		if r.ChannelPoint == nil {
+			return value
+		}
+
+		txid, err := getChanPointFundingTxid(r.ChannelPoint)
+		if err == nil {
+			value.dest = fmt.Sprintf("%v:%d", txid,
+				r.ChannelPoint.OutputIndex)
+		}
+		return value
+
+	case *routerrpc.PaymentRequest:
+		value := &auditValue{}
+		decodePayReqAuditValue(r.PayReq, value)
+		return value
+
+	case *routerrpc.RebalanceRequest:
+		return &auditValue{
+			amt: lnwire.NewMSatFromSatoshis(
+				btcutil.Amount(r.AmtSat),
+			),
+			dest: lnwire.NewShortChanIDFromInt(
+				r.IncomingChanId,
+			).String(),
+		}
+
+	case *walletrpc.SendOutputsRequest:
+		var (
+			value auditValue
+			dests []string
+		)
+		for _, output := range r.Outputs {
+			value.amt += lnwire.NewMSatFromSatoshis(
+				btcutil.Amount(output.Value),
+			)
+			dests = append(dests, auditPkScript(output.PkScript))
+		}
+		value.dest = strings.Join(dests, ",")
+		return &value
+	}
+
+	return nil
+}
+
+// auditPkScript returns the address the given output script pays to, or the
+// hex encoded script itself if it doesn't pay to a single known address.
+func auditPkScript(pkScript []byte) string {
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+		pkScript, activeNetParams.Params,
+	)
+	if err != nil || len(addrs) != 1 {
+		return hex.EncodeToString(pkScript)
+	}
+
+	return addrs[0].String()
+}
+
+// auditPaymentHash returns the payment hash of a request that carries it in
+// either raw or hex encoded form, or nil if it has none.
+func auditPaymentHash(hash []byte, hashStr string) []byte {
+	if len(hash) != 0 || hashStr == "" {
+		return hash
+	}
+
+	hash, err := hex.DecodeString(hashStr)
+	if err != nil {
+		return nil
+	}
+
+	return hash
+}
+
+// decodePayReqAuditValue fills in the destination and payment hash of the
+// given payment request, as well as its amount if none has been set yet.
+func decodePayReqAuditValue(payReqStr string, value *auditValue) {
+	payReq, err := zpay32.Decode(payReqStr, activeNetParams.Params)
+	if err != nil {
+		return
+	}
+
+	if payReq.Destination != nil {
+		value.dest = hex.EncodeToString(
+			payReq.Destination.SerializeCompressed(),
+		)
+	}
+	if payReq.PaymentHash != nil {
+		value.hash = payReq.PaymentHash[:]
+	}
+	if value.amt == 0 && payReq.MilliSat != nil {
+		value.amt = *payReq.MilliSat
+	}
+}
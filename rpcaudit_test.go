@@ -0,0 +1,316 @@
+// +build !rpctest
+
+package lnd
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/signrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	grpcpeer "google.golang.org/grpc/peer"
+)
+
+// TestRPCAuditorUnary tests that unary calls are recorded in the audit log,
+// along with their outcome and the funds they moved.
+func TestRPCAuditorUnary(t *testing.T) {
+	t.Parallel()
+
+	tempDir, err := ioutil.TempDir("", "rpcaudit")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	db, err := channeldb.Open(tempDir)
+	if err != nil {
+		t.Fatalf("unable to open db: %v", err)
+	}
+	defer db.Close()
+
+	auditor := newRPCAuditor(db.AuditLog(), []string{"ListChannels"})
+	interceptor := auditor.UnaryServerInterceptor()
+
+	remoteAddr := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1234}
+	ctx := grpcpeer.NewContext(
+		context.Background(), &grpcpeer.Peer{Addr: remoteAddr},
+	)
+
+	// We'll make a call that moves funds and succeeds, followed by one
+	// that doesn't move funds and fails. The last call is of an excluded
+	// method, so it shouldn't be recorded.
+	calls := []struct {
+		method string
+		req    interface{}
+		err    error
+	}{
+		{
+			method: "/lnrpc.Lightning/SendCoins",
+			req: &lnrpc.SendCoinsRequest{
+				Addr:   "addr",
+				Amount: 1000,
+			},
+		},
+		{
+			method: "/lnrpc.Lightning/GetInfo",
+			req:    &lnrpc.GetInfoRequest{},
+			err:    errors.New("permission denied"),
+		},
+		{
+			method: "/lnrpc.Lightning/ListChannels",
+			req:    &lnrpc.ListChannelsRequest{},
+		},
+	}
+
+	start := time.Now()
+	for _, call := range calls {
+		info := &grpc.UnaryServerInfo{FullMethod: call.method}
+		handler := func(ctx context.Context,
+			req interface{}) (interface{}, error) {
+
+			return nil, call.err
+		}
+
+		_, err := interceptor(ctx, call.req, info, handler)
+		if err != call.err {
+			t.Fatalf("expected error %v, got %v", call.err, err)
+		}
+	}
+
+	timeSlice, err := db.AuditLog().Query(channeldb.AuditEventQuery{
+		StartTime:    start.Add(-time.Second),
+		EndTime:      time.Now().Add(time.Second),
+		NumMaxEvents: 100,
+	})
+	if err != nil {
+		t.Fatalf("unable to query audit log: %v", err)
+	}
+	if len(timeSlice.AuditEvents) != len(calls)-1 {
+		t.Fatalf("expected %v events, got %v", len(calls)-1,
+			len(timeSlice.AuditEvents))
+	}
+
+	sendCoins := timeSlice.AuditEvents[0]
+	if sendCoins.Method != calls[0].method {
+		t.Fatalf("expected method %v, got %v", calls[0].method,
+			sendCoins.Method)
+	}
+	if sendCoins.Error != "" {
+		t.Fatalf("expected success, got error %v", sendCoins.Error)
+	}
+	if sendCoins.Amount != lnwire.NewMSatFromSatoshis(1000) {
+		t.Fatalf("expected amount of 1000 sat, got %v",
+			sendCoins.Amount)
+	}
+	if sendCoins.Destination != "addr" {
+		t.Fatalf("expected destination addr, got %v",
+			sendCoins.Destination)
+	}
+	if sendCoins.RemoteAddr != remoteAddr.String() {
+		t.Fatalf("expected remote addr %v, got %v", remoteAddr,
+			sendCoins.RemoteAddr)
+	}
+
+	getInfo := timeSlice.AuditEvents[1]
+	if getInfo.Error != calls[1].err.Error() {
+		t.Fatalf("expected error %v, got %v", calls[1].err,
+			getInfo.Error)
+	}
+	if getInfo.Amount != 0 || getInfo.Destination != "" {
+		t.Fatalf("expected no funds to be moved, got %v to %v",
+			getInfo.Amount, getInfo.Destination)
+	}
+}
+
+// mockAuditStream is a grpc.ServerStream that hands out a fixed set of
+// requests and collects the responses sent over it.
+type mockAuditStream struct {
+	grpc.ServerStream
+
+	ctx       context.Context
+	requests  []*lnrpc.SendRequest
+	responses []interface{}
+}
+
+func (m *mockAuditStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockAuditStream) RecvMsg(msg interface{}) error {
+	if len(m.requests) == 0 {
+		return io.EOF
+	}
+
+	*msg.(*lnrpc.SendRequest) = *m.requests[0]
+	m.requests = m.requests[1:]
+
+	return nil
+}
+
+func (m *mockAuditStream) SendMsg(msg interface{}) error {
+	m.responses = append(m.responses, msg)
+	return nil
+}
+
+// TestRPCAuditorStream tests that every request received over a stream is
+// recorded along with its own outcome as soon as it's responded to, even if
+// the responses are sent out of order.
+func TestRPCAuditorStream(t *testing.T) {
+	t.Parallel()
+
+	tempDir, err := ioutil.TempDir("", "rpcaudit")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	db, err := channeldb.Open(tempDir)
+	if err != nil {
+		t.Fatalf("unable to open db: %v", err)
+	}
+	defer db.Close()
+
+	auditLog := db.AuditLog()
+	interceptor := newRPCAuditor(auditLog, nil).StreamServerInterceptor()
+
+	hashes := [][]byte{
+		bytes.Repeat([]byte{1}, 32),
+		bytes.Repeat([]byte{2}, 32),
+		bytes.Repeat([]byte{3}, 32),
+	}
+	stream := &mockAuditStream{
+		ctx: context.Background(),
+	}
+	for i, hash := range hashes {
+		stream.requests = append(stream.requests, &lnrpc.SendRequest{
+			Amt:         int64(i+1) * 1000,
+			PaymentHash: hash,
+		})
+	}
+
+	queryAll := func() []channeldb.AuditEvent {
+		timeSlice, err := auditLog.Query(channeldb.AuditEventQuery{
+			StartTime:    time.Unix(0, 0),
+			EndTime:      time.Now().Add(time.Second),
+			NumMaxEvents: 100,
+		})
+		if err != nil {
+			t.Fatalf("unable to query audit log: %v", err)
+		}
+		return timeSlice.AuditEvents
+	}
+
+	// The handler receives all payments, and responds to the second one
+	// with a failure before the first one succeeds. The third payment is
+	// still outstanding when the stream is closed.
+	method := "/lnrpc.Lightning/SendPayment"
+	info := &grpc.StreamServerInfo{FullMethod: method}
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		for range hashes {
+			if err := ss.RecvMsg(&lnrpc.SendRequest{}); err != nil {
+				return err
+			}
+		}
+
+		err := ss.SendMsg(&lnrpc.SendResponse{
+			PaymentError: "no route",
+			PaymentHash:  hashes[1],
+		})
+		if err != nil {
+			return err
+		}
+
+		// The failed payment should be recorded right away, rather
+		// than once the stream is closed.
+		events := queryAll()
+		if len(events) != 1 || events[0].Error != "no route" {
+			return fmt.Errorf("expected failed payment to be "+
+				"recorded, got %v", spew.Sdump(events))
+		}
+
+		return ss.SendMsg(&lnrpc.SendResponse{
+			PaymentHash: hashes[0],
+		})
+	}
+	if err := interceptor(nil, stream, info, handler); err != nil {
+		t.Fatalf("unable to handle stream: %v", err)
+	}
+
+	events := queryAll()
+	if len(events) != len(hashes) {
+		t.Fatalf("expected %v events, got %v", len(hashes),
+			spew.Sdump(events))
+	}
+
+	// The events are ordered by the time their requests were received.
+	expectedErrors := []string{"", "no route", errAuditNoResponse.Error()}
+	for i, event := range events {
+		if event.Method != method {
+			t.Fatalf("expected method %v, got %v", method,
+				event.Method)
+		}
+		if event.Error != expectedErrors[i] {
+			t.Fatalf("expected error %q for payment %d, got %q",
+				expectedErrors[i], i, event.Error)
+		}
+		amt := lnwire.NewMSatFromSatoshis(btcutil.Amount(i+1) * 1000)
+		if event.Amount != amt {
+			t.Fatalf("expected amount %v for payment %d, got %v",
+				amt, i, event.Amount)
+		}
+	}
+}
+
+// TestExtractAuditValueSendOutputs tests that the outputs of a walletkit
+// SendOutputs request are summed up, and that their scripts are recorded as
+// addresses where possible.
+func TestExtractAuditValueSendOutputs(t *testing.T) {
+	t.Parallel()
+
+	addr, err := btcutil.NewAddressPubKeyHash(
+		make([]byte, 20), activeNetParams.Params,
+	)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatalf("unable to create script: %v", err)
+	}
+	nullData := []byte{txscript.OP_RETURN}
+
+	value := extractAuditValue(&walletrpc.SendOutputsRequest{
+		Outputs: []*signrpc.TxOut{
+			{Value: 1000, PkScript: pkScript},
+			{Value: 500, PkScript: nullData},
+		},
+	})
+	if value == nil {
+		t.Fatalf("expected audit value for SendOutputs")
+	}
+	if value.amt != lnwire.NewMSatFromSatoshis(1500) {
+		t.Fatalf("expected amount of 1500 sat, got %v", value.amt)
+	}
+
+	expectedDest := addr.String() + "," + hex.EncodeToString(nullData)
+	if value.dest != expectedDest {
+		t.Fatalf("expected destination %v, got %v", expectedDest,
+			value.dest)
+	}
+}
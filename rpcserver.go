@@ -1,6 +1,7 @@
 package lnd
 
 import (
+	"bufio"
 	"bytes"
 	"crypto/sha256"
 	"crypto/tls"
@@ -108,6 +109,10 @@ var (
 			Entity: "invoices",
 			Action: "read",
 		},
+		{
+			Entity: "macaroon",
+			Action: "read",
+		},
 	}
 
 	// writePermissions is a slice of all entities that allow write
@@ -145,8 +150,27 @@ var (
 			Entity: "signer",
 			Action: "generate",
 		},
+		{
+			Entity: "macaroon",
+			Action: "generate",
+		},
+		{
+			Entity: "macaroon",
+			Action: "write",
+		},
+	}
+
+	// validEntities is the set of entities that permissions of baked
+	// macaroons may be granted for.
+	validEntities = []string{
+		"onchain", "offchain", "address", "message", "peers", "info",
+		"invoices", "signer", "macaroon",
 	}
 
+	// validActions is the set of actions that permissions of baked
+	// macaroons may grant.
+	validActions = []string{"read", "write", "generate"}
+
 	// invoicePermissions is a slice of all the entities that allows a user
 	// to only access calls that are related to invoices, so: streaming
 	// RPCs, generating, and listening invoices.
@@ -236,6 +260,14 @@ var (
 			Entity: "peers",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/SendCustomMessage": {{
+			Entity: "peers",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/SubscribeCustomMessages": {{
+			Entity: "peers",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/WalletBalance": {{
 			Entity: "onchain",
 			Action: "read",
@@ -324,6 +356,22 @@ var (
 			Entity: "info",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/UpdateGraphBanList": {{
+			Entity: "info",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/ListGraphBanList": {{
+			Entity: "info",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/ExportGraphSnapshot": {{
+			Entity: "info",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/ImportGraphSnapshot": {{
+			Entity: "info",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/StopDaemon": {{
 			Entity: "info",
 			Action: "write",
@@ -352,6 +400,10 @@ var (
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/FeeControllerDecisions": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/UpdateChannelPolicy": {{
 			Entity: "offchain",
 			Action: "write",
@@ -360,6 +412,26 @@ var (
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/HtlcQuotas": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/BakeMacaroon": {{
+			Entity: "macaroon",
+			Action: "generate",
+		}},
+		"/lnrpc.Lightning/ListMacaroonIDs": {{
+			Entity: "macaroon",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/DeleteMacaroonID": {{
+			Entity: "macaroon",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/AuditLog": {{
+			Entity: "macaroon",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/RestoreChannelBackups": {{
 			Entity: "offchain",
 			Action: "write",
@@ -425,6 +497,10 @@ type rpcServer struct {
 	// rpc sub server.
 	routerBackend *routerrpc.RouterBackend
 
+	// macService is the macaroon service that we need to mint new
+	// macaroons. It's nil if macaroons are disabled.
+	macService *macaroons.Service
+
 	quit chan struct{}
 }
 
@@ -432,6 +508,53 @@ type rpcServer struct {
 // LightningServer gRPC service.
 var _ lnrpc.LightningServer = (*rpcServer)(nil)
 
+// chainUnaryServer combines the passed unary interceptors into a single one,
+// as the gRPC server only accepts a single interceptor. The interceptors are
+// executed in the order they're passed in.
+func chainUnaryServer(
+	chain ...grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+
+	return func(ctx context.Context, req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		chained := handler
+		for i := len(chain) - 1; i >= 0; i-- {
+			interceptor, next := chain[i], chained
+			chained = func(ctx context.Context,
+				req interface{}) (interface{}, error) {
+
+				return interceptor(ctx, req, info, next)
+			}
+		}
+
+		return chained(ctx, req)
+	}
+}
+
+// chainStreamServer combines the passed stream interceptors into a single
+// one, as the gRPC server only accepts a single interceptor. The interceptors
+// are executed in the order they're passed in.
+func chainStreamServer(
+	chain ...grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+
+	return func(srv interface{}, ss grpc.ServerStream,
+		info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+		chained := handler
+		for i := len(chain) - 1; i >= 0; i-- {
+			interceptor, next := chain[i], chained
+			chained = func(srv interface{},
+				ss grpc.ServerStream) error {
+
+				return interceptor(srv, ss, info, next)
+			}
+		}
+
+		return chained(srv, ss)
+	}
+}
+
 // newRPCServer creates and returns a new instance of the rpcServer. The
 // rpcServer will handle creating all listening sockets needed by it, and any
 // of the sub-servers that it maintains. The set of serverOpts should be the
@@ -462,7 +585,18 @@ func newRPCServer(s *server, macService *macaroons.Service,
 			}
 			return info.Capacity, nil
 		},
-		FindRoutes: s.chanRouter.FindRoutes,
+		FetchChannelEndpoints: func(chanID uint64) (route.Vertex,
+			route.Vertex, error) {
+
+			info, _, _, err := graph.FetchChannelEdgesByID(chanID)
+			if err != nil {
+				return route.Vertex{}, route.Vertex{}, err
+			}
+
+			return info.NodeKey1Bytes, info.NodeKey2Bytes, nil
+		},
+		FindRoutes:   s.chanRouter.FindRoutes,
+		ReserveSpend: newSpendReserver(macService),
 	}
 
 	var (
@@ -476,7 +610,7 @@ func newRPCServer(s *server, macService *macaroons.Service,
 	err = subServerCgs.PopulateDependencies(
 		s.cc, networkDir, macService, atpl, invoiceRegistry,
 		s.htlcSwitch, activeNetParams.Params, s.chanRouter,
-		routerBackend, s.nodeSigner, s.chanDB,
+		routerBackend, s.nodeSigner, s.chanDB, s.htlcNotifier,
 	)
 	if err != nil {
 		return nil, err
@@ -515,19 +649,50 @@ func newRPCServer(s *server, macService *macaroons.Service,
 		}
 	}
 
+	var (
+		unaryInterceptors  []grpc.UnaryServerInterceptor
+		streamInterceptors []grpc.StreamServerInterceptor
+	)
+
+	// If the audit log is enabled, every call is recorded in it. This
+	// interceptor comes first, such that calls rejected during macaroon
+	// authentication are recorded as well.
+	if cfg.AuditLog {
+		auditLog := s.chanDB.AuditLog(
+			channeldb.AuditLogMaxEntries(cfg.AuditLogMaxEntries),
+			channeldb.AuditLogMaxAge(cfg.AuditLogMaxAge),
+		)
+		auditor := newRPCAuditor(auditLog, cfg.AuditLogExclude)
+		unaryInterceptors = append(
+			unaryInterceptors, auditor.UnaryServerInterceptor(),
+		)
+		streamInterceptors = append(
+			streamInterceptors, auditor.StreamServerInterceptor(),
+		)
+	}
+
 	// If macaroons aren't disabled (a non-nil service), then we'll set up
 	// our set of interceptors which will allow us handle the macaroon
 	// authentication in a single location .
 	if macService != nil {
-		unaryInterceptor := grpc.UnaryInterceptor(
+		unaryInterceptors = append(
+			unaryInterceptors,
 			macService.UnaryServerInterceptor(permissions),
 		)
-		streamInterceptor := grpc.StreamInterceptor(
+		streamInterceptors = append(
+			streamInterceptors,
 			macService.StreamServerInterceptor(permissions),
 		)
+	}
 
+	if len(unaryInterceptors) > 0 {
 		serverOpts = append(serverOpts,
-			unaryInterceptor, streamInterceptor,
+			grpc.UnaryInterceptor(
+				chainUnaryServer(unaryInterceptors...),
+			),
+			grpc.StreamInterceptor(
+				chainStreamServer(streamInterceptors...),
+			),
 		)
 	}
 
@@ -542,6 +707,7 @@ func newRPCServer(s *server, macService *macaroons.Service,
 		grpcServer:    grpcServer,
 		server:        s,
 		routerBackend: routerBackend,
+		macService:    macService,
 		quit:          make(chan struct{}, 1),
 	}
 	lnrpc.RegisterLightningServer(grpcServer, rootRPCServer)
@@ -883,6 +1049,10 @@ func (r *rpcServer) EstimateFee(ctx context.Context,
 func (r *rpcServer) SendCoins(ctx context.Context,
 	in *lnrpc.SendCoinsRequest) (*lnrpc.SendCoinsResponse, error) {
 
+	if err := r.rejectUntrackedSpend(ctx); err != nil {
+		return nil, err
+	}
+
 	// Based on the passed fee related parameters, we'll determine an
 	// appropriate fee rate for this transaction.
 	satPerKw := lnwallet.SatPerKVByte(in.SatPerByte * 1000).FeePerKWeight()
@@ -1005,6 +1175,10 @@ func (r *rpcServer) SendCoins(ctx context.Context,
 func (r *rpcServer) SendMany(ctx context.Context,
 	in *lnrpc.SendManyRequest) (*lnrpc.SendManyResponse, error) {
 
+	if err := r.rejectUntrackedSpend(ctx); err != nil {
+		return nil, err
+	}
+
 	// Based on the passed fee related parameters, we'll determine an
 	// appropriate fee rate for this transaction.
 	satPerKw := lnwallet.SatPerKVByte(in.SatPerByte * 1000).FeePerKWeight()
@@ -1315,6 +1489,11 @@ func extractOpenChannelMinConfs(in *lnrpc.OpenChannelRequest) (int32, error) {
 func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 	updateStream lnrpc.Lightning_OpenChannelServer) error {
 
+	err := r.rejectUntrackedSpend(updateStream.Context())
+	if err != nil {
+		return err
+	}
+
 	rpcsLog.Tracef("[openchannel] request to NodeKey(%v) "+
 		"allocation(us=%v, them=%v)", in.NodePubkeyString,
 		in.LocalFundingAmount, in.PushSat)
@@ -1472,6 +1651,10 @@ out:
 func (r *rpcServer) OpenChannelSync(ctx context.Context,
 	in *lnrpc.OpenChannelRequest) (*lnrpc.ChannelPoint, error) {
 
+	if err := r.rejectUntrackedSpend(ctx); err != nil {
+		return nil, err
+	}
+
 	rpcsLog.Tracef("[openchannel] request to NodeKey(%v) "+
 		"allocation(us=%v, them=%v)", in.NodePubkeyString,
 		in.LocalFundingAmount, in.PushSat)
@@ -1624,6 +1807,11 @@ func getChanPointFundingTxid(chanPoint *lnrpc.ChannelPoint) (*chainhash.Hash, er
 func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 	updateStream lnrpc.Lightning_CloseChannelServer) error {
 
+	err := r.rejectUntrackedSpend(updateStream.Context())
+	if err != nil {
+		return err
+	}
+
 	// If the user didn't specify a channel point, then we'll reject this
 	// request all together.
 	if in.GetChannelPoint() == nil {
@@ -1986,9 +2174,14 @@ func (r *rpcServer) GetInfo(ctx context.Context,
 		Testnet:             isTestnet(&activeNetParams),
 		Chains:              activeChains,
 		Uris:                uris,
+		RpcOnionUris:        r.server.RPCOnionURIs(),
 		Alias:               nodeAnn.Alias.String(),
 		BestHeaderTimestamp: int64(bestHeaderTimestamp),
 		Version:             build.Version(),
+		NumRateLimitedConns: r.server.connLimiter.NumRejected(),
+		NumEvictedPeers: atomic.LoadUint64(
+			&r.server.numEvictedPeers,
+		),
 	}, nil
 }
 
@@ -2048,16 +2241,58 @@ func (r *rpcServer) ListPeers(ctx context.Context,
 			}
 		}
 
+		// Break down the messages exchanged with the peer by their
+		// type.
+		msgsSent, msgsRecv := serverPeer.MsgStats()
+		rpcMsgsSent := make(map[string]uint64, len(msgsSent))
+		for msgType, count := range msgsSent {
+			rpcMsgsSent[msgType.String()] += count
+		}
+		rpcMsgsRecv := make(map[string]uint64, len(msgsRecv))
+		for msgType, count := range msgsRecv {
+			rpcMsgsRecv[msgType.String()] += count
+		}
+
+		var rpcErrs []*lnrpc.TimestampedError
+		if in.IncludeErrors {
+			rpcErrs = marshalPeerErrors(
+				r.server.PeerErrors(nodePub),
+			)
+		}
+
+		// Flap stats are only tracked for peers we have channels with,
+		// so it's expected that none are found for other peers.
+		var (
+			flapCount  int32
+			lastFlapNs int64
+		)
+		flapStats, err := r.server.chanDB.FetchPeerFlapStats(nodePub)
+		switch {
+		case err == nil:
+			flapCount = int32(flapStats.FlapCount)
+			if !flapStats.LastFlap.IsZero() {
+				lastFlapNs = flapStats.LastFlap.UnixNano()
+			}
+
+		case err != channeldb.ErrNoPeerFlapStats:
+			return nil, err
+		}
+
 		peer := &lnrpc.Peer{
-			PubKey:    hex.EncodeToString(nodePub[:]),
-			Address:   serverPeer.conn.RemoteAddr().String(),
-			Inbound:   serverPeer.inbound,
-			BytesRecv: atomic.LoadUint64(&serverPeer.bytesReceived),
-			BytesSent: atomic.LoadUint64(&serverPeer.bytesSent),
-			SatSent:   satSent,
-			SatRecv:   satRecv,
-			PingTime:  serverPeer.PingTime(),
-			SyncType:  lnrpcSyncType,
+			PubKey:     hex.EncodeToString(nodePub[:]),
+			Address:    serverPeer.conn.RemoteAddr().String(),
+			Inbound:    serverPeer.inbound,
+			BytesRecv:  atomic.LoadUint64(&serverPeer.bytesReceived),
+			BytesSent:  atomic.LoadUint64(&serverPeer.bytesSent),
+			SatSent:    satSent,
+			SatRecv:    satRecv,
+			PingTime:   serverPeer.PingTime(),
+			SyncType:   lnrpcSyncType,
+			MsgsSent:   rpcMsgsSent,
+			MsgsRecv:   rpcMsgsRecv,
+			Errors:     rpcErrs,
+			FlapCount:  flapCount,
+			LastFlapNs: lastFlapNs,
 		}
 
 		resp.Peers = append(resp.Peers, peer)
@@ -2068,6 +2303,96 @@ func (r *rpcServer) ListPeers(ctx context.Context,
 	return resp, nil
 }
 
+// marshalPeerErrors converts the errors exchanged with a peer into their RPC
+// representation.
+func marshalPeerErrors(peerErrs []*channeldb.PeerError) []*lnrpc.TimestampedError {
+	rpcErrs := make([]*lnrpc.TimestampedError, 0, len(peerErrs))
+	for _, peerErr := range peerErrs {
+		rpcErrs = append(rpcErrs, &lnrpc.TimestampedError{
+			Timestamp: uint64(peerErr.Timestamp.Unix()),
+			Error:     string(peerErr.Data),
+			ChannelId: peerErr.ChanID.String(),
+			Outgoing:  peerErr.Outgoing,
+		})
+	}
+
+	return rpcErrs
+}
+
+// SendCustomMessage sends a custom peer message to the target peer. The call
+// blocks until the message has been written to the wire.
+func (r *rpcServer) SendCustomMessage(ctx context.Context,
+	in *lnrpc.SendCustomMessageRequest) (*lnrpc.SendCustomMessageResponse,
+	error) {
+
+	if len(in.Peer) != 33 {
+		return nil, fmt.Errorf("peer must be a 33-byte compressed "+
+			"public key, got %v bytes", len(in.Peer))
+	}
+	if in.Type > math.MaxUint16 {
+		return nil, fmt.Errorf("message type %v exceeds the maximum "+
+			"of %v", in.Type, math.MaxUint16)
+	}
+
+	var peerPub [33]byte
+	copy(peerPub[:], in.Peer)
+
+	rpcsLog.Debugf("[sendcustom] peer=%x, type=%v, len=%v", peerPub[:],
+		in.Type, len(in.Data))
+
+	err := r.server.SendCustomMessage(
+		peerPub, lnwire.MessageType(in.Type), in.Data,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.SendCustomMessageResponse{}, nil
+}
+
+// SubscribeCustomMessages returns a uni-directional stream (server -> client)
+// for notifying the client of custom peer messages received from any of our
+// peers.
+func (r *rpcServer) SubscribeCustomMessages(
+	req *lnrpc.SubscribeCustomMessagesRequest,
+	updateStream lnrpc.Lightning_SubscribeCustomMessagesServer) error {
+
+	customMsgSub, err := r.server.SubscribeCustomMessages()
+	if err != nil {
+		return err
+	}
+
+	// Ensure that the resources for the client is cleaned up once either
+	// the server, or client exits.
+	defer customMsgSub.Cancel()
+
+	for {
+		select {
+		case e := <-customMsgSub.Updates():
+			msg, ok := e.(*CustomMessage)
+			if !ok {
+				return fmt.Errorf("unexpected custom message "+
+					"update: %v", e)
+			}
+
+			err := updateStream.Send(&lnrpc.CustomMessage{
+				Peer: msg.Peer[:],
+				Type: uint32(msg.Msg.Type),
+				Data: msg.Msg.Data,
+			})
+			if err != nil {
+				return err
+			}
+
+		case <-updateStream.Context().Done():
+			return updateStream.Context().Err()
+
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
 // WalletBalance returns total unspent outputs(confirmed and unconfirmed), all
 // confirmed unspent outputs and all unconfirmed unspent outputs under control
 // by the wallet. This method can be modified by having the request specify
@@ -2763,6 +3088,7 @@ func validatePayReqExpiry(payReq *zpay32.Invoice) error {
 // execute sendPayment. We use this struct as a sort of bridge to enable code
 // re-use between SendPayment and SendToRoute.
 type paymentStream struct {
+	ctx  context.Context
 	recv func() (*rpcPaymentRequest, error)
 	send func(*lnrpc.SendResponse) error
 }
@@ -2803,6 +3129,7 @@ func (r *rpcServer) SendPayment(stream lnrpc.Lightning_SendPaymentServer) error
 	var lock sync.Mutex
 
 	return r.sendPayment(&paymentStream{
+		ctx: stream.Context(),
 		recv: func() (*rpcPaymentRequest, error) {
 			req, err := stream.Recv()
 			if err != nil {
@@ -2831,15 +3158,16 @@ func (r *rpcServer) SendToRoute(stream lnrpc.Lightning_SendToRouteServer) error
 	var lock sync.Mutex
 
 	return r.sendPayment(&paymentStream{
+		ctx: stream.Context(),
 		recv: func() (*rpcPaymentRequest, error) {
 			req, err := stream.Recv()
 			if err != nil {
 				return nil, err
 			}
 
-			graph := r.server.chanDB.ChannelGraph()
-
-			return unmarshallSendToRouteRequest(req, graph)
+			return unmarshallSendToRouteRequest(
+				req, r.routerBackend,
+			)
 		},
 		send: func(r *lnrpc.SendResponse) error {
 			// Calling stream.Send concurrently is not safe.
@@ -2852,7 +3180,7 @@ func (r *rpcServer) SendToRoute(stream lnrpc.Lightning_SendToRouteServer) error
 
 // unmarshallSendToRouteRequest unmarshalls an rpc sendtoroute request
 func unmarshallSendToRouteRequest(req *lnrpc.SendToRouteRequest,
-	graph *channeldb.ChannelGraph) (*rpcPaymentRequest, error) {
+	routerBackend *routerrpc.RouterBackend) (*rpcPaymentRequest, error) {
 
 	switch {
 	case len(req.Routes) == 0 && req.Route == nil:
@@ -2865,14 +3193,14 @@ func unmarshallSendToRouteRequest(req *lnrpc.SendToRouteRequest,
 	if len(req.Routes) > 0 {
 		routes = make([]*route.Route, len(req.Routes))
 		for i, rpcroute := range req.Routes {
-			route, err := unmarshallRoute(rpcroute, graph)
+			route, err := routerBackend.UnmarshallRoute(rpcroute)
 			if err != nil {
 				return nil, err
 			}
 			routes[i] = route
 		}
 	} else {
-		rt, err := unmarshallRoute(req.Route, graph)
+		rt, err := routerBackend.UnmarshallRoute(req.Route)
 		if err != nil {
 			return nil, err
 		}
@@ -2902,6 +3230,10 @@ type rpcPaymentIntent struct {
 	cltvDelta         uint16
 	routeHints        [][]zpay32.HopHint
 	outgoingChannelID *uint64
+	lastHop           *route.Vertex
+	ignoredNodes      map[route.Vertex]struct{}
+	ignoredEdges      map[routing.EdgeLocator]struct{}
+	ignoredPairs      map[routing.DirectedNodePair]struct{}
 
 	routes []*route.Route
 }
@@ -2940,6 +3272,34 @@ func extractPaymentIntent(rpcPayReq *rpcPaymentRequest) (rpcPaymentIntent, error
 		payIntent.outgoingChannelID = &rpcPayReq.OutgoingChanId
 	}
 
+	// Parse the optional last hop and the nodes, edges and node pairs to
+	// exclude from path finding.
+	var err error
+	payIntent.lastHop, err = routerrpc.UnmarshallLastHop(
+		rpcPayReq.LastHopPubkey,
+	)
+	if err != nil {
+		return payIntent, err
+	}
+
+	payIntent.ignoredNodes, err = routerrpc.UnmarshallIgnoredNodes(
+		rpcPayReq.IgnoredNodes,
+	)
+	if err != nil {
+		return payIntent, err
+	}
+
+	payIntent.ignoredEdges = routerrpc.UnmarshallIgnoredEdges(
+		rpcPayReq.IgnoredEdges,
+	)
+
+	payIntent.ignoredPairs, err = routerrpc.UnmarshallIgnoredPairs(
+		rpcPayReq.IgnoredPairs,
+	)
+	if err != nil {
+		return payIntent, err
+	}
+
 	// Take cltv limit from request if set.
 	if rpcPayReq.CltvLimit != 0 {
 		payIntent.cltvLimit = &rpcPayReq.CltvLimit
@@ -3075,7 +3435,7 @@ type paymentIntentResponse struct {
 // pre-built route. The first error this method returns denotes if we were
 // unable to save the payment. The second error returned denotes if the payment
 // didn't succeed.
-func (r *rpcServer) dispatchPaymentIntent(
+func (r *rpcServer) dispatchPaymentIntent(ctx context.Context,
 	payIntent *rpcPaymentIntent) (*paymentIntentResponse, error) {
 
 	// Construct a payment request to send to the channel router. If the
@@ -3087,6 +3447,23 @@ func (r *rpcServer) dispatchPaymentIntent(
 		routerErr error
 	)
 
+	// Before dispatching the payment, we'll make sure it's permitted by
+	// the spending caveats of the caller's macaroon. The maximum cost of
+	// the payment is reserved against its spend limits until we know how
+	// much was actually spent.
+	maxCost := payIntent.msat + payIntent.feeLimit
+	for _, rt := range payIntent.routes {
+		if rt.TotalAmount > maxCost {
+			maxCost = rt.TotalAmount
+		}
+	}
+	settleSpend, err := r.routerBackend.ReserveSpend(ctx, maxCost)
+	if err != nil {
+		return &paymentIntentResponse{
+			Err: err,
+		}, nil
+	}
+
 	// If a route was specified, then we'll pass the route directly to the
 	// router, otherwise we'll create a payment session to execute it.
 	if len(payIntent.routes) == 0 {
@@ -3098,6 +3475,10 @@ func (r *rpcServer) dispatchPaymentIntent(
 			PaymentHash:       payIntent.rHash,
 			RouteHints:        payIntent.routeHints,
 			OutgoingChannelID: payIntent.outgoingChannelID,
+			LastHop:           payIntent.lastHop,
+			IgnoredNodes:      payIntent.ignoredNodes,
+			IgnoredEdges:      payIntent.ignoredEdges,
+			IgnoredPairs:      payIntent.ignoredPairs,
 		}
 
 		// If the final CLTV value was specified, then we'll use that
@@ -3119,6 +3500,17 @@ func (r *rpcServer) dispatchPaymentIntent(
 		)
 	}
 
+	// Now that the payment has completed, we know how much it actually
+	// cost.
+	var spent lnwire.MilliSatoshi
+	if routerErr == nil {
+		spent = route.TotalAmount
+	}
+	if err := settleSpend(spent); err != nil {
+		rpcsLog.Errorf("Unable to settle spend of payment %x: %v",
+			payIntent.rHash[:], err)
+	}
+
 	// If the route failed, then we'll return a nil save err, but a non-nil
 	// routing err.
 	if routerErr != nil {
@@ -3138,7 +3530,7 @@ func (r *rpcServer) dispatchPaymentIntent(
 
 	// Save the completed payment to the database for record keeping
 	// purposes.
-	err := r.savePayment(route, amt, preImage[:])
+	err = r.savePayment(route, amt, preImage[:])
 	if err != nil {
 		// We weren't able to save the payment, so we return the save
 		// err, but a nil routing err.
@@ -3151,6 +3543,45 @@ func (r *rpcServer) dispatchPaymentIntent(
 	}, nil
 }
 
+// rejectUntrackedSpend returns an error if the caller's macaroon carries
+// spending limits, as the calls that use this move funds on-chain, whose cost
+// can't be accounted for against these limits.
+func (r *rpcServer) rejectUntrackedSpend(ctx context.Context) error {
+	if r.macService == nil {
+		return nil
+	}
+
+	return r.macService.RejectUntrackedSpend(ctx)
+}
+
+// newSpendReserver returns a function that checks payments against the
+// spending caveats of the caller's macaroon, and reserves their maximum cost
+// against the macaroon's spend limits. If macaroons are disabled, all payments
+// are permitted.
+func newSpendReserver(macService *macaroons.Service) func(ctx context.Context,
+	maxCost lnwire.MilliSatoshi) (func(lnwire.MilliSatoshi) error, error) {
+
+	return func(ctx context.Context, maxCost lnwire.MilliSatoshi) (
+		func(lnwire.MilliSatoshi) error, error) {
+
+		if macService == nil {
+			noop := func(lnwire.MilliSatoshi) error { return nil }
+			return noop, nil
+		}
+
+		reservation, err := macService.ReserveSpend(
+			ctx, uint64(maxCost),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(spent lnwire.MilliSatoshi) error {
+			return reservation.Settle(uint64(spent))
+		}, nil
+	}
+}
+
 // sendPayment takes a paymentStream (a source of pre-built routes or payment
 // requests) and continually attempt to dispatch payment requests written to
 // the write end of the stream. Responses will also be streamed back to the
@@ -3267,7 +3698,7 @@ func (r *rpcServer) sendPayment(stream *paymentStream) error {
 				}()
 
 				resp, saveErr := r.dispatchPaymentIntent(
-					payIntent,
+					stream.ctx, payIntent,
 				)
 
 				switch {
@@ -3332,9 +3763,9 @@ func (r *rpcServer) SendToRouteSync(ctx context.Context,
 		return nil, fmt.Errorf("unable to send, no routes provided")
 	}
 
-	graph := r.server.chanDB.ChannelGraph()
-
-	paymentRequest, err := unmarshallSendToRouteRequest(req, graph)
+	paymentRequest, err := unmarshallSendToRouteRequest(
+		req, r.routerBackend,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -3364,7 +3795,7 @@ func (r *rpcServer) sendPaymentSync(ctx context.Context,
 
 	// With the payment validated, we'll now attempt to dispatch the
 	// payment.
-	resp, saveErr := r.dispatchPaymentIntent(&payIntent)
+	resp, saveErr := r.dispatchPaymentIntent(ctx, &payIntent)
 	switch {
 	case saveErr != nil:
 		return nil, saveErr
@@ -3404,6 +3835,18 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 		ChanDB:            r.server.chanDB,
 	}
 
+	// Make sure the invoice amount is permitted by the caveats of the
+	// caller's macaroon.
+	if r.macService != nil {
+		amtMsat := lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(invoice.Value),
+		)
+		err := r.macService.CheckInvoiceAmount(ctx, uint64(amtMsat))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	addInvoiceData := &invoicesrpc.AddInvoiceData{
 		Memo:            invoice.Memo,
 		Receipt:         invoice.Receipt,
@@ -3883,112 +4326,6 @@ func (r *rpcServer) QueryRoutes(ctx context.Context,
 	return r.routerBackend.QueryRoutes(ctx, in)
 }
 
-// unmarshallHopByChannelLookup unmarshalls an rpc hop for which the pub key is
-// not known. This function will query the channel graph with channel id to
-// retrieve both endpoints and determine the hop pubkey using the previous hop
-// pubkey. If the channel is unknown, an error is returned.
-func unmarshallHopByChannelLookup(graph *channeldb.ChannelGraph, hop *lnrpc.Hop,
-	prevPubKeyBytes [33]byte) (*route.Hop, error) {
-
-	// Discard edge policies, because they may be nil.
-	edgeInfo, _, _, err := graph.FetchChannelEdgesByID(hop.ChanId)
-	if err != nil {
-		return nil, fmt.Errorf("unable to fetch channel edges by "+
-			"channel ID %d: %v", hop.ChanId, err)
-	}
-
-	var pubKeyBytes [33]byte
-	switch {
-	case prevPubKeyBytes == edgeInfo.NodeKey1Bytes:
-		pubKeyBytes = edgeInfo.NodeKey2Bytes
-	case prevPubKeyBytes == edgeInfo.NodeKey2Bytes:
-		pubKeyBytes = edgeInfo.NodeKey1Bytes
-	default:
-		return nil, fmt.Errorf("channel edge does not match expected node")
-	}
-
-	return &route.Hop{
-		OutgoingTimeLock: hop.Expiry,
-		AmtToForward:     lnwire.MilliSatoshi(hop.AmtToForwardMsat),
-		PubKeyBytes:      pubKeyBytes,
-		ChannelID:        edgeInfo.ChannelID,
-	}, nil
-}
-
-// unmarshallKnownPubkeyHop unmarshalls an rpc hop that contains the hop pubkey.
-// The channel graph doesn't need to be queried because all information required
-// for sending the payment is present.
-func unmarshallKnownPubkeyHop(hop *lnrpc.Hop) (*route.Hop, error) {
-	pubKey, err := hex.DecodeString(hop.PubKey)
-	if err != nil {
-		return nil, fmt.Errorf("cannot decode pubkey %s", hop.PubKey)
-	}
-
-	var pubKeyBytes [33]byte
-	copy(pubKeyBytes[:], pubKey)
-
-	return &route.Hop{
-		OutgoingTimeLock: hop.Expiry,
-		AmtToForward:     lnwire.MilliSatoshi(hop.AmtToForwardMsat),
-		PubKeyBytes:      pubKeyBytes,
-		ChannelID:        hop.ChanId,
-	}, nil
-}
-
-// unmarshallHop unmarshalls an rpc hop that may or may not contain a node
-// pubkey.
-func unmarshallHop(graph *channeldb.ChannelGraph, hop *lnrpc.Hop,
-	prevNodePubKey [33]byte) (*route.Hop, error) {
-
-	if hop.PubKey == "" {
-		// If no pub key is given of the hop, the local channel
-		// graph needs to be queried to complete the information
-		// necessary for routing.
-		return unmarshallHopByChannelLookup(graph, hop, prevNodePubKey)
-	}
-
-	return unmarshallKnownPubkeyHop(hop)
-}
-
-// unmarshallRoute unmarshalls an rpc route. For hops that don't specify a
-// pubkey, the channel graph is queried.
-func unmarshallRoute(rpcroute *lnrpc.Route,
-	graph *channeldb.ChannelGraph) (*route.Route, error) {
-
-	sourceNode, err := graph.SourceNode()
-	if err != nil {
-		return nil, fmt.Errorf("unable to fetch source node from graph "+
-			"while unmarshaling route. %v", err)
-	}
-
-	prevNodePubKey := sourceNode.PubKeyBytes
-
-	hops := make([]*route.Hop, len(rpcroute.Hops))
-	for i, hop := range rpcroute.Hops {
-		routeHop, err := unmarshallHop(graph,
-			hop, prevNodePubKey)
-		if err != nil {
-			return nil, err
-		}
-
-		hops[i] = routeHop
-
-		prevNodePubKey = routeHop.PubKeyBytes
-	}
-
-	route, err := route.NewRouteFromHops(
-		lnwire.MilliSatoshi(rpcroute.TotalAmtMsat),
-		rpcroute.TotalTimeLock,
-		sourceNode.PubKeyBytes,
-		hops,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	return route, nil
-}
-
 // GetNetworkInfo returns some basic stats about the known channel graph from
 // the PoV of the node.
 func (r *rpcServer) GetNetworkInfo(ctx context.Context,
@@ -4090,6 +4427,10 @@ func (r *rpcServer) GetNetworkInfo(ctx context.Context,
 	// TODO(roasbeef): graph diameter
 
 	// TODO(roasbeef): also add oldest channel?
+	// We'll also report the number of channel updates the gossiper dropped
+	// for exceeding its rate limits.
+	rateLimitStats := r.server.authGossiper.RateLimitStats()
+
 	netInfo := &lnrpc.NetworkInfo{
 		MaxOutDegree:         maxChanOut,
 		AvgOutDegree:         float64(2*numChannels) / float64(numNodes),
@@ -4101,6 +4442,9 @@ func (r *rpcServer) GetNetworkInfo(ctx context.Context,
 		MinChannelSize:       int64(minChannelSize),
 		MaxChannelSize:       int64(maxChannelSize),
 		MedianChannelSizeSat: int64(medianChanSize),
+
+		NumThrottledChanUpdates: rateLimitStats.ThrottledByChannel,
+		NumThrottledPeerUpdates: rateLimitStats.ThrottledByPeer,
 	}
 
 	// Similarly, if we don't have any channels, then we'll also set the
@@ -4113,6 +4457,194 @@ func (r *rpcServer) GetNetworkInfo(ctx context.Context,
 	return netInfo, nil
 }
 
+// UpdateGraphBanList adds nodes and channels to, or removes them from, the ban
+// list of the graph, returning the updated ban list. Banned nodes and channels
+// are purged from the graph, while unbanned ones are learned of once again
+// through the gossip network.
+func (r *rpcServer) UpdateGraphBanList(ctx context.Context,
+	in *lnrpc.UpdateGraphBanListRequest) (*lnrpc.GraphBanList, error) {
+
+	banNodes, err := parseBanListNodes(in.BanNodes)
+	if err != nil {
+		return nil, err
+	}
+	unbanNodes, err := parseBanListNodes(in.UnbanNodes)
+	if err != nil {
+		return nil, err
+	}
+
+	router := r.server.chanRouter
+	if len(banNodes) > 0 {
+		if err := router.BanNodes(banNodes...); err != nil {
+			return nil, err
+		}
+	}
+	if len(unbanNodes) > 0 {
+		if err := router.UnbanNodes(unbanNodes...); err != nil {
+			return nil, err
+		}
+	}
+	if len(in.BanChanIds) > 0 {
+		if err := router.BanChannels(in.BanChanIds...); err != nil {
+			return nil, err
+		}
+	}
+	if len(in.UnbanChanIds) > 0 {
+		if err := router.UnbanChannels(in.UnbanChanIds...); err != nil {
+			return nil, err
+		}
+	}
+
+	rpcsLog.Infof("[updatebanlist] banned %v nodes and %v channels, "+
+		"unbanned %v nodes and %v channels", len(banNodes),
+		len(in.BanChanIds), len(unbanNodes), len(in.UnbanChanIds))
+
+	return r.ListGraphBanList(ctx, &lnrpc.ListGraphBanListRequest{})
+}
+
+// ListGraphBanList returns the nodes and channels within the ban list of the
+// graph, including those banned through the config.
+func (r *rpcServer) ListGraphBanList(ctx context.Context,
+	in *lnrpc.ListGraphBanListRequest) (*lnrpc.GraphBanList, error) {
+
+	banList := r.server.banList
+
+	nodes := banList.Nodes()
+	resp := &lnrpc.GraphBanList{
+		Nodes:   make([]string, 0, len(nodes)),
+		ChanIds: banList.Channels(),
+	}
+	for _, node := range nodes {
+		resp.Nodes = append(resp.Nodes, hex.EncodeToString(node[:]))
+	}
+
+	// The ban list is unordered, so we'll sort it to return a stable
+	// response.
+	sort.Strings(resp.Nodes)
+	sort.Slice(resp.ChanIds, func(i, j int) bool {
+		return resp.ChanIds[i] < resp.ChanIds[j]
+	})
+
+	return resp, nil
+}
+
+// parseBanListNodes parses the given hex encoded public keys of nodes to ban or
+// unban.
+func parseBanListNodes(pubKeys []string) ([]route.Vertex, error) {
+	nodes := make([]route.Vertex, 0, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		pubKeyBytes, err := hex.DecodeString(pubKey)
+		if err != nil {
+			return nil, err
+		}
+		pubKey, err := btcec.ParsePubKey(pubKeyBytes, btcec.S256())
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, route.NewVertex(pubKey))
+	}
+
+	return nodes, nil
+}
+
+// graphSnapshotChunkSize is the size of the chunks a graph snapshot is
+// streamed in.
+const graphSnapshotChunkSize = 64 * 1024
+
+// graphSnapshotSender is an io.Writer that sends everything written to it as
+// a chunk of a graph snapshot.
+type graphSnapshotSender struct {
+	stream lnrpc.Lightning_ExportGraphSnapshotServer
+}
+
+// Write sends the given data as a chunk of the graph snapshot.
+//
+// NOTE: Part of the io.Writer interface.
+func (g *graphSnapshotSender) Write(p []byte) (int, error) {
+	data := make([]byte, len(p))
+	copy(data, p)
+
+	err := g.stream.Send(&lnrpc.GraphSnapshotChunk{Data: data})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// graphSnapshotReceiver is an io.Reader that reads the chunks of a graph
+// snapshot received from a stream.
+type graphSnapshotReceiver struct {
+	stream lnrpc.Lightning_ImportGraphSnapshotServer
+	buf    []byte
+}
+
+// Read reads from the current chunk of the graph snapshot, receiving the next
+// one once it has been read entirely.
+//
+// NOTE: Part of the io.Reader interface.
+func (g *graphSnapshotReceiver) Read(p []byte) (int, error) {
+	for len(g.buf) == 0 {
+		chunk, err := g.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		g.buf = chunk.Data
+	}
+
+	n := copy(p, g.buf)
+	g.buf = g.buf[n:]
+
+	return n, nil
+}
+
+// ExportGraphSnapshot streams a compact binary snapshot of the public part of
+// the channel graph, which can be imported by other nodes to bootstrap their
+// graph.
+func (r *rpcServer) ExportGraphSnapshot(in *lnrpc.ExportGraphSnapshotRequest,
+	stream lnrpc.Lightning_ExportGraphSnapshotServer) error {
+
+	w := bufio.NewWriterSize(
+		&graphSnapshotSender{stream: stream}, graphSnapshotChunkSize,
+	)
+	stats, err := routing.WriteGraphSnapshot(
+		r.server.chanDB.ChannelGraph(), *activeNetParams.GenesisHash, w,
+	)
+	if err != nil {
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	rpcsLog.Debugf("[exportgraph] exported snapshot with %v nodes, %v "+
+		"channels and %v policies", stats.NumNodes, stats.NumChannels,
+		stats.NumPolicies)
+
+	return nil
+}
+
+// ImportGraphSnapshot adds the contents of a graph snapshot created by
+// ExportGraphSnapshot to the channel graph.
+func (r *rpcServer) ImportGraphSnapshot(
+	stream lnrpc.Lightning_ImportGraphSnapshotServer) error {
+
+	stats, err := r.server.chanRouter.ImportGraphSnapshot(
+		&graphSnapshotReceiver{stream: stream},
+		*activeNetParams.GenesisHash,
+	)
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(&lnrpc.ImportGraphSnapshotResponse{
+		NumNodes:    stats.NumNodes,
+		NumChannels: stats.NumChannels,
+		NumPolicies: stats.NumPolicies,
+		NumSkipped:  stats.NumSkipped,
+	})
+}
+
 // StopDaemon will send a shutdown request to the interrupt handler, triggering
 // a graceful shutdown of the daemon.
 func (r *rpcServer) StopDaemon(ctx context.Context,
@@ -4527,6 +5059,46 @@ func (r *rpcServer) FeeReport(ctx context.Context,
 	}, nil
 }
 
+// FeeControllerDecisions returns the latest decision taken by the fee
+// controller for each of our channels.
+func (r *rpcServer) FeeControllerDecisions(ctx context.Context,
+	_ *lnrpc.FeeControllerDecisionsRequest) (
+	*lnrpc.FeeControllerDecisionsResponse, error) {
+
+	rpcsLog.Debugf("[feedecisions]")
+
+	if r.server.feeController == nil {
+		return nil, fmt.Errorf("fee controller not active, start lnd " +
+			"with --feepolicy to enable it")
+	}
+
+	decisions := r.server.feeController.Decisions()
+	resp := &lnrpc.FeeControllerDecisionsResponse{
+		Decisions: make(
+			[]*lnrpc.FeeControllerDecision, 0, len(decisions),
+		),
+	}
+	for _, decision := range decisions {
+		rpcDecision := &lnrpc.FeeControllerDecision{
+			ChanId:            decision.ChanID.ToUint64(),
+			ChannelPoint:      decision.ChanPoint.String(),
+			Timestamp:         decision.Timestamp.Unix(),
+			LocalBalanceRatio: decision.LocalBalanceRatio,
+			ForwardVolumeMsat: uint64(decision.ForwardVolume),
+			LiquidityFailures: decision.LiquidityFailures,
+			OldBaseFeeMsat:    int64(decision.OldBaseFee),
+			OldFeePerMil:      int64(decision.OldFeeRate),
+			NewBaseFeeMsat:    int64(decision.NewBaseFee),
+			NewFeePerMil:      int64(decision.NewFeeRate),
+			Applied:           decision.Applied,
+			Reason:            decision.Reason,
+		}
+		resp.Decisions = append(resp.Decisions, rpcDecision)
+	}
+
+	return resp, nil
+}
+
 // minFeeRate is the smallest permitted fee rate within the network. This is
 // derived by the fact that fee rates are computed using a fixed point of
 // 1,000,000. As a result, the smallest representable fee rate is 1e-6, or
@@ -4717,6 +5289,99 @@ func (r *rpcServer) ForwardingHistory(ctx context.Context,
 	return resp, nil
 }
 
+// AuditLog allows the caller to query the audit log for a record of all RPC
+// calls made within the target time range, and integer offset within that time
+// range. If no time-range is specified, then the first chunk of the past 24
+// hrs of the audit log are returned.
+func (r *rpcServer) AuditLog(ctx context.Context,
+	req *lnrpc.AuditLogRequest) (*lnrpc.AuditLogResponse, error) {
+
+	rpcsLog.Debugf("[auditlog]")
+
+	// If the start and end time were not set, then we'll just return the
+	// records over the past 24 hours.
+	var startTime, endTime time.Time
+	if req.StartTime == 0 && req.EndTime == 0 {
+		now := time.Now()
+		startTime = now.Add(-time.Hour * 24)
+		endTime = now
+	} else {
+		startTime = time.Unix(int64(req.StartTime), 0)
+		endTime = time.Unix(int64(req.EndTime), 0)
+	}
+
+	// If the number of events wasn't specified, then we'll default to
+	// returning the last 100 events.
+	numEvents := req.NumMaxEvents
+	if numEvents == 0 {
+		numEvents = 100
+	}
+	if numEvents > channeldb.MaxResponseEvents {
+		numEvents = channeldb.MaxResponseEvents
+	}
+
+	eventQuery := channeldb.AuditEventQuery{
+		StartTime:    startTime,
+		EndTime:      endTime,
+		IndexOffset:  req.IndexOffset,
+		NumMaxEvents: numEvents,
+	}
+	timeSlice, err := r.server.chanDB.AuditLog().Query(eventQuery)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query audit log: %v", err)
+	}
+
+	resp := &lnrpc.AuditLogResponse{
+		AuditEvents: make(
+			[]*lnrpc.AuditEvent, len(timeSlice.AuditEvents),
+		),
+		LastOffsetIndex: timeSlice.LastIndexOffset,
+	}
+	for i, event := range timeSlice.AuditEvents {
+		resp.AuditEvents[i] = &lnrpc.AuditEvent{
+			TimestampNs: uint64(event.Timestamp.UnixNano()),
+			Method:      event.Method,
+			MacaroonId:  hex.EncodeToString(event.MacaroonID),
+			RemoteAddr:  event.RemoteAddr,
+			Success:     event.Error == "",
+			Error:       event.Error,
+			AmtMsat:     uint64(event.Amount),
+			Destination: event.Destination,
+		}
+	}
+
+	return resp, nil
+}
+
+// HtlcQuotas returns the htlc quota counters of every incoming channel that
+// htlcs have been forwarded or rejected for since startup.
+func (r *rpcServer) HtlcQuotas(ctx context.Context,
+	_ *lnrpc.HtlcQuotasRequest) (*lnrpc.HtlcQuotasResponse, error) {
+
+	rpcsLog.Debugf("[htlcquotas]")
+
+	stats := r.server.htlcSwitch.HtlcQuotaStats()
+	resp := &lnrpc.HtlcQuotasResponse{
+		MaxSlotPercent:     cfg.HtlcQuota.MaxSlotPercent,
+		MaxValuePercent:    cfg.HtlcQuota.MaxValuePercent,
+		MaxHoldTimeSeconds: uint64(cfg.HtlcQuota.MaxHoldTime.Seconds()),
+		Quotas:             make([]*lnrpc.HtlcQuota, 0, len(stats)),
+	}
+	for _, stat := range stats {
+		resp.Quotas = append(resp.Quotas, &lnrpc.HtlcQuota{
+			ChanId:             stat.ChanID.ToUint64(),
+			NumInFlight:        stat.NumInFlight,
+			ValueInFlightMsat:  uint64(stat.ValueInFlight),
+			NumSlotRejects:     stat.NumSlotRejects,
+			NumValueRejects:    stat.NumValueRejects,
+			NumHoldTimeRejects: stat.NumHoldTimeRejects,
+			NumSlowResolved:    stat.NumSlowResolved,
+		})
+	}
+
+	return resp, nil
+}
+
 // ExportChannelBackup attempts to return an encrypted static channel backup
 // for the target channel identified by it channel point. The backup is
 // encrypted with a key generated from the aezeed seed of the user. The
@@ -5052,3 +5717,148 @@ func (r *rpcServer) SubscribeChannelBackups(req *lnrpc.ChannelBackupSubscription
 		}
 	}
 }
+
+// stringInSlice returns true if a string is contained in the given slice.
+func stringInSlice(a string, slice []string) bool {
+	for _, b := range slice {
+		if b == a {
+			return true
+		}
+	}
+	return false
+}
+
+// BakeMacaroon allows the creation of a new macaroon with custom read and
+// write permissions. No first-party caveats are added since this can be done
+// offline.
+func (r *rpcServer) BakeMacaroon(ctx context.Context,
+	req *lnrpc.BakeMacaroonRequest) (*lnrpc.BakeMacaroonResponse, error) {
+
+	rpcsLog.Debugf("[bakemacaroon]")
+
+	// If the --no-macaroons flag is used to start lnd, the macaroon
+	// service is not initialized. Therefore we can't bake new macaroons.
+	if r.macService == nil {
+		return nil, fmt.Errorf("macaroon authentication disabled, " +
+			"remove --no-macaroons flag to enable")
+	}
+
+	// A macaroon with spending limits must not be able to mint a new
+	// macaroon without them.
+	if err := r.rejectUntrackedSpend(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(req.Permissions) == 0 {
+		return nil, fmt.Errorf("permission list cannot be empty")
+	}
+
+	// Make sure all the permissions are known to us, so that we don't mint
+	// macaroons that grant nothing because of a typo.
+	requestedPermissions := make([]bakery.Op, len(req.Permissions))
+	for idx, op := range req.Permissions {
+		if !stringInSlice(op.Entity, validEntities) {
+			return nil, fmt.Errorf("invalid permission entity: "+
+				"%v, valid entities are %v", op.Entity,
+				validEntities)
+		}
+		if !stringInSlice(op.Action, validActions) {
+			return nil, fmt.Errorf("invalid permission action: "+
+				"%v, valid actions are %v", op.Action,
+				validActions)
+		}
+
+		requestedPermissions[idx] = bakery.Op{
+			Entity: op.Entity,
+			Action: op.Action,
+		}
+	}
+
+	rootKeyID := macaroons.EncodeRootKeyID(req.RootKeyId)
+	newMac, err := r.macService.NewMacaroon(
+		ctx, rootKeyID, requestedPermissions...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	newMacBytes, err := newMac.M().MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Infof("Baked macaroon with root key id %v granting %v",
+		req.RootKeyId, newLogClosure(func() string {
+			return spew.Sdump(requestedPermissions)
+		}))
+
+	return &lnrpc.BakeMacaroonResponse{
+		Macaroon: hex.EncodeToString(newMacBytes),
+	}, nil
+}
+
+// ListMacaroonIDs returns the IDs of all root keys that macaroons have been
+// minted with.
+func (r *rpcServer) ListMacaroonIDs(ctx context.Context,
+	_ *lnrpc.ListMacaroonIDsRequest) (*lnrpc.ListMacaroonIDsResponse,
+	error) {
+
+	rpcsLog.Debugf("[listmacaroonids]")
+
+	if r.macService == nil {
+		return nil, fmt.Errorf("macaroon authentication disabled, " +
+			"remove --no-macaroons flag to enable")
+	}
+
+	rootKeyIDByteSlice, err := r.macService.ListMacaroonIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Root key IDs are stored as decimal strings, but exposed as numbers.
+	// IDs that weren't created through RPC are skipped.
+	rootKeyIDs := make([]uint64, 0, len(rootKeyIDByteSlice))
+	for _, value := range rootKeyIDByteSlice {
+		id, err := macaroons.DecodeRootKeyID(value)
+		if err != nil {
+			rpcsLog.Warnf("Skipping non-numeric root key id %x",
+				value)
+			continue
+		}
+
+		rootKeyIDs = append(rootKeyIDs, id)
+	}
+	sort.Slice(rootKeyIDs, func(i, j int) bool {
+		return rootKeyIDs[i] < rootKeyIDs[j]
+	})
+
+	return &lnrpc.ListMacaroonIDsResponse{RootKeyIds: rootKeyIDs}, nil
+}
+
+// DeleteMacaroonID deletes the root key of the given ID, which revokes all
+// macaroons that were minted with it.
+func (r *rpcServer) DeleteMacaroonID(ctx context.Context,
+	req *lnrpc.DeleteMacaroonIDRequest) (*lnrpc.DeleteMacaroonIDResponse,
+	error) {
+
+	rpcsLog.Debugf("[deletemacaroonid]")
+
+	if r.macService == nil {
+		return nil, fmt.Errorf("macaroon authentication disabled, " +
+			"remove --no-macaroons flag to enable")
+	}
+
+	rootKeyID := macaroons.EncodeRootKeyID(req.RootKeyId)
+	deletedID, err := r.macService.DeleteMacaroonID(ctx, rootKeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if deletedID != nil {
+		rpcsLog.Infof("Deleted macaroon root key id %v, all macaroons "+
+			"minted with it are revoked", req.RootKeyId)
+	}
+
+	return &lnrpc.DeleteMacaroonIDResponse{
+		Deleted: deletedID != nil,
+	}, nil
+}
@@ -4,15 +4,18 @@ import (
 	"bytes"
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
 	"image/color"
+	"io/ioutil"
 	"math/big"
 	prand "math/rand"
 	"net"
 	"path/filepath"
 	"regexp"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -28,6 +31,7 @@ import (
 	"github.com/lightningnetwork/lnd/autopilot"
 	"github.com/lightningnetwork/lnd/brontide"
 	"github.com/lightningnetwork/lnd/chanbackup"
+	"github.com/lightningnetwork/lnd/chanfees"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/channelnotifier"
 	"github.com/lightningnetwork/lnd/contractcourt"
@@ -35,6 +39,7 @@ import (
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnpeer"
 	"github.com/lightningnetwork/lnd/lnrpc"
@@ -43,8 +48,10 @@ import (
 	"github.com/lightningnetwork/lnd/nat"
 	"github.com/lightningnetwork/lnd/netann"
 	"github.com/lightningnetwork/lnd/pool"
+	"github.com/lightningnetwork/lnd/queue"
 	"github.com/lightningnetwork/lnd/routing"
 	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/subscribe"
 	"github.com/lightningnetwork/lnd/sweep"
 	"github.com/lightningnetwork/lnd/ticker"
 	"github.com/lightningnetwork/lnd/tor"
@@ -57,6 +64,11 @@ const (
 	// connected to.
 	defaultMinPeers = 3
 
+	// defaultNATRefreshInterval is the default interval at which we'll
+	// check for an updated external IP address and renew our port
+	// mappings when using NAT traversal.
+	defaultNATRefreshInterval = 15 * time.Minute
+
 	// defaultStableConnDuration is a floor under which all reconnection
 	// attempts will apply exponential randomized backoff. Connections
 	// durations exceeding this value will be eligible to have their
@@ -74,6 +86,22 @@ const (
 	// value used or a particular peer will be chosen between 0s and this
 	// value.
 	maxInitReconnectDelay = 30
+
+	// maxPeerErrors is the maximum number of errors exchanged with a peer
+	// that we'll keep track of. Once exceeded, the oldest errors are
+	// dropped.
+	maxPeerErrors = 10
+
+	// peerErrorQueueSize is the number of errors exchanged with peers that
+	// can be queued for persistence. Once the queue is full, errors are
+	// only kept in memory until there is room again.
+	peerErrorQueueSize = 100
+
+	// peerErrorWriteInterval is the interval at which errors exchanged with
+	// peers are persisted. Errors exchanged within the same interval are
+	// written at once, which limits the rate of database writes a peer can
+	// cause by sending us errors.
+	peerErrorWriteInterval = time.Second
 )
 
 var (
@@ -108,6 +136,12 @@ func (e *errPeerAlreadyConnected) Error() string {
 // Additionally, the server is also used as a central messaging bus to interact
 // with any of its companion objects.
 type server struct {
+	// numEvictedPeers is the number of inbound peers without channels
+	// that have been disconnected to make room for new ones.
+	//
+	// NOTE: This MUST be used atomically.
+	numEvictedPeers uint64
+
 	active   int32 // atomic
 	stopping int32 // atomic
 
@@ -128,12 +162,21 @@ type server struct {
 	// listening on.
 	listenAddrs []net.Addr
 
+	// connLimiter rate limits the inbound connections accepted by our
+	// listeners, before any handshake is performed.
+	connLimiter *brontide.ConnRateLimiter
+
 	// torController is a client that will communicate with a locally
 	// running Tor server. This client will handle initiating and
 	// authenticating the connection to the Tor server, automatically
 	// creating and setting up onion services, etc.
 	torController *tor.Controller
 
+	// rpcOnionURIs is the set of URIs our gRPC and REST interfaces can be
+	// reached at over Tor, if exposed through an onion service.
+	rpcOnionURIs   []string
+	rpcOnionURIsMu sync.RWMutex
+
 	// natTraversal is the specific NAT traversal technique used to
 	// automatically set up port forwarding rules in order to advertise to
 	// the network that the node is accepting inbound connections.
@@ -158,6 +201,22 @@ type server struct {
 	persistentConnReqs     map[string][]*connmgr.ConnReq
 	persistentRetryCancels map[string]chan struct{}
 
+	// peerErrors buffers the most recent errors exchanged with each peer.
+	// The errors are kept across reconnections for as long as we have
+	// channels with the peer, or the peer is connected.
+	peerErrors    map[string]*queue.CircularBuffer
+	peerErrorsMtx sync.Mutex
+
+	// persistPeerErrors indicates whether the errors exchanged with peers
+	// we have channels with should also be persisted to the database,
+	// such that they survive restarts.
+	persistPeerErrors bool
+
+	// peerErrorWrites queues the errors exchanged with peers that are to
+	// be persisted, such that the peers' read and write handlers never
+	// block on the database.
+	peerErrorWrites chan *peerErrorWrite
+
 	// ignorePeerTermination tracks peers for which the server has initiated
 	// a disconnect. Adding a peer to this map causes the peer termination
 	// watcher to short circuit in the event that peers are purposefully
@@ -183,12 +242,20 @@ type server struct {
 
 	channelNotifier *channelnotifier.ChannelNotifier
 
+	htlcNotifier *htlcswitch.HtlcNotifier
+
+	// customMessageServer is used to dispatch custom messages received
+	// from our peers to any subscribed clients.
+	customMessageServer *subscribe.Server
+
 	witnessBeacon contractcourt.WitnessBeacon
 
 	breachArbiter *breachArbiter
 
 	chanRouter *routing.ChannelRouter
 
+	banList *routing.BanList
+
 	authGossiper *discovery.AuthenticatedGossiper
 
 	utxoNursery *utxoNursery
@@ -225,6 +292,11 @@ type server struct {
 	// channelNotifier to be notified of newly opened and closed channels.
 	chanSubSwapper *chanbackup.SubSwapper
 
+	// feeController periodically recomputes the fees of our channels as
+	// described by the fee policy file. It is nil if no policy file was
+	// configured.
+	feeController *chanfees.Controller
+
 	quit chan struct{}
 
 	wg sync.WaitGroup
@@ -283,6 +355,15 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 
 	var err error
 
+	// All of our listeners share a single connection limiter, such that
+	// the rate limits apply across all of them.
+	connLimiter := brontide.NewConnRateLimiter(brontide.RateLimiterConfig{
+		IPInterval:     cfg.ConnLimit.IPInterval,
+		IPBurst:        cfg.ConnLimit.IPBurst,
+		SubnetInterval: cfg.ConnLimit.SubnetInterval,
+		SubnetBurst:    cfg.ConnLimit.SubnetBurst,
+	})
+
 	listeners := make([]net.Listener, len(listenAddrs))
 	for i, listenAddr := range listenAddrs {
 		// Note: though brontide.NewListener uses ResolveTCPAddr, it
@@ -290,6 +371,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		// since we are resolving a local address.
 		listeners[i], err = brontide.NewListener(
 			privKey, listenAddr.String(),
+			brontide.WithConnLimiter(connLimiter),
 		)
 		if err != nil {
 			return nil, err
@@ -345,11 +427,15 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		invoices: invoices.NewRegistry(chanDB, decodeFinalCltvExpiry),
 
 		channelNotifier: channelnotifier.New(chanDB),
+		htlcNotifier:    htlcswitch.NewHtlcNotifier(time.Now),
+
+		customMessageServer: subscribe.NewServer(),
 
 		identityPriv: privKey,
 		nodeSigner:   netann.NewNodeSigner(privKey),
 
 		listenAddrs: listenAddrs,
+		connLimiter: connLimiter,
 
 		// TODO(roasbeef): derive proper onion key based on rotation
 		// schedule
@@ -359,6 +445,9 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		persistentPeersBackoff:  make(map[string]time.Duration),
 		persistentConnReqs:      make(map[string][]*connmgr.ConnReq),
 		persistentRetryCancels:  make(map[string]chan struct{}),
+		peerErrors:              make(map[string]*queue.CircularBuffer),
+		persistPeerErrors:       cfg.PersistPeerErrors,
+		peerErrorWrites:         make(chan *peerErrorWrite, peerErrorQueueSize),
 		ignorePeerTermination:   make(map[*peer]struct{}),
 		scheduledPeerConnection: make(map[string]func()),
 
@@ -430,6 +519,12 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 			htlcswitch.DefaultLogInterval),
 		NotifyActiveChannel:   s.channelNotifier.NotifyActiveChannelEvent,
 		NotifyInactiveChannel: s.channelNotifier.NotifyInactiveChannelEvent,
+		HtlcNotifier:          s.htlcNotifier,
+		HtlcQuotas: htlcswitch.HtlcQuotaConfig{
+			MaxSlotPercent:  cfg.HtlcQuota.MaxSlotPercent,
+			MaxValuePercent: cfg.HtlcQuota.MaxValuePercent,
+			MaxHoldTime:     cfg.HtlcQuota.MaxHoldTime,
+		},
 	}, uint32(currentHeight))
 	if err != nil {
 		return nil, err
@@ -538,7 +633,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 	// If we were requested to route connections through Tor and to
 	// automatically create an onion service, we'll initiate our Tor
 	// controller and establish a connection to the Tor server.
-	if cfg.Tor.Active && (cfg.Tor.V2 || cfg.Tor.V3) {
+	if cfg.Tor.Active && (cfg.Tor.V2 || cfg.Tor.V3 || cfg.Tor.RPCOnion) {
 		s.torController = tor.NewController(cfg.Tor.Control)
 	}
 
@@ -607,6 +702,26 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 	}
 	s.currentNodeAnn = nodeAnn
 
+	// Next, we'll assemble the ban list of the nodes and channels whose
+	// graph data we ignore, which consists of those banned through our
+	// config along with those previously banned through RPC.
+	bannedNodes := make([]route.Vertex, 0, len(cfg.Gossip.BannedNodes))
+	for _, node := range cfg.Gossip.BannedNodes {
+		pubKey, err := hex.DecodeString(node)
+		if err != nil {
+			return nil, err
+		}
+		var vertex route.Vertex
+		copy(vertex[:], pubKey)
+		bannedNodes = append(bannedNodes, vertex)
+	}
+	s.banList, err = routing.NewBanList(
+		chanDB, bannedNodes, cfg.Gossip.BannedChannels,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load ban list: %v", err)
+	}
+
 	s.chanRouter, err = routing.New(routing.Config{
 		Graph:     chanGraph,
 		Chain:     cc.chainIO,
@@ -626,6 +741,18 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 				firstHop, htlcAdd, errorDecryptor,
 			)
 		},
+		SendProbeToSwitch: func(firstHop lnwire.ShortChannelID,
+			htlcAdd *lnwire.UpdateAddHTLC,
+			circuit *sphinx.Circuit) ([32]byte, error) {
+
+			errorDecryptor := &htlcswitch.SphinxErrorDecrypter{
+				OnionErrorDecrypter: sphinx.NewOnionErrorDecrypter(circuit),
+			}
+
+			return s.htlcSwitch.SendProbe(
+				firstHop, htlcAdd, errorDecryptor,
+			)
+		},
 		ChannelPruneExpiry: routing.DefaultChannelPruneExpiry,
 		GraphPruneInterval: time.Duration(time.Hour),
 		QueryBandwidth: func(edge *channeldb.ChannelEdgeInfo) lnwire.MilliSatoshi {
@@ -657,7 +784,10 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 			// for the available bandwidth for the link.
 			return link.Bandwidth()
 		},
-		AssumeChannelValid: cfg.Routing.UseAssumeChannelValid(),
+		AssumeChannelValid:     cfg.Routing.UseAssumeChannelValid(),
+		BanList:                s.banList,
+		AddRebalanceInvoice:    s.invoices.AddInternalInvoice,
+		RemoveRebalanceInvoice: s.invoices.RemoveInternalInvoice,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("can't create router: %v", err)
@@ -686,10 +816,22 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		RetransmitDelay:      time.Minute * 30,
 		WaitingProofStore:    waitingProofStore,
 		MessageStore:         gossipMessageStore,
+		BroadcastStore:       gossipMessageStore,
 		AnnSigner:            s.nodeSigner,
 		RotateTicker:         ticker.New(discovery.DefaultSyncerRotationInterval),
 		HistoricalSyncTicker: ticker.New(cfg.HistoricalSyncInterval),
 		NumActiveSyncers:     cfg.NumGraphSyncPeers,
+		EncodingType:         lnwire.EncodingSortedZlib,
+		QueryOptions: lnwire.QueryOptionTimestamps |
+			lnwire.QueryOptionChecksums,
+		CurrentNodeAnnouncement: func() (lnwire.NodeAnnouncement, error) {
+			return s.genNodeAnnouncement(true)
+		},
+		ChannelUpdateInterval: cfg.Gossip.ChanUpdateInterval,
+		ChannelUpdateBurst:    cfg.Gossip.ChanUpdateBurst,
+		PeerUpdateInterval:    cfg.Gossip.PeerUpdateInterval,
+		PeerUpdateBurst:       cfg.Gossip.PeerUpdateBurst,
+		BanList:               s.banList,
 	},
 		s.identityPriv.PubKey(),
 	)
@@ -1044,6 +1186,28 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		return nil, err
 	}
 
+	// If a fee policy was configured, we'll create the fee controller
+	// that will apply it to our channels.
+	if cfg.FeePolicy != "" {
+		feePolicy, err := chanfees.LoadPolicy(cfg.FeePolicy)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load fee policy: %v",
+				err)
+		}
+
+		s.feeController = chanfees.NewController(&chanfees.Config{
+			Policy:              feePolicy,
+			FetchChannels:       s.fetchFeeControllerChannels,
+			FwdingLog:           chanDB.ForwardingLog(),
+			SubscribeHtlcEvents: s.htlcNotifier.SubscribeHtlcEvents,
+			ApplyPolicy:         s.updateChannelPolicy,
+			Ticker: ticker.New(
+				time.Duration(feePolicy.Interval),
+			),
+			Now: time.Now,
+		})
+	}
+
 	// Create the connection manager which will be responsible for
 	// maintaining persistent outbound connections and also accepting new
 	// incoming connections
@@ -1075,11 +1239,14 @@ func (s *server) Started() bool {
 func (s *server) Start() error {
 	var startErr error
 	s.start.Do(func() {
+		var onionAddrsChanged bool
 		if s.torController != nil {
-			if err := s.initTorController(); err != nil {
+			changed, err := s.initTorController()
+			if err != nil {
 				startErr = err
 				return
 			}
+			onionAddrsChanged = changed
 		}
 
 		if s.natTraversal != nil {
@@ -1112,6 +1279,14 @@ func (s *server) Start() error {
 			startErr = err
 			return
 		}
+		if err := s.htlcNotifier.Start(); err != nil {
+			startErr = err
+			return
+		}
+		if err := s.customMessageServer.Start(); err != nil {
+			startErr = err
+			return
+		}
 		if err := s.sphinx.Start(); err != nil {
 			startErr = err
 			return
@@ -1144,6 +1319,14 @@ func (s *server) Start() error {
 			startErr = err
 			return
 		}
+
+		// If our onion addresses changed since we were last running,
+		// we'll announce the new ones to the network, as otherwise
+		// peers would be unable to reach us over Tor.
+		if onionAddrsChanged {
+			s.wg.Add(1)
+			go s.announceNodeAddrs()
+		}
 		if err := s.fundingMgr.Start(); err != nil {
 			startErr = err
 			return
@@ -1193,6 +1376,12 @@ func (s *server) Start() error {
 			startErr = err
 			return
 		}
+		if s.feeController != nil {
+			if err := s.feeController.Start(); err != nil {
+				startErr = err
+				return
+			}
+		}
 
 		s.connMgr.Start()
 
@@ -1215,8 +1404,14 @@ func (s *server) Start() error {
 		// configure the set of active bootstrappers, and launch a
 		// dedicated goroutine to maintain a set of persistent
 		// connections.
-		if !cfg.NoNetBootstrap && !(cfg.Bitcoin.SimNet || cfg.Litecoin.SimNet) &&
-			!(cfg.Bitcoin.RegTest || cfg.Litecoin.RegTest) {
+		// On simnet and regtest, we'll only bootstrap if the user has
+		// explicitly configured a bootstrap source, as there are no
+		// public seeds for these networks.
+		localNet := cfg.Bitcoin.SimNet || cfg.Litecoin.SimNet ||
+			cfg.Bitcoin.RegTest || cfg.Litecoin.RegTest
+		explicitBootstrap := len(cfg.Bootstrappers) > 0 ||
+			cfg.BootstrapFile != ""
+		if !cfg.NoNetBootstrap && (!localNet || explicitBootstrap) {
 
 			bootstrappers, err := initNetworkBootstrappers(s)
 			if err != nil {
@@ -1230,6 +1425,11 @@ func (s *server) Start() error {
 			srvrLog.Infof("Auto peer bootstrapping is disabled")
 		}
 
+		if s.persistPeerErrors {
+			s.wg.Add(1)
+			go s.peerErrorWriter()
+		}
+
 		// Set the active flag now that we've completed the full
 		// startup.
 		atomic.StoreInt32(&s.active, 1)
@@ -1252,6 +1452,12 @@ func (s *server) Stop() error {
 			s.torController.Stop()
 		}
 
+		// Stop the fee controller first, as it relies on the gossiper
+		// and switch to apply new fees.
+		if s.feeController != nil {
+			s.feeController.Stop()
+		}
+
 		// Shutdown the wallet, funding manager, and the rpc server.
 		s.chanStatusMgr.Stop()
 		s.cc.chainNotifier.Stop()
@@ -1264,6 +1470,8 @@ func (s *server) Stop() error {
 		s.chainArb.Stop()
 		s.sweeper.Stop()
 		s.channelNotifier.Stop()
+		s.htlcNotifier.Stop()
+		s.customMessageServer.Stop()
 		s.cc.wallet.Shutdown()
 		s.cc.chainView.Stop()
 		s.connMgr.Stop()
@@ -1336,10 +1544,21 @@ func (s *server) removePortForwarding() {
 	}
 }
 
+// natRefreshInterval returns the interval at which we'll check for an updated
+// external IP address and renew our port mappings. Port mappings are renewed
+// once half of their lease has passed, ensuring they don't run out.
+func natRefreshInterval(lease time.Duration) time.Duration {
+	if lease > 0 && lease/2 < defaultNATRefreshInterval {
+		return lease / 2
+	}
+
+	return defaultNATRefreshInterval
+}
+
 // watchExternalIP continuously checks for an updated external IP address every
-// 15 minutes. Once a new IP address has been detected, it will automatically
-// handle port forwarding rules and send updated node announcements to the
-// currently connected peers.
+// 15 minutes, or more often if our port mappings would expire before then.
+// Once a new IP address has been detected, it will automatically handle port
+// forwarding rules and announce our new addresses to the network.
 //
 // NOTE: This MUST be run as a goroutine.
 func (s *server) watchExternalIP() {
@@ -1358,22 +1577,20 @@ func (s *server) watchExternalIP() {
 
 	forwardedPorts := s.natTraversal.ForwardedPorts()
 
-	ticker := time.NewTicker(15 * time.Minute)
+	refreshInterval := natRefreshInterval(
+		s.natTraversal.PortMappingLease(),
+	)
+	srvrLog.Debugf("Refreshing %s port mappings every %v",
+		s.natTraversal.Name(), refreshInterval)
+
+	ticker := time.NewTicker(refreshInterval)
 	defer ticker.Stop()
 out:
 	for {
 		select {
 		case <-ticker.C:
-			// We'll start off by making sure a new IP address has
-			// been detected.
-			ip, err := s.natTraversal.ExternalIP()
-			if err != nil {
-				srvrLog.Debugf("Unable to retrieve the "+
-					"external IP address: %v", err)
-				continue
-			}
-
-			// Periodically renew the NAT port forwarding.
+			// Periodically renew the NAT port forwarding, before
+			// the lease of the mappings runs out.
 			for _, port := range forwardedPorts {
 				err := s.natTraversal.AddPortMapping(port)
 				if err != nil {
@@ -1388,6 +1605,15 @@ out:
 				}
 			}
 
+			// Then, we'll check whether a new IP address has been
+			// detected.
+			ip, err := s.natTraversal.ExternalIP()
+			if err != nil {
+				srvrLog.Debugf("Unable to retrieve the "+
+					"external IP address: %v", err)
+				continue
+			}
+
 			if ip.Equal(s.lastDetectedIP) {
 				continue
 			}
@@ -1450,21 +1676,15 @@ out:
 			}
 
 			// Then, we'll generate a new timestamped node
-			// announcement with the updated addresses and broadcast
-			// it to our peers.
-			newNodeAnn, err := s.genNodeAnnouncement(
-				true, lnwire.UpdateNodeAnnAddrs(newAddrs),
+			// announcement with the updated addresses and hand it
+			// to the gossiper, which will persist it and broadcast
+			// it throughout the network.
+			_, err = s.updateNodeAnnouncement(
+				netann.NodeAnnSetAddrs(newAddrs),
 			)
 			if err != nil {
-				srvrLog.Debugf("Unable to generate new node "+
-					"announcement: %v", err)
-				continue
-			}
-
-			err = s.BroadcastMessage(nil, &newNodeAnn)
-			if err != nil {
-				srvrLog.Debugf("Unable to broadcast new node "+
-					"announcement to peers: %v", err)
+				srvrLog.Errorf("Unable to announce new "+
+					"addresses %v: %v", newAddrs, err)
 				continue
 			}
 
@@ -1482,26 +1702,47 @@ out:
 func initNetworkBootstrappers(s *server) ([]discovery.NetworkPeerBootstrapper, error) {
 	srvrLog.Infof("Initializing peer network bootstrappers!")
 
-	var bootStrappers []discovery.NetworkPeerBootstrapper
-
-	// First, we'll create an instance of the ChannelGraphBootstrapper as
-	// this can be used by default if we've already partially seeded the
-	// network.
-	chanGraph := autopilot.ChannelGraphFromDatabase(s.chanDB.ChannelGraph())
-	graphBootstrapper, err := discovery.NewGraphBootstrapper(chanGraph)
-	if err != nil {
-		return nil, err
+	// If the user hasn't configured an explicit set of bootstrappers,
+	// we'll fall back to the channel graph and DNS seeds, along with the
+	// bootstrap file if one was set.
+	bootstrapperNames := cfg.Bootstrappers
+	if len(bootstrapperNames) == 0 {
+		bootstrapperNames = []string{bootstrapperGraph, bootstrapperDNS}
+		if cfg.BootstrapFile != "" {
+			bootstrapperNames = append(
+				bootstrapperNames, bootstrapperFile,
+			)
+		}
 	}
-	bootStrappers = append(bootStrappers, graphBootstrapper)
 
-	// If this isn't simnet mode, then one of our additional bootstrapping
-	// sources will be the set of running DNS seeds.
-	if !cfg.Bitcoin.SimNet || !cfg.Litecoin.SimNet {
-		dnsSeeds, ok := chainDNSSeeds[*activeNetParams.GenesisHash]
+	var bootStrappers []discovery.NetworkPeerBootstrapper
+	for _, name := range bootstrapperNames {
+		switch name {
+
+		// The ChannelGraphBootstrapper can be used by default if we've
+		// already partially seeded the network.
+		case bootstrapperGraph:
+			chanGraph := autopilot.ChannelGraphFromDatabase(
+				s.chanDB.ChannelGraph(),
+			)
+			graphBootstrapper, err := discovery.NewGraphBootstrapper(
+				chanGraph,
+			)
+			if err != nil {
+				return nil, err
+			}
+			bootStrappers = append(bootStrappers, graphBootstrapper)
 
 		// If we have a set of DNS seeds for this chain, then we'll add
 		// it as an additional bootstrapping source.
-		if ok {
+		case bootstrapperDNS:
+			dnsSeeds, ok := chainDNSSeeds[*activeNetParams.GenesisHash]
+			if !ok {
+				srvrLog.Infof("No DNS seeds known for the active " +
+					"chain, skipping DNS peer bootstrapper")
+				continue
+			}
+
 			srvrLog.Infof("Creating DNS peer bootstrapper with "+
 				"seeds: %v", dnsSeeds)
 
@@ -1509,12 +1750,53 @@ func initNetworkBootstrappers(s *server) ([]discovery.NetworkPeerBootstrapper, e
 				dnsSeeds, cfg.net,
 			)
 			bootStrappers = append(bootStrappers, dnsBootStrapper)
+
+		case bootstrapperFile:
+			srvrLog.Infof("Creating file peer bootstrapper with "+
+				"file: %v", cfg.BootstrapFile)
+
+			fileBootstrapper := discovery.NewFileBootstrapper(
+				cfg.BootstrapFile, strconv.Itoa(defaultPeerPort),
+				cfg.net,
+			)
+			bootStrappers = append(bootStrappers, fileBootstrapper)
+
+		case bootstrapperLinkNode:
+			linkNodeBootstrapper := discovery.NewLinkNodeBootstrapper(
+				s.chanDB.FetchAllLinkNodes,
+			)
+			bootStrappers = append(
+				bootStrappers, linkNodeBootstrapper,
+			)
+
+		default:
+			return nil, fmt.Errorf("unknown bootstrapper: %v", name)
 		}
 	}
 
 	return bootStrappers, nil
 }
 
+// sampleBootstrapAddrs queries the passed bootstrappers for up to numAddrs
+// peer addresses, skipping the nodes within the ignore set. If the user
+// configured an explicit order of bootstrappers, it is respected, otherwise
+// the bootstrappers are queried in random order.
+func sampleBootstrapAddrs(ignore map[autopilot.NodeID]struct{},
+	numAddrs uint32,
+	bootstrappers []discovery.NetworkPeerBootstrapper) ([]*lnwire.NetAddress,
+	error) {
+
+	if len(cfg.Bootstrappers) > 0 {
+		return discovery.OrderedMultiSourceBootstrap(
+			ignore, numAddrs, bootstrappers...,
+		)
+	}
+
+	return discovery.MultiSourceBootstrap(
+		ignore, numAddrs, bootstrappers...,
+	)
+}
+
 // peerBootstrapper is a goroutine which is tasked with attempting to establish
 // and maintain a target minimum number of outbound connections. With this
 // invariant, we ensure that our node is connected to a diverse set of peers
@@ -1612,8 +1894,8 @@ func (s *server) peerBootstrapper(numTargetPeers uint32,
 			}
 			s.mu.RUnlock()
 
-			peerAddrs, err := discovery.MultiSourceBootstrap(
-				ignoreList, numNeeded*2, bootstrappers...,
+			peerAddrs, err := sampleBootstrapAddrs(
+				ignoreList, numNeeded*2, bootstrappers,
 			)
 			if err != nil {
 				srvrLog.Errorf("Unable to retrieve bootstrap "+
@@ -1679,8 +1961,8 @@ func (s *server) initialPeerBootstrap(ignore map[autopilot.NodeID]struct{},
 		// Otherwise, we'll request for the remaining number of peers in
 		// order to reach our target.
 		peersNeeded := numTargetPeers - numActivePeers
-		bootstrapAddrs, err := discovery.MultiSourceBootstrap(
-			ignore, peersNeeded, bootstrappers...,
+		bootstrapAddrs, err := sampleBootstrapAddrs(
+			ignore, peersNeeded, bootstrappers,
 		)
 		if err != nil {
 			srvrLog.Errorf("Unable to retrieve initial bootstrap "+
@@ -1725,12 +2007,156 @@ func (s *server) initialPeerBootstrap(ignore map[autopilot.NodeID]struct{},
 	}
 }
 
+// genOnionEncryptionKey derives the key that we'll use to encrypt the private
+// keys of our onion services at rest. Similar to the static channel backups,
+// the key is the sha2 of a base key that we get from the keyring.
+func genOnionEncryptionKey(keyRing keychain.KeyRing) ([]byte, error) {
+	baseKey, err := keyRing.DeriveKey(keychain.KeyLocator{
+		Family: keychain.KeyFamilyTorOnion,
+		Index:  0,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	encryptionKey := sha256.Sum256(baseKey.PubKey.SerializeCompressed())
+
+	return encryptionKey[:], nil
+}
+
+// newOnionStore creates the store holding the private key of an onion service
+// at the given path. If requested, the private key will be encrypted at rest
+// using a key derived from our wallet.
+func (s *server) newOnionStore(privateKeyPath string) (tor.OnionStore, error) {
+	var store tor.OnionStore = tor.NewOnionFile(privateKeyPath, 0600)
+	if !cfg.Tor.EncryptKey {
+		return store, nil
+	}
+
+	encryptionKey, err := genOnionEncryptionKey(s.cc.keyRing)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive onion encryption "+
+			"key: %v", err)
+	}
+
+	return tor.NewEncryptedOnionStore(store, encryptionKey)
+}
+
+// firstTCPPort returns the port of the first TCP address within the passed
+// set of addresses, or zero if there is none.
+func firstTCPPort(addrs []net.Addr) int {
+	for _, addr := range addrs {
+		if tcpAddr, ok := addr.(*net.TCPAddr); ok {
+			return tcpAddr.Port
+		}
+	}
+
+	return 0
+}
+
+// initRPCOnion sets up a v3 onion service exposing our gRPC and REST
+// interfaces, allowing them to be reached over Tor without any port
+// forwarding. The resulting URIs are written next to our TLS certificate.
+func (s *server) initRPCOnion() error {
+	// We'll expose the first TCP listener of each interface, using the
+	// same port for the onion service as we're listening on locally.
+	grpcPort := firstTCPPort(cfg.RPCListeners)
+	restPort := firstTCPPort(cfg.RESTListeners)
+	if grpcPort == 0 && restPort == 0 {
+		return errors.New("no TCP RPC listeners to expose over Tor")
+	}
+
+	store, err := s.newOnionStore(cfg.Tor.RPCKeyPath)
+	if err != nil {
+		return err
+	}
+	onionCfg := tor.AddOnionConfig{
+		Type:         tor.V3,
+		VirtualPort:  grpcPort,
+		Store:        store,
+		ClientAuthV3: cfg.Tor.RPCClientAuth,
+	}
+
+	// If both interfaces share the same port, it only needs to be exposed
+	// once.
+	switch {
+	case grpcPort == 0:
+		onionCfg.VirtualPort = restPort
+	case restPort != 0 && restPort != grpcPort:
+		onionCfg.ExtraPorts = map[int]int{restPort: restPort}
+	}
+
+	addr, err := s.torController.AddOnion(onionCfg)
+	if err != nil {
+		return err
+	}
+
+	var uris []string
+	if grpcPort != 0 {
+		uris = append(uris, net.JoinHostPort(
+			addr.OnionService, strconv.Itoa(grpcPort),
+		))
+	}
+	if restPort != 0 {
+		uris = append(uris, "https://"+net.JoinHostPort(
+			addr.OnionService, strconv.Itoa(restPort),
+		))
+	}
+
+	// We'll write the URIs next to our TLS certificate, so they can be
+	// picked up together with it when setting up a remote client.
+	urisPath := filepath.Join(
+		filepath.Dir(cfg.TLSCertPath), defaultTorRPCOnionURIsFilename,
+	)
+	err = ioutil.WriteFile(
+		urisPath, []byte(strings.Join(uris, "\n")+"\n"), 0600,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to write RPC onion URIs: %v", err)
+	}
+
+	s.rpcOnionURIsMu.Lock()
+	s.rpcOnionURIs = uris
+	s.rpcOnionURIsMu.Unlock()
+
+	srvrLog.Infof("RPC interfaces reachable over Tor at %v",
+		strings.Join(uris, ", "))
+
+	return nil
+}
+
+// RPCOnionURIs returns the set of URIs our gRPC and REST interfaces can be
+// reached at over Tor, if exposed through an onion service.
+func (s *server) RPCOnionURIs() []string {
+	s.rpcOnionURIsMu.RLock()
+	defer s.rpcOnionURIsMu.RUnlock()
+
+	uris := make([]string, len(s.rpcOnionURIs))
+	copy(uris, s.rpcOnionURIs)
+
+	return uris
+}
+
 // initTorController initiliazes the Tor controller backed by lnd and
-// automatically sets up a v2 onion service in order to listen for inbound
-// connections over Tor.
-func (s *server) initTorController() error {
+// automatically sets up a v2 or v3 onion service in order to listen for
+// inbound connections over Tor, as well as an onion service for our RPC
+// interfaces if requested. The returned boolean indicates whether the onion
+// addresses we advertise changed since the last time we were running.
+func (s *server) initTorController() (bool, error) {
 	if err := s.torController.Start(); err != nil {
-		return err
+		return false, err
+	}
+
+	if cfg.Tor.RPCOnion {
+		if err := s.initRPCOnion(); err != nil {
+			return false, err
+		}
+	}
+
+	// If we weren't requested to accept inbound peer connections over
+	// Tor, then there's nothing left to set up.
+	if !cfg.Tor.V2 && !cfg.Tor.V3 {
+		return false, nil
 	}
 
 	// Determine the different ports the server is listening on. The onion
@@ -1744,11 +2170,17 @@ func (s *server) initTorController() error {
 
 	// Once the port mapping has been set, we can go ahead and automatically
 	// create our onion service. The service's private key will be saved to
-	// disk in order to regain access to this service when restarting `lnd`.
+	// its store in order to regain access to this service when restarting
+	// `lnd`.
+	store, err := s.newOnionStore(cfg.Tor.PrivateKeyPath)
+	if err != nil {
+		return false, err
+	}
 	onionCfg := tor.AddOnionConfig{
-		VirtualPort:    defaultPeerPort,
-		TargetPorts:    listenPorts,
-		PrivateKeyPath: cfg.Tor.PrivateKeyPath,
+		VirtualPort:  defaultPeerPort,
+		TargetPorts:  listenPorts,
+		Store:        store,
+		ClientAuthV3: cfg.Tor.V3ClientAuth,
 	}
 
 	switch {
@@ -1760,18 +2192,41 @@ func (s *server) initTorController() error {
 
 	addr, err := s.torController.AddOnion(onionCfg)
 	if err != nil {
-		return err
+		return false, err
+	}
+
+	// Before updating our announcement, we'll fetch the onion addresses
+	// we advertised previously, so we can determine whether the network
+	// needs to learn about a new one.
+	var prevOnionAddrs []net.Addr
+	prevSelfNode, err := s.chanDB.ChannelGraph().SourceNode()
+	if err != nil {
+		return false, fmt.Errorf("unable to fetch self node: %v", err)
+	}
+	for _, prevAddr := range prevSelfNode.Addresses {
+		if _, ok := prevAddr.(*tor.OnionAddr); ok {
+			prevOnionAddrs = append(prevOnionAddrs, prevAddr)
+		}
 	}
+	changed := len(prevOnionAddrs) != 1 ||
+		prevOnionAddrs[0].String() != addr.String()
 
 	// Now that the onion service has been created, we'll add the onion
-	// address it can be reached at to our list of advertised addresses.
+	// address it can be reached at to our list of advertised addresses,
+	// replacing any onion address we may have advertised before.
 	newNodeAnn, err := s.genNodeAnnouncement(
 		true, func(currentAnn *lnwire.NodeAnnouncement) {
-			currentAnn.Addresses = append(currentAnn.Addresses, addr)
+			addrs := make([]net.Addr, 0, len(currentAnn.Addresses)+1)
+			for _, a := range currentAnn.Addresses {
+				if _, ok := a.(*tor.OnionAddr); !ok {
+					addrs = append(addrs, a)
+				}
+			}
+			currentAnn.Addresses = append(addrs, addr)
 		},
 	)
 	if err != nil {
-		return fmt.Errorf("Unable to generate new node "+
+		return false, fmt.Errorf("Unable to generate new node "+
 			"announcement: %v", err)
 	}
 
@@ -1790,17 +2245,66 @@ func (s *server) initTorController() error {
 	}
 	copy(selfNode.PubKeyBytes[:], s.identityPriv.PubKey().SerializeCompressed())
 	if err := s.chanDB.ChannelGraph().SetSourceNode(selfNode); err != nil {
-		return fmt.Errorf("can't set self node: %v", err)
+		return false, fmt.Errorf("can't set self node: %v", err)
 	}
 
-	return nil
+	if changed {
+		srvrLog.Infof("Advertised onion address changed to %v", addr)
+	}
+
+	return changed, nil
+}
+
+// announceNodeAddrs announces our current set of addresses to the network.
+//
+// NOTE: This MUST be run as a goroutine.
+func (s *server) announceNodeAddrs() {
+	defer s.wg.Done()
+
+	nodeAnn, err := s.updateNodeAnnouncement()
+	if err != nil {
+		srvrLog.Errorf("Unable to announce node addresses: %v", err)
+		return
+	}
+
+	srvrLog.Infof("Announced node addresses %v to the network",
+		nodeAnn.Addresses)
+}
+
+// updateNodeAnnouncement generates a fresh node announcement with the given
+// modifications applied, and hands it to the gossiper. The gossiper will
+// persist the announcement within our graph and propagate it throughout the
+// network, including to peers that connect to us later on.
+func (s *server) updateNodeAnnouncement(
+	mods ...netann.NodeAnnModifier) (*lnwire.NodeAnnouncement, error) {
+
+	nodeAnn, err := s.genNodeAnnouncement(true, mods...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate new node "+
+			"announcement: %v", err)
+	}
+
+	errChan := s.authGossiper.ProcessLocalAnnouncement(
+		&nodeAnn, s.identityPriv.PubKey(),
+	)
+	select {
+	case err := <-errChan:
+		if err != nil {
+			return nil, err
+		}
+
+	case <-s.quit:
+		return nil, ErrServerShuttingDown
+	}
+
+	return &nodeAnn, nil
 }
 
 // genNodeAnnouncement generates and returns the current fully signed node
 // announcement. If refresh is true, then the time stamp of the announcement
 // will be updated in order to ensure it propagates through the network.
 func (s *server) genNodeAnnouncement(refresh bool,
-	updates ...func(*lnwire.NodeAnnouncement)) (lnwire.NodeAnnouncement, error) {
+	updates ...netann.NodeAnnModifier) (lnwire.NodeAnnouncement, error) {
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -1813,32 +2317,16 @@ func (s *server) genNodeAnnouncement(refresh bool,
 
 	// Now that we know we need to update our copy, we'll apply all the
 	// function updates that'll mutate the current version of our node
+	// announcement, bump its timestamp, and generate a new signature over
+	// it to ensure nodes on the network accept the new authenticated
 	// announcement.
-	for _, update := range updates {
-		update(s.currentNodeAnn)
-	}
-
-	// We'll now update the timestamp, ensuring that with each update, the
-	// timestamp monotonically increases.
-	newStamp := uint32(time.Now().Unix())
-	if newStamp <= s.currentNodeAnn.Timestamp {
-		newStamp = s.currentNodeAnn.Timestamp + 1
-	}
-	s.currentNodeAnn.Timestamp = newStamp
-
-	// Now that the announcement is fully updated, we'll generate a new
-	// signature over the announcement to ensure nodes on the network
-	// accepted the new authenticated announcement.
-	sig, err := discovery.SignAnnouncement(
+	err := netann.SignNodeAnnouncement(
 		s.nodeSigner, s.identityPriv.PubKey(), s.currentNodeAnn,
+		updates...,
 	)
 	if err != nil {
 		return lnwire.NodeAnnouncement{}, err
 	}
-	s.currentNodeAnn.Signature, err = lnwire.NewSigFromSignature(sig)
-	if err != nil {
-		return lnwire.NodeAnnouncement{}, err
-	}
 
 	return *s.currentNodeAnn, nil
 }
@@ -2209,6 +2697,43 @@ func (s *server) findPeerByPubStr(pubStr string) (*peer, error) {
 	return peer, nil
 }
 
+// CustomMessage is a message within the custom range that was received from
+// one of our peers.
+type CustomMessage struct {
+	// Peer is the identity public key of the peer that sent the message.
+	Peer [33]byte
+
+	// Msg is the custom message itself.
+	Msg *lnwire.Custom
+}
+
+// SendCustomMessage sends a custom message of the given type to the target
+// peer. The message is queued within the peer's regular write queue, and this
+// method blocks until it has been written to the wire or the peer exits.
+//
+// NOTE: This function is safe for concurrent access.
+func (s *server) SendCustomMessage(peerPub [33]byte, msgType lnwire.MessageType,
+	data []byte) error {
+
+	msg, err := lnwire.NewCustom(msgType, data)
+	if err != nil {
+		return err
+	}
+
+	targetPeer, err := s.FindPeerByPubStr(string(peerPub[:]))
+	if err != nil {
+		return err
+	}
+
+	return targetPeer.SendMessageLazy(true, msg)
+}
+
+// SubscribeCustomMessages returns a subscription client that will receive a
+// *CustomMessage for each custom message received from any of our peers.
+func (s *server) SubscribeCustomMessages() (*subscribe.Client, error) {
+	return s.customMessageServer.Subscribe()
+}
+
 // nextPeerBackoff computes the next backoff duration for a peer's pubkey using
 // exponential backoff. If no previous backoff was known, the default is
 // returned.
@@ -2318,7 +2843,10 @@ func (s *server) InboundPeerConnected(conn net.Conn) {
 	switch err {
 	case ErrPeerNotConnected:
 		// We were unable to locate an existing connection with the
-		// target peer, proceed to connect.
+		// target peer, proceed to connect. If we don't have any
+		// channels with the peer, we may first need to make room for
+		// it among our other channel-less peers.
+		s.evictChanlessPeer(pubStr)
 		s.cancelConnReqs(pubStr, nil)
 		s.peerConnected(conn, nil, true)
 
@@ -2648,6 +3176,15 @@ func (s *server) peerInitializer(p *peer) {
 	// was successful, and to begin watching the peer's wait group.
 	close(ready)
 
+	// If we have channels with the peer, we'll record that it's online.
+	if s.hasLinkNode(p) {
+		err := s.chanDB.RecordPeerOnline(p.PubKey(), p.StartTime())
+		if err != nil {
+			srvrLog.Errorf("Unable to record peer %v as online: %v",
+				p, err)
+		}
+	}
+
 	pubStr := string(p.addr.IdentityKey.SerializeCompressed())
 
 	s.mu.Lock()
@@ -2748,6 +3285,11 @@ func (s *server) peerTerminationWatcher(p *peer, ready chan struct{}) {
 		return
 	}
 
+	// Record the flap of the connection if we have channels with the peer,
+	// otherwise we'll forget about any errors it sent us. We only do so
+	// now, as connections that were replaced by a newer one didn't flap.
+	s.recordPeerDisconnect(p)
+
 	// First, cleanup any remaining state the server has regarding the peer
 	// in question.
 	s.removePeer(p)
@@ -2822,6 +3364,276 @@ func (s *server) peerTerminationWatcher(p *peer, ready chan struct{}) {
 	}
 }
 
+// evictChanlessPeer ensures that there's room for a new inbound peer with the
+// given pubkey. If we don't have any channels with the new peer, and we've
+// already reached the maximum number of inbound peers without channels, then
+// the oldest of these peers is disconnected.
+//
+// NOTE: This function MUST be called with the server's mutex held.
+func (s *server) evictChanlessPeer(pubStr string) {
+	maxChanlessPeers := cfg.ConnLimit.MaxChanlessPeers
+	if maxChanlessPeers == 0 {
+		return
+	}
+
+	// If we don't even have as many inbound peers as we allow without
+	// channels, there's nothing to evict, so we can avoid hitting the
+	// database on every inbound connection.
+	if len(s.inboundPeers) < maxChanlessPeers {
+		return
+	}
+
+	// We'll fetch all of our link nodes at once, rather than querying the
+	// database for each of our inbound peers.
+	linkNodes, err := s.chanDB.FetchAllLinkNodes()
+	if err != nil {
+		srvrLog.Errorf("Unable to fetch link nodes: %v", err)
+		return
+	}
+	withChans := make(map[string]struct{}, len(linkNodes))
+	for _, linkNode := range linkNodes {
+		nodePub := string(linkNode.IdentityPub.SerializeCompressed())
+		withChans[nodePub] = struct{}{}
+	}
+
+	// Peers we have channels with are never subject to eviction.
+	if _, ok := withChans[pubStr]; ok {
+		return
+	}
+
+	var (
+		numChanless int
+		oldest      *peer
+	)
+	for peerPub, p := range s.inboundPeers {
+		if _, ok := withChans[peerPub]; ok {
+			continue
+		}
+
+		// Peers that are already disconnecting will be removed
+		// shortly, so they don't count towards the limit.
+		if atomic.LoadInt32(&p.disconnect) == 1 {
+			continue
+		}
+
+		numChanless++
+		if oldest == nil || p.connectedAt.Before(oldest.connectedAt) {
+			oldest = p
+		}
+	}
+
+	if numChanless < maxChanlessPeers {
+		return
+	}
+
+	srvrLog.Infof("Reached max of %d inbound peers without channels, "+
+		"evicting %v", maxChanlessPeers, oldest)
+
+	atomic.AddUint64(&s.numEvictedPeers, 1)
+
+	// The peer termination watcher will remove the peer from our state
+	// once it has fully disconnected.
+	oldest.Disconnect(fmt.Errorf("evicted to make room for new inbound " +
+		"peer without channels"))
+}
+
+// hasLinkNode returns whether we have a link node for the given peer, i.e.
+// whether we have channels with it.
+func (s *server) hasLinkNode(p *peer) bool {
+	_, err := s.chanDB.FetchLinkNode(p.addr.IdentityKey)
+	switch {
+	case err == nil:
+		return true
+
+	case err != channeldb.ErrNodeNotFound &&
+		err != channeldb.ErrLinkNodesNotFound:
+
+		srvrLog.Errorf("Unable to fetch link node for peer %v: %v",
+			p, err)
+	}
+
+	return false
+}
+
+// recordPeerDisconnect updates the persisted flap stats of a peer we have
+// channels with after its connection went down. For any other peer, the
+// errors exchanged with it are dropped, as we only keep them around across
+// connections for peers we have channels with.
+func (s *server) recordPeerDisconnect(p *peer) {
+	if !s.hasLinkNode(p) {
+		s.peerErrorsMtx.Lock()
+		delete(s.peerErrors, string(p.pubKeyBytes[:]))
+		s.peerErrorsMtx.Unlock()
+
+		return
+	}
+
+	// A connection that was never fully established didn't flap.
+	if p.StartTime().IsZero() {
+		return
+	}
+
+	stats, err := s.chanDB.RecordPeerFlap(p.PubKey(), time.Now())
+	if err != nil {
+		srvrLog.Errorf("Unable to record flap of peer %v: %v", p, err)
+		return
+	}
+
+	srvrLog.Debugf("Peer %v flapped, total flap count: %v", p,
+		stats.FlapCount)
+}
+
+// peerErrorWrite is an error exchanged with a peer that is queued for
+// persistence.
+type peerErrorWrite struct {
+	peer    *peer
+	peerErr *channeldb.PeerError
+}
+
+// recordPeerError stores an error exchanged with the given peer within its
+// error buffer, dropping the oldest error if the buffer is full. If enabled,
+// the error is also queued to be persisted by the peerErrorWriter, such that
+// this method never blocks on the database.
+func (s *server) recordPeerError(p *peer, msg *lnwire.Error, outgoing bool) {
+	peerErr := &channeldb.PeerError{
+		Timestamp: time.Now(),
+		Outgoing:  outgoing,
+		ChanID:    msg.ChanID,
+		Data:      msg.Data,
+	}
+
+	s.peerErrorsMtx.Lock()
+	s.peerErrorBuffer(p.pubKeyBytes).Add(peerErr)
+	s.peerErrorsMtx.Unlock()
+
+	if !s.persistPeerErrors {
+		return
+	}
+
+	select {
+	case s.peerErrorWrites <- &peerErrorWrite{peer: p, peerErr: peerErr}:
+	default:
+		srvrLog.Debugf("Persistence queue full, not persisting error "+
+			"exchanged with peer %v", p)
+	}
+}
+
+// peerErrorWriter persists the errors exchanged with peers we have channels
+// with. Queued errors are written once every peerErrorWriteInterval, and only
+// the most recent maxPeerErrors errors of each peer are written, as any older
+// ones would be dropped from the database right away.
+//
+// NOTE: This MUST be run as a goroutine.
+func (s *server) peerErrorWriter() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(peerErrorWriteInterval)
+	defer ticker.Stop()
+
+	pending := make(map[*peer][]*channeldb.PeerError)
+	addPending := func(w *peerErrorWrite) {
+		peerErrs := append(pending[w.peer], w.peerErr)
+		if len(peerErrs) > maxPeerErrors {
+			peerErrs = peerErrs[len(peerErrs)-maxPeerErrors:]
+		}
+		pending[w.peer] = peerErrs
+	}
+
+	for {
+		select {
+		case w := <-s.peerErrorWrites:
+			addPending(w)
+
+		case <-ticker.C:
+			s.writePeerErrors(pending)
+			pending = make(map[*peer][]*channeldb.PeerError)
+
+		// Before exiting, we'll write out any errors that are still
+		// queued, such that they survive the restart.
+		case <-s.quit:
+		drain:
+			for {
+				select {
+				case w := <-s.peerErrorWrites:
+					addPending(w)
+				default:
+					break drain
+				}
+			}
+			s.writePeerErrors(pending)
+
+			return
+		}
+	}
+}
+
+// writePeerErrors persists the given errors exchanged with peers, skipping
+// those of peers we don't have channels with.
+func (s *server) writePeerErrors(pending map[*peer][]*channeldb.PeerError) {
+	for p, peerErrs := range pending {
+		if !s.hasLinkNode(p) {
+			continue
+		}
+
+		for _, peerErr := range peerErrs {
+			err := s.chanDB.AddPeerError(
+				p.pubKeyBytes, peerErr, maxPeerErrors,
+			)
+			if err != nil {
+				srvrLog.Errorf("Unable to persist error "+
+					"exchanged with peer %v: %v", p, err)
+				break
+			}
+		}
+	}
+}
+
+// PeerErrors returns the most recent errors exchanged with the given peer,
+// ordered from oldest to newest.
+func (s *server) PeerErrors(pubKey [33]byte) []*channeldb.PeerError {
+	s.peerErrorsMtx.Lock()
+	defer s.peerErrorsMtx.Unlock()
+
+	items := s.peerErrorBuffer(pubKey).List()
+
+	peerErrs := make([]*channeldb.PeerError, 0, len(items))
+	for _, item := range items {
+		peerErrs = append(peerErrs, item.(*channeldb.PeerError))
+	}
+
+	return peerErrs
+}
+
+// peerErrorBuffer returns the error buffer of the given peer, creating it if
+// it doesn't exist yet. If persistence is enabled, a newly created buffer is
+// populated with the errors persisted for the peer.
+//
+// NOTE: This method MUST be called with the peerErrorsMtx held.
+func (s *server) peerErrorBuffer(pubKey [33]byte) *queue.CircularBuffer {
+	pubStr := string(pubKey[:])
+	if buffer, ok := s.peerErrors[pubStr]; ok {
+		return buffer
+	}
+
+	// As maxPeerErrors is positive, creating the buffer can't fail.
+	buffer, _ := queue.NewCircularBuffer(maxPeerErrors)
+
+	if s.persistPeerErrors {
+		peerErrs, err := s.chanDB.FetchPeerErrors(pubKey)
+		if err != nil {
+			srvrLog.Errorf("Unable to fetch errors exchanged with "+
+				"peer %x: %v", pubKey[:], err)
+		}
+		for _, peerErr := range peerErrs {
+			buffer.Add(peerErr)
+		}
+	}
+
+	s.peerErrors[pubStr] = buffer
+
+	return buffer
+}
+
 // removePeer removes the passed peer from the server's state of all active
 // peers.
 func (s *server) removePeer(p *peer) {
@@ -3170,3 +3982,75 @@ func (s *server) applyChannelUpdate(update *lnwire.ChannelUpdate) error {
 		return ErrServerShuttingDown
 	}
 }
+
+// fetchFeeControllerChannels returns the balances and current routing policies
+// of all of our open channels that have been announced to the router.
+func (s *server) fetchFeeControllerChannels() ([]*chanfees.ChannelInfo, error) {
+	openChannels, err := s.chanDB.FetchAllOpenChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	channelsByPoint := make(map[wire.OutPoint]*channeldb.OpenChannel)
+	for _, channel := range openChannels {
+		channelsByPoint[channel.FundingOutpoint] = channel
+	}
+
+	var channels []*chanfees.ChannelInfo
+	err = s.chanRouter.ForAllOutgoingChannels(func(
+		info *channeldb.ChannelEdgeInfo,
+		edge *channeldb.ChannelEdgePolicy) error {
+
+		channel, ok := channelsByPoint[info.ChannelPoint]
+		if !ok {
+			return nil
+		}
+
+		commitment := channel.LocalCommitment
+		chanID := lnwire.NewShortChanIDFromInt(info.ChannelID)
+		channels = append(channels, &chanfees.ChannelInfo{
+			ChanID:        chanID,
+			ChanPoint:     info.ChannelPoint,
+			LocalBalance:  commitment.LocalBalance,
+			RemoteBalance: commitment.RemoteBalance,
+			BaseFee:       edge.FeeBaseMSat,
+			FeeRate:       uint32(edge.FeeProportionalMillionths),
+			TimeLockDelta: uint32(edge.TimeLockDelta),
+			LastUpdate:    edge.LastUpdate,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return channels, nil
+}
+
+// updateChannelPolicy announces a new routing policy for the given channel to
+// the network, and applies it to the channel's link if it is active.
+func (s *server) updateChannelPolicy(chanPoint wire.OutPoint,
+	policy routing.ChannelPolicy) error {
+
+	err := s.authGossiper.PropagateChanPolicyUpdate(policy, chanPoint)
+	if err != nil {
+		return err
+	}
+
+	err = s.htlcSwitch.UpdateForwardingPolicies(
+		htlcswitch.ForwardingPolicy{
+			BaseFee:       policy.BaseFee,
+			FeeRate:       lnwire.MilliSatoshi(policy.FeeRate),
+			TimeLockDelta: policy.TimeLockDelta,
+		}, chanPoint,
+	)
+	if err != nil {
+		// The link may simply be offline, in which case it'll pick up
+		// the new policy from the graph once it comes back online.
+		srvrLog.Warnf("Unable to update link fees of %v: %v",
+			chanPoint, err)
+	}
+
+	return nil
+}
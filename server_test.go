@@ -2,7 +2,20 @@
 
 package lnd
 
-import "testing"
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/queue"
+)
 
 func TestParseHexColor(t *testing.T) {
 	var colorTestCases = []struct {
@@ -41,3 +54,151 @@ func TestParseHexColor(t *testing.T) {
 		}
 	}
 }
+
+// TestNATRefreshInterval ensures that port mappings are renewed before their
+// lease runs out, while never refreshing less often than the default.
+func TestNATRefreshInterval(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		lease    time.Duration
+		expected time.Duration
+	}{
+		{0, defaultNATRefreshInterval},
+		{2 * time.Hour, defaultNATRefreshInterval},
+		{2 * defaultNATRefreshInterval, defaultNATRefreshInterval},
+		{10 * time.Minute, 5 * time.Minute},
+		{time.Minute, 30 * time.Second},
+	}
+
+	for _, test := range testCases {
+		interval := natRefreshInterval(test.lease)
+		if interval != test.expected {
+			t.Fatalf("expected refresh interval %v for lease %v, "+
+				"got %v", test.expected, test.lease, interval)
+		}
+	}
+}
+
+// TestRecordPeerError asserts that the server only keeps the most recent
+// maxPeerErrors errors exchanged with a peer, oldest first.
+func TestRecordPeerError(t *testing.T) {
+	t.Parallel()
+
+	s := &server{
+		peerErrors: make(map[string]*queue.CircularBuffer),
+	}
+	p := &peer{}
+
+	if errs := s.PeerErrors(p.pubKeyBytes); len(errs) != 0 {
+		t.Fatalf("expected no errors, got %v", len(errs))
+	}
+
+	const numErrors = maxPeerErrors + 5
+	for i := 0; i < numErrors; i++ {
+		msg := &lnwire.Error{
+			Data: lnwire.ErrorData(fmt.Sprintf("error %d", i)),
+		}
+		s.recordPeerError(p, msg, i%2 == 0)
+	}
+
+	errs := s.PeerErrors(p.pubKeyBytes)
+	if len(errs) != maxPeerErrors {
+		t.Fatalf("expected %v errors, got %v", maxPeerErrors,
+			len(errs))
+	}
+	for i, peerErr := range errs {
+		n := numErrors - maxPeerErrors + i
+		expected := fmt.Sprintf("error %d", n)
+		if string(peerErr.Data) != expected {
+			t.Fatalf("expected error %q at index %v, got %q",
+				expected, i, peerErr.Data)
+		}
+		if peerErr.Outgoing != (n%2 == 0) {
+			t.Fatalf("expected outgoing=%v at index %v",
+				n%2 == 0, i)
+		}
+	}
+}
+
+// TestPeerErrorWriter asserts that errors exchanged with a peer we have
+// channels with are persisted by the peerErrorWriter, rather than by the
+// caller of recordPeerError, and that queued errors are written on shutdown.
+func TestPeerErrorWriter(t *testing.T) {
+	t.Parallel()
+
+	tempDir, err := ioutil.TempDir("", "peererrors")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	db, err := channeldb.Open(tempDir)
+	if err != nil {
+		t.Fatalf("unable to open db: %v", err)
+	}
+	defer db.Close()
+
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	pubKey := privKey.PubKey()
+
+	addr := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 9735}
+	linkNode := db.NewLinkNode(wire.MainNet, pubKey, addr)
+	if err := linkNode.Sync(); err != nil {
+		t.Fatalf("unable to add link node: %v", err)
+	}
+
+	s := &server{
+		chanDB:            db,
+		peerErrors:        make(map[string]*queue.CircularBuffer),
+		persistPeerErrors: true,
+		peerErrorWrites: make(
+			chan *peerErrorWrite, peerErrorQueueSize,
+		),
+		quit: make(chan struct{}),
+	}
+	p := &peer{
+		addr: &lnwire.NetAddress{IdentityKey: pubKey},
+	}
+	copy(p.pubKeyBytes[:], pubKey.SerializeCompressed())
+
+	s.wg.Add(1)
+	go s.peerErrorWriter()
+
+	const numErrors = maxPeerErrors + 5
+	for i := 0; i < numErrors; i++ {
+		msg := &lnwire.Error{
+			Data: lnwire.ErrorData(fmt.Sprintf("error %d", i)),
+		}
+		s.recordPeerError(p, msg, false)
+	}
+
+	// The errors are kept in memory right away, while they're only
+	// persisted once the writer flushes them.
+	if errs := s.PeerErrors(p.pubKeyBytes); len(errs) != maxPeerErrors {
+		t.Fatalf("expected %v errors, got %v", maxPeerErrors,
+			len(errs))
+	}
+
+	close(s.quit)
+	s.wg.Wait()
+
+	peerErrs, err := db.FetchPeerErrors(p.pubKeyBytes)
+	if err != nil {
+		t.Fatalf("unable to fetch peer errors: %v", err)
+	}
+	if len(peerErrs) != maxPeerErrors {
+		t.Fatalf("expected %v persisted errors, got %v",
+			maxPeerErrors, len(peerErrs))
+	}
+	for i, peerErr := range peerErrs {
+		expected := fmt.Sprintf("error %d", numErrors-maxPeerErrors+i)
+		if string(peerErr.Data) != expected {
+			t.Fatalf("expected error %q at index %v, got %q",
+				expected, i, peerErr.Data)
+		}
+	}
+}
@@ -72,7 +72,8 @@ func (s *subRPCServerConfigs) PopulateDependencies(cc *chainControl,
 	chanRouter *routing.ChannelRouter,
 	routerBackend *routerrpc.RouterBackend,
 	nodeSigner *netann.NodeSigner,
-	chanDB *channeldb.DB) error {
+	chanDB *channeldb.DB,
+	htlcNotifier *htlcswitch.HtlcNotifier) error {
 
 	// First, we'll use reflect to obtain a version of the config struct
 	// that allows us to programmatically inspect its fields.
@@ -203,6 +204,9 @@ func (s *subRPCServerConfigs) PopulateDependencies(cc *chainControl,
 			subCfgValue.FieldByName("RouterBackend").Set(
 				reflect.ValueOf(routerBackend),
 			)
+			subCfgValue.FieldByName("HtlcNotifier").Set(
+				reflect.ValueOf(htlcNotifier),
+			)
 
 		default:
 			return fmt.Errorf("unknown field: %v, %T", fieldName,
@@ -10,7 +10,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/textproto"
-	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"sync/atomic"
@@ -36,6 +36,11 @@ const (
 	// must be running on. This is needed in order to create v3 onion
 	// services through Tor's control port.
 	MinTorVersion = "0.3.3.6"
+
+	// MinTorV3ClientAuthVersion is the minimum version the Tor server must
+	// be running on in order to restrict access to v3 onion services
+	// through Tor's control port to a set of authorized clients.
+	MinTorV3ClientAuthVersion = "0.4.6.1"
 )
 
 var (
@@ -323,11 +328,46 @@ func computeHMAC256(key, message []byte) []byte {
 // Tor's control port. The version string should be of the format:
 //	major.minor.revision.build
 func supportsV3(version string) error {
-	// We'll split the minimum Tor version that's supported and the given
+	return supportsVersion(version, MinTorVersion)
+}
+
+// supportsVersion is a helper function that parses the current version of the
+// Tor server and determines whether it is at least the given minimum version.
+// The version strings should be of the format:
+//	major.minor.revision.build
+func supportsVersion(version, minVersion string) error {
+	// We'll parse the minimum Tor version that's supported and the given
 	// version in order to individually compare each number.
+	parts, err := parseTorVersion(version)
+	if err != nil {
+		return err
+	}
+	minParts, err := parseTorVersion(minVersion)
+	if err != nil {
+		return err
+	}
+
+	// The numbers are compared from most to least significant, as a
+	// string comparison would consider e.g. 0.4.10.0 to be below 0.4.6.1.
+	for i := range parts {
+		if parts[i] > minParts[i] {
+			return nil
+		}
+		if parts[i] < minParts[i] {
+			return fmt.Errorf("version %v below minimum version "+
+				"supported %v", version, minVersion)
+		}
+	}
+
+	return nil
+}
+
+// parseTorVersion parses a Tor version string of the format
+// major.minor.revision.build into its individual numbers.
+func parseTorVersion(version string) ([]int, error) {
 	parts := strings.Split(version, ".")
 	if len(parts) != 4 {
-		return errors.New("version string is not of the format " +
+		return nil, errors.New("version string is not of the format " +
 			"major.minor.revision.build")
 	}
 
@@ -338,21 +378,16 @@ func supportsV3(version string) error {
 	parts[len(parts)-1] = build[0]
 
 	// Ensure that each part of the version string corresponds to a number.
+	numbers := make([]int, 0, len(parts))
 	for _, part := range parts {
-		if _, err := strconv.Atoi(part); err != nil {
-			return err
+		number, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, err
 		}
+		numbers = append(numbers, number)
 	}
 
-	// Once we've determined we have a proper version string of the format
-	// major.minor.revision.build, we can just do a string comparison to
-	// determine if it satisfies the minimum version supported.
-	if version < MinTorVersion {
-		return fmt.Errorf("version %v below minimum version supported "+
-			"%v", version, MinTorVersion)
-	}
-
-	return nil
+	return numbers, nil
 }
 
 // ProtocolInfo returns the different authentication methods supported by the
@@ -427,9 +462,27 @@ type AddOnionConfig struct {
 	// port.
 	TargetPorts []int
 
-	// PrivateKeyPath is the full path to where the onion service's private
-	// key is stored. This can be used to restore an existing onion service.
-	PrivateKeyPath string
+	// ExtraPorts maps additional virtual ports of the onion service to the
+	// local port traffic to them should be forwarded to. This allows a
+	// single onion service to expose several services, e.g. both the gRPC
+	// and REST interfaces.
+	ExtraPorts map[int]int
+
+	// Store is the store holding the onion service's private key. This
+	// can be used to restore an existing onion service, such that it keeps
+	// the same onion address across restarts. If a new onion service is
+	// created, its private key will be written to the store.
+	//
+	// NOTE: If nil, the private key of a newly created onion service will
+	// be discarded.
+	Store OnionStore
+
+	// ClientAuthV3 is the set of base32-encoded x25519 public keys of the
+	// clients that are authorized to access the onion service. If empty,
+	// the onion service can be accessed by anyone knowing its address.
+	//
+	// NOTE: This is only supported for V3 onion services.
+	ClientAuthV3 []string
 }
 
 // AddOnion creates an onion service and returns its onion address. Once
@@ -445,26 +498,69 @@ func (c *Controller) AddOnion(cfg AddOnionConfig) (*OnionAddr, error) {
 		}
 	}
 
-	// We'll start off by checking if the file containing the private key
-	// exists. If it does not, then we should request the server to create
-	// a new onion service and return its private key. Otherwise, we'll
-	// request the server to recreate the onion server from our private key.
-	var keyParam string
-	if _, err := os.Stat(cfg.PrivateKeyPath); os.IsNotExist(err) {
+	// Similarly, client authorization is only available for V3 onion
+	// services on recent versions of the Tor server.
+	if len(cfg.ClientAuthV3) != 0 {
+		if cfg.Type != V3 {
+			return nil, errors.New("client authorization is only " +
+				"supported for v3 onion services")
+		}
+		err := supportsVersion(c.version, MinTorV3ClientAuthVersion)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// We'll start off by checking if the store contains a private key. If
+	// it does not, then we should request the server to create a new onion
+	// service and return its private key. Otherwise, we'll request the
+	// server to recreate the onion server from our private key.
+	var privateKey []byte
+	if cfg.Store != nil {
+		var err error
+		privateKey, err = cfg.Store.PrivateKey()
+		if err != nil && err != ErrNoPrivateKey {
+			return nil, fmt.Errorf("unable to retrieve private "+
+				"key: %v", err)
+		}
+	}
+
+	var (
+		keyParam string
+		flags    []string
+	)
+	if len(privateKey) == 0 {
 		switch cfg.Type {
 		case V2:
 			keyParam = "NEW:RSA1024"
 		case V3:
 			keyParam = "NEW:ED25519-V3"
 		}
-	} else {
-		privateKey, err := ioutil.ReadFile(cfg.PrivateKeyPath)
-		if err != nil {
-			return nil, err
+
+		// If there's no store to save the private key to, we'll
+		// instruct the Tor server not to return it at all.
+		if cfg.Store == nil {
+			flags = append(flags, "DiscardPK")
 		}
+	} else {
 		keyParam = string(privateKey)
 	}
 
+	// If client authorization was requested, we'll add each of the
+	// authorized clients' public keys.
+	var clientAuthParam string
+	if len(cfg.ClientAuthV3) != 0 {
+		flags = append(flags, "V3Auth")
+		for _, clientKey := range cfg.ClientAuthV3 {
+			clientAuthParam += fmt.Sprintf("ClientAuthV3=%s ",
+				clientKey)
+		}
+	}
+
+	if len(flags) != 0 {
+		keyParam += fmt.Sprintf(" Flags=%s", strings.Join(flags, ","))
+	}
+
 	// Now, we'll create a mapping from the virtual port to each target
 	// port. If no target ports were specified, we'll use the virtual port
 	// to provide a one-to-one mapping.
@@ -479,9 +575,21 @@ func (c *Controller) AddOnion(cfg AddOnionConfig) (*OnionAddr, error) {
 		}
 	}
 
+	// We'll add any additional port mappings in a deterministic order.
+	extraPorts := make([]int, 0, len(cfg.ExtraPorts))
+	for virtualPort := range cfg.ExtraPorts {
+		extraPorts = append(extraPorts, virtualPort)
+	}
+	sort.Ints(extraPorts)
+	for _, virtualPort := range extraPorts {
+		portParam += fmt.Sprintf("Port=%d,%d ", virtualPort,
+			cfg.ExtraPorts[virtualPort])
+	}
+
 	// Send the command to create the onion service to the Tor server and
 	// await its response.
-	cmd := fmt.Sprintf("ADD_ONION %s %s", keyParam, portParam)
+	cmd := fmt.Sprintf("ADD_ONION %s %s%s", keyParam, portParam,
+		clientAuthParam)
 	_, reply, err := c.sendCommand(cmd)
 	if err != nil {
 		return nil, err
@@ -508,15 +616,12 @@ func (c *Controller) AddOnion(cfg AddOnionConfig) (*OnionAddr, error) {
 	}
 
 	// If a new onion service was created, we'll write its private key to
-	// disk under strict permissions in the event that it needs to be
-	// recreated later on.
-	if privateKey, ok := replyParams["PrivateKey"]; ok {
-		err := ioutil.WriteFile(
-			cfg.PrivateKeyPath, []byte(privateKey), 0600,
-		)
+	// the store in the event that it needs to be recreated later on.
+	if privateKey, ok := replyParams["PrivateKey"]; ok && cfg.Store != nil {
+		err := cfg.Store.StorePrivateKey([]byte(privateKey))
 		if err != nil {
 			return nil, fmt.Errorf("unable to write private key "+
-				"to file: %v", err)
+				"to store: %v", err)
 		}
 	}
 
@@ -528,3 +633,15 @@ func (c *Controller) AddOnion(cfg AddOnionConfig) (*OnionAddr, error) {
 		Port:         cfg.VirtualPort,
 	}, nil
 }
+
+// DelOnion tells the Tor server to remove the onion service with the given
+// service ID, i.e. the onion address without the ".onion" suffix. Any private
+// key stored for the onion service is left untouched, allowing it to be
+// recreated later on.
+func (c *Controller) DelOnion(serviceID string) error {
+	serviceID = strings.TrimSuffix(serviceID, ".onion")
+
+	cmd := fmt.Sprintf("DEL_ONION %s", serviceID)
+	_, _, err := c.sendCommand(cmd)
+	return err
+}
@@ -36,6 +36,10 @@ func TestParseTorVersion(t *testing.T) {
 			version: "1.3.3.6",
 			valid:   true,
 		},
+		{
+			version: "0.3.10.0",
+			valid:   true,
+		},
 		{
 			version: "0.3.3.6-rc",
 			valid:   true,
@@ -74,3 +78,55 @@ func TestParseTorVersion(t *testing.T) {
 		}
 	}
 }
+
+// TestSupportsVersion tests that versions are compared by the value of their
+// individual numbers, rather than as strings.
+func TestSupportsVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		version string
+		valid   bool
+	}{
+		{
+			version: "0.4.6.1",
+			valid:   true,
+		},
+		{
+			version: "0.4.6.10",
+			valid:   true,
+		},
+		{
+			version: "0.4.10.0",
+			valid:   true,
+		},
+		{
+			version: "0.10.0.0",
+			valid:   true,
+		},
+		{
+			version: "0.4.6.0",
+			valid:   false,
+		},
+		{
+			version: "0.4.5.10",
+			valid:   false,
+		},
+		{
+			version: "0.4.6",
+			valid:   false,
+		},
+		{
+			version: "0.4.x.1",
+			valid:   false,
+		},
+	}
+
+	for i, test := range tests {
+		err := supportsVersion(test.version, MinTorV3ClientAuthVersion)
+		if test.valid != (err == nil) {
+			t.Fatalf("test %d with version string %v failed: %v", i,
+				test.version, err)
+		}
+	}
+}
@@ -67,6 +67,13 @@ type ProxyNet struct {
 	// means that our traffic may be harder to correlate as each connection
 	// will now use a distinct circuit.
 	StreamIsolation bool
+
+	// PeerIsolation is a bool that determines if we should isolate the
+	// connections to each distinct address from one another. Unlike
+	// StreamIsolation, subsequent connections to the same address may
+	// reuse an existing circuit. If StreamIsolation is set, then this has
+	// no effect.
+	PeerIsolation bool
 }
 
 // Dial uses the Tor Dial function in order to establish connections through
@@ -77,6 +84,11 @@ func (p *ProxyNet) Dial(network, address string) (net.Conn, error) {
 	default:
 		return nil, errors.New("cannot dial non-tcp network via Tor")
 	}
+
+	if p.PeerIsolation && !p.StreamIsolation {
+		return DialIsolated(address, p.SOCKS, address)
+	}
+
 	return Dial(address, p.SOCKS, p.StreamIsolation)
 }
 
@@ -0,0 +1,190 @@
+package tor
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+var (
+	// ErrNoPrivateKey is an error returned by an OnionStore when there is
+	// no private key stored for the onion service.
+	ErrNoPrivateKey = errors.New("no private key found")
+
+	// ErrEncryptionKeySize is an error returned when attempting to create
+	// an EncryptedOnionStore with a key of the wrong size.
+	ErrEncryptionKeySize = fmt.Errorf("encryption key must be %d bytes",
+		chacha20poly1305.KeySize)
+)
+
+// OnionStore is a store containing the private key of a single onion service.
+// Each onion service lnd sets up, e.g. one for inbound peer connections and
+// one for RPC access, should be backed by its own store, such that the service
+// can be recreated with the same onion address across restarts.
+type OnionStore interface {
+	// StorePrivateKey stores the private key of the onion service,
+	// replacing any existing one.
+	StorePrivateKey(privateKey []byte) error
+
+	// PrivateKey retrieves the private key of the onion service. If no key
+	// has been stored yet, ErrNoPrivateKey is returned.
+	PrivateKey() ([]byte, error)
+
+	// DeletePrivateKey removes the private key of the onion service.
+	DeletePrivateKey() error
+}
+
+// OnionFile is a file-based implementation of the OnionStore interface that
+// stores the private key of an onion service in plaintext.
+type OnionFile struct {
+	privateKeyPath string
+	privateKeyPerm os.FileMode
+}
+
+// A compile-time constraint to ensure OnionFile satisfies the OnionStore
+// interface.
+var _ OnionStore = (*OnionFile)(nil)
+
+// NewOnionFile creates a file-based implementation of the OnionStore interface
+// to store the private key of an onion service at the given path.
+func NewOnionFile(privateKeyPath string, privateKeyPerm os.FileMode) *OnionFile {
+	return &OnionFile{
+		privateKeyPath: privateKeyPath,
+		privateKeyPerm: privateKeyPerm,
+	}
+}
+
+// StorePrivateKey stores the private key of the onion service at the path
+// of the OnionFile.
+func (f *OnionFile) StorePrivateKey(privateKey []byte) error {
+	return ioutil.WriteFile(f.privateKeyPath, privateKey, f.privateKeyPerm)
+}
+
+// PrivateKey retrieves the private key of the onion service from the path of
+// the OnionFile.
+func (f *OnionFile) PrivateKey() ([]byte, error) {
+	privateKey, err := ioutil.ReadFile(f.privateKeyPath)
+	if os.IsNotExist(err) {
+		return nil, ErrNoPrivateKey
+	}
+
+	return privateKey, err
+}
+
+// DeletePrivateKey removes the file containing the private key of the onion
+// service.
+func (f *OnionFile) DeletePrivateKey() error {
+	return os.Remove(f.privateKeyPath)
+}
+
+// EncryptedOnionStore is an OnionStore that encrypts the private key of an
+// onion service before handing it off to an underlying store. This allows the
+// key to be stored at rest encrypted under a key derived from the wallet, so
+// that the onion address of the node can't be hijacked by someone obtaining
+// access to the data directory alone.
+type EncryptedOnionStore struct {
+	store OnionStore
+	key   []byte
+}
+
+// A compile-time constraint to ensure EncryptedOnionStore satisfies the
+// OnionStore interface.
+var _ OnionStore = (*EncryptedOnionStore)(nil)
+
+// NewEncryptedOnionStore creates a new EncryptedOnionStore which encrypts the
+// private key using the passed 32-byte key before storing it in the
+// underlying store.
+func NewEncryptedOnionStore(store OnionStore,
+	key []byte) (*EncryptedOnionStore, error) {
+
+	if len(key) != chacha20poly1305.KeySize {
+		return nil, ErrEncryptionKeySize
+	}
+
+	return &EncryptedOnionStore{
+		store: store,
+		key:   key,
+	}, nil
+}
+
+// StorePrivateKey encrypts the private key of the onion service and stores it
+// within the underlying store. The encrypted blob is the randomized 24-byte
+// nonce followed by the chachapoly ciphertext of the private key.
+func (s *EncryptedOnionStore) StorePrivateKey(privateKey []byte) error {
+	cipher, err := chacha20poly1305.NewX(s.key)
+	if err != nil {
+		return err
+	}
+
+	var nonce [chacha20poly1305.NonceSizeX]byte
+	if _, err := rand.Read(nonce[:]); err != nil {
+		return err
+	}
+
+	ciphertext := cipher.Seal(nonce[:], nonce[:], privateKey, nonce[:])
+
+	return s.store.StorePrivateKey(ciphertext)
+}
+
+// PrivateKey retrieves the encrypted private key of the onion service from the
+// underlying store and decrypts it.
+//
+// NOTE: In order to migrate existing onion services, a private key that was
+// previously stored in plaintext will be returned as is, and re-stored in its
+// encrypted form.
+func (s *EncryptedOnionStore) PrivateKey() ([]byte, error) {
+	blob, err := s.store.PrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	if isPlaintextPrivateKey(blob) {
+		if err := s.StorePrivateKey(blob); err != nil {
+			return nil, fmt.Errorf("unable to encrypt existing "+
+				"private key: %v", err)
+		}
+
+		return blob, nil
+	}
+
+	if len(blob) < chacha20poly1305.NonceSizeX {
+		return nil, fmt.Errorf("encrypted private key too small, must "+
+			"be at least %v bytes", chacha20poly1305.NonceSizeX)
+	}
+
+	cipher, err := chacha20poly1305.NewX(s.key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := blob[:chacha20poly1305.NonceSizeX]
+	ciphertext := blob[chacha20poly1305.NonceSizeX:]
+	privateKey, err := cipher.Open(nil, nonce, ciphertext, nonce)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt private key: %v", err)
+	}
+
+	return privateKey, nil
+}
+
+// DeletePrivateKey removes the private key from the underlying store.
+func (s *EncryptedOnionStore) DeletePrivateKey() error {
+	return s.store.DeletePrivateKey()
+}
+
+// isPlaintextPrivateKey determines whether the blob is a private key in the
+// plaintext format returned by the Tor server, e.g. "ED25519-V3:[Blob]".
+func isPlaintextPrivateKey(blob []byte) bool {
+	for _, prefix := range []string{"RSA1024:", "ED25519-V3:"} {
+		if bytes.HasPrefix(blob, []byte(prefix)) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,148 @@
+package tor
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var (
+	testPrivateKey = []byte("ED25519-V3:test-private-key")
+
+	testEncryptionKey = bytes.Repeat([]byte{0x01}, 32)
+)
+
+// newTestOnionFile creates an OnionFile within a fresh temporary directory,
+// returning a function to clean up the directory.
+func newTestOnionFile(t *testing.T) (*OnionFile, string, func()) {
+	t.Helper()
+
+	tempDir, err := ioutil.TempDir("", "onionstore")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+
+	privateKeyPath := filepath.Join(tempDir, "onion_private_key")
+	cleanUp := func() {
+		os.RemoveAll(tempDir)
+	}
+
+	return NewOnionFile(privateKeyPath, 0600), privateKeyPath, cleanUp
+}
+
+// TestOnionFile tests that the OnionFile properly stores, retrieves and
+// deletes the private key of an onion service.
+func TestOnionFile(t *testing.T) {
+	t.Parallel()
+
+	store, _, cleanUp := newTestOnionFile(t)
+	defer cleanUp()
+
+	if _, err := store.PrivateKey(); err != ErrNoPrivateKey {
+		t.Fatalf("expected ErrNoPrivateKey, got %v", err)
+	}
+
+	if err := store.StorePrivateKey(testPrivateKey); err != nil {
+		t.Fatalf("unable to store private key: %v", err)
+	}
+	privateKey, err := store.PrivateKey()
+	if err != nil {
+		t.Fatalf("unable to retrieve private key: %v", err)
+	}
+	if !bytes.Equal(privateKey, testPrivateKey) {
+		t.Fatalf("expected private key %s, got %s", testPrivateKey,
+			privateKey)
+	}
+
+	if err := store.DeletePrivateKey(); err != nil {
+		t.Fatalf("unable to delete private key: %v", err)
+	}
+	if _, err := store.PrivateKey(); err != ErrNoPrivateKey {
+		t.Fatalf("expected ErrNoPrivateKey, got %v", err)
+	}
+}
+
+// TestEncryptedOnionStore tests that the EncryptedOnionStore never writes the
+// private key in plaintext to the underlying store, can only decrypt it with
+// the correct key, and migrates existing plaintext private keys.
+func TestEncryptedOnionStore(t *testing.T) {
+	t.Parallel()
+
+	fileStore, privateKeyPath, cleanUp := newTestOnionFile(t)
+	defer cleanUp()
+
+	if _, err := NewEncryptedOnionStore(fileStore, []byte{1}); err !=
+		ErrEncryptionKeySize {
+
+		t.Fatalf("expected ErrEncryptionKeySize, got %v", err)
+	}
+
+	// We'll start by writing a plaintext private key to the file, as lnd
+	// would have done before encryption was enabled.
+	if err := fileStore.StorePrivateKey(testPrivateKey); err != nil {
+		t.Fatalf("unable to store private key: %v", err)
+	}
+
+	store, err := NewEncryptedOnionStore(fileStore, testEncryptionKey)
+	if err != nil {
+		t.Fatalf("unable to create encrypted store: %v", err)
+	}
+
+	// Retrieving the private key should return the plaintext key as is,
+	// and encrypt it on disk.
+	privateKey, err := store.PrivateKey()
+	if err != nil {
+		t.Fatalf("unable to retrieve private key: %v", err)
+	}
+	if !bytes.Equal(privateKey, testPrivateKey) {
+		t.Fatalf("expected private key %s, got %s", testPrivateKey,
+			privateKey)
+	}
+
+	blob, err := ioutil.ReadFile(privateKeyPath)
+	if err != nil {
+		t.Fatalf("unable to read private key file: %v", err)
+	}
+	if bytes.Contains(blob, testPrivateKey) {
+		t.Fatalf("private key stored in plaintext")
+	}
+
+	// The encrypted private key should be retrievable from then on.
+	privateKey, err = store.PrivateKey()
+	if err != nil {
+		t.Fatalf("unable to retrieve private key: %v", err)
+	}
+	if !bytes.Equal(privateKey, testPrivateKey) {
+		t.Fatalf("expected private key %s, got %s", testPrivateKey,
+			privateKey)
+	}
+
+	// A store using a different key should fail to decrypt it.
+	wrongKey := bytes.Repeat([]byte{0x02}, 32)
+	wrongStore, err := NewEncryptedOnionStore(fileStore, wrongKey)
+	if err != nil {
+		t.Fatalf("unable to create encrypted store: %v", err)
+	}
+	if _, err := wrongStore.PrivateKey(); err == nil {
+		t.Fatalf("expected decryption with wrong key to fail")
+	}
+}
+
+// TestIsolationAuth ensures that the SOCKS credentials derived for an
+// isolation key are deterministic, and distinct for distinct keys.
+func TestIsolationAuth(t *testing.T) {
+	t.Parallel()
+
+	auth1 := isolationAuth("peer1.onion:9735")
+	auth2 := isolationAuth("peer1.onion:9735")
+	auth3 := isolationAuth("peer2.onion:9735")
+
+	if *auth1 != *auth2 {
+		t.Fatalf("expected identical credentials for the same key")
+	}
+	if auth1.User == auth3.User || auth1.Password == auth3.Password {
+		t.Fatalf("expected distinct credentials for distinct keys")
+	}
+}
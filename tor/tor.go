@@ -1,7 +1,9 @@
 package tor
 
 import (
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
 	"net"
@@ -38,8 +40,20 @@ var (
 		22: "bad truncation",
 		23: "bad/missing server cookie",
 	}
+
+	// isolationSalt is a random salt generated on startup that's used to
+	// derive the SOCKS credentials for connections isolated by key. This
+	// ensures that the credentials can't be linked to the key across
+	// restarts.
+	isolationSalt [32]byte
 )
 
+func init() {
+	if _, err := rand.Read(isolationSalt[:]); err != nil {
+		panic(fmt.Sprintf("unable to generate isolation salt: %v", err))
+	}
+}
+
 // proxyConn is a wrapper around net.Conn that allows us to expose the actual
 // remote address we're dialing, rather than the proxy's address.
 type proxyConn struct {
@@ -60,11 +74,33 @@ func Dial(address, socksAddr string, streamIsolation bool) (net.Conn, error) {
 		return nil, err
 	}
 
+	return newProxyConn(conn, address, socksAddr)
+}
+
+// DialIsolated establishes a connection to the address via Tor's SOCKS proxy,
+// isolating it by the given key. All connections sharing the same isolation
+// key may share a circuit, while connections with distinct keys will never do
+// so. As an example, using the address of a peer as the key isolates the
+// connections to each peer from one another, while still allowing reconnects
+// to the same peer to reuse an existing circuit.
+func DialIsolated(address, socksAddr, isolationKey string) (net.Conn, error) {
+	conn, err := dialWithAuth(address, socksAddr, isolationAuth(isolationKey))
+	if err != nil {
+		return nil, err
+	}
+
+	return newProxyConn(conn, address, socksAddr)
+}
+
+// newProxyConn wraps a connection established through Tor's SOCKS proxy in
+// order to expose the actual remote address we've dialed.
+func newProxyConn(conn net.Conn, address, socksAddr string) (net.Conn, error) {
 	// Now that the connection is established, we'll create our internal
 	// proxyConn that will serve in populating the correct remote address
 	// of the connection, rather than using the proxy's address.
 	remoteAddr, err := ParseAddr(address, socksAddr)
 	if err != nil {
+		conn.Close()
 		return nil, err
 	}
 
@@ -96,6 +132,28 @@ func dial(address, socksAddr string, streamIsolation bool) (net.Conn, error) {
 		}
 	}
 
+	return dialWithAuth(address, socksAddr, auth)
+}
+
+// isolationAuth derives the SOCKS credentials used to isolate connections by
+// the given key. As Tor creates a new circuit for each distinct set of
+// credentials, connections sharing the key will be isolated from all others.
+func isolationAuth(isolationKey string) *proxy.Auth {
+	mac := hmac.New(sha256.New, isolationSalt[:])
+	mac.Write([]byte(isolationKey))
+	b := mac.Sum(nil)
+
+	return &proxy.Auth{
+		User:     hex.EncodeToString(b[:16]),
+		Password: hex.EncodeToString(b[16:]),
+	}
+}
+
+// dialWithAuth establishes a connection to the address via Tor's SOCKS proxy
+// using the given credentials, if any.
+func dialWithAuth(address, socksAddr string, auth *proxy.Auth) (net.Conn,
+	error) {
+
 	// Establish the connection through Tor's SOCKS proxy.
 	dialer, err := proxy.SOCKS5("tcp", socksAddr, auth, proxy.Direct)
 	if err != nil {
@@ -176,6 +176,39 @@ func (u *UnlockerService) GenSeed(ctx context.Context,
 		return nil, err
 	}
 
+	// If the user requested the seed to be split into shares, we'll only
+	// return the shares, such that the full seed is never revealed.
+	if in.ShareThreshold != 0 || in.NumShares != 0 {
+		// The parameters are validated before being narrowed to the
+		// types used by the split, as out of range values would
+		// otherwise silently wrap around.
+		if in.ShareThreshold < 1 || in.ShareThreshold > in.NumShares ||
+			in.NumShares > aezeed.MaxSeedShares {
+
+			return nil, aezeed.ErrInvalidShareParams
+		}
+
+		shares, err := mnemonic.Split(
+			uint8(in.ShareThreshold), uint8(in.NumShares),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		resp := &lnrpc.GenSeedResponse{
+			CipherSeedShares: make(
+				[]*lnrpc.CipherSeedShare, len(shares),
+			),
+		}
+		for i := range shares {
+			resp.CipherSeedShares[i] = &lnrpc.CipherSeedShare{
+				ShareMnemonic: shares[i][:],
+			}
+		}
+
+		return resp, nil
+	}
+
 	// Additionally, we'll also obtain the raw enciphered cipher seed as
 	// well to return to the user.
 	encipheredSeed, err := cipherSeed.Encipher(in.AezeedPassphrase)
@@ -226,6 +259,26 @@ func extractChanBackups(chanBackups *lnrpc.ChanBackupSnapshot) *ChannelsToRecove
 	return &backups
 }
 
+// combineSeedShares is a helper function that combines a set of cipher seed
+// shares from the proto into the cipher seed mnemonic they were split from.
+func combineSeedShares(rpcShares []*lnrpc.CipherSeedShare) (aezeed.Mnemonic,
+	error) {
+
+	shares := make([]aezeed.ShareMnemonic, len(rpcShares))
+	for i, rpcShare := range rpcShares {
+		if len(rpcShare.ShareMnemonic) != aezeed.NumShareMnemonicWords {
+			return aezeed.Mnemonic{}, fmt.Errorf("wrong cipher "+
+				"seed share length: got %v words, expecting "+
+				"%v words", len(rpcShare.ShareMnemonic),
+				aezeed.NumShareMnemonicWords)
+		}
+
+		copy(shares[i][:], rpcShare.ShareMnemonic)
+	}
+
+	return aezeed.CombineShares(shares)
+}
+
 // InitWallet is used when lnd is starting up for the first time to fully
 // initialize the daemon and its internal wallet. At the very least a wallet
 // password must be provided. This will be used to encrypt sensitive material
@@ -272,9 +325,23 @@ func (u *UnlockerService) InitWallet(ctx context.Context,
 
 	// At this point, we know that the wallet doesn't already exist. So
 	// we'll map the user provided aezeed and passphrase into a decoded
-	// cipher seed instance.
+	// cipher seed instance. If the user provided a set of seed shares
+	// instead, we'll first combine them to recover the mnemonic.
 	var mnemonic aezeed.Mnemonic
-	copy(mnemonic[:], in.CipherSeedMnemonic[:])
+	switch {
+	case len(in.CipherSeedShares) != 0 && len(in.CipherSeedMnemonic) != 0:
+		return nil, fmt.Errorf("only one of cipher seed mnemonic and " +
+			"cipher seed shares can be set")
+
+	case len(in.CipherSeedShares) != 0:
+		mnemonic, err = combineSeedShares(in.CipherSeedShares)
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		copy(mnemonic[:], in.CipherSeedMnemonic[:])
+	}
 
 	// If we're unable to map it back into the ciphertext, then either the
 	// mnemonic is wrong, or the passphrase is wrong.
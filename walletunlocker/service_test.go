@@ -170,6 +170,51 @@ func TestGenSeedInvalidEntropy(t *testing.T) {
 	}
 }
 
+// TestGenSeedInvalidShareParams tests that share parameters that are out of
+// range are rejected, rather than being truncated into a valid but different
+// split.
+func TestGenSeedInvalidShareParams(t *testing.T) {
+	t.Parallel()
+
+	testDir, err := ioutil.TempDir("", "testcreate")
+	if err != nil {
+		t.Fatalf("unable to create temp directory: %v", err)
+	}
+	defer func() {
+		os.RemoveAll(testDir)
+	}()
+	service := walletunlocker.New(testDir, testNetParams, nil)
+
+	testCases := []struct {
+		threshold uint32
+		numShares uint32
+	}{
+		// A threshold of 258 would wrap around to 2.
+		{258, 3},
+		{2, 258},
+		{4, 3},
+		{0, 3},
+		{2, aezeed.MaxSeedShares + 1},
+	}
+
+	ctx := context.Background()
+	for _, test := range testCases {
+		genSeedReq := &lnrpc.GenSeedRequest{
+			AezeedPassphrase: []byte("kek"),
+			SeedEntropy:      testEntropy[:],
+			ShareThreshold:   test.threshold,
+			NumShares:        test.numShares,
+		}
+
+		_, err := service.GenSeed(ctx, genSeedReq)
+		if err != aezeed.ErrInvalidShareParams {
+			t.Fatalf("expected ErrInvalidShareParams for %v-of-%v "+
+				"shares, got %v", test.threshold,
+				test.numShares, err)
+		}
+	}
+}
+
 // TestInitWallet tests that the user is able to properly initialize the wallet
 // given an existing cipher seed passphrase.
 func TestInitWallet(t *testing.T) {
@@ -303,6 +348,81 @@ func TestCreateWalletInvalidEntropy(t *testing.T) {
 	}
 }
 
+// TestInitWalletSeedShares tests that a seed generated as a set of M-of-N
+// shares can be used to initialize the wallet given any M of its shares.
+func TestInitWalletSeedShares(t *testing.T) {
+	t.Parallel()
+
+	testDir, err := ioutil.TempDir("", "testcreate")
+	if err != nil {
+		t.Fatalf("unable to create temp directory: %v", err)
+	}
+	defer func() {
+		os.RemoveAll(testDir)
+	}()
+
+	service := walletunlocker.New(testDir, testNetParams, nil)
+
+	// We'll first ask the service to generate a seed split into 2-of-3
+	// shares. Only the shares should be returned.
+	aezeedPass := []byte("kek")
+	genSeedReq := &lnrpc.GenSeedRequest{
+		AezeedPassphrase: aezeedPass,
+		SeedEntropy:      testEntropy[:],
+		ShareThreshold:   2,
+		NumShares:        3,
+	}
+
+	ctx := context.Background()
+	seedResp, err := service.GenSeed(ctx, genSeedReq)
+	if err != nil {
+		t.Fatalf("unable to generate seed: %v", err)
+	}
+	if len(seedResp.CipherSeedMnemonic) != 0 {
+		t.Fatalf("full mnemonic should not be returned with shares")
+	}
+	if len(seedResp.CipherSeedShares) != 3 {
+		t.Fatalf("expected 3 shares, got %v",
+			len(seedResp.CipherSeedShares))
+	}
+
+	// Providing both a mnemonic and a set of shares should fail.
+	req := &lnrpc.InitWalletRequest{
+		WalletPassword:     testPassword,
+		CipherSeedMnemonic: []string{"invalid", "seed"},
+		CipherSeedShares:   seedResp.CipherSeedShares[1:],
+		AezeedPassphrase:   aezeedPass,
+	}
+	if _, err := service.InitWallet(ctx, req); err == nil {
+		t.Fatalf("InitWallet with mnemonic and shares should fail")
+	}
+
+	// A single share isn't enough to recover the seed.
+	req.CipherSeedMnemonic = nil
+	req.CipherSeedShares = seedResp.CipherSeedShares[:1]
+	if _, err := service.InitWallet(ctx, req); err == nil {
+		t.Fatalf("InitWallet with a single share should fail")
+	}
+
+	// With two of the three shares, we should be able to initialize the
+	// wallet with the original entropy.
+	req.CipherSeedShares = seedResp.CipherSeedShares[1:]
+	if _, err := service.InitWallet(ctx, req); err != nil {
+		t.Fatalf("InitWallet call failed: %v", err)
+	}
+
+	select {
+	case msg := <-service.InitMsgs:
+		if msg.WalletSeed.Entropy != testEntropy {
+			t.Fatalf("mismatched entropy: expected %x, got %x",
+				testEntropy[:], msg.WalletSeed.Entropy[:])
+		}
+
+	case <-time.After(3 * time.Second):
+		t.Fatalf("password not received")
+	}
+}
+
 // TestUnlockWallet checks that trying to unlock non-existing wallet fail, that
 // unlocking existing wallet with wrong passphrase fails, and that unlocking
 // existing wallet with correct passphrase succeeds.